@@ -3,6 +3,7 @@ package cmd
 import (
 	"flag"
 	"fmt"
+	"os"
 	"runtime/debug"
 
 	"github.com/derailed/k9s/internal/client"
@@ -42,6 +43,7 @@ func init() {
 	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	color.Disabled = os.Getenv(render.NoColorEnv) != ""
 
 	// Klogs (of course) want to print stuff to the screen ;(
 	klog.InitFlags(nil)
@@ -79,6 +81,10 @@ func run(cmd *cobra.Command, args []string) {
 	}()
 
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+	if isBoolSet(k9sFlags.NoColor) {
+		color.Disabled = true
+	}
+	render.SetMonochrome(color.Disabled)
 	cfg := loadConfiguration()
 	app := view.NewApp(cfg)
 	{
@@ -109,6 +115,14 @@ func loadConfiguration() *config.Config {
 		k9sCfg.K9s.OverrideHeadless(*k9sFlags.Headless)
 	}
 
+	if isBoolSet(k9sFlags.ForceQuit) {
+		k9sCfg.K9s.OverrideForceQuit(true)
+	}
+
+	if isBoolSet(k9sFlags.NoResume) {
+		k9sCfg.K9s.OverrideNoResume(true)
+	}
+
 	if k9sFlags.Command != nil {
 		k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
 	}
@@ -120,16 +134,9 @@ func loadConfiguration() *config.Config {
 	if err := k9sCfg.Refine(k8sFlags); err != nil {
 		log.Panic().Err(err).Msg("Unable to locate kubeconfig file")
 	}
-	k9sCfg.SetConnection(client.InitConnectionOrDie(k8sCfg))
-
-	// Try to access server version if that fail. Connectivity issue?
-	if _, err := k9sCfg.GetConnection().ServerVersion(); err != nil {
-		log.Panic().Err(err).Msg("K9s can't connect to cluster")
-	}
-	log.Info().Msg("✅ Kubernetes connectivity")
-	if err := k9sCfg.Save(); err != nil {
-		log.Error().Err(err).Msg("Config save")
-	}
+	// Connectivity is checked by the app health gate. The config is only
+	// validated and saved once the cluster is reachable.
+	k9sCfg.SetConnection(client.InitConnection(k8sCfg))
 
 	return k9sCfg
 }
@@ -183,7 +190,25 @@ func initK9sFlags() {
 		k9sFlags.Command,
 		"command", "c",
 		config.DefaultCommand,
-		"Specify the default command to view when the application launches",
+		"Specify the default command to view when the application launches ie po or \"dp payments\"",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.NoColor,
+		"no-color",
+		false,
+		"Turn colors off. Also turned off when the "+render.NoColorEnv+" env var is set",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.ForceQuit,
+		"force-quit",
+		false,
+		"Quit without confirming active port-forwards, benchmarks or pins",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.NoResume,
+		"no-resume",
+		false,
+		"Start afresh without offering to resume the previous session",
 	)
 }
 
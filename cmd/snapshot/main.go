@@ -0,0 +1,90 @@
+// Command snapshot prints a resource table as K9s would display it.
+//
+//	snapshot [-kubeconfig path] [-context name] [-n namespace] [-o table|json] po
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/pkg/snapshot"
+)
+
+func main() {
+	var (
+		opts    snapshot.Options
+		output  string
+		timeout time.Duration
+	)
+	flag.StringVar(&opts.KubeConfig, "kubeconfig", "", "Path to the kubeconfig file")
+	flag.StringVar(&opts.Context, "context", "", "The kubeconfig context to use")
+	flag.StringVar(&opts.Namespace, "n", "", "Namespace to snapshot. Defaults to all namespaces")
+	flag.StringVar(&output, "o", "table", "Output format table|json")
+	flag.DurationVar(&timeout, "timeout", 30*time.Second, "Snapshot timeout")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: snapshot [flags] resource")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	t, err := snapshot.Take(ctx, opts, flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "snapshot failed: %s\n", err)
+		os.Exit(1)
+	}
+
+	switch output {
+	case "json":
+		err = printJSON(os.Stdout, t)
+	case "table":
+		err = printTable(os.Stdout, t)
+	default:
+		err = fmt.Errorf("unsupported output format %q", output)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func printJSON(w io.Writer, t *snapshot.Table) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+
+	return e.Encode(t)
+}
+
+// printTable prints the non wide columns followed by the row severity.
+func printTable(w io.Writer, t *snapshot.Table) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	hh := make([]string, 0, len(t.Header)+1)
+	for _, h := range t.Header {
+		if !h.Wide {
+			hh = append(hh, h.Name)
+		}
+	}
+	fmt.Fprintln(tw, strings.Join(append(hh, "SEVERITY"), "\t"))
+	for _, r := range t.Rows {
+		ff := make([]string, 0, len(hh)+1)
+		for i, f := range r.Fields {
+			if i < len(t.Header) && t.Header[i].Wide {
+				continue
+			}
+			ff = append(ff, f)
+		}
+		fmt.Fprintln(tw, strings.Join(append(ff, r.Severity), "\t"))
+	}
+
+	return tw.Flush()
+}
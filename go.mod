@@ -27,7 +27,6 @@ replace (
 )
 
 require (
-	github.com/atotto/clipboard v0.1.2
 	github.com/derailed/tview v0.3.3
 	github.com/docker/spdystream v0.0.0-20181023171402-6480d4af844c // indirect
 	github.com/elazarl/goproxy v0.0.0-20190421051319-9d40249d3c2f // indirect
@@ -43,11 +42,11 @@ require (
 	github.com/kylelemons/godebug v1.1.0 // indirect
 	github.com/mattn/go-runewidth v0.0.5
 	github.com/petergtz/pegomock v2.6.0+incompatible
-	github.com/rakyll/hey v0.1.2
 	github.com/rs/zerolog v1.17.2
 	github.com/sahilm/fuzzy v0.1.0
 	github.com/spf13/cobra v0.0.5
 	github.com/stretchr/testify v1.3.0
+	golang.org/x/net v0.0.0-20190812203447-cdfb69ac37fc
 	golang.org/x/text v0.3.2
 	golang.org/x/time v0.0.0-20190308202827-9d24e82272b4 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
@@ -36,8 +36,10 @@ type Connection interface {
 	Authorizer
 
 	Config() *Config
+	CheckConnectivity() error
 	DialOrDie() kubernetes.Interface
 	SwitchContextOrDie(ctx string)
+	SwitchKubeConfig(path string) error
 	CachedDiscovery() (*disk.CachedDiscoveryClient, error)
 	RestConfigOrDie() *restclient.Config
 	MXDial() (*versioned.Clientset, error)
@@ -63,6 +65,12 @@ type APIClient struct {
 	mx              sync.Mutex
 }
 
+// InitConnection initializes a connection from command line args without
+// dialing the api server. Use CheckConnectivity to probe the cluster.
+func InitConnection(config *Config) *APIClient {
+	return &APIClient{config: config}
+}
+
 // InitConnectionOrDie initialize connection from command line args.
 // Checks for connectivity with the api server.
 func InitConnectionOrDie(config *Config) *APIClient {
@@ -270,6 +278,18 @@ func (a *APIClient) SwitchContextOrDie(ctx string) {
 	}
 }
 
+// SwitchKubeConfig reconnects using a different kubeconfig file.
+func (a *APIClient) SwitchKubeConfig(path string) error {
+	if err := a.config.SwitchKubeConfig(path); err != nil {
+		return err
+	}
+	a.cachedDiscovery = nil
+	a.reset()
+	a.useMetricServer = a.supportsMxServer()
+
+	return nil
+}
+
 func (a *APIClient) reset() {
 	a.mx.Lock()
 	defer a.mx.Unlock()
@@ -3,6 +3,10 @@ package client
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/rs/zerolog/log"
@@ -20,17 +24,32 @@ type Config struct {
 	currentContext string
 	rawConfig      *clientcmdapi.Config
 	restConfig     *restclient.Config
+	deprecations   *Deprecations
+	stats          *RequestStats
+	loadErrs       []error
 	mutex          *sync.RWMutex
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
 func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
-		flags: f,
-		mutex: &sync.RWMutex{},
+		flags:        f,
+		deprecations: NewDeprecations(),
+		stats:        NewRequestStats(),
+		mutex:        &sync.RWMutex{},
 	}
 }
 
+// Deprecations returns the deprecated apis detected on this connection.
+func (c *Config) Deprecations() *Deprecations {
+	return c.deprecations
+}
+
+// Stats returns the api requests metrics recorded on this connection.
+func (c *Config) Stats() *RequestStats {
+	return c.stats
+}
+
 // Flags returns configuration flags.
 func (c *Config) Flags() *genericclioptions.ConfigFlags {
 	return c.flags
@@ -50,6 +69,33 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// SwitchKubeConfig loads a different kubeconfig file. Context overrides are
+// dropped so the file current context becomes active.
+func (c *Config) SwitchKubeConfig(path string) error {
+	abs, err := checkKubeConfig(path)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.flags.KubeConfig = &abs
+	c.flags.Context, c.flags.ClusterName, c.flags.AuthInfoName = nil, nil, nil
+	c.currentContext, c.loadErrs = "", nil
+	c.reset()
+
+	return nil
+}
+
+// LoadWarnings returns the kubeconfig files that failed to load.
+func (c *Config) LoadWarnings() []error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.ensureConfig()
+	return c.loadErrs
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -92,13 +138,43 @@ func (c *Config) Contexts() (map[string]*clientcmdapi.Context, error) {
 
 // DelContext remove a given context from the configuration.
 func (c *Config) DelContext(n string) error {
-	cfg, err := c.RawConfig()
+	current, err := c.CurrentContextName()
 	if err != nil {
 		return err
 	}
-	delete(cfg.Contexts, n)
+	if current == n {
+		return fmt.Errorf("unable to delete current context %s", n)
+	}
+
+	return c.updateContext(n, func(cfg *clientcmdapi.Config) error {
+		delete(cfg.Contexts, n)
+		return nil
+	})
+}
 
-	return clientcmd.ModifyConfig(c.clientConfig.ConfigAccess(), cfg, true)
+// SetContextNamespace sets the default namespace for a given context.
+func (c *Config) SetContextNamespace(n, ns string) error {
+	return c.updateContext(n, func(cfg *clientcmdapi.Config) error {
+		cfg.Contexts[n].Namespace = ns
+		return nil
+	})
+}
+
+// ContextOrigin returns the kubeconfig file a given context was loaded from.
+func (c *Config) ContextOrigin(n string) (string, error) {
+	ctx, err := c.GetContext(n)
+	if err != nil {
+		return "", err
+	}
+	if ctx.LocationOfOrigin != "" {
+		return ctx.LocationOfOrigin, nil
+	}
+
+	acc, err := c.ConfigAccess()
+	if err != nil {
+		return "", err
+	}
+	return acc.GetDefaultFilename(), nil
 }
 
 // ContextNames fetch all available contexts.
@@ -276,27 +352,144 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 		return c.restConfig, nil
 	}
 
+	c.mutex.Lock()
+	c.ensureConfig()
+	cfg := c.clientConfig
+	c.mutex.Unlock()
+
 	var err error
-	if c.restConfig, err = c.flags.ToRESTConfig(); err != nil {
+	if c.restConfig, err = cfg.ClientConfig(); err != nil {
 		return nil, err
 	}
 	log.Debug().Msgf("Connecting to API Server %s", c.restConfig.Host)
+	c.watchWarnings(c.restConfig)
+	c.watchStats(c.restConfig)
 
 	return c.restConfig, nil
 }
 
+func (c *Config) watchWarnings(cfg *restclient.Config) {
+	if c.deprecations == nil {
+		return
+	}
+	wrap := cfg.WrapTransport
+	cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return NewWarningTransport(c.deprecations, rt)
+	}
+}
+
+func (c *Config) watchStats(cfg *restclient.Config) {
+	if c.stats == nil {
+		return
+	}
+	wrap := cfg.WrapTransport
+	cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return NewStatsTransport(c.stats, rt)
+	}
+}
+
 func (c *Config) ensureConfig() {
 	if c.clientConfig != nil {
 		return
 	}
 
 	log.Debug().Msg("Loading raw config from flags...")
-	c.clientConfig = c.flags.ToRawKubeConfigLoader()
+	// An explicit kubeconfig must load while a broken file in the KUBECONFIG
+	// path list is skipped.
+	var files []string
+	if !isSet(c.flags.KubeConfig) {
+		files, c.loadErrs = validKubeConfigs(kubeConfigFiles())
+		for _, err := range c.loadErrs {
+			log.Warn().Err(err).Msg("Kubeconfig load failed")
+		}
+	}
+	c.clientConfig = newClientConfig(c.flags, files)
+}
+
+// updateContext applies a change to the kubeconfig file a context originates
+// from and writes it back.
+func (c *Config) updateContext(n string, f func(*clientcmdapi.Config) error) error {
+	path, err := c.ContextOrigin(n)
+	if err != nil {
+		return err
+	}
+	cfg, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return err
+	}
+	if _, ok := cfg.Contexts[n]; !ok {
+		return fmt.Errorf("unable to locate context %s in %s", n, path)
+	}
+	if err := f(cfg); err != nil {
+		return err
+	}
+	if err := writeKubeConfig(path, cfg); err != nil {
+		return fmt.Errorf("kubeconfig update failed for %s: %w", path, err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.clientConfig, c.rawConfig = nil, nil
+
+	return nil
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// writeKubeConfig saves a kubeconfig to a temporary file and swaps it in place
+// so a failed write never leaves a partial file behind.
+func writeKubeConfig(path string, cfg *clientcmdapi.Config) error {
+	raw, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return err
+	}
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		path = p
+	}
+	mode := os.FileMode(0600)
+	if fi, err := os.Stat(path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if err := writeSync(tmp, raw, mode); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
+
+func writeSync(f *os.File, raw []byte, mode os.FileMode) error {
+	if _, err := f.Write(raw); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Chmod(f.Name(), mode)
+}
+
 func isSet(s *string) bool {
 	return s != nil && len(*s) != 0
 }
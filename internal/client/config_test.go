@@ -3,6 +3,9 @@ package client_test
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -194,20 +197,69 @@ func TestConfigClusterNames(t *testing.T) {
 }
 
 func TestConfigDelContext(t *testing.T) {
-	cluster, kubeConfig := "duh", "./assets/config.1"
+	kubeConfig := copyConfig(t, "./assets/config.1")
+	defer os.RemoveAll(filepath.Dir(kubeConfig))
 	flags := genericclioptions.ConfigFlags{
-		KubeConfig:  &kubeConfig,
-		ClusterName: &cluster,
+		KubeConfig: &kubeConfig,
 	}
 
 	cfg := client.NewConfig(&flags)
-	err := cfg.DelContext("fred")
+	err := cfg.DelContext("blee")
 	assert.Nil(t, err)
 	cc, err := cfg.ContextNames()
 	assert.Nil(t, err)
+	assert.Equal(t, []string{"duh"}, cc)
+
+	cfg = client.NewConfig(&flags)
+	cc, err = cfg.ContextNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"duh"}, cc)
+}
+
+func TestConfigDelCurrentContext(t *testing.T) {
+	ctx, kubeConfig := "duh", copyConfig(t, "./assets/config.1")
+	defer os.RemoveAll(filepath.Dir(kubeConfig))
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+		Context:    &ctx,
+	}
+
+	cfg := client.NewConfig(&flags)
+	err := cfg.DelContext("duh")
+	assert.Equal(t, errors.New("unable to delete current context duh"), err)
+	cc, err := cfg.ContextNames()
+	assert.Nil(t, err)
 	assert.Equal(t, 2, len(cc))
 }
 
+func TestConfigSetContextNamespace(t *testing.T) {
+	kubeConfig := copyConfig(t, "./assets/config.1")
+	defer os.RemoveAll(filepath.Dir(kubeConfig))
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+	}
+
+	cfg := client.NewConfig(&flags)
+	assert.Nil(t, cfg.SetContextNamespace("duh", "ns1"))
+	ctx, err := cfg.GetContext("duh")
+	assert.Nil(t, err)
+	assert.Equal(t, "ns1", ctx.Namespace)
+
+	assert.NotNil(t, cfg.SetContextNamespace("zorg", "ns1"))
+}
+
+func TestConfigContextOrigin(t *testing.T) {
+	kubeConfig := "./assets/config.1"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+	}
+
+	cfg := client.NewConfig(&flags)
+	o, err := cfg.ContextOrigin("duh")
+	assert.Nil(t, err)
+	assert.Equal(t, "config.1", filepath.Base(o))
+}
+
 func TestConfigRestConfig(t *testing.T) {
 	kubeConfig := "./assets/config"
 	flags := genericclioptions.ConfigFlags{
@@ -249,3 +301,17 @@ func TestNamespaceNames(t *testing.T) {
 	assert.Equal(t, 2, len(nns))
 	assert.Equal(t, []string{"ns1", "ns2"}, nns)
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func copyConfig(t *testing.T, path string) string {
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	dir, err := ioutil.TempDir("", "k9s-config")
+	assert.Nil(t, err)
+	cfg := filepath.Join(dir, "config")
+	assert.Nil(t, ioutil.WriteFile(cfg, raw, 0600))
+
+	return cfg
+}
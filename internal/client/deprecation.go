@@ -0,0 +1,243 @@
+package client
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// deprecatedAPIs tracks well known deprecated resources and their replacement.
+var deprecatedAPIs = map[string]string{
+	"extensions/v1beta1/deployments":         "apps/v1",
+	"extensions/v1beta1/daemonsets":          "apps/v1",
+	"extensions/v1beta1/replicasets":         "apps/v1",
+	"extensions/v1beta1/networkpolicies":     "networking.k8s.io/v1",
+	"extensions/v1beta1/podsecuritypolicies": "policy/v1beta1",
+	"extensions/v1beta1/ingresses":           "networking.k8s.io/v1beta1",
+	"apps/v1beta1/deployments":               "apps/v1",
+	"apps/v1beta1/statefulsets":              "apps/v1",
+	"apps/v1beta2/deployments":               "apps/v1",
+	"apps/v1beta2/statefulsets":              "apps/v1",
+	"apps/v1beta2/daemonsets":                "apps/v1",
+	"apps/v1beta2/replicasets":               "apps/v1",
+}
+
+var replacementRX = regexp.MustCompile(`use ([\w.\-/]+)`)
+
+// DeprecatedAPI returns the replacement group/version for a deprecated resource.
+func DeprecatedAPI(gvr string) (string, bool) {
+	r, ok := deprecatedAPIs[gvr]
+	return r, ok
+}
+
+// Deprecation represents a deprecated api in use.
+type Deprecation struct {
+	GVR         string
+	Kind        string
+	Replacement string
+	Message     string
+	Count       int
+}
+
+// DeprecationListener represents a deprecation listener.
+type DeprecationListener interface {
+	// DeprecationAdded notifies a new deprecated api was detected.
+	DeprecationAdded(Deprecation)
+}
+
+// Deprecations aggregates deprecated apis in use.
+type Deprecations struct {
+	items     map[string]*Deprecation
+	listeners []DeprecationListener
+	mx        sync.RWMutex
+}
+
+// NewDeprecations returns a new store.
+func NewDeprecations() *Deprecations {
+	return &Deprecations{items: make(map[string]*Deprecation)}
+}
+
+// AddListener registers a new listener.
+func (d *Deprecations) AddListener(l DeprecationListener) {
+	if d == nil {
+		return
+	}
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	d.listeners = append(d.listeners, l)
+}
+
+// Record tracks a deprecated api and notifies listeners the first time it is seen.
+func (d *Deprecations) Record(dep Deprecation) bool {
+	if d == nil {
+		return false
+	}
+	d.mx.Lock()
+	if item, ok := d.items[dep.GVR]; ok {
+		item.Count++
+		if item.Kind == "" {
+			item.Kind = dep.Kind
+		}
+		if item.Replacement == "" {
+			item.Replacement = dep.Replacement
+		}
+		d.mx.Unlock()
+		return false
+	}
+	dep.Count = 1
+	d.items[dep.GVR] = &dep
+	ll := make([]DeprecationListener, len(d.listeners))
+	copy(ll, d.listeners)
+	d.mx.Unlock()
+
+	for _, l := range ll {
+		l.DeprecationAdded(dep)
+	}
+
+	return true
+}
+
+// Has returns true if the given resource was reported as deprecated.
+func (d *Deprecations) Has(gvr string) bool {
+	if d == nil {
+		return false
+	}
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+	_, ok := d.items[gvr]
+
+	return ok
+}
+
+// List returns all deprecations sorted by resource.
+func (d *Deprecations) List() []Deprecation {
+	if d == nil {
+		return nil
+	}
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+	dd := make([]Deprecation, 0, len(d.items))
+	for _, item := range d.items {
+		dd = append(dd, *item)
+	}
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].GVR < dd[j].GVR
+	})
+
+	return dd
+}
+
+// Clear removes all deprecations.
+func (d *Deprecations) Clear() {
+	if d == nil {
+		return
+	}
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	d.items = make(map[string]*Deprecation)
+}
+
+// ----------------------------------------------------------------------------
+
+// WarningTransport records deprecation warnings surfaced by the api server.
+type WarningTransport struct {
+	deprecations *Deprecations
+	rt           http.RoundTripper
+}
+
+// NewWarningTransport wraps a round tripper to collect warning headers.
+func NewWarningTransport(d *Deprecations, rt http.RoundTripper) *WarningTransport {
+	return &WarningTransport{deprecations: d, rt: rt}
+}
+
+// RoundTrip executes a request and records any deprecation warnings.
+func (w *WarningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := w.rt.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	for _, h := range resp.Header["Warning"] {
+		msg, ok := ParseWarningHeader(h)
+		if !ok || !strings.Contains(strings.ToLower(msg), "deprecated") {
+			continue
+		}
+		gvr, ok := gvrFromPath(req.URL.Path)
+		if !ok {
+			continue
+		}
+		w.deprecations.Record(Deprecation{
+			GVR:         gvr,
+			Replacement: replacement(gvr, msg),
+			Message:     msg,
+		})
+	}
+
+	return resp, nil
+}
+
+// ParseWarningHeader extracts the text of a 299 warning header.
+func ParseWarningHeader(h string) (string, bool) {
+	tokens := strings.SplitN(strings.TrimSpace(h), " ", 3)
+	if len(tokens) != 3 || tokens[0] != "299" {
+		return "", false
+	}
+	text := strings.TrimSpace(tokens[2])
+	if !strings.HasPrefix(text, `"`) {
+		return "", false
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			if i+1 < len(text) {
+				i++
+				b.WriteByte(text[i])
+			}
+		case '"':
+			return b.String(), true
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+
+	return "", false
+}
+
+func replacement(gvr, msg string) string {
+	if r, ok := DeprecatedAPI(gvr); ok {
+		return r
+	}
+	if mm := replacementRX.FindStringSubmatch(msg); len(mm) == 2 {
+		return mm[1]
+	}
+
+	return NA
+}
+
+// gvrFromPath extracts a resource descriptor from an api request path.
+func gvrFromPath(p string) (string, bool) {
+	tokens := strings.Split(strings.Trim(p, "/"), "/")
+	var gv string
+	switch {
+	case len(tokens) >= 3 && tokens[0] == "api":
+		gv, tokens = tokens[1], tokens[2:]
+	case len(tokens) >= 4 && tokens[0] == "apis":
+		gv, tokens = tokens[1]+"/"+tokens[2], tokens[3:]
+	default:
+		return "", false
+	}
+	if len(tokens) > 0 && tokens[0] == "watch" {
+		tokens = tokens[1:]
+	}
+	if len(tokens) >= 3 && tokens[0] == "namespaces" {
+		tokens = tokens[2:]
+	}
+	if len(tokens) == 0 {
+		return "", false
+	}
+
+	return gv + "/" + tokens[0], true
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWarningHeader(t *testing.T) {
+	uu := map[string]struct {
+		h  string
+		e  string
+		ok bool
+	}{
+		"plain":   {h: `299 - "fred is deprecated"`, e: "fred is deprecated", ok: true},
+		"escaped": {h: `299 - "a \"quoted\" api"`, e: `a "quoted" api`, ok: true},
+		"date":    {h: `299 - "blee" "Mon, 01 Jan 2020 00:00:00 GMT"`, e: "blee", ok: true},
+		"code":    {h: `199 - "blee"`},
+		"noQuote": {h: `299 - blee`},
+		"open":    {h: `299 - "blee`},
+		"short":   {h: `299`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			msg, ok := ParseWarningHeader(u.h)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, msg)
+		})
+	}
+}
+
+func TestGvrFromPath(t *testing.T) {
+	uu := map[string]struct {
+		p  string
+		e  string
+		ok bool
+	}{
+		"core":       {p: "/api/v1/pods", e: "v1/pods", ok: true},
+		"coreNS":     {p: "/api/v1/namespaces/default/pods/p1", e: "v1/pods", ok: true},
+		"namespaces": {p: "/api/v1/namespaces/default", e: "v1/namespaces", ok: true},
+		"group":      {p: "/apis/extensions/v1beta1/namespaces/default/ingresses", e: "extensions/v1beta1/ingresses", ok: true},
+		"watch":      {p: "/apis/apps/v1beta2/watch/deployments", e: "apps/v1beta2/deployments", ok: true},
+		"discovery":  {p: "/apis/apps/v1"},
+		"version":    {p: "/version"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, ok := gvrFromPath(u.p)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, gvr)
+		})
+	}
+}
+
+func TestDeprecationsRecord(t *testing.T) {
+	var l depListener
+	d := NewDeprecations()
+	d.AddListener(&l)
+
+	assert.True(t, d.Record(Deprecation{GVR: "extensions/v1beta1/ingresses"}))
+	assert.False(t, d.Record(Deprecation{GVR: "extensions/v1beta1/ingresses", Kind: "Ingress", Replacement: "networking.k8s.io/v1beta1"}))
+	assert.True(t, d.Record(Deprecation{GVR: "apps/v1beta1/deployments", Kind: "Deployment"}))
+
+	assert.Equal(t, 2, len(l.dd))
+	assert.True(t, d.Has("extensions/v1beta1/ingresses"))
+	assert.False(t, d.Has("v1/pods"))
+	assert.Equal(t, []Deprecation{
+		{GVR: "apps/v1beta1/deployments", Kind: "Deployment", Count: 1},
+		{GVR: "extensions/v1beta1/ingresses", Kind: "Ingress", Replacement: "networking.k8s.io/v1beta1", Count: 2},
+	}, d.List())
+
+	d.Clear()
+	assert.Equal(t, 0, len(d.List()))
+}
+
+func TestDeprecationsNil(t *testing.T) {
+	var d *Deprecations
+
+	assert.False(t, d.Record(Deprecation{GVR: "fred"}))
+	assert.False(t, d.Has("fred"))
+	assert.Nil(t, d.List())
+}
+
+func TestWarningTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/extensions/v1beta1/namespaces/default/ingresses":
+			w.Header().Add("Warning", `299 - "extensions/v1beta1 Ingress is deprecated in v1.14+, unavailable in v1.22+; use networking.k8s.io/v1 Ingress"`)
+		case "/apis/batch/v1beta1/cronjobs":
+			w.Header().Add("Warning", `299 - "batch/v1beta1 CronJob is deprecated in v1.21+, unavailable in v1.25+; use batch/v1 CronJob"`)
+		case "/api/v1/pods":
+			w.Header().Add("Warning", `299 - "spec.foo: unknown field"`)
+		}
+	}))
+	defer srv.Close()
+
+	d := NewDeprecations()
+	c := http.Client{Transport: NewWarningTransport(d, http.DefaultTransport)}
+	for _, p := range []string{
+		"/apis/extensions/v1beta1/namespaces/default/ingresses",
+		"/apis/batch/v1beta1/cronjobs",
+		"/api/v1/pods",
+	} {
+		resp, err := c.Get(srv.URL + p)
+		assert.Nil(t, err)
+		assert.Nil(t, resp.Body.Close())
+	}
+
+	dd := d.List()
+	assert.Equal(t, 2, len(dd))
+	assert.Equal(t, "batch/v1beta1/cronjobs", dd[0].GVR)
+	assert.Equal(t, "batch/v1", dd[0].Replacement)
+	assert.Equal(t, "extensions/v1beta1/ingresses", dd[1].GVR)
+	assert.Equal(t, "networking.k8s.io/v1beta1", dd[1].Replacement)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type depListener struct {
+	dd []Deprecation
+}
+
+func (l *depListener) DeprecationAdded(d Deprecation) {
+	l.dd = append(l.dd, d)
+}
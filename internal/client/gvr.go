@@ -158,6 +158,8 @@ func mapVerb(v string) ([]string, error) {
 		return []string{"delete"}, nil
 	case "edit":
 		return []string{"patch", "update"}, nil
+	case "watch":
+		return []string{"watch"}, nil
 	default:
 		return []string{}, fmt.Errorf("no standard verb for %q", v)
 	}
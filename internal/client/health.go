@@ -0,0 +1,108 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	// ConnDNS indicates the api server host could not be resolved.
+	ConnDNS = "DNS"
+	// ConnTLS indicates the api server certificate could not be verified.
+	ConnTLS = "TLS"
+	// ConnAuth indicates the api server rejected the credentials.
+	ConnAuth = "Auth"
+	// ConnTimeout indicates the api server did not respond in time.
+	ConnTimeout = "Timeout"
+	// ConnRefused indicates nothing is listening on the api server address.
+	ConnRefused = "Refused"
+	// ConnConfig indicates the kubeconfig does not yield a valid client config.
+	ConnConfig = "Config"
+	// ConnUnknown indicates an unclassified connection failure.
+	ConnUnknown = "Unknown"
+
+	healthTimeout = 5 * time.Second
+)
+
+// CheckConnectivity probes the api server with a short timeout. Cached
+// clients are dropped first so kubeconfig changes made while disconnected
+// are honored.
+func (a *APIClient) CheckConnectivity() error {
+	a.mx.Lock()
+	a.cachedDiscovery = nil
+	a.mx.Unlock()
+	a.reset()
+
+	cfg, err := a.config.RESTConfig()
+	if err != nil {
+		return &ConfigError{err: err}
+	}
+	cfg = restclient.CopyConfig(cfg)
+	cfg.Timeout = healthTimeout
+	c, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return &ConfigError{err: err}
+	}
+	if _, err := c.Discovery().ServerVersion(); err != nil {
+		return err
+	}
+	log.Info().Msg("✅ Kubernetes connectivity")
+	a.useMetricServer = a.supportsMxServer()
+
+	return nil
+}
+
+// ConfigError indicates a connection could not be configured.
+type ConfigError struct {
+	err error
+}
+
+// Error returns the error message.
+func (e *ConfigError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ConfigError) Unwrap() error {
+	return e.err
+}
+
+// ConnErrorKind classifies a connectivity error.
+func ConnErrorKind(err error) string {
+	var (
+		cfgErr  *ConfigError
+		dnsErr  *net.DNSError
+		netErr  net.Error
+		authErr x509.UnknownAuthorityError
+		certErr x509.CertificateInvalidError
+		hostErr x509.HostnameError
+		recErr  tls.RecordHeaderError
+	)
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &cfgErr):
+		return ConnConfig
+	case kerrors.IsUnauthorized(err), kerrors.IsForbidden(err):
+		return ConnAuth
+	case errors.As(err, &dnsErr):
+		return ConnDNS
+	case errors.As(err, &authErr), errors.As(err, &certErr), errors.As(err, &hostErr), errors.As(err, &recErr):
+		return ConnTLS
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ConnRefused
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ConnTimeout
+	default:
+		return ConnUnknown
+	}
+}
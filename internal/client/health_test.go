@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestConnErrorKind(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   string
+	}{
+		"none": {},
+		"dns": {
+			err: &url.Error{Op: "Get", URL: "https://fred:6443", Err: &net.DNSError{Err: "no such host", Name: "fred"}},
+			e:   client.ConnDNS,
+		},
+		"tls": {
+			err: &url.Error{Op: "Get", URL: "https://fred:6443", Err: x509.UnknownAuthorityError{}},
+			e:   client.ConnTLS,
+		},
+		"hostname": {
+			err: fmt.Errorf("blee: %w", x509.HostnameError{Certificate: &x509.Certificate{}, Host: "fred"}),
+			e:   client.ConnTLS,
+		},
+		"unauthorized": {
+			err: kerrors.NewUnauthorized("bad token"),
+			e:   client.ConnAuth,
+		},
+		"forbidden": {
+			err: kerrors.NewForbidden(schema.GroupResource{}, "", errors.New("denied")),
+			e:   client.ConnAuth,
+		},
+		"refused": {
+			err: &url.Error{Op: "Get", URL: "https://fred:6443", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}},
+			e:   client.ConnRefused,
+		},
+		"dnsTimeout": {
+			err: &url.Error{Op: "Get", URL: "https://fred:6443", Err: &net.DNSError{IsTimeout: true}},
+			e:   client.ConnDNS,
+		},
+		"timeout": {
+			err: &net.OpError{Op: "dial", Err: timeoutError{}},
+			e:   client.ConnTimeout,
+		},
+		"unknown": {
+			err: errors.New("blee"),
+			e:   client.ConnUnknown,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.ConnErrorKind(u.err))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
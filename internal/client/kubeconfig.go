@@ -0,0 +1,135 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// kubeConfigFiles returns the KUBECONFIG path list or the default kubeconfig.
+func kubeConfigFiles() []string {
+	return clientcmd.NewDefaultClientConfigLoadingRules().GetLoadingPrecedence()
+}
+
+// validKubeConfigs weeds out the kubeconfig files that fail to load so a
+// broken file does not prevent the others from loading. Missing files are
+// skipped as kubectl does.
+func validKubeConfigs(ff []string) ([]string, []error) {
+	var (
+		valid []string
+		errs  []error
+	)
+	seen := make(map[string]struct{}, len(ff))
+	for _, f := range ff {
+		if f == "" {
+			continue
+		}
+		if _, ok := seen[f]; ok {
+			continue
+		}
+		seen[f] = struct{}{}
+		if _, err := clientcmd.LoadFromFile(f); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("kubeconfig %s skipped: %w", f, err))
+			continue
+		}
+		valid = append(valid, f)
+	}
+
+	return valid, errs
+}
+
+// newClientConfig loads an explicit kubeconfig or merges the given files
+// using the KUBECONFIG precedence rules and applies the cli overrides.
+func newClientConfig(flags *genericclioptions.ConfigFlags, files []string) clientcmd.ClientConfig {
+	rules := clientcmd.ClientConfigLoadingRules{
+		Precedence:          files,
+		DefaultClientConfig: &clientcmd.DefaultClientConfig,
+	}
+	if isSet(flags.KubeConfig) {
+		rules.ExplicitPath = *flags.KubeConfig
+	}
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(&rules, overridesFrom(flags))
+}
+
+func overridesFrom(flags *genericclioptions.ConfigFlags) *clientcmd.ConfigOverrides {
+	o := clientcmd.ConfigOverrides{ClusterDefaults: clientcmd.ClusterDefaults}
+	if flags.CertFile != nil {
+		o.AuthInfo.ClientCertificate = *flags.CertFile
+	}
+	if flags.KeyFile != nil {
+		o.AuthInfo.ClientKey = *flags.KeyFile
+	}
+	if flags.BearerToken != nil {
+		o.AuthInfo.Token = *flags.BearerToken
+	}
+	if flags.Impersonate != nil {
+		o.AuthInfo.Impersonate = *flags.Impersonate
+	}
+	if flags.ImpersonateGroup != nil {
+		o.AuthInfo.ImpersonateGroups = *flags.ImpersonateGroup
+	}
+	if flags.Username != nil {
+		o.AuthInfo.Username = *flags.Username
+	}
+	if flags.Password != nil {
+		o.AuthInfo.Password = *flags.Password
+	}
+	if flags.APIServer != nil {
+		o.ClusterInfo.Server = *flags.APIServer
+	}
+	if flags.CAFile != nil {
+		o.ClusterInfo.CertificateAuthority = *flags.CAFile
+	}
+	if flags.Insecure != nil {
+		o.ClusterInfo.InsecureSkipTLSVerify = *flags.Insecure
+	}
+	if flags.Context != nil {
+		o.CurrentContext = *flags.Context
+	}
+	if flags.ClusterName != nil {
+		o.Context.Cluster = *flags.ClusterName
+	}
+	if flags.AuthInfoName != nil {
+		o.Context.AuthInfo = *flags.AuthInfoName
+	}
+	if flags.Namespace != nil {
+		o.Context.Namespace = *flags.Namespace
+	}
+	if flags.Timeout != nil {
+		o.Timeout = *flags.Timeout
+	}
+
+	return &o
+}
+
+// checkKubeConfig ensures a kubeconfig file can be switched to.
+func checkKubeConfig(path string) (string, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, path[2:])
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	cfg, err := clientcmd.LoadFromFile(abs)
+	if err != nil {
+		return "", fmt.Errorf("invalid kubeconfig %s: %w", path, err)
+	}
+	if len(cfg.Contexts) == 0 {
+		return "", fmt.Errorf("kubeconfig %s defines no contexts", path)
+	}
+
+	return abs, nil
+}
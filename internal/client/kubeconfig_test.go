@@ -0,0 +1,119 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestConfigKubeConfigList(t *testing.T) {
+	dir := kubeConfigDir(t)
+	defer os.RemoveAll(dir)
+	list := strings.Join([]string{
+		filepath.Join(dir, "zorg"),
+		"./assets/config",
+		filepath.Join(dir, "bork"),
+		filepath.Join(dir, "missing"),
+	}, string(os.PathListSeparator))
+	defer os.Setenv("KUBECONFIG", os.Getenv("KUBECONFIG"))
+	os.Setenv("KUBECONFIG", list)
+
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{})
+	cc, err := cfg.ContextNames()
+	assert.Nil(t, err)
+	sort.Strings(cc)
+	assert.Equal(t, []string{"blee", "duh", "fred", "zorg"}, cc)
+
+	ctx, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "zorg", ctx)
+
+	o, err := cfg.ContextOrigin("fred")
+	assert.Nil(t, err)
+	assert.Equal(t, "config", filepath.Base(o))
+
+	ee := cfg.LoadWarnings()
+	assert.Equal(t, 1, len(ee))
+	assert.Contains(t, ee[0].Error(), "bork")
+}
+
+func TestConfigKubeConfigExplicit(t *testing.T) {
+	dir := kubeConfigDir(t)
+	defer os.RemoveAll(dir)
+	defer os.Setenv("KUBECONFIG", os.Getenv("KUBECONFIG"))
+	os.Setenv("KUBECONFIG", filepath.Join(dir, "zorg"))
+
+	kubeConfig := "./assets/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	cc, err := cfg.ContextNames()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(cc))
+	assert.Equal(t, 0, len(cfg.LoadWarnings()))
+}
+
+func TestConfigSwitchKubeConfig(t *testing.T) {
+	dir := kubeConfigDir(t)
+	defer os.RemoveAll(dir)
+	ctx, kubeConfig := "blee", "./assets/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+		Context:    &ctx,
+	})
+	current, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", current)
+
+	zorg := filepath.Join(dir, "zorg")
+	assert.Nil(t, cfg.SwitchKubeConfig(zorg))
+	assert.Equal(t, zorg, *cfg.Flags().KubeConfig)
+	current, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "zorg", current)
+	cc, err := cfg.ContextNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"zorg"}, cc)
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://zorg:6443", rc.Host)
+
+	assert.NotNil(t, cfg.SwitchKubeConfig(filepath.Join(dir, "bork")))
+	assert.NotNil(t, cfg.SwitchKubeConfig(filepath.Join(dir, "missing")))
+	assert.Equal(t, zorg, *cfg.Flags().KubeConfig)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+const zorgConfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://zorg:6443
+  name: zorg
+contexts:
+- context:
+    cluster: zorg
+    user: zorg
+  name: zorg
+current-context: zorg
+users:
+- name: zorg
+  user:
+    token: blee
+`
+
+func kubeConfigDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "k9s-kubeconfig")
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "zorg"), []byte(zorgConfig), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "bork"), []byte("contexts: [:"), 0600))
+
+	return dir
+}
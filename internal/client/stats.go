@@ -0,0 +1,269 @@
+package client
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// histBucketsPerOctave sets the histogram resolution, ie each bucket
+	// spans about 19% of its lower bound.
+	histBucketsPerOctave = 4
+	// histBuckets spans latencies from 1ms to about 17mn.
+	histBuckets = 20*histBucketsPerOctave + 1
+	histFloor   = time.Millisecond
+)
+
+// Histogram tracks latencies in exponential buckets. Percentiles are
+// approximated by the upper bound of the bucket holding the rank.
+type Histogram struct {
+	counts [histBuckets]uint64
+	total  uint64
+	sum    time.Duration
+	max    time.Duration
+}
+
+// Observe records a latency.
+func (h *Histogram) Observe(d time.Duration) {
+	h.counts[bucketFor(d)]++
+	h.total++
+	h.sum += d
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// Count returns the number of observations.
+func (h *Histogram) Count() uint64 {
+	return h.total
+}
+
+// Mean returns the average latency.
+func (h *Histogram) Mean() time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+
+	return h.sum / time.Duration(h.total)
+}
+
+// Percentile returns the latency under which p percent of the observations
+// fall. The max observed latency caps the estimate.
+func (h *Histogram) Percentile(p float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(p / 100 * float64(h.total)))
+	if rank == 0 {
+		rank = 1
+	}
+	var n uint64
+	for i, c := range h.counts {
+		if n += c; n >= rank {
+			// The last bucket is unbounded.
+			if b := bucketBound(i); i < histBuckets-1 && b < h.max {
+				return b
+			}
+			return h.max
+		}
+	}
+
+	return h.max
+}
+
+func bucketFor(d time.Duration) int {
+	if d <= histFloor {
+		return 0
+	}
+	i := int(math.Ceil(math.Log2(float64(d)/float64(histFloor)) * histBucketsPerOctave))
+	if i >= histBuckets {
+		return histBuckets - 1
+	}
+
+	return i
+}
+
+// bucketBound returns a bucket upper bound.
+func bucketBound(i int) time.Duration {
+	return time.Duration(float64(histFloor) * math.Exp2(float64(i)/histBucketsPerOctave))
+}
+
+// ----------------------------------------------------------------------------
+
+// RequestStat represents the api requests metrics for a verb.
+type RequestStat struct {
+	Verb          string
+	Count, Errors uint64
+	Mean          time.Duration
+	P50, P90, P99 time.Duration
+}
+
+// ErrorRate returns the percentage of failed requests.
+func (r RequestStat) ErrorRate() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+
+	return float64(r.Errors) * 100 / float64(r.Count)
+}
+
+type verbStats struct {
+	errors  uint64
+	latency Histogram
+}
+
+// RequestStats aggregates api server requests metrics per verb.
+type RequestStats struct {
+	verbs map[string]*verbStats
+	since time.Time
+	mx    sync.Mutex
+}
+
+// NewRequestStats returns a new store.
+func NewRequestStats() *RequestStats {
+	return &RequestStats{verbs: make(map[string]*verbStats), since: time.Now()}
+}
+
+// Record tracks a request outcome.
+func (s *RequestStats) Record(verb string, d time.Duration, failed bool) {
+	if s == nil {
+		return
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	v, ok := s.verbs[verb]
+	if !ok {
+		v = &verbStats{}
+		s.verbs[verb] = v
+	}
+	v.latency.Observe(d)
+	if failed {
+		v.errors++
+	}
+}
+
+// Since returns the time the metrics were last reset.
+func (s *RequestStats) Since() time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.since
+}
+
+// List returns the requests metrics sorted by verb.
+func (s *RequestStats) List() []RequestStat {
+	if s == nil {
+		return nil
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	rr := make([]RequestStat, 0, len(s.verbs))
+	for k, v := range s.verbs {
+		rr = append(rr, RequestStat{
+			Verb:   k,
+			Count:  v.latency.Count(),
+			Errors: v.errors,
+			Mean:   v.latency.Mean(),
+			P50:    v.latency.Percentile(50),
+			P90:    v.latency.Percentile(90),
+			P99:    v.latency.Percentile(99),
+		})
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Verb < rr[j].Verb
+	})
+
+	return rr
+}
+
+// Reset zeroes all metrics.
+func (s *RequestStats) Reset() {
+	if s == nil {
+		return
+	}
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.verbs, s.since = make(map[string]*verbStats), time.Now()
+}
+
+// ----------------------------------------------------------------------------
+
+// StatsTransport records api requests latencies and failures. Responses are
+// passed through untouched so upgraded exec and port-forward connections
+// keep working.
+type StatsTransport struct {
+	stats *RequestStats
+	rt    http.RoundTripper
+}
+
+// NewStatsTransport wraps a round tripper to collect requests metrics.
+func NewStatsTransport(s *RequestStats, rt http.RoundTripper) *StatsTransport {
+	return &StatsTransport{stats: s, rt: rt}
+}
+
+// RoundTrip executes a request and records its latency.
+func (s *StatsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := s.rt.RoundTrip(req)
+	s.stats.Record(requestVerb(req), time.Since(start), err != nil || resp == nil || resp.StatusCode >= http.StatusBadRequest)
+
+	return resp, err
+}
+
+// requestVerb maps an api request to its resource verb.
+func requestVerb(req *http.Request) string {
+	if strings.EqualFold(req.Header.Get("Connection"), "upgrade") {
+		return "connect"
+	}
+	switch req.Method {
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	case http.MethodGet:
+		if req.URL.Query().Get("watch") == "true" || strings.Contains(req.URL.Path, "/watch/") {
+			return "watch"
+		}
+		if isNamedResource(req.URL.Path) {
+			return "get"
+		}
+		return "list"
+	default:
+		return strings.ToLower(req.Method)
+	}
+}
+
+// isNamedResource checks if an api path targets a single resource.
+func isNamedResource(p string) bool {
+	tokens := strings.Split(strings.Trim(p, "/"), "/")
+	switch {
+	case len(tokens) >= 3 && tokens[0] == "api":
+		tokens = tokens[2:]
+	case len(tokens) >= 4 && tokens[0] == "apis":
+		tokens = tokens[3:]
+	default:
+		return false
+	}
+	if len(tokens) >= 2 && tokens[0] == "namespaces" {
+		if len(tokens) == 2 {
+			return true
+		}
+		tokens = tokens[2:]
+	}
+
+	return len(tokens) >= 2
+}
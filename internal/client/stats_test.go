@@ -0,0 +1,198 @@
+package client
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestHistogramPercentile(t *testing.T) {
+	var h Histogram
+	for i := 1; i <= 100; i++ {
+		h.Observe(time.Duration(i) * time.Millisecond)
+	}
+
+	assert.Equal(t, uint64(100), h.Count())
+	assert.Equal(t, 50500*time.Microsecond, h.Mean())
+	uu := map[string]struct {
+		p   float64
+		min time.Duration
+	}{
+		"p50": {p: 50, min: 50 * time.Millisecond},
+		"p90": {p: 90, min: 90 * time.Millisecond},
+		"p99": {p: 99, min: 99 * time.Millisecond},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := h.Percentile(u.p)
+			assert.True(t, v >= u.min, "%s too low %v", k, v)
+			assert.True(t, float64(v) <= float64(u.min)*1.2, "%s too high %v", k, v)
+		})
+	}
+	assert.Equal(t, 100*time.Millisecond, h.Percentile(100))
+}
+
+func TestHistogramEdges(t *testing.T) {
+	var h Histogram
+	assert.Equal(t, time.Duration(0), h.Percentile(99))
+	assert.Equal(t, time.Duration(0), h.Mean())
+
+	h.Observe(0)
+	h.Observe(time.Hour)
+	assert.Equal(t, histFloor, h.Percentile(50))
+	assert.Equal(t, time.Hour, h.Percentile(100))
+	assert.Equal(t, 0, bucketFor(-time.Second))
+	assert.Equal(t, histBuckets-1, bucketFor(time.Hour))
+}
+
+func TestBucketBound(t *testing.T) {
+	for _, d := range []time.Duration{2 * time.Millisecond, 15 * time.Millisecond, 3 * time.Second} {
+		i := bucketFor(d)
+		assert.True(t, bucketBound(i) >= d)
+		assert.True(t, bucketBound(i-1) < d)
+	}
+}
+
+func TestRequestVerb(t *testing.T) {
+	uu := map[string]struct {
+		method, url string
+		upgrade     bool
+		e           string
+	}{
+		"list":      {method: "GET", url: "/api/v1/namespaces/default/pods", e: "list"},
+		"listAll":   {method: "GET", url: "/apis/apps/v1/deployments", e: "list"},
+		"get":       {method: "GET", url: "/api/v1/namespaces/default/pods/p1", e: "get"},
+		"getNS":     {method: "GET", url: "/api/v1/namespaces/default", e: "get"},
+		"getNode":   {method: "GET", url: "/api/v1/nodes/n1", e: "get"},
+		"logs":      {method: "GET", url: "/api/v1/namespaces/default/pods/p1/log", e: "get"},
+		"watch":     {method: "GET", url: "/api/v1/pods?watch=true", e: "watch"},
+		"create":    {method: "POST", url: "/api/v1/namespaces/default/pods", e: "create"},
+		"update":    {method: "PUT", url: "/api/v1/namespaces/default/pods/p1", e: "update"},
+		"patch":     {method: "PATCH", url: "/api/v1/namespaces/default/pods/p1", e: "patch"},
+		"delete":    {method: "DELETE", url: "/api/v1/namespaces/default/pods/p1", e: "delete"},
+		"exec":      {method: "POST", url: "/api/v1/namespaces/default/pods/p1/exec", upgrade: true, e: "connect"},
+		"discovery": {method: "GET", url: "/apis", e: "list"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			req := httptest.NewRequest(u.method, u.url, nil)
+			if u.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+			}
+			assert.Equal(t, u.e, requestVerb(req))
+		})
+	}
+}
+
+func TestRequestStats(t *testing.T) {
+	s := NewRequestStats()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.Record("list", time.Duration(i)*time.Millisecond, i%5 == 0)
+		}(i)
+	}
+	wg.Wait()
+	s.Record("get", time.Millisecond, false)
+
+	rr := s.List()
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, "get", rr[0].Verb)
+	assert.Equal(t, "list", rr[1].Verb)
+	assert.Equal(t, uint64(10), rr[1].Count)
+	assert.Equal(t, uint64(2), rr[1].Errors)
+	assert.Equal(t, 20.0, rr[1].ErrorRate())
+
+	since := s.Since()
+	s.Reset()
+	assert.Equal(t, 0, len(s.List()))
+	assert.False(t, s.Since().Before(since))
+
+	var n *RequestStats
+	n.Record("get", 0, false)
+	assert.Nil(t, n.List())
+}
+
+func TestStatsTransportUpgrade(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		conn, buff, err := w.(http.Hijacker).Hijack()
+		assert.Nil(t, err)
+		defer conn.Close()
+		_, _ = buff.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: echo\r\n\r\n")
+		_ = buff.Flush()
+		line, _ := buff.ReadString('\n')
+		_, _ = buff.WriteString(line)
+		_ = buff.Flush()
+	}))
+	defer srv.Close()
+
+	s := NewRequestStats()
+	c := Config{stats: s}
+	cfg := restclient.Config{}
+	c.watchStats(&cfg)
+	rt, err := restclient.HTTPWrappersForConfig(&cfg, http.DefaultTransport)
+	assert.Nil(t, err)
+
+	req, err := http.NewRequest("POST", srv.URL+"/api/v1/namespaces/default/pods/p1/exec", nil)
+	assert.Nil(t, err)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "echo")
+	resp, err := rt.RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+	conn, ok := resp.Body.(io.ReadWriteCloser)
+	assert.True(t, ok)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello\n"))
+	assert.Nil(t, err)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	assert.Nil(t, err)
+	assert.Equal(t, "hello\n", line)
+
+	req, err = http.NewRequest("GET", srv.URL+"/api/v1/pods", nil)
+	assert.Nil(t, err)
+	resp, err = rt.RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Body.Close())
+
+	rr := s.List()
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, RequestStat{Verb: "connect", Count: 1}, RequestStat{Verb: rr[0].Verb, Count: rr[0].Count, Errors: rr[0].Errors})
+	assert.Equal(t, "list", rr[1].Verb)
+	assert.Equal(t, uint64(1), rr[1].Errors)
+}
+
+func BenchmarkStatsTransport(b *testing.B) {
+	rt := NewStatsTransport(NewRequestStats(), nopTransport{})
+	req := httptest.NewRequest("GET", "/api/v1/namespaces/default/pods/p1", nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = rt.RoundTrip(req)
+	}
+}
+
+// Helpers...
+
+type nopTransport struct{}
+
+func (nopTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
@@ -22,8 +22,14 @@ const (
 	Bold = 1
 )
 
+// Disabled turns colorization off.
+var Disabled bool
+
 // Colorize returns an ASCII colored string based on given color.
 func Colorize(s string, c Paint) string {
+	if Disabled {
+		return s
+	}
 	if c == 0 {
 		c = White
 	}
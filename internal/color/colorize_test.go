@@ -24,3 +24,10 @@ func TestColorize(t *testing.T) {
 		})
 	}
 }
+
+func TestColorizeDisabled(t *testing.T) {
+	Disabled = true
+	defer func() { Disabled = false }()
+
+	assert.Equal(t, "blee", Colorize("blee", Red))
+}
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -21,10 +24,18 @@ type (
 	// Benchmarks tracks K9s benchmarks configuration.
 	Benchmarks struct {
 		Defaults   Benchmark              `yaml:"defaults"`
+		Retention  Retention              `yaml:"retention"`
 		Services   map[string]BenchConfig `yam':"services"`
 		Containers map[string]BenchConfig `yam':"containers"`
 	}
 
+	// Retention represents benchmark reports retention limits. Zero values disable a limit.
+	Retention struct {
+		MaxReports int           `yaml:"maxReports"`
+		MaxAge     time.Duration `yaml:"maxAge"`
+		MaxSizeMB  int64         `yaml:"maxSizeMB"`
+	}
+
 	// Auth basic auth creds
 	Auth struct {
 		User     string `yaml:"user"`
@@ -39,12 +50,13 @@ type (
 
 	// HTTP represents an http request.
 	HTTP struct {
-		Method  string      `yaml:"method"`
-		Host    string      `yaml:"host"`
-		Path    string      `yaml:"path"`
-		HTTP2   bool        `yaml:"http2"`
-		Body    string      `yaml:"body"`
-		Headers http.Header `yaml:"headers"`
+		Method  string        `yaml:"method"`
+		Host    string        `yaml:"host"`
+		Path    string        `yaml:"path"`
+		HTTP2   bool          `yaml:"http2"`
+		Body    string        `yaml:"body"`
+		Headers http.Header   `yaml:"headers"`
+		Timeout time.Duration `yaml:"timeout"`
 	}
 
 	// BenchConfig represents a service benchmark.
@@ -64,6 +76,8 @@ const (
 	DefaultN = 200
 	// DefaultMethod default http verb.
 	DefaultMethod = "GET"
+	// DefaultMaxReports default number of reports kept per benchmark target.
+	DefaultMaxReports = 50
 )
 
 func newBenchmark() Benchmark {
@@ -79,7 +93,8 @@ func (b Benchmark) empty() bool {
 
 func newBenchmarks() *Benchmarks {
 	return &Benchmarks{
-		Defaults: newBenchmark(),
+		Defaults:  newBenchmark(),
+		Retention: Retention{MaxReports: DefaultMaxReports},
 	}
 }
 
@@ -95,6 +110,38 @@ func (s *Bench) Reload(path string) error {
 	return s.load(path)
 }
 
+// Save writes the benchmarks configuration to disk.
+func (s *Bench) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0644)
+}
+
+// SetContainer records a container benchmark configuration.
+func (s *Bench) SetContainer(id string, cfg BenchConfig) {
+	if s.Benchmarks.Containers == nil {
+		s.Benchmarks.Containers = make(map[string]BenchConfig)
+	}
+	cfg.Name = ""
+	s.Benchmarks.Containers[id] = cfg
+}
+
+// Validate checks a benchmark configuration can be run.
+func (b BenchConfig) Validate() error {
+	if b.C <= 0 || b.N < b.C {
+		return fmt.Errorf("Invalid benchmark requests %d for concurrency %d", b.N, b.C)
+	}
+	if strings.TrimSpace(b.HTTP.Method) == "" {
+		return fmt.Errorf("Invalid benchmark method %q", b.HTTP.Method)
+	}
+
+	return nil
+}
+
 // Load K9s benchmark configs from file
 func (s *Bench) load(path string) error {
 	f, err := ioutil.ReadFile(path)
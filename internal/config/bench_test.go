@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -188,3 +192,56 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchRetention(t *testing.T) {
+	b, err := NewBench("test_assets/b_retention.yml")
+
+	assert.Nil(t, err)
+	assert.Equal(t, Retention{
+		MaxReports: DefaultMaxReports,
+		MaxAge:     72 * time.Hour,
+		MaxSizeMB:  100,
+	}, b.Benchmarks.Retention)
+}
+
+func TestBenchConfigValidate(t *testing.T) {
+	uu := map[string]struct {
+		cfg BenchConfig
+		err bool
+	}{
+		"ok":          {cfg: BenchConfig{C: 2, N: 10, HTTP: HTTP{Method: "GET"}}},
+		"noC":         {cfg: BenchConfig{C: 0, N: 10, HTTP: HTTP{Method: "GET"}}, err: true},
+		"fewRequests": {cfg: BenchConfig{C: 5, N: 2, HTTP: HTTP{Method: "GET"}}, err: true},
+		"noMethod":    {cfg: BenchConfig{C: 1, N: 2, HTTP: HTTP{Method: " "}}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err, u.cfg.Validate() != nil)
+		})
+	}
+}
+
+func TestBenchSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	b, err := NewBench("test_assets/b_containers.yml")
+	assert.Nil(t, err)
+	b.SetContainer("default/nginx:nginx", BenchConfig{C: 5, N: 50, Name: "blee", HTTP: HTTP{Method: "GET", Path: "/zorg"}})
+
+	path := filepath.Join(dir, "bench-fred.yml")
+	assert.Nil(t, b.Save(path))
+	b1, err := NewBench(path)
+	assert.Nil(t, err)
+
+	co := b1.Benchmarks.Containers["default/nginx:nginx"]
+	assert.Equal(t, 5, co.C)
+	assert.Equal(t, 50, co.N)
+	assert.Equal(t, "/zorg", co.HTTP.Path)
+	assert.Equal(t, "", co.Name)
+	assert.Equal(t, len(b.Benchmarks.Containers), len(b1.Benchmarks.Containers))
+	assert.Equal(t, b.Benchmarks.Defaults, b1.Benchmarks.Defaults)
+}
@@ -4,8 +4,12 @@ import "github.com/derailed/k9s/internal/client"
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace *Namespace `yaml:"namespace"`
-	View      *View      `yaml:"view"`
+	Namespace    *Namespace    `yaml:"namespace"`
+	View         *View         `yaml:"view"`
+	PortForwards []PortForward `yaml:"portForwards,omitempty"`
+	// LastForwards tracks the last successful local:container port mapping
+	// per container ID.
+	LastForwards map[string]string `yaml:"lastForwards,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
@@ -25,3 +29,18 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 	}
 	c.View.Validate()
 }
+
+// LastForward returns the last successful local:container port mapping for
+// a given container ID.
+func (c *Cluster) LastForward(id string) (string, bool) {
+	m, ok := c.LastForwards[id]
+	return m, ok
+}
+
+// SetLastForward records a successful port mapping for a given container ID.
+func (c *Cluster) SetLastForward(id, lport, cport string) {
+	if c.LastForwards == nil {
+		c.LastForwards = make(map[string]string)
+	}
+	c.LastForwards[id] = lport + ":" + cport
+}
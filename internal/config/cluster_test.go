@@ -42,6 +42,20 @@ func TestClusterValidateEmpty(t *testing.T) {
 	assert.Equal(t, []string{"default"}, c.Namespace.Favorites)
 }
 
+func TestClusterLastForward(t *testing.T) {
+	c := config.NewCluster()
+	_, ok := c.LastForward("default/nginx:nginx")
+	assert.False(t, ok)
+
+	c.SetLastForward("default/nginx:nginx", "8080", "80")
+	c.SetLastForward("default/nginx:nginx", "9090", "80")
+	m, ok := c.LastForward("default/nginx:nginx")
+	assert.True(t, ok)
+	assert.Equal(t, "9090:80", m)
+	_, ok = c.LastForward("default/nginx:sidecar")
+	assert.False(t, ok)
+}
+
 func namespaces() []v1.Namespace {
 	return []v1.Namespace{
 		{
@@ -59,7 +59,7 @@ func NewConfig(ks KubeSettings) *Config {
 
 // Refine the configuration based on cli args.
 func (c *Config) Refine(flags *genericclioptions.ConfigFlags) error {
-	cfg, err := flags.ToRawKubeConfigLoader().RawConfig()
+	cfg, err := client.NewConfig(flags).RawConfig()
 	if err != nil {
 		return err
 	}
@@ -128,6 +128,31 @@ func (c *Config) FavNamespaces() []string {
 	return []string{}
 }
 
+// RecentNamespaces returns the current cluster namespaces from most to least
+// recently used.
+func (c *Config) RecentNamespaces() []string {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.Namespace.Recent
+	}
+	return []string{}
+}
+
+// LastPortForward returns the last port mapping used on a container in the
+// current cluster.
+func (c *Config) LastPortForward(id string) (string, bool) {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		return cl.LastForward(id)
+	}
+	return "", false
+}
+
+// SetLastPortForward records a container port mapping in the current cluster.
+func (c *Config) SetLastPortForward(id, lport, cport string) {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		cl.SetLastForward(id, lport, cport)
+	}
+}
+
 // SetActiveNamespace set the active namespace in the current cluster.
 func (c *Config) SetActiveNamespace(ns string) error {
 	if c.K9s.ActiveCluster() != nil {
@@ -139,18 +164,20 @@ func (c *Config) SetActiveNamespace(ns string) error {
 	return err
 }
 
-// ActiveView returns the active view in the current cluster.
+// ActiveView returns the view to launch. A command flag wins over the
+// configured default view which wins over the current cluster active view.
 func (c *Config) ActiveView() string {
+	if c.K9s.manualCommand != nil && *c.K9s.manualCommand != "" {
+		return *c.K9s.manualCommand
+	}
+	if c.K9s.DefaultView != "" {
+		return c.K9s.DefaultView
+	}
 	if c.K9s.ActiveCluster() == nil {
 		return defaultView
 	}
 
-	cmd := c.K9s.ActiveCluster().View.Active
-	if c.K9s.manualCommand != nil && *c.K9s.manualCommand != "" {
-		cmd = *c.K9s.manualCommand
-	}
-
-	return cmd
+	return c.K9s.ActiveCluster().View.Active
 }
 
 // SetActiveView set the currently cluster active view
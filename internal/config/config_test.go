@@ -160,6 +160,42 @@ func TestConfigActiveViewBlank(t *testing.T) {
 	assert.Equal(t, "po", cfg.ActiveView())
 }
 
+func TestConfigActiveViewOverrides(t *testing.T) {
+	uu := map[string]struct {
+		defaultView, command, e string
+	}{
+		"cluster": {
+			e: "ctx",
+		},
+		"defaultView": {
+			defaultView: "dp",
+			e:           "dp",
+		},
+		"command": {
+			defaultView: "dp",
+			command:     "svc kube-system",
+			e:           "svc kube-system",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewConfig(NewMockKubeSettings())
+			assert.Nil(t, cfg.Load("test_assets/k9s.yml"))
+			cfg.K9s.DefaultView = u.defaultView
+			cfg.K9s.OverrideCommand(u.command)
+			assert.Equal(t, u.e, cfg.ActiveView())
+		})
+	}
+}
+
+func TestConfigActiveViewBlankCommand(t *testing.T) {
+	cfg := config.Config{K9s: new(config.K9s)}
+	cfg.K9s.OverrideCommand("dp")
+	assert.Equal(t, "dp", cfg.ActiveView())
+}
+
 func TestConfigSetActiveView(t *testing.T) {
 	mk := NewMockKubeSettings()
 	cfg := config.NewConfig(mk)
@@ -18,6 +18,9 @@ type Flags struct {
 	Headless      *bool
 	Command       *string
 	AllNamespaces *bool
+	NoColor       *bool
+	ForceQuit     *bool
+	NoResume      *bool
 }
 
 // NewFlags returns new configuration flags.
@@ -28,6 +31,9 @@ func NewFlags() *Flags {
 		Headless:      boolPtr(false),
 		Command:       strPtr(DefaultCommand),
 		AllNamespaces: boolPtr(false),
+		NoColor:       boolPtr(false),
+		ForceQuit:     boolPtr(false),
+		NoResume:      boolPtr(false),
 	}
 }
 
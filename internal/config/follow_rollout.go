@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+const defaultFollowTimeout = 5 * time.Minute
+
+// FollowRollout tracks a deployment rollout right after it was scaled or
+// restarted from K9s.
+type FollowRollout struct {
+	// Enable switches to the rollout follow view once a deployment mutates.
+	Enable bool `yaml:"enable,omitempty"`
+	// Timeout sets how long the rollout is followed at most.
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// GetTimeout returns how long a rollout is followed for.
+func (f FollowRollout) GetTimeout() time.Duration {
+	if d := toDuration(f.Timeout); d > 0 {
+		return d
+	}
+
+	return defaultFollowTimeout
+}
@@ -1,11 +1,23 @@
 package config
 
-import "github.com/derailed/k9s/internal/client"
+import (
+	"path"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
 
 const (
-	defaultRefreshRate    = 2
-	defaultLogRequestSize = 200
-	defaultLogBufferSize  = 1000
+	defaultRefreshRate     = 2
+	defaultLogRequestSize  = 200
+	defaultLogBufferSize   = 1000
+	defaultKillTimeout     = 10
+	defaultProblemRestarts = 3
+	defaultDebugImage      = "busybox:1.31"
+	defaultMaxInformers    = 20
+
+	// KeymapSafe requires destructive actions to be keyed in twice.
+	KeymapSafe = "safe"
 )
 
 // K9s tracks K9s configuration options.
@@ -14,12 +26,33 @@ type K9s struct {
 	Headless          bool                `yaml:"headless"`
 	LogBufferSize     int                 `yaml:"logBufferSize"`
 	LogRequestSize    int                 `yaml:"logRequestSize"`
+	Clipboard         string              `yaml:"clipboard,omitempty"`
+	KillTimeout       int                 `yaml:"killTimeout,omitempty"`
+	ProblemRestarts   int                 `yaml:"problemRestarts,omitempty"`
+	PortForwardTTL    string              `yaml:"portForwardTTL,omitempty"`
+	PortForwardIdle   string              `yaml:"portForwardIdleTimeout,omitempty"`
+	DisableLogReopen  bool                `yaml:"disableLogReopen,omitempty"`
+	Advanced          bool                `yaml:"advanced,omitempty"`
+	DebugImage        string              `yaml:"debugImage,omitempty"`
+	DefaultView       string              `yaml:"defaultView,omitempty"`
+	EnableMouse       bool                `yaml:"enableMouse,omitempty"`
+	DisableResume     bool                `yaml:"disableResume,omitempty"`
+	AbsoluteTime      bool                `yaml:"absoluteTime,omitempty"`
+	Keymap            string              `yaml:"keymap,omitempty"`
+	MaxInformers      int                 `yaml:"maxInformers,omitempty"`
+	ProtectedNS       []string            `yaml:"protectedNamespaces,omitempty"`
+	Notifications     Notifications       `yaml:"notifications,omitempty"`
+	RestartStorm      RestartStorm        `yaml:"restartStorm,omitempty"`
+	FollowRollout     FollowRollout       `yaml:"followRollout,omitempty"`
+	StatusServer      StatusServer        `yaml:"statusServer,omitempty"`
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
 	manualCommand     *string
+	manualForceQuit   bool
+	manualNoResume    bool
 }
 
 // NewK9s create a new K9s configuration.
@@ -32,6 +65,24 @@ func NewK9s() *K9s {
 	}
 }
 
+// IsProtected checks if a namespace matches one of the protected namespace
+// glob patterns.
+func (k *K9s) IsProtected(ns string) bool {
+	if ns == "" {
+		return false
+	}
+	for _, p := range k.ProtectedNS {
+		if p == ns {
+			return true
+		}
+		if ok, err := path.Match(p, ns); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // OverrideRefreshRate set the refresh rate manually.
 func (k *K9s) OverrideRefreshRate(r int) {
 	k.manualRefreshRate = r
@@ -42,6 +93,26 @@ func (k *K9s) OverrideHeadless(b bool) {
 	k.manualHeadless = &b
 }
 
+// OverrideForceQuit skips the quit confirmation manually.
+func (k *K9s) OverrideForceQuit(b bool) {
+	k.manualForceQuit = b
+}
+
+// ForceQuit checks if quitting skips the confirmation.
+func (k *K9s) ForceQuit() bool {
+	return k.manualForceQuit
+}
+
+// OverrideNoResume opts out of resuming the previous session manually.
+func (k *K9s) OverrideNoResume(b bool) {
+	k.manualNoResume = b
+}
+
+// ResumeDisabled checks if sessions are neither saved nor resumed.
+func (k *K9s) ResumeDisabled() bool {
+	return k.DisableResume || k.manualNoResume
+}
+
 // OverrideCommand set the command manually.
 func (k *K9s) OverrideCommand(cmd string) {
 	k.manualCommand = &cmd
@@ -67,6 +138,57 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
+// GetKillTimeout returns the time given to a container to exit before being killed.
+func (k *K9s) GetKillTimeout() time.Duration {
+	if k.KillTimeout <= 0 {
+		return defaultKillTimeout * time.Second
+	}
+
+	return time.Duration(k.KillTimeout) * time.Second
+}
+
+// GetProblemRestarts returns the restart count above which a pod is deemed a problem.
+func (k *K9s) GetProblemRestarts() int {
+	if k.ProblemRestarts <= 0 {
+		return defaultProblemRestarts
+	}
+
+	return k.ProblemRestarts
+}
+
+// GetPortForwardTTL returns the default port-forward time to live. Zero means no TTL.
+func (k *K9s) GetPortForwardTTL() time.Duration {
+	return toDuration(k.PortForwardTTL)
+}
+
+// GetPortForwardIdle returns the default port-forward idle timeout. Zero means no timeout.
+func (k *K9s) GetPortForwardIdle() time.Duration {
+	return toDuration(k.PortForwardIdle)
+}
+
+// GetDebugImage returns the image used by privileged node helper pods.
+func (k *K9s) GetDebugImage() string {
+	if k.DebugImage == "" {
+		return defaultDebugImage
+	}
+
+	return k.DebugImage
+}
+
+// GetMaxInformers returns the number of resource informers to retain.
+func (k *K9s) GetMaxInformers() int {
+	if k.MaxInformers <= 0 {
+		return defaultMaxInformers
+	}
+
+	return k.MaxInformers
+}
+
+// SafeKeymap checks if destructive actions require a two-key sequence.
+func (k *K9s) SafeKeymap() bool {
+	return k.Keymap == KeymapSafe
+}
+
 // ActiveCluster returns the currently active cluster.
 func (k *K9s) ActiveCluster() *Cluster {
 	if k.Clusters == nil {
@@ -134,3 +256,15 @@ func (k *K9s) Validate(c client.Connection, ks KubeSettings) {
 	}
 	k.Clusters[k.CurrentCluster].Validate(c, ks)
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toDuration(s string) time.Duration {
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0
+	}
+
+	return d
+}
@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	m "github.com/petergtz/pegomock"
@@ -79,3 +80,135 @@ func TestK9sActiveCluster(t *testing.T) {
 	assert.Equal(t, "kube-system", cl.Namespace.Active)
 	assert.Equal(t, 5, len(cl.Namespace.Favorites))
 }
+
+func TestK9sGetKillTimeout(t *testing.T) {
+	var c config.K9s
+	assert.Equal(t, 10*time.Second, c.GetKillTimeout())
+
+	c.KillTimeout = 3
+	assert.Equal(t, 3*time.Second, c.GetKillTimeout())
+}
+
+func TestK9sGetProblemRestarts(t *testing.T) {
+	c := config.NewK9s()
+	assert.Equal(t, 3, c.GetProblemRestarts())
+
+	c.ProblemRestarts = 10
+	assert.Equal(t, 10, c.GetProblemRestarts())
+}
+
+func TestK9sGetPortForwardExpiry(t *testing.T) {
+	c := config.NewK9s()
+	assert.Equal(t, time.Duration(0), c.GetPortForwardTTL())
+	assert.Equal(t, time.Duration(0), c.GetPortForwardIdle())
+
+	c.PortForwardTTL, c.PortForwardIdle = "8h", "30m"
+	assert.Equal(t, 8*time.Hour, c.GetPortForwardTTL())
+	assert.Equal(t, 30*time.Minute, c.GetPortForwardIdle())
+
+	c.PortForwardTTL, c.PortForwardIdle = "-1h", "blee"
+	assert.Equal(t, time.Duration(0), c.GetPortForwardTTL())
+	assert.Equal(t, time.Duration(0), c.GetPortForwardIdle())
+}
+
+func TestK9sGetDebugImage(t *testing.T) {
+	c := config.NewK9s()
+	assert.Equal(t, "busybox:1.31", c.GetDebugImage())
+
+	c.DebugImage = "nicolaka/netshoot"
+	assert.Equal(t, "nicolaka/netshoot", c.GetDebugImage())
+}
+
+func TestK9sGetMaxInformers(t *testing.T) {
+	c := config.NewK9s()
+	assert.Equal(t, 20, c.GetMaxInformers())
+
+	c.MaxInformers = 5
+	assert.Equal(t, 5, c.GetMaxInformers())
+
+	c.MaxInformers = -1
+	assert.Equal(t, 20, c.GetMaxInformers())
+}
+
+func TestK9sSafeKeymap(t *testing.T) {
+	c := config.NewK9s()
+	assert.False(t, c.SafeKeymap())
+
+	c.Keymap = config.KeymapSafe
+	assert.True(t, c.SafeKeymap())
+
+	c.Keymap = "blee"
+	assert.False(t, c.SafeKeymap())
+}
+
+func TestK9sNotificationsTimeout(t *testing.T) {
+	c := config.NewK9s()
+	assert.Equal(t, 5*time.Second, c.Notifications.GetTimeout())
+
+	c.Notifications.Timeout = "2s"
+	assert.Equal(t, 2*time.Second, c.Notifications.GetTimeout())
+
+	c.Notifications.Timeout = "blee"
+	assert.Equal(t, 5*time.Second, c.Notifications.GetTimeout())
+}
+
+func TestK9sRestartStorm(t *testing.T) {
+	c := config.NewK9s()
+	assert.Equal(t, 10, c.RestartStorm.GetThreshold())
+	assert.Equal(t, 5*time.Minute, c.RestartStorm.GetWindow())
+
+	c.RestartStorm.Threshold, c.RestartStorm.Window = 3, "90s"
+	assert.Equal(t, 3, c.RestartStorm.GetThreshold())
+	assert.Equal(t, 90*time.Second, c.RestartStorm.GetWindow())
+
+	c.RestartStorm.Threshold, c.RestartStorm.Window = -1, "blee"
+	assert.Equal(t, 10, c.RestartStorm.GetThreshold())
+	assert.Equal(t, 5*time.Minute, c.RestartStorm.GetWindow())
+}
+
+func TestK9sFollowRollout(t *testing.T) {
+	c := config.NewK9s()
+	assert.False(t, c.FollowRollout.Enable)
+	assert.Equal(t, 5*time.Minute, c.FollowRollout.GetTimeout())
+
+	c.FollowRollout.Timeout = "90s"
+	assert.Equal(t, 90*time.Second, c.FollowRollout.GetTimeout())
+
+	c.FollowRollout.Timeout = "blee"
+	assert.Equal(t, 5*time.Minute, c.FollowRollout.GetTimeout())
+}
+
+func TestK9sIsProtected(t *testing.T) {
+	uu := map[string]struct {
+		pp []string
+		ns string
+		e  bool
+	}{
+		"none":      {ns: "kube-system"},
+		"exact":     {pp: []string{"kube-system"}, ns: "kube-system", e: true},
+		"glob":      {pp: []string{"kube-system", "prod-*"}, ns: "prod-east", e: true},
+		"globMiss":  {pp: []string{"prod-*"}, ns: "preprod-east"},
+		"class":     {pp: []string{"prod-[ab]"}, ns: "prod-b", e: true},
+		"single":    {pp: []string{"prod-?"}, ns: "prod-10"},
+		"allNS":     {pp: []string{"*"}, ns: "", e: false},
+		"badGlob":   {pp: []string{"prod-["}, ns: "prod-a"},
+		"literal":   {pp: []string{"prod-["}, ns: "prod-[", e: true},
+		"wildcards": {pp: []string{"*"}, ns: "default", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.K9s{ProtectedNS: u.pp}
+			assert.Equal(t, u.e, c.IsProtected(u.ns))
+		})
+	}
+}
+
+func TestK9sForceQuit(t *testing.T) {
+	c := config.NewK9s()
+	assert.False(t, c.ForceQuit())
+
+	c.OverrideForceQuit(true)
+	assert.True(t, c.ForceQuit())
+}
@@ -71,6 +71,21 @@ func (mock *MockConnection) CanI(_param0 string, _param1 string, _param2 []strin
 	return ret0, ret1
 }
 
+func (mock *MockConnection) CheckConnectivity() error {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockConnection().")
+	}
+	params := []pegomock.Param{}
+	result := pegomock.GetGenericMockFrom(mock).Invoke("CheckConnectivity", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(error)
+		}
+	}
+	return ret0
+}
+
 func (mock *MockConnection) Config() *client.Config {
 	if mock == nil {
 		panic("mock must not be nil. Use myMock := NewMockConnection().")
@@ -283,6 +298,21 @@ func (mock *MockConnection) SwitchContextOrDie(_param0 string) {
 	pegomock.GetGenericMockFrom(mock).Invoke("SwitchContextOrDie", params, []reflect.Type{})
 }
 
+func (mock *MockConnection) SwitchKubeConfig(_param0 string) error {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockConnection().")
+	}
+	params := []pegomock.Param{_param0}
+	result := pegomock.GetGenericMockFrom(mock).Invoke("SwitchKubeConfig", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(error)
+		}
+	}
+	return ret0
+}
+
 func (mock *MockConnection) ValidNamespaces() ([]v1.Namespace, error) {
 	if mock == nil {
 		panic("mock must not be nil. Use myMock := NewMockConnection().")
@@ -391,6 +421,23 @@ func (c *MockConnection_CanI_OngoingVerification) GetAllCapturedArguments() (_pa
 	return
 }
 
+func (verifier *VerifierMockConnection) CheckConnectivity() *MockConnection_CheckConnectivity_OngoingVerification {
+	params := []pegomock.Param{}
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "CheckConnectivity", params, verifier.timeout)
+	return &MockConnection_CheckConnectivity_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockConnection_CheckConnectivity_OngoingVerification struct {
+	mock              *MockConnection
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockConnection_CheckConnectivity_OngoingVerification) GetCapturedArguments() {
+}
+
+func (c *MockConnection_CheckConnectivity_OngoingVerification) GetAllCapturedArguments() {
+}
+
 func (verifier *VerifierMockConnection) Config() *MockConnection_Config_OngoingVerification {
 	params := []pegomock.Param{}
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Config", params, verifier.timeout)
@@ -666,6 +713,33 @@ func (c *MockConnection_SwitchContextOrDie_OngoingVerification) GetAllCapturedAr
 	return
 }
 
+func (verifier *VerifierMockConnection) SwitchKubeConfig(_param0 string) *MockConnection_SwitchKubeConfig_OngoingVerification {
+	params := []pegomock.Param{_param0}
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SwitchKubeConfig", params, verifier.timeout)
+	return &MockConnection_SwitchKubeConfig_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockConnection_SwitchKubeConfig_OngoingVerification struct {
+	mock              *MockConnection
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockConnection_SwitchKubeConfig_OngoingVerification) GetCapturedArguments() string {
+	_param0 := c.GetAllCapturedArguments()
+	return _param0[len(_param0)-1]
+}
+
+func (c *MockConnection_SwitchKubeConfig_OngoingVerification) GetAllCapturedArguments() (_param0 []string) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]string, len(params[0]))
+		for u, param := range params[0] {
+			_param0[u] = param.(string)
+		}
+	}
+	return
+}
+
 func (verifier *VerifierMockConnection) ValidNamespaces() *MockConnection_ValidNamespaces_OngoingVerification {
 	params := []pegomock.Param{}
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "ValidNamespaces", params, verifier.timeout)
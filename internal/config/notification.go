@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+const defaultNotifyTimeout = 5 * time.Second
+
+// Notifications tracks how watch alerts are surfaced beyond flash messages.
+type Notifications struct {
+	// Bell rings the terminal bell on warnings and errors.
+	Bell bool `yaml:"bell,omitempty"`
+	// Command runs an external notifier. Each argument is a Go template with
+	// access to .Level, .Message and .Context.
+	Command string `yaml:"command,omitempty"`
+	// Timeout kills the notifier command once expired.
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// GetTimeout returns the notifier command timeout.
+func (n Notifications) GetTimeout() time.Duration {
+	if d := toDuration(n.Timeout); d > 0 {
+		return d
+	}
+
+	return defaultNotifyTimeout
+}
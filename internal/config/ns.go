@@ -8,14 +8,18 @@ import (
 const (
 	// MaxFavoritesNS number # favorite namespaces to keep in the configuration.
 	MaxFavoritesNS = 9
-	defaultNS      = "default"
-	allNS          = "all"
+	// MaxRecentNS number # recently used namespaces to keep in the configuration.
+	MaxRecentNS = 10
+
+	defaultNS = "default"
+	allNS     = "all"
 )
 
-// Namespace tracks active and favorites namespaces.
+// Namespace tracks active, favorites and recently used namespaces.
 type Namespace struct {
 	Active    string   `yaml:"active"`
 	Favorites []string `yaml:"favorites"`
+	Recent    []string `yaml:"recent,omitempty"`
 }
 
 // NewNamespace create a new namespace configuration.
@@ -43,6 +47,13 @@ func (n *Namespace) Validate(c client.Connection, ks KubeSettings) {
 			n.rmFavNS(ns)
 		}
 	}
+	recent := make([]string, 0, len(n.Recent))
+	for _, ns := range n.Recent {
+		if ns == allNS || InList(nn, ns) {
+			recent = append(recent, ns)
+		}
+	}
+	n.Recent = recent
 }
 
 // SetActive set the active namespace.
@@ -51,6 +62,7 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	n.Active = ns
 	if ns != "" {
 		n.addFavNS(ns)
+		n.touchNS(ns)
 	}
 	return nil
 }
@@ -88,3 +100,19 @@ func (n *Namespace) rmFavNS(ns string) {
 
 	n.Favorites = append(n.Favorites[:victim], n.Favorites[victim+1:]...)
 }
+
+// touchNS moves a namespace to the front of the recently used namespaces
+// evicting the least recently used ones.
+func (n *Namespace) touchNS(ns string) {
+	mru := make([]string, 0, MaxRecentNS)
+	mru = append(mru, ns)
+	for _, r := range n.Recent {
+		if len(mru) == MaxRecentNS {
+			break
+		}
+		if r != ns {
+			mru = append(mru, r)
+		}
+	}
+	n.Recent = mru
+}
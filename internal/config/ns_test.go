@@ -92,3 +92,34 @@ func TestNSValidateRmFavs(t *testing.T) {
 
 	assert.Equal(t, []string{"default"}, ns.Favorites)
 }
+
+func TestNSSetActiveRecent(t *testing.T) {
+	mk := NewMockKubeSettings()
+	ns := config.NewNamespace()
+	for i := 0; i < config.MaxRecentNS+2; i++ {
+		assert.Nil(t, ns.SetActive(fmt.Sprintf("ns%d", i), mk))
+	}
+
+	assert.Equal(t, config.MaxRecentNS, len(ns.Recent))
+	assert.Equal(t, "ns11", ns.Recent[0])
+	assert.Equal(t, "ns2", ns.Recent[config.MaxRecentNS-1])
+
+	assert.Nil(t, ns.SetActive("ns5", mk))
+	assert.Nil(t, ns.SetActive("", mk))
+	assert.Equal(t, config.MaxRecentNS, len(ns.Recent))
+	assert.Equal(t, []string{"ns5", "ns11", "ns10", "ns9"}, ns.Recent[:4])
+	assert.Equal(t, "ns2", ns.Recent[config.MaxRecentNS-1])
+}
+
+func TestNSValidateRmRecent(t *testing.T) {
+	mc := NewMockConnection()
+	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
+	mk := NewMockKubeSettings()
+	m.When(mk.NamespaceNames(namespaces())).ThenReturn([]string{"default", "kube-system"})
+
+	ns := config.NewNamespace()
+	ns.Recent = []string{"fred", "blee", "kube-system", "all", "zorg", "default"}
+	ns.Validate(mc, mk)
+
+	assert.Equal(t, []string{"kube-system", "all", "default"}, ns.Recent)
+}
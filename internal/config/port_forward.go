@@ -0,0 +1,50 @@
+package config
+
+import (
+	"errors"
+	"strings"
+)
+
+// PortForward represents a port-forward K9s establishes on startup.
+type PortForward struct {
+	Namespace string   `yaml:"namespace"`
+	Workload  string   `yaml:"workload,omitempty"`
+	Selector  string   `yaml:"selector,omitempty"`
+	Container string   `yaml:"container"`
+	Ports     []string `yaml:"ports"`
+	Address   string   `yaml:"address,omitempty"`
+}
+
+// Target returns the forward workload ie deploy/fred or pod selector.
+func (p PortForward) Target() string {
+	if p.Workload != "" {
+		return p.Workload
+	}
+
+	return p.Selector
+}
+
+// Path returns the forward fully qualified target.
+func (p PortForward) Path() string {
+	return p.Namespace + "/" + p.Target() + ":" + p.Container
+}
+
+// Validate checks a port-forward spec.
+func (p PortForward) Validate() error {
+	switch {
+	case p.Namespace == "":
+		return errors.New("no namespace specified")
+	case p.Workload == "" && p.Selector == "":
+		return errors.New("a workload or a pod selector must be specified")
+	case p.Workload != "" && p.Selector != "":
+		return errors.New("workload and pod selector are mutually exclusive")
+	case p.Workload != "" && len(strings.Split(p.Workload, "/")) != 2:
+		return errors.New("workload must be specified as kind/name")
+	case p.Container == "":
+		return errors.New("no container specified")
+	case len(p.Ports) == 0:
+		return errors.New("no ports specified")
+	}
+
+	return nil
+}
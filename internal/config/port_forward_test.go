@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortForwardValidate(t *testing.T) {
+	uu := map[string]struct {
+		p config.PortForward
+		e error
+	}{
+		"workload": {
+			p: config.PortForward{Namespace: "default", Workload: "deploy/fred", Container: "c1", Ports: []string{"8080:80"}},
+		},
+		"selector": {
+			p: config.PortForward{Namespace: "default", Selector: "app=fred", Container: "c1", Ports: []string{"8080:80"}},
+		},
+		"noNS": {
+			p: config.PortForward{Workload: "deploy/fred", Container: "c1", Ports: []string{"8080:80"}},
+			e: errors.New("no namespace specified"),
+		},
+		"noTarget": {
+			p: config.PortForward{Namespace: "default", Container: "c1", Ports: []string{"8080:80"}},
+			e: errors.New("a workload or a pod selector must be specified"),
+		},
+		"both": {
+			p: config.PortForward{Namespace: "default", Workload: "deploy/fred", Selector: "app=fred", Container: "c1", Ports: []string{"8080:80"}},
+			e: errors.New("workload and pod selector are mutually exclusive"),
+		},
+		"badWorkload": {
+			p: config.PortForward{Namespace: "default", Workload: "fred", Container: "c1", Ports: []string{"8080:80"}},
+			e: errors.New("workload must be specified as kind/name"),
+		},
+		"noPorts": {
+			p: config.PortForward{Namespace: "default", Workload: "deploy/fred", Container: "c1"},
+			e: errors.New("no ports specified"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.p.Validate())
+		})
+	}
+}
+
+func TestPortForwardLoad(t *testing.T) {
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("test_assets/k9s_forwards.yml"))
+
+	ff := cfg.K9s.Clusters["minikube"].PortForwards
+	assert.Equal(t, 2, len(ff))
+	assert.Equal(t, "default/deploy/nginx:nginx", ff[0].Path())
+	assert.Equal(t, []string{"8080:80", "8443:443"}, ff[0].Ports)
+	assert.Equal(t, "kube-system/k8s-app=kube-dns:coredns", ff[1].Path())
+}
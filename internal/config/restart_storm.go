@@ -0,0 +1,36 @@
+package config
+
+import "time"
+
+const (
+	defaultStormThreshold = 10
+	defaultStormWindow    = 5 * time.Minute
+)
+
+// RestartStorm tracks when a burst of pod restarts raises a header alert.
+type RestartStorm struct {
+	// Threshold raises the alert once more restarts occur within the window.
+	Threshold int `yaml:"threshold,omitempty"`
+	// Window sets the sliding window restarts are tallied over.
+	Window string `yaml:"window,omitempty"`
+	// Disable turns the detector off.
+	Disable bool `yaml:"disable,omitempty"`
+}
+
+// GetThreshold returns the restart count above which the alert fires.
+func (r RestartStorm) GetThreshold() int {
+	if r.Threshold <= 0 {
+		return defaultStormThreshold
+	}
+
+	return r.Threshold
+}
+
+// GetWindow returns the restarts sliding window.
+func (r RestartStorm) GetWindow() time.Duration {
+	if d := toDuration(r.Window); d > 0 {
+		return d
+	}
+
+	return defaultStormWindow
+}
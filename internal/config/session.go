@@ -0,0 +1,100 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sSession the name of the session state files.
+var K9sSession = "session"
+
+var sessionNameRX = regexp.MustCompile(`[^\w.-]`)
+
+type (
+	// Session tracks a context session state so it can be resumed after a
+	// restart.
+	Session struct {
+		Context   string        `yaml:"context"`
+		Namespace string        `yaml:"namespace"`
+		Views     []SessionView `yaml:"views"`
+		Pins      []SessionPin  `yaml:"pins,omitempty"`
+		SavedAt   time.Time     `yaml:"savedAt"`
+	}
+
+	// SessionView represents a view on the session view stack.
+	SessionView struct {
+		GVR      string `yaml:"gvr"`
+		Path     string `yaml:"path,omitempty"`
+		Filter   string `yaml:"filter,omitempty"`
+		SortCol  int    `yaml:"sortColumn"`
+		SortCols int    `yaml:"sortColumns"`
+		SortAsc  bool   `yaml:"sortAsc"`
+		Selected string `yaml:"selected,omitempty"`
+	}
+
+	// SessionPin represents a resource watched for changes.
+	SessionPin struct {
+		GVR  string `yaml:"gvr"`
+		Path string `yaml:"path"`
+	}
+)
+
+// SessionFile returns the session state file location for a context.
+func SessionFile(context string) string {
+	return filepath.Join(K9sHome, K9sSession+"-"+sessionNameRX.ReplaceAllString(context, "_")+".yml")
+}
+
+// LoadSession loads a session state file.
+func LoadSession(path string) (*Session, error) {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var s Session
+	if err := yaml.Unmarshal(f, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Empty checks if the session has no view to resume.
+func (s *Session) Empty() bool {
+	return s == nil || len(s.Views) == 0
+}
+
+// Same checks if two sessions hold the same state regardless of when they
+// were saved.
+func (s *Session) Same(o *Session) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+	a, b := *s, *o
+	a.SavedAt, b.SavedAt = time.Time{}, time.Time{}
+	ba, err := yaml.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bb, err := yaml.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return string(ba) == string(bb)
+}
+
+// Save writes the session state to disk.
+func (s *Session) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
@@ -0,0 +1,76 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionFile(t *testing.T) {
+	uu := map[string]struct {
+		ctx, e string
+	}{
+		"plain": {ctx: "fred", e: "session-fred.yml"},
+		"arn":   {ctx: "arn:aws:eks:us-east-1:1234:cluster/blee", e: "session-arn_aws_eks_us-east-1_1234_cluster_blee.yml"},
+		"dots":  {ctx: "kind.local", e: "session-kind.local.yml"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, filepath.Join(config.K9sHome, u.e), config.SessionFile(u.ctx))
+		})
+	}
+}
+
+func TestSessionSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-session")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s := config.Session{
+		Context:   "fred",
+		Namespace: "blee",
+		Views: []config.SessionView{
+			{GVR: "v1/pods", Filter: "nginx", SortCol: 3, SortCols: 12, SortAsc: true, Selected: "blee/nginx"},
+			{GVR: "containers", Path: "blee/nginx", SortCols: 10},
+		},
+		Pins:    []config.SessionPin{{GVR: "apps/v1/deployments", Path: "blee/nginx"}},
+		SavedAt: time.Now().Round(time.Second),
+	}
+	path := filepath.Join(dir, "session-fred.yml")
+	assert.Nil(t, s.Save(path))
+
+	l, err := config.LoadSession(path)
+	assert.Nil(t, err)
+	assert.True(t, s.SavedAt.Equal(l.SavedAt))
+	assert.True(t, l.Same(&s))
+	assert.False(t, l.Empty())
+
+	_, err = config.LoadSession(filepath.Join(dir, "none.yml"))
+	assert.NotNil(t, err)
+}
+
+func TestSessionSame(t *testing.T) {
+	s1 := config.Session{
+		Context: "fred",
+		Views:   []config.SessionView{{GVR: "v1/pods"}},
+		SavedAt: time.Now(),
+	}
+	s2 := s1
+	s2.SavedAt = s1.SavedAt.Add(time.Minute)
+	s3 := s1
+	s3.Views = []config.SessionView{{GVR: "v1/pods", Filter: "fred"}}
+
+	assert.True(t, s1.Same(&s2))
+	assert.False(t, s1.Same(&s3))
+	assert.False(t, s1.Same(nil))
+	assert.True(t, (*config.Session)(nil).Same(nil))
+	assert.True(t, (*config.Session)(nil).Empty())
+	assert.True(t, (&config.Session{Context: "fred"}).Empty())
+}
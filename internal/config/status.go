@@ -0,0 +1,28 @@
+package config
+
+import "fmt"
+
+// StatusServer tracks the local status endpoint settings.
+type StatusServer struct {
+	// Port the endpoint listens on localhost. Zero disables the endpoint.
+	Port int `yaml:"port,omitempty"`
+	// Token must be passed in the request headers by clients.
+	Token string `yaml:"token,omitempty"`
+}
+
+// Enabled checks if the status endpoint is turned on.
+func (s StatusServer) Enabled() bool {
+	return s.Port > 0
+}
+
+// Validate checks the endpoint settings.
+func (s StatusServer) Validate() error {
+	if s.Port > 65535 {
+		return fmt.Errorf("invalid status server port %d", s.Port)
+	}
+	if s.Enabled() && s.Token == "" {
+		return fmt.Errorf("status server on port %d requires a token", s.Port)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusServerValidate(t *testing.T) {
+	uu := map[string]struct {
+		s       config.StatusServer
+		enabled bool
+		err     string
+	}{
+		"off": {},
+		"on": {
+			s:       config.StatusServer{Port: 8089, Token: "fred"},
+			enabled: true,
+		},
+		"noToken": {
+			s:       config.StatusServer{Port: 8089},
+			enabled: true,
+			err:     "status server on port 8089 requires a token",
+		},
+		"badPort": {
+			s:       config.StatusServer{Port: 70000, Token: "fred"},
+			enabled: true,
+			err:     "invalid status server port 70000",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.enabled, u.s.Enabled())
+			err := u.s.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
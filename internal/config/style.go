@@ -3,6 +3,7 @@ package config
 import (
 	"io/ioutil"
 	"path/filepath"
+	"reflect"
 
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -58,6 +59,7 @@ type (
 		HighlightColor string `yaml:"highlightColor"`
 		KillColor      string `yaml:"killColor"`
 		CompletedColor string `yaml:"completedColor"`
+		EvictedColor   string `yaml:"evictedColor"`
 	}
 
 	// Log tracks Log styles.
@@ -68,9 +70,10 @@ type (
 
 	// Yaml tracks yaml styles.
 	Yaml struct {
-		KeyColor   string `yaml:"keyColor"`
-		ValueColor string `yaml:"valueColor"`
-		ColonColor string `yaml:"colonColor"`
+		KeyColor    string `yaml:"keyColor"`
+		ValueColor  string `yaml:"valueColor"`
+		NumberColor string `yaml:"numberColor"`
+		ColonColor  string `yaml:"colonColor"`
 	}
 
 	// Title tracks title styles.
@@ -178,6 +181,7 @@ func newStatus() Status {
 		HighlightColor: "aqua",
 		KillColor:      "mediumpurple",
 		CompletedColor: "gray",
+		EvictedColor:   "goldenrod",
 	}
 }
 
@@ -192,9 +196,10 @@ func newLog() Log {
 // NewYaml returns a new yaml style.
 func newYaml() Yaml {
 	return Yaml{
-		KeyColor:   "steelblue",
-		ColonColor: "white",
-		ValueColor: "papayawhip",
+		KeyColor:    "steelblue",
+		ColonColor:  "white",
+		ValueColor:  "papayawhip",
+		NumberColor: "lightskyblue",
 	}
 }
 
@@ -351,6 +356,13 @@ func (s *Styles) Load(path string) error {
 	return nil
 }
 
+// Monochrome reverts all styles to the terminal default colors.
+func (s *Styles) Monochrome() {
+	s.K9s = newStyle()
+	decolorize(reflect.ValueOf(&s.K9s).Elem())
+	s.fireStylesChanged()
+}
+
 // Update apply terminal colors based on styles.
 func (s *Styles) Update() {
 	tview.Styles.PrimitiveBackgroundColor = s.BgColor()
@@ -368,3 +380,18 @@ func AsColor(c string) tcell.Color {
 
 	return tcell.GetColor(c)
 }
+
+// monoColor represents the terminal default color.
+const monoColor = "default"
+
+// decolorize sets all color fields to the terminal default color.
+func decolorize(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		switch f := v.Field(i); f.Kind() {
+		case reflect.Struct:
+			decolorize(f)
+		case reflect.String:
+			f.SetString(monoColor)
+		}
+	}
+}
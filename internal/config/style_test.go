@@ -60,3 +60,17 @@ func TestSkinBoarked(t *testing.T) {
 	s := config.NewStyles()
 	assert.NotNil(t, s.Load("test_assets/skin_boarked.yml"))
 }
+
+func TestSkinMonochrome(t *testing.T) {
+	s := config.NewStyles()
+	assert.Nil(t, s.Load("test_assets/black_and_wtf.yml"))
+	s.Monochrome()
+	s.Update()
+
+	assert.Equal(t, "default", s.Body().FgColor)
+	assert.Equal(t, "default", s.Frame().Status.ErrorColor)
+	assert.Equal(t, "default", s.GetTable().Header.BgColor)
+	assert.Equal(t, tcell.ColorDefault, s.FgColor())
+	assert.Equal(t, tcell.ColorDefault, s.BgColor())
+	assert.Equal(t, tcell.ColorDefault, tview.Styles.PrimitiveBackgroundColor)
+}
@@ -0,0 +1,282 @@
+// Package cron parses standard cron schedules the way the Kubernetes
+// cronjob controller does and computes their next activation time.
+package cron
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// starBit flags fields specified as * which matters for day matching.
+const starBit = 1 << 63
+
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minutes = bounds{0, 59, nil}
+	hours   = bounds{0, 23, nil}
+	dom     = bounds{1, 31, nil}
+	months  = bounds{1, 12, map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dow = bounds{0, 6, map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule represents a parsed cron schedule.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	loc                           *time.Location
+}
+
+// Parse parses a 5 fields cron schedule or a descriptor ie @daily in the given
+// location. A CRON_TZ= or TZ= schedule prefix overrides the location.
+func Parse(spec string, loc *time.Location) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.Index(spec, " ")
+		if i == -1 {
+			return nil, fmt.Errorf("missing schedule after time zone in %q", spec)
+		}
+		tz := spec[strings.Index(spec, "=")+1 : i]
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", tz)
+		}
+		loc, spec = l, strings.TrimSpace(spec[i:])
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	if strings.HasPrefix(spec, "@") {
+		d, ok := descriptors[spec]
+		if !ok {
+			return nil, fmt.Errorf("unsupported descriptor %q", spec)
+		}
+		spec = d
+	}
+
+	ff := strings.Fields(spec)
+	if len(ff) != 5 {
+		return nil, fmt.Errorf("expected 5 fields but found %d in %q", len(ff), spec)
+	}
+	s := Schedule{loc: loc}
+	var err error
+	for i, f := range []struct {
+		bits *uint64
+		b    bounds
+	}{
+		{&s.minute, minutes}, {&s.hour, hours}, {&s.dom, dom}, {&s.month, months}, {&s.dow, dow},
+	} {
+		if *f.bits, err = parseField(ff[i], f.b); err != nil {
+			return nil, err
+		}
+	}
+
+	return &s, nil
+}
+
+// Next returns the first activation time strictly after t or the zero time
+// if none is found within 5 years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	origLoc := t.Location()
+	t = t.In(s.loc)
+	t = t.Add(time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+
+	// added tracks whether a field was incremented in which case lower fields are reset.
+	added := false
+	yearLimit := t.Year() + 5
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.month == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, s.loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		// Midnight may not exist or land on a different hour across DST changes.
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(time.Duration(-t.Hour()) * time.Hour)
+			}
+		}
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.hour == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, s.loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.minute == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t.In(origLoc)
+}
+
+// dayMatches honors the cron rule that when both day of month and day of week
+// are restricted, either one matching is enough.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.dom > 0
+	dowMatch := 1<<uint(t.Weekday())&s.dow > 0
+	if s.dom&starBit > 0 || s.dow&starBit > 0 {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		v, err := parseRange(expr, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= v
+	}
+
+	return bits, nil
+}
+
+// parseRange parses an expression of the form *, N, N-M, */S, N/S or N-M/S.
+func parseRange(expr string, b bounds) (uint64, error) {
+	var (
+		start, end, step uint = 0, 0, 1
+		extra            uint64
+		err              error
+	)
+	rangeAndStep := strings.Split(expr, "/")
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	single := len(lowAndHigh) == 1
+
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		if !single {
+			return 0, fmt.Errorf("invalid range %q", expr)
+		}
+		start, end, extra = b.min, b.max, starBit
+	} else {
+		if start, err = parseValue(lowAndHigh[0], b); err != nil {
+			return 0, err
+		}
+		switch len(lowAndHigh) {
+		case 1:
+			end = start
+		case 2:
+			if end, err = parseValue(lowAndHigh[1], b); err != nil {
+				return 0, err
+			}
+		default:
+			return 0, fmt.Errorf("too many hyphens in %q", expr)
+		}
+	}
+
+	switch len(rangeAndStep) {
+	case 1:
+	case 2:
+		if step, err = parseUint(rangeAndStep[1]); err != nil {
+			return 0, err
+		}
+		if step == 0 {
+			return 0, fmt.Errorf("step of range should be a positive number in %q", expr)
+		}
+		// N/S means N-max/S.
+		if single && extra == 0 {
+			end = b.max
+		}
+		if step > 1 {
+			extra = 0
+		}
+	default:
+		return 0, fmt.Errorf("too many slashes in %q", expr)
+	}
+
+	if start < b.min || end > b.max || start > end {
+		return 0, fmt.Errorf("value out of range [%d, %d] in %q", b.min, b.max, expr)
+	}
+
+	return bitsFor(start, end, step) | extra, nil
+}
+
+func parseValue(s string, b bounds) (uint, error) {
+	if v, ok := b.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	return parseUint(s)
+}
+
+func parseUint(s string) (uint, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %q", s)
+	}
+
+	return uint(v), nil
+}
+
+func bitsFor(min, max, step uint) uint64 {
+	if step == 1 {
+		return ^(math.MaxUint64 << (max + 1)) & (math.MaxUint64 << min)
+	}
+	var bits uint64
+	for i := min; i <= max; i += step {
+		bits |= 1 << i
+	}
+
+	return bits
+}
@@ -0,0 +1,115 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/cron"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrors(t *testing.T) {
+	uu := map[string]string{
+		"fields":     "* * * *",
+		"range":      "60 * * * *",
+		"reversed":   "* 5-2 * * *",
+		"step":       "*/0 * * * *",
+		"garbage":    "fred * * * *",
+		"descriptor": "@every 5m",
+		"tz":         "CRON_TZ=Mars/Olympus 0 * * * *",
+		"dow":        "* * * * 7",
+	}
+
+	for k := range uu {
+		spec := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := cron.Parse(spec, nil)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	uu := map[string]struct {
+		spec, from, next string
+	}{
+		"everyMinute":  {"* * * * *", "2020-03-01T10:00:30Z", "2020-03-01T10:01:00Z"},
+		"steps":        {"*/15 * * * *", "2020-03-01T10:14:00Z", "2020-03-01T10:15:00Z"},
+		"offsetStep":   {"5/20 * * * *", "2020-03-01T10:26:00Z", "2020-03-01T10:45:00Z"},
+		"list":         {"0 8,20 * * *", "2020-03-01T09:00:00Z", "2020-03-01T20:00:00Z"},
+		"range":        {"0 0 * * mon-fri", "2020-03-06T12:00:00Z", "2020-03-09T00:00:00Z"},
+		"names":        {"0 0 1 jun *", "2020-03-01T00:00:00Z", "2020-06-01T00:00:00Z"},
+		"leap":         {"0 0 29 2 *", "2020-03-01T00:00:00Z", "2024-02-29T00:00:00Z"},
+		"domOrDow":     {"0 0 13 * fri", "2020-03-01T00:00:00Z", "2020-03-06T00:00:00Z"},
+		"domStarDow":   {"0 0 * * fri", "2020-03-01T00:00:00Z", "2020-03-06T00:00:00Z"},
+		"descriptor":   {"@monthly", "2020-03-15T00:00:00Z", "2020-04-01T00:00:00Z"},
+		"tzPrefix":     {"CRON_TZ=Asia/Tokyo 0 9 * * *", "2020-03-01T00:00:00Z", "2020-03-02T00:00:00Z"},
+		"notIncluding": {"0 * * * *", "2020-03-01T10:00:00Z", "2020-03-01T11:00:00Z"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := cron.Parse(u.spec, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, mustTime(t, u.next), s.Next(mustTime(t, u.from)).UTC())
+		})
+	}
+}
+
+func TestNextDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no time zone database available")
+	}
+
+	uu := map[string]struct {
+		spec, from string
+		next       []string
+	}{
+		// 2:30 does not exist when clocks spring forward so the run is skipped.
+		"springForwardSkip": {
+			spec: "30 2 * * *",
+			from: "2020-03-07T12:00:00-05:00",
+			next: []string{"2020-03-09T02:30:00-04:00"},
+		},
+		"springForwardDaily": {
+			spec: "0 9 * * *",
+			from: "2020-03-07T12:00:00-05:00",
+			next: []string{"2020-03-08T09:00:00-04:00", "2020-03-09T09:00:00-04:00"},
+		},
+		// 1:30 happens twice when clocks fall back.
+		"fallBackTwice": {
+			spec: "30 1 * * *",
+			from: "2020-10-31T12:00:00-04:00",
+			next: []string{"2020-11-01T01:30:00-04:00", "2020-11-01T01:30:00-05:00", "2020-11-02T01:30:00-05:00"},
+		},
+		"fallBackMidnight": {
+			spec: "0 0 * * *",
+			from: "2020-10-31T12:00:00-04:00",
+			next: []string{"2020-11-01T00:00:00-04:00", "2020-11-02T00:00:00-05:00"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := cron.Parse(u.spec, ny)
+			assert.Nil(t, err)
+			at := mustTime(t, u.from)
+			for _, n := range u.next {
+				at = s.Next(at)
+				assert.True(t, mustTime(t, n).Equal(at), "expected %s but got %s", n, at)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func mustTime(t *testing.T, s string) time.Time {
+	at, err := time.Parse(time.RFC3339, s)
+	assert.Nil(t, err)
+
+	return at.UTC()
+}
@@ -0,0 +1,135 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// ListVerbs represents the verbs required to view a resource.
+var ListVerbs = []string{"list", "watch"}
+
+// Access caches the current user resource permissions per context. Checks
+// are performed lazily, the first time a given verb is requested.
+type Access struct {
+	factory Factory
+	rules   map[string]bool
+	mx      sync.RWMutex
+}
+
+// NewAccess returns a new instance.
+func NewAccess(f Factory) *Access {
+	return &Access{
+		factory: f,
+		rules:   make(map[string]bool),
+	}
+}
+
+// Can checks if the user is allowed the given verbs on a resource.
+// Transient failures are not cached so the next check retries.
+func (a *Access) Can(ns, gvr string, verbs []string) (bool, error) {
+	ctx := a.context()
+	for _, v := range verbs {
+		ok, cached := a.lookup(ctx, ns, gvr, v)
+		if !cached {
+			var err error
+			ok, err = a.check(ns, gvr, v)
+			if err != nil {
+				return false, err
+			}
+			a.mx.Lock()
+			a.rules[accessKey(ctx, ns, gvr, v)] = ok
+			a.mx.Unlock()
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// CanList checks if the user can view a resource in a given namespace.
+func (a *Access) CanList(ns, gvr string) (bool, error) {
+	return a.Can(ns, gvr, ListVerbs)
+}
+
+// Known returns a previously checked permission without hitting the api server.
+// The last value is false if the permission was never checked.
+func (a *Access) Known(ns, gvr string, verbs []string) (bool, bool) {
+	ctx := a.context()
+	for _, v := range verbs {
+		ok, cached := a.lookup(ctx, ns, gvr, v)
+		if !cached {
+			return false, false
+		}
+		if !ok {
+			return false, true
+		}
+	}
+
+	return true, true
+}
+
+// Reset clears the permissions cached for the current context.
+func (a *Access) Reset() {
+	prefix := a.context() + "|"
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	for k := range a.rules {
+		if strings.HasPrefix(k, prefix) {
+			delete(a.rules, k)
+		}
+	}
+}
+
+func (a *Access) lookup(ctx, ns, gvr, verb string) (bool, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	ok, cached := a.rules[accessKey(ctx, ns, gvr, verb)]
+
+	return ok, cached
+}
+
+// check issues an access review. Denials come back as errors from the client
+// hence only dial failures are reported.
+func (a *Access) check(ns, gvr, verb string) (bool, error) {
+	ok, err := a.factory.Client().CanI(accessNS(ns), gvr, []string{verb})
+	if ok {
+		return true, nil
+	}
+	if err != nil && !isDenied(err) {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (a *Access) context() string {
+	ctx, err := a.factory.Client().Config().CurrentContextName()
+	if err != nil {
+		log.Warn().Err(err).Msg("No current context")
+	}
+
+	return ctx
+}
+
+func isDenied(err error) bool {
+	return strings.Contains(err.Error(), "access denied")
+}
+
+func accessKey(ctx, ns, gvr, verb string) string {
+	return fmt.Sprintf("%s|%s|%s|%s", ctx, accessNS(ns), gvr, verb)
+}
+
+// accessNS normalizes the all namespaces and cluster scope markers.
+func accessNS(ns string) string {
+	if ns == client.NamespaceAll || ns == "-" {
+		return client.AllNamespaces
+	}
+
+	return ns
+}
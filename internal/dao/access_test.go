@@ -0,0 +1,166 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestAccessCan(t *testing.T) {
+	uu := map[string]struct {
+		ns    string
+		gvr   string
+		verbs []string
+		e     bool
+		calls int
+	}{
+		"allowed": {
+			ns:    "default",
+			gvr:   "v1/pods",
+			verbs: ListVerbs,
+			e:     true,
+			calls: 2,
+		},
+		"denied": {
+			ns:    "default",
+			gvr:   "v1/secrets",
+			verbs: ListVerbs,
+			calls: 1,
+		},
+		"partial": {
+			ns:    "default",
+			gvr:   "v1/pods",
+			verbs: []string{"list", "delete"},
+			calls: 2,
+		},
+		"allNS": {
+			ns:    "all",
+			gvr:   "v1/nodes",
+			verbs: []string{"list"},
+			e:     true,
+			calls: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			conn := newAccessConn("fred", map[string]bool{
+				"default:v1/pods:list":  true,
+				"default:v1/pods:watch": true,
+				":v1/nodes:list":        true,
+			})
+			a := NewAccess(accessFactory{conn: conn})
+
+			ok, err := a.Can(u.ns, u.gvr, u.verbs)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ok)
+			ok, err = a.Can(u.ns, u.gvr, u.verbs)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ok)
+			assert.Equal(t, u.calls, conn.calls)
+		})
+	}
+}
+
+func TestAccessKnown(t *testing.T) {
+	conn := newAccessConn("fred", map[string]bool{"default:v1/pods:list": true})
+	a := NewAccess(accessFactory{conn: conn})
+
+	_, known := a.Known("default", "v1/pods", []string{"list"})
+	assert.False(t, known)
+	_, _ = a.Can("default", "v1/pods", []string{"list"})
+	_, _ = a.Can("default", "v1/secrets", []string{"list"})
+
+	ok, known := a.Known("default", "v1/pods", []string{"list"})
+	assert.True(t, known)
+	assert.True(t, ok)
+	ok, known = a.Known("default", "v1/secrets", []string{"list"})
+	assert.True(t, known)
+	assert.False(t, ok)
+	_, known = a.Known("default", "v1/pods", ListVerbs)
+	assert.False(t, known)
+}
+
+func TestAccessPerContext(t *testing.T) {
+	conn := newAccessConn("fred", map[string]bool{"default:v1/pods:list": true})
+	a := NewAccess(accessFactory{conn: conn})
+	_, _ = a.Can("default", "v1/pods", []string{"list"})
+
+	conn.setContext("blee")
+	_, known := a.Known("default", "v1/pods", []string{"list"})
+	assert.False(t, known)
+	_, _ = a.Can("default", "v1/pods", []string{"list"})
+	a.Reset()
+	_, known = a.Known("default", "v1/pods", []string{"list"})
+	assert.False(t, known)
+
+	conn.setContext("fred")
+	_, known = a.Known("default", "v1/pods", []string{"list"})
+	assert.True(t, known)
+}
+
+func TestAccessDialFailed(t *testing.T) {
+	conn := newAccessConn("fred", nil)
+	conn.err = errors.New("boom")
+	a := NewAccess(accessFactory{conn: conn})
+
+	ok, err := a.Can("default", "v1/pods", []string{"list"})
+	assert.Equal(t, conn.err, err)
+	assert.False(t, ok)
+	_, known := a.Known("default", "v1/pods", []string{"list"})
+	assert.False(t, known)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type accessFactory struct {
+	Factory
+	conn *accessConn
+}
+
+func (f accessFactory) Client() client.Connection {
+	return f.conn
+}
+
+type accessConn struct {
+	client.Connection
+	cfg   *client.Config
+	rules map[string]bool
+	err   error
+	calls int
+}
+
+func newAccessConn(ctx string, rules map[string]bool) *accessConn {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.Context = &ctx
+
+	return &accessConn{cfg: client.NewConfig(flags), rules: rules}
+}
+
+func (c *accessConn) setContext(ctx string) {
+	c.cfg.Flags().Context = &ctx
+}
+
+func (c *accessConn) Config() *client.Config {
+	return c.cfg
+}
+
+func (c *accessConn) CanI(ns, gvr string, verbs []string) (bool, error) {
+	c.calls++
+	if c.err != nil {
+		return false, c.err
+	}
+	for _, v := range verbs {
+		if !c.rules[ns+":"+gvr+":"+v] {
+			return false, fmt.Errorf("`%s access denied for user on %q:%s", v, ns, gvr)
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,230 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+const (
+	// ApplyCreated indicates a new resource.
+	ApplyCreated = "created"
+	// ApplyConfigured indicates an updated resource.
+	ApplyConfigured = "configured"
+	// ApplyUnchanged indicates a resource matching its manifest.
+	ApplyUnchanged = "unchanged"
+	// ApplyFailed indicates a resource that could not be applied.
+	ApplyFailed = "error"
+
+	applyFieldManager = "k9s"
+	lastAppliedKey    = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// ApplyResult represents the outcome of applying a manifest document.
+type ApplyResult struct {
+	Name   string
+	GVR    string
+	Path   string
+	Status string
+	Err    error
+}
+
+// Applier applies manifests via the dynamic client.
+type Applier struct {
+	dial   dynamic.Interface
+	ns     string
+	dryRun bool
+}
+
+// NewApplier returns a new applier. Documents without a namespace are
+// applied in the given namespace.
+func NewApplier(dial dynamic.Interface, ns string, dryRun bool) *Applier {
+	return &Applier{dial: dial, ns: ns, dryRun: dryRun}
+}
+
+// ApplyManifests applies each manifest document to the cluster.
+func ApplyManifests(f Factory, raw []byte, ns string, dryRun bool) ([]ApplyResult, error) {
+	return NewApplier(f.Client().DynDialOrDie(), ns, dryRun).Apply(raw)
+}
+
+// Apply applies all manifest documents. Failures are reported per document.
+func (a *Applier) Apply(raw []byte) ([]ApplyResult, error) {
+	docs, err := splitManifests(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]ApplyResult, 0, len(docs))
+	for _, doc := range docs {
+		r := a.apply(unstructured.Unstructured{Object: doc})
+		if r.Err != nil {
+			r.Status = ApplyFailed
+		}
+		rr = append(rr, r)
+	}
+
+	return rr, nil
+}
+
+func (a *Applier) apply(local unstructured.Unstructured) ApplyResult {
+	ns := a.ns
+	if local.GetNamespace() != "" {
+		ns = local.GetNamespace()
+	}
+	r := ApplyResult{Name: manifestName(local, ns)}
+
+	gvr, namespaced, err := resourceForKind(local.GroupVersionKind())
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.GVR = gvr.Group + "/" + gvr.Version + "/" + gvr.Resource
+	if gvr.Group == "" {
+		r.GVR = gvr.Version + "/" + gvr.Resource
+	}
+
+	var res dynamic.ResourceInterface = a.dial.Resource(gvr)
+	r.Path = local.GetName()
+	if namespaced {
+		local.SetNamespace(ns)
+		res = a.dial.Resource(gvr).Namespace(ns)
+		r.Path = ns + "/" + local.GetName()
+	}
+
+	live, err := res.Get(local.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		r.Status, r.Err = a.create(res, local)
+		return r
+	}
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Status, r.Err = a.update(res, gvr, live, local)
+
+	return r
+}
+
+func (a *Applier) create(res dynamic.ResourceInterface, local unstructured.Unstructured) (string, error) {
+	if err := setLastApplied(&local); err != nil {
+		return "", err
+	}
+	opts := metav1.CreateOptions{FieldManager: applyFieldManager, DryRun: a.dryRunOpts()}
+	if _, err := res.Create(&local, opts); err != nil {
+		return "", err
+	}
+
+	return ApplyCreated, nil
+}
+
+// update attempts a server side apply and falls back to a client side three
+// way merge on servers that do not support it.
+func (a *Applier) update(res dynamic.ResourceInterface, gvr schema.GroupVersionResource, live *unstructured.Unstructured, local unstructured.Unstructured) (string, error) {
+	raw, err := json.Marshal(local.Object)
+	if err != nil {
+		return "", err
+	}
+	opts := metav1.PatchOptions{FieldManager: applyFieldManager, DryRun: a.dryRunOpts()}
+	o, err := res.Patch(local.GetName(), types.ApplyPatchType, raw, opts)
+	if errors.IsUnsupportedMediaType(err) {
+		return a.mergeUpdate(res, live, local)
+	}
+	if errors.IsConflict(err) {
+		return "", fmt.Errorf("conflict applying %s: %v", gvr.Resource, err)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return changeStatus(live, o), nil
+}
+
+func (a *Applier) mergeUpdate(res dynamic.ResourceInterface, live *unstructured.Unstructured, local unstructured.Unstructured) (string, error) {
+	if err := setLastApplied(&local); err != nil {
+		return "", err
+	}
+	modified, err := json.Marshal(local.Object)
+	if err != nil {
+		return "", err
+	}
+	current, err := json.Marshal(live.Object)
+	if err != nil {
+		return "", err
+	}
+	original := []byte(live.GetAnnotations()[lastAppliedKey])
+
+	pt, patch, err := threeWayPatch(local.GroupVersionKind(), original, modified, current)
+	if err != nil {
+		return "", err
+	}
+	if string(patch) == "{}" {
+		return ApplyUnchanged, nil
+	}
+	opts := metav1.PatchOptions{FieldManager: applyFieldManager, DryRun: a.dryRunOpts()}
+	o, err := res.Patch(local.GetName(), pt, patch, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return changeStatus(live, o), nil
+}
+
+func (a *Applier) dryRunOpts() []string {
+	if !a.dryRun {
+		return nil
+	}
+	return []string{metav1.DryRunAll}
+}
+
+// threeWayPatch computes a strategic merge patch for built-in kinds and a
+// JSON merge patch otherwise.
+func threeWayPatch(gvk schema.GroupVersionKind, original, modified, current []byte) (types.PatchType, []byte, error) {
+	if o, err := scheme.Scheme.New(gvk); err == nil {
+		meta, err := strategicpatch.NewPatchMetaFromStruct(o)
+		if err != nil {
+			return "", nil, err
+		}
+		patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, current, meta, true)
+		return types.StrategicMergePatchType, patch, err
+	}
+	patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current)
+
+	return types.MergePatchType, patch, err
+}
+
+func setLastApplied(u *unstructured.Unstructured) error {
+	aa := u.GetAnnotations()
+	delete(aa, lastAppliedKey)
+	if len(aa) == 0 {
+		aa = nil
+	}
+	u.SetAnnotations(aa)
+	raw, err := json.Marshal(u.Object)
+	if err != nil {
+		return err
+	}
+	if aa == nil {
+		aa = make(map[string]string, 1)
+	}
+	aa[lastAppliedKey] = string(raw)
+	u.SetAnnotations(aa)
+
+	return nil
+}
+
+func changeStatus(before, after *unstructured.Unstructured) string {
+	if after == nil || reflect.DeepEqual(normalizeManifest(before.Object), normalizeManifest(after.Object)) {
+		return ApplyUnchanged
+	}
+	return ApplyConfigured
+}
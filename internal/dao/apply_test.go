@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	ktesting "k8s.io/client-go/testing"
+)
+
+const applyManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+data:
+  a: "1"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+  namespace: ns2
+data:
+  b: "2"
+`
+
+func init() {
+	RegisterMeta("v1/configmaps", metav1.APIResource{
+		Name:       "configmaps",
+		Kind:       "ConfigMap",
+		Namespaced: true,
+	})
+}
+
+func TestApplyCreate(t *testing.T) {
+	dial := fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	rr, err := NewApplier(dial, "default", false).Apply([]byte(applyManifest))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, ApplyResult{Name: "ConfigMap default/cm1", GVR: "v1/configmaps", Path: "default/cm1", Status: ApplyCreated}, rr[0])
+	assert.Equal(t, ApplyResult{Name: "ConfigMap ns2/cm2", GVR: "v1/configmaps", Path: "ns2/cm2", Status: ApplyCreated}, rr[1])
+
+	o, err := dial.Resource(cmGVR()).Namespace("ns2").Get("cm2", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Contains(t, o.GetAnnotations(), lastAppliedKey)
+}
+
+func TestApplyDryRun(t *testing.T) {
+	dial := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	var dryRun []string
+	dial.PrependReactor("create", "configmaps", func(a ktesting.Action) (bool, runtime.Object, error) {
+		dryRun = append(dryRun, a.(ktesting.CreateAction).GetObject().(*unstructured.Unstructured).GetName())
+		return true, nil, nil
+	})
+
+	rr, err := NewApplier(dial, "default", true).Apply([]byte(applyManifest))
+
+	assert.Nil(t, err)
+	assert.Equal(t, ApplyCreated, rr[0].Status)
+	assert.Equal(t, []string{"cm1", "cm2"}, dryRun)
+}
+
+func TestApplyUpdate(t *testing.T) {
+	uu := map[string]struct {
+		data   map[string]interface{}
+		status string
+	}{
+		"configured": {
+			data:   map[string]interface{}{"a": "0"},
+			status: ApplyConfigured,
+		},
+		"unchanged": {
+			data:   map[string]interface{}{"a": "1"},
+			status: ApplyUnchanged,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			live := makeConfigMap("default", "cm1", u.data)
+			assert.Nil(t, setLastApplied(live))
+			dial := fake.NewSimpleDynamicClient(runtime.NewScheme(), live)
+			var (
+				patches []types.PatchType
+				patch   string
+			)
+			dial.PrependReactor("patch", "configmaps", func(a ktesting.Action) (bool, runtime.Object, error) {
+				pa := a.(ktesting.PatchAction)
+				patches = append(patches, pa.GetPatchType())
+				if pa.GetPatchType() == types.ApplyPatchType {
+					return true, nil, kerrors.NewGenericServerResponse(415, "patch", schema.GroupResource{Resource: "configmaps"}, "cm1", "", 0, false)
+				}
+				patch = string(pa.GetPatch())
+				return true, makeConfigMap("default", "cm1", map[string]interface{}{"a": "1"}), nil
+			})
+
+			rr, err := NewApplier(dial, "default", false).Apply([]byte(applyManifest))
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.status, rr[0].Status)
+			assert.Nil(t, rr[0].Err)
+			if u.status == ApplyConfigured {
+				assert.Equal(t, []types.PatchType{types.ApplyPatchType, types.StrategicMergePatchType}, patches)
+				assert.Contains(t, patch, `"data":{"a":"1"}`)
+			} else {
+				assert.Equal(t, []types.PatchType{types.ApplyPatchType}, patches)
+			}
+		})
+	}
+}
+
+func TestApplyServerSide(t *testing.T) {
+	live := makeConfigMap("default", "cm1", map[string]interface{}{"a": "0"})
+	dial := fake.NewSimpleDynamicClient(runtime.NewScheme(), live)
+	dial.PrependReactor("patch", "configmaps", func(a ktesting.Action) (bool, runtime.Object, error) {
+		if a.(ktesting.PatchAction).GetPatchType() != types.ApplyPatchType {
+			return true, nil, errors.New("expecting server side apply")
+		}
+		return true, makeConfigMap("default", "cm1", map[string]interface{}{"a": "1"}), nil
+	})
+
+	rr, err := NewApplier(dial, "default", false).Apply([]byte(applyManifest))
+
+	assert.Nil(t, err)
+	assert.Equal(t, ApplyConfigured, rr[0].Status)
+	assert.Equal(t, ApplyCreated, rr[1].Status)
+}
+
+func TestApplyErrors(t *testing.T) {
+	live := makeConfigMap("default", "cm1", map[string]interface{}{"a": "0"})
+	dial := fake.NewSimpleDynamicClient(runtime.NewScheme(), live)
+	dial.PrependReactor("patch", "configmaps", func(a ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, kerrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "cm1", errors.New("data.a owned by fred"))
+	})
+	raw := applyManifest + "---\napiVersion: v1\nkind: Zorg\nmetadata:\n  name: z1\n"
+
+	rr, err := NewApplier(dial, "default", false).Apply([]byte(raw))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(rr))
+	assert.Equal(t, ApplyFailed, rr[0].Status)
+	assert.Contains(t, rr[0].Err.Error(), "conflict")
+	assert.Equal(t, ApplyCreated, rr[1].Status)
+	assert.Equal(t, ApplyFailed, rr[2].Status)
+	assert.Error(t, rr[2].Err)
+}
+
+// Helpers...
+
+func cmGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+}
+
+func makeConfigMap(ns, n string, data map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": ns,
+			"name":      n,
+		},
+		"data": data,
+	}}
+}
@@ -0,0 +1,186 @@
+package dao
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// AuditOK represents a successful action outcome.
+	AuditOK = "ok"
+
+	auditBufferSize = 100
+)
+
+// K9sAuditDir represents the directory where the clusters action logs are persisted.
+var K9sAuditDir = filepath.Join(config.K9sHome, "audit")
+
+// AuditEntry represents a mutation performed through K9s.
+type AuditEntry struct {
+	Time    time.Time `json:"time"`
+	Cluster string    `json:"cluster"`
+	Context string    `json:"context"`
+	User    string    `json:"user"`
+	Action  string    `json:"action"`
+	Target  string    `json:"target"`
+	Outcome string    `json:"outcome"`
+}
+
+// OK checks if the action succeeded.
+func (e AuditEntry) OK() bool {
+	return e.Outcome == AuditOK
+}
+
+// AuditOutcome returns an action outcome given its error.
+func AuditOutcome(err error) string {
+	if err == nil {
+		return AuditOK
+	}
+
+	return err.Error()
+}
+
+// AuditLogPath returns a cluster action log location.
+func AuditLogPath(cluster string) string {
+	return filepath.Join(K9sAuditDir, strings.Replace(cluster, "/", "_", -1)+".jsonl")
+}
+
+// AuditLog appends entries to the clusters action logs. Entries are
+// buffered and written in the background so recording never blocks.
+type AuditLog struct {
+	entries chan AuditEntry
+	errFn   func(error)
+	failed  int32
+	closed  bool
+	done    chan struct{}
+	mx      sync.RWMutex
+}
+
+// NewAuditLog returns a new instance. The error callback fires on the first
+// failure only.
+func NewAuditLog(errFn func(error)) *AuditLog {
+	a := AuditLog{
+		entries: make(chan AuditEntry, auditBufferSize),
+		errFn:   errFn,
+		done:    make(chan struct{}),
+	}
+	go a.run()
+
+	return &a
+}
+
+// Record queues an entry for writing. Entries are dropped if the buffer is full.
+func (a *AuditLog) Record(e AuditEntry) {
+	if a == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	if a.closed {
+		return
+	}
+	select {
+	case a.entries <- e:
+	default:
+		a.fail(errors.New("audit log buffer full"))
+	}
+}
+
+// Close flushes pending entries and stops the writer.
+func (a *AuditLog) Close() {
+	if a == nil {
+		return
+	}
+	a.mx.Lock()
+	if a.closed {
+		a.mx.Unlock()
+		return
+	}
+	a.closed = true
+	close(a.entries)
+	a.mx.Unlock()
+	<-a.done
+}
+
+func (a *AuditLog) run() {
+	defer close(a.done)
+	for e := range a.entries {
+		if err := writeAudit(AuditLogPath(e.Cluster), e); err != nil {
+			a.fail(err)
+		}
+	}
+}
+
+func (a *AuditLog) fail(err error) {
+	log.Error().Err(err).Msg("Audit log write failed")
+	if atomic.CompareAndSwapInt32(&a.failed, 0, 1) && a.errFn != nil {
+		a.errFn(err)
+	}
+}
+
+func writeAudit(path string, e AuditEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(raw, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// ReadAudit returns a cluster action log entries, most recent first.
+// Malformed lines are skipped.
+func ReadAudit(cluster string) ([]AuditEntry, error) {
+	f, err := os.Open(AuditLogPath(cluster))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing audit log")
+		}
+	}()
+
+	var ee []AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			log.Warn().Err(err).Msg("Skipping malformed audit entry")
+			continue
+		}
+		ee = append(ee, e)
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].Time.After(ee[j].Time)
+	})
+
+	return ee, scanner.Err()
+}
@@ -0,0 +1,82 @@
+package dao_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { dao.K9sAuditDir = d }(dao.K9sAuditDir)
+	dao.K9sAuditDir = dir
+
+	now := time.Now()
+	a := dao.NewAuditLog(func(error) {})
+	a.Record(dao.AuditEntry{Time: now.Add(-time.Minute), Cluster: "c1", Action: "Delete", Target: "default/p1", Outcome: dao.AuditOK})
+	a.Record(dao.AuditEntry{Time: now, Cluster: "c1", Action: "Scale", Target: "default/d1", Outcome: dao.AuditOutcome(errors.New("boom"))})
+	a.Record(dao.AuditEntry{Time: now, Cluster: "arn:aws/c2", Action: "Delete", Target: "default/p2", Outcome: dao.AuditOK})
+	a.Close()
+	a.Close()
+	a.Record(dao.AuditEntry{Cluster: "c1", Action: "Edit"})
+
+	ee, err := dao.ReadAudit("c1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "Scale", ee[0].Action)
+	assert.False(t, ee[0].OK())
+	assert.Equal(t, "boom", ee[0].Outcome)
+	assert.Equal(t, "Delete", ee[1].Action)
+	assert.True(t, ee[1].OK())
+
+	ee, err = dao.ReadAudit("arn:aws/c2")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ee))
+	assert.Equal(t, filepath.Join(dir, "arn:aws_c2.jsonl"), dao.AuditLogPath("arn:aws/c2"))
+
+	ee, err = dao.ReadAudit("c3")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ee))
+}
+
+func TestAuditLogFailsOnce(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { dao.K9sAuditDir = d }(dao.K9sAuditDir)
+	// A file where the audit directory should be fails every write.
+	dao.K9sAuditDir = filepath.Join(dir, "blocked")
+	assert.Nil(t, ioutil.WriteFile(dao.K9sAuditDir, []byte("blee"), 0600))
+
+	var count int
+	a := dao.NewAuditLog(func(error) { count++ })
+	for i := 0; i < 3; i++ {
+		a.Record(dao.AuditEntry{Cluster: "c1", Action: "Delete"})
+	}
+	a.Close()
+
+	assert.Equal(t, 1, count)
+}
+
+func TestAuditLogMalformed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { dao.K9sAuditDir = d }(dao.K9sAuditDir)
+	dao.K9sAuditDir = dir
+
+	raw := `{"cluster":"c1","action":"Delete","outcome":"ok"}` + "\nblee\n"
+	assert.Nil(t, ioutil.WriteFile(dao.AuditLogPath("c1"), []byte(raw), 0600))
+
+	ee, err := dao.ReadAudit("c1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ee))
+}
@@ -0,0 +1,212 @@
+package dao
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ChangeSummary describes notable changes between two revisions of a
+// resource. Changes confined to metadata bookkeeping are ignored.
+func ChangeSummary(prev, curr map[string]interface{}) []string {
+	var ss []string
+	if _, ok, _ := unstructured.NestedString(curr, "metadata", "deletionTimestamp"); ok {
+		if _, was, _ := unstructured.NestedString(prev, "metadata", "deletionTimestamp"); !was {
+			ss = append(ss, "terminating")
+		}
+	}
+
+	ss = append(ss, intChange(prev, curr, "replicas", "spec", "replicas")...)
+	ss = append(ss, intChange(prev, curr, "ready", "status", "readyReplicas")...)
+	ss = append(ss, intChange(prev, curr, "available", "status", "availableReplicas")...)
+	ss = append(ss, intChange(prev, curr, "updated", "status", "updatedReplicas")...)
+	ss = append(ss, intChange(prev, curr, "desired", "status", "desiredNumberScheduled")...)
+	ss = append(ss, intChange(prev, curr, "scheduled", "status", "currentNumberScheduled")...)
+	ss = append(ss, intChange(prev, curr, "active", "status", "active")...)
+	ss = append(ss, intChange(prev, curr, "succeeded", "status", "succeeded")...)
+	ss = append(ss, intChange(prev, curr, "failed", "status", "failed")...)
+	ss = append(ss, strChange(prev, curr, "phase", "status", "phase")...)
+	ss = append(ss, boolChange(prev, curr, "suspended", "spec", "suspend")...)
+	ss = append(ss, boolChange(prev, curr, "cordoned", "spec", "unschedulable")...)
+	ss = append(ss, imageChanges(prev, curr)...)
+	ss = append(ss, restartChanges(prev, curr)...)
+	ss = append(ss, conditionChanges(prev, curr)...)
+
+	if len(ss) > 0 {
+		return ss
+	}
+	for _, k := range []string{"spec", "data", "status"} {
+		if !reflect.DeepEqual(prev[k], curr[k]) {
+			ss = append(ss, k+" changed")
+		}
+	}
+
+	return ss
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func intChange(prev, curr map[string]interface{}, label string, fields ...string) []string {
+	p, pok := nestedInt(prev, fields...)
+	c, cok := nestedInt(curr, fields...)
+	if (!pok && !cok) || p == c {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("%s %d→%d", label, p, c)}
+}
+
+func nestedInt(o map[string]interface{}, fields ...string) (int64, bool) {
+	v, ok, _ := unstructured.NestedFieldNoCopy(o, fields...)
+	if !ok {
+		return 0, false
+	}
+	switch i := v.(type) {
+	case int64:
+		return i, true
+	case int:
+		return int64(i), true
+	case float64:
+		return int64(i), true
+	default:
+		return 0, false
+	}
+}
+
+func strChange(prev, curr map[string]interface{}, label string, fields ...string) []string {
+	p, _, _ := unstructured.NestedString(prev, fields...)
+	c, _, _ := unstructured.NestedString(curr, fields...)
+	if p == c {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("%s %s→%s", label, orNone(p), orNone(c))}
+}
+
+func boolChange(prev, curr map[string]interface{}, label string, fields ...string) []string {
+	p, _, _ := unstructured.NestedBool(prev, fields...)
+	c, _, _ := unstructured.NestedBool(curr, fields...)
+	switch {
+	case p == c:
+		return nil
+	case c:
+		return []string{label}
+	default:
+		return []string{"un" + label}
+	}
+}
+
+// podSpec locates the pod template spec of workloads or the spec of a pod.
+func podSpec(o map[string]interface{}) map[string]interface{} {
+	for _, path := range [][]string{
+		{"spec", "template", "spec"},
+		{"spec", "jobTemplate", "spec", "template", "spec"},
+		{"spec"},
+	} {
+		if m, ok, _ := unstructured.NestedMap(o, path...); ok {
+			if _, ok := m["containers"]; ok {
+				return m
+			}
+		}
+	}
+
+	return nil
+}
+
+func imageChanges(prev, curr map[string]interface{}) []string {
+	pp, cc := containerImages(podSpec(prev)), containerImages(podSpec(curr))
+	var ss []string
+	for _, n := range sortedKeys(cc) {
+		if p, ok := pp[n]; ok && p != cc[n] {
+			ss = append(ss, fmt.Sprintf("image %s %s→%s", n, p, cc[n]))
+		}
+	}
+
+	return ss
+}
+
+func containerImages(spec map[string]interface{}) map[string]string {
+	mm := make(map[string]string)
+	cc, _, _ := unstructured.NestedSlice(spec, "containers")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mm[fmt.Sprintf("%v", m["name"])] = fmt.Sprintf("%v", m["image"])
+	}
+
+	return mm
+}
+
+func restartChanges(prev, curr map[string]interface{}) []string {
+	pp, cc := restartCounts(prev), restartCounts(curr)
+	var ss []string
+	for _, n := range sortedKeys(cc) {
+		if p := pp[n]; p != cc[n] {
+			ss = append(ss, fmt.Sprintf("restarts %s %s→%s", n, orZeroStr(p), cc[n]))
+		}
+	}
+
+	return ss
+}
+
+func orZeroStr(s string) string {
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+func restartCounts(o map[string]interface{}) map[string]string {
+	mm := make(map[string]string)
+	cc, _, _ := unstructured.NestedSlice(o, "status", "containerStatuses")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mm[fmt.Sprintf("%v", m["name"])] = fmt.Sprintf("%v", m["restartCount"])
+	}
+
+	return mm
+}
+
+func conditionChanges(prev, curr map[string]interface{}) []string {
+	pp, cc := conditions(prev), conditions(curr)
+	var ss []string
+	for _, t := range sortedKeys(cc) {
+		if pp[t] != cc[t] {
+			ss = append(ss, fmt.Sprintf("condition %s=%s", t, cc[t]))
+		}
+	}
+
+	return ss
+}
+
+func conditions(o map[string]interface{}) map[string]string {
+	mm := make(map[string]string)
+	cc, _, _ := unstructured.NestedSlice(o, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mm[fmt.Sprintf("%v", m["type"])] = fmt.Sprintf("%v", m["status"])
+	}
+
+	return mm
+}
+
+func sortedKeys(m map[string]string) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
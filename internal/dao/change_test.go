@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestChangeSummary(t *testing.T) {
+	uu := map[string]struct {
+		prev, curr string
+		e          []string
+	}{
+		"noop": {
+			prev: "metadata:\n  resourceVersion: \"1\"\nspec:\n  replicas: 1\n",
+			curr: "metadata:\n  resourceVersion: \"2\"\nspec:\n  replicas: 1\n",
+		},
+		"scaled": {
+			prev: "spec:\n  replicas: 3\nstatus:\n  readyReplicas: 3\n",
+			curr: "spec:\n  replicas: 5\nstatus:\n  readyReplicas: 4\n",
+			e:    []string{"replicas 3→5", "ready 3→4"},
+		},
+		"scaledToZero": {
+			prev: "spec:\n  replicas: 1\nstatus:\n  readyReplicas: 1\n",
+			curr: "spec:\n  replicas: 0\nstatus: {}\n",
+			e:    []string{"replicas 1→0", "ready 1→0"},
+		},
+		"conditions": {
+			prev: `
+status:
+  conditions:
+  - type: Available
+    status: "True"
+  - type: Progressing
+    status: "True"
+`,
+			curr: `
+status:
+  conditions:
+  - type: Progressing
+    status: "True"
+  - type: Available
+    status: "False"
+`,
+			e: []string{"condition Available=False"},
+		},
+		"image": {
+			prev: `
+spec:
+  template:
+    spec:
+      containers:
+      - name: c1
+        image: nginx:1.0
+      - name: c2
+        image: redis
+`,
+			curr: `
+spec:
+  template:
+    spec:
+      containers:
+      - name: c1
+        image: nginx:1.1
+      - name: c2
+        image: redis
+`,
+			e: []string{"image c1 nginx:1.0→nginx:1.1"},
+		},
+		"pod": {
+			prev: `
+status:
+  phase: Pending
+  containerStatuses:
+  - name: c1
+    restartCount: 0
+`,
+			curr: `
+status:
+  phase: Running
+  containerStatuses:
+  - name: c1
+    restartCount: 2
+`,
+			e: []string{"phase Pending→Running", "restarts c1 0→2"},
+		},
+		"terminating": {
+			prev: "metadata:\n  name: fred\n",
+			curr: "metadata:\n  name: fred\n  deletionTimestamp: \"2020-01-01T00:00:00Z\"\n",
+			e:    []string{"terminating"},
+		},
+		"cordoned": {
+			prev: "spec: {}\n",
+			curr: "spec:\n  unschedulable: true\n",
+			e:    []string{"cordoned"},
+		},
+		"cronJobActive": {
+			prev: "status:\n  active: []\n",
+			curr: "status:\n  active:\n  - name: j1\n",
+			e:    []string{"status changed"},
+		},
+		"data": {
+			prev: "data:\n  a: \"1\"\n",
+			curr: "data:\n  a: \"2\"\n",
+			e:    []string{"data changed"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var prev, curr map[string]interface{}
+			assert.Nil(t, yaml.Unmarshal([]byte(u.prev), &prev))
+			assert.Nil(t, yaml.Unmarshal([]byte(u.curr), &curr))
+			assert.Equal(t, u.e, ChangeSummary(prev, curr))
+		})
+	}
+}
@@ -29,7 +29,7 @@ func (c *Context) Get(_, n string) (runtime.Object, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &render.NamedContext{Name: n, Context: ctx}, nil
+	return render.NewNamedContext(c.config(), n, ctx), nil
 }
 
 // List all Contexts on the current cluster.
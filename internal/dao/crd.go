@@ -0,0 +1,160 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	preserveUnknownFields = "x-kubernetes-preserve-unknown-fields"
+	intOrString           = "x-kubernetes-int-or-string"
+)
+
+// SchemaNode represents a CRD schema property.
+type SchemaNode struct {
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+	Children    []*SchemaNode
+}
+
+// VersionSchema represents the schema of a CRD served version.
+type VersionSchema struct {
+	Version string
+	Root    *SchemaNode
+}
+
+// CRDSchemas returns the openAPIV3Schema tree for each served CRD version.
+func CRDSchemas(f Factory, gvr, path string) ([]VersionSchema, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return crdSchemas(u.Object)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// crdSchemas extracts per version schemas. Legacy v1beta1 CRDs may specify a
+// single top level schema shared by all versions.
+func crdSchemas(o map[string]interface{}) ([]VersionSchema, error) {
+	legacy, _, _ := unstructured.NestedMap(o, "spec", "validation", "openAPIV3Schema")
+	vv, _, _ := unstructured.NestedSlice(o, "spec", "versions")
+	if len(vv) == 0 {
+		if v, _, _ := unstructured.NestedString(o, "spec", "version"); v != "" {
+			vv = append(vv, map[string]interface{}{"name": v})
+		}
+	}
+
+	ss := make([]VersionSchema, 0, len(vv))
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if served, ok := m["served"].(bool); ok && !served {
+			continue
+		}
+		schema, _, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema")
+		if schema == nil {
+			schema = legacy
+		}
+		if schema == nil {
+			continue
+		}
+		n, _ := m["name"].(string)
+		ss = append(ss, VersionSchema{Version: n, Root: schemaNode(n, schema, false)})
+	}
+	if len(ss) == 0 {
+		return nil, errors.New("no schema found for served versions")
+	}
+
+	return ss, nil
+}
+
+func schemaNode(name string, s map[string]interface{}, required bool) *SchemaNode {
+	n := SchemaNode{
+		Name:     name,
+		Type:     schemaType(s),
+		Required: required,
+		Children: schemaChildren(s),
+	}
+	n.Description, _ = s["description"].(string)
+
+	return &n
+}
+
+func schemaType(s map[string]interface{}) string {
+	if b, _ := s[intOrString].(bool); b {
+		return "int-or-string"
+	}
+
+	t, _ := s["type"].(string)
+	switch t {
+	case "array":
+		t = "[]any"
+		if items, ok := s["items"].(map[string]interface{}); ok {
+			t = "[]" + schemaType(items)
+		}
+	case "object", "":
+		if ap, ok := s["additionalProperties"].(map[string]interface{}); ok {
+			t = "map[string]" + schemaType(ap)
+		} else if _, ok := s["properties"]; ok || t == "object" {
+			t = "object"
+		} else {
+			t = "any"
+		}
+	}
+	if b, _ := s[preserveUnknownFields].(bool); b {
+		t += " (preserve-unknown-fields)"
+	}
+
+	return t
+}
+
+func schemaChildren(s map[string]interface{}) []*SchemaNode {
+	if props, ok := s["properties"].(map[string]interface{}); ok {
+		req := make(map[string]struct{})
+		if rr, ok := s["required"].([]interface{}); ok {
+			for _, r := range rr {
+				if n, ok := r.(string); ok {
+					req[n] = struct{}{}
+				}
+			}
+		}
+		kk := make([]string, 0, len(props))
+		for k := range props {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		cc := make([]*SchemaNode, 0, len(kk))
+		for _, k := range kk {
+			p, ok := props[k].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			_, r := req[k]
+			cc = append(cc, schemaNode(k, p, r))
+		}
+		return cc
+	}
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		return schemaChildren(items)
+	}
+	if ap, ok := s["additionalProperties"].(map[string]interface{}); ok {
+		return schemaChildren(ap)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestCRDSchemas(t *testing.T) {
+	uu := map[string]struct {
+		crd string
+		e   []VersionSchema
+		err string
+	}{
+		"v1": {
+			crd: `
+spec:
+  versions:
+  - name: v1
+    served: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            description: Desired state.
+            required: [size]
+            properties:
+              size:
+                type: integer
+              ports:
+                type: array
+                items:
+                  type: object
+                  properties:
+                    port:
+                      x-kubernetes-int-or-string: true
+              labels:
+                type: object
+                additionalProperties:
+                  type: string
+              config:
+                type: object
+                x-kubernetes-preserve-unknown-fields: true
+  - name: v0
+    served: false
+    schema:
+      openAPIV3Schema:
+        type: object
+`,
+			e: []VersionSchema{
+				{
+					Version: "v1",
+					Root: &SchemaNode{
+						Name: "v1",
+						Type: "object",
+						Children: []*SchemaNode{
+							{
+								Name:        "spec",
+								Type:        "object",
+								Description: "Desired state.",
+								Children: []*SchemaNode{
+									{Name: "config", Type: "object (preserve-unknown-fields)"},
+									{Name: "labels", Type: "map[string]string"},
+									{
+										Name: "ports",
+										Type: "[]object",
+										Children: []*SchemaNode{
+											{Name: "port", Type: "int-or-string"},
+										},
+									},
+									{Name: "size", Type: "integer", Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"v1beta1": {
+			crd: `
+spec:
+  validation:
+    openAPIV3Schema:
+      properties:
+        spec:
+          x-kubernetes-preserve-unknown-fields: true
+        items:
+          type: array
+          items:
+            type: array
+            items:
+              type: string
+  versions:
+  - name: v1beta1
+    served: true
+  - name: v1alpha1
+    served: true
+`,
+			e: []VersionSchema{
+				{
+					Version: "v1beta1",
+					Root: &SchemaNode{
+						Name: "v1beta1",
+						Type: "object",
+						Children: []*SchemaNode{
+							{Name: "items", Type: "[][]string"},
+							{Name: "spec", Type: "any (preserve-unknown-fields)"},
+						},
+					},
+				},
+				{
+					Version: "v1alpha1",
+					Root: &SchemaNode{
+						Name: "v1alpha1",
+						Type: "object",
+						Children: []*SchemaNode{
+							{Name: "items", Type: "[][]string"},
+							{Name: "spec", Type: "any (preserve-unknown-fields)"},
+						},
+					},
+				},
+			},
+		},
+		"legacyVersion": {
+			crd: `
+spec:
+  version: v1
+  validation:
+    openAPIV3Schema:
+      type: object
+`,
+			e: []VersionSchema{
+				{Version: "v1", Root: &SchemaNode{Name: "v1", Type: "object"}},
+			},
+		},
+		"noSchema": {
+			crd: `
+spec:
+  versions:
+  - name: v1
+    served: true
+`,
+			err: "no schema found for served versions",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o map[string]interface{}
+			assert.Nil(t, yaml.Unmarshal([]byte(u.crd), &o))
+			ss, err := crdSchemas(o)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ss)
+		})
+	}
+}
@@ -0,0 +1,436 @@
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const diffContext = 3
+
+var docSepRX = regexp.MustCompile(`(?m)^---.*$`)
+
+// ManifestDiff represents a manifest document compared to its live object.
+type ManifestDiff struct {
+	Name    string
+	Diff    string
+	Missing bool
+	Err     error
+}
+
+// Differs returns true if the manifest does not match the live object.
+func (m ManifestDiff) Differs() bool {
+	return m.Missing || m.Diff != ""
+}
+
+// DiffManifests compares each manifest document with its live resource.
+// Documents without a namespace are looked up in the given namespace.
+func DiffManifests(f Factory, raw []byte, ns string) ([]ManifestDiff, error) {
+	docs, err := splitManifests(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dd := make([]ManifestDiff, 0, len(docs))
+	for _, doc := range docs {
+		dd = append(dd, diffManifest(f, doc, ns))
+	}
+
+	return dd, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func diffManifest(f Factory, doc map[string]interface{}, ns string) ManifestDiff {
+	local := unstructured.Unstructured{Object: doc}
+	if local.GetNamespace() != "" {
+		ns = local.GetNamespace()
+	}
+	d := ManifestDiff{Name: manifestName(local, ns)}
+
+	gvr, namespaced, err := resourceForKind(local.GroupVersionKind())
+	if err != nil {
+		d.Err = err
+		return d
+	}
+	res := f.Client().DynDialOrDie().Resource(gvr)
+	var live *unstructured.Unstructured
+	if namespaced {
+		live, err = res.Namespace(ns).Get(local.GetName(), metav1.GetOptions{})
+	} else {
+		live, err = res.Get(local.GetName(), metav1.GetOptions{})
+	}
+	if errors.IsNotFound(err) {
+		d.Missing = true
+		d.Diff, d.Err = diffObjects(nil, doc)
+		return d
+	}
+	if err != nil {
+		d.Err = err
+		return d
+	}
+	d.Diff, d.Err = diffObjects(live.Object, doc)
+
+	return d
+}
+
+func manifestName(u unstructured.Unstructured, ns string) string {
+	if ns == "" || u.GetNamespace() == "" && u.GetKind() == "Namespace" {
+		return u.GetKind() + " " + u.GetName()
+	}
+	return u.GetKind() + " " + ns + "/" + u.GetName()
+}
+
+// resourceForKind locates a resource matching a kind, favoring the manifest
+// version.
+func resourceForKind(gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+	var found *metav1.APIResource
+	for _, gvr := range AllGVRs() {
+		m := resMetas[gvr]
+		if m.Kind != gvk.Kind || IsK9sMeta(m) || gvr.ToG() != gvk.Group || strings.Contains(m.Name, "/") {
+			continue
+		}
+		found = &m
+		if gvr.ToV() == gvk.Version {
+			break
+		}
+	}
+	if found == nil {
+		return schema.GroupVersionResource{}, false, fmt.Errorf("unable to locate resource for %s", gvk)
+	}
+
+	return gvk.GroupVersion().WithResource(found.Name), found.Namespaced, nil
+}
+
+// splitManifests parses a multi documents YAML file. Lists are expanded.
+func splitManifests(raw []byte) ([]map[string]interface{}, error) {
+	var docs []map[string]interface{}
+	for i, d := range docSepRX.Split(string(raw), -1) {
+		if strings.TrimSpace(d) == "" {
+			continue
+		}
+		var o map[string]interface{}
+		if err := yaml.Unmarshal([]byte(d), &o); err != nil {
+			return nil, fmt.Errorf("document #%d: %w", i+1, err)
+		}
+		if len(o) == 0 {
+			continue
+		}
+		if items, ok := o["items"].([]interface{}); ok && strings.HasSuffix(fmt.Sprintf("%v", o["kind"]), "List") {
+			for _, item := range items {
+				if m, ok := item.(map[string]interface{}); ok {
+					docs = append(docs, m)
+				}
+			}
+			continue
+		}
+		docs = append(docs, o)
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("no manifests found")
+	}
+
+	return docs, nil
+}
+
+// diffObjects renders a unified diff of normalized live vs local objects.
+func diffObjects(live, local map[string]interface{}) (string, error) {
+	local = normalizeManifest(local)
+	var liveRaw []byte
+	if live != nil {
+		live = normalizeManifest(live)
+		pruned, _ := pruneDefaults(live, local).(map[string]interface{})
+		var err error
+		if liveRaw, err = yaml.Marshal(pruned); err != nil {
+			return "", err
+		}
+	}
+	localRaw, err := yaml.Marshal(local)
+	if err != nil {
+		return "", err
+	}
+
+	return unifiedDiff(splitLines(liveRaw), splitLines(localRaw)), nil
+}
+
+// normalizeManifest strips server populated fields and orders keyed lists.
+func normalizeManifest(o map[string]interface{}) map[string]interface{} {
+	o = runtimeDeepCopy(o)
+	delete(o, "status")
+	if m, ok := o["metadata"].(map[string]interface{}); ok {
+		for _, k := range []string{"managedFields", "creationTimestamp", "resourceVersion", "uid", "selfLink", "generation"} {
+			delete(m, k)
+		}
+		if aa, ok := m["annotations"].(map[string]interface{}); ok {
+			delete(aa, "kubectl.kubernetes.io/last-applied-configuration")
+			delete(aa, "deployment.kubernetes.io/revision")
+			if len(aa) == 0 {
+				delete(m, "annotations")
+			}
+		}
+	}
+
+	return sortKeyedLists(o).(map[string]interface{})
+}
+
+func runtimeDeepCopy(o map[string]interface{}) map[string]interface{} {
+	return (&unstructured.Unstructured{Object: o}).DeepCopy().Object
+}
+
+// sortKeyedLists orders lists of named elements so reordering does not
+// register as a change.
+func sortKeyedLists(o interface{}) interface{} {
+	switch v := o.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = sortKeyedLists(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = sortKeyedLists(e)
+		}
+		if key := listKey(v); key != "" {
+			sort.SliceStable(v, func(i, j int) bool {
+				return fmt.Sprintf("%v", v[i].(map[string]interface{})[key]) < fmt.Sprintf("%v", v[j].(map[string]interface{})[key])
+			})
+		}
+		return v
+	default:
+		return o
+	}
+}
+
+// listKey returns the merge key of a list of maps if any.
+func listKey(ll []interface{}) string {
+	if len(ll) == 0 {
+		return ""
+	}
+	for _, key := range []string{"name", "containerPort", "port", "mountPath", "ip"} {
+		keyed := true
+		for _, e := range ll {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				return ""
+			}
+			if _, ok := m[key]; !ok {
+				keyed = false
+				break
+			}
+		}
+		if keyed {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// pruneDefaults drops live fields not specified in the local manifest, most
+// likely defaulted by the server.
+func pruneDefaults(live, local interface{}) interface{} {
+	switch l := local.(type) {
+	case map[string]interface{}:
+		lv, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		res := make(map[string]interface{}, len(l))
+		for k, v := range lv {
+			if lo, ok := l[k]; ok {
+				res[k] = pruneDefaults(v, lo)
+			}
+		}
+		return res
+	case []interface{}:
+		lv, ok := live.([]interface{})
+		if !ok {
+			return live
+		}
+		res := make([]interface{}, 0, len(lv))
+		key := listKey(l)
+		for i, v := range lv {
+			switch {
+			case key != "":
+				if lo := findKeyed(l, key, v); lo != nil {
+					res = append(res, pruneDefaults(v, lo))
+				} else {
+					res = append(res, v)
+				}
+			case i < len(l):
+				res = append(res, pruneDefaults(v, l[i]))
+			default:
+				res = append(res, v)
+			}
+		}
+		return res
+	default:
+		return live
+	}
+}
+
+func findKeyed(ll []interface{}, key string, o interface{}) interface{} {
+	m, ok := o.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for _, e := range ll {
+		if em, ok := e.(map[string]interface{}); ok && fmt.Sprintf("%v", em[key]) == fmt.Sprintf("%v", m[key]) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func splitLines(raw []byte) []string {
+	raw = bytes.TrimRight(raw, "\n")
+	if len(raw) == 0 {
+		return nil
+	}
+	return strings.Split(string(raw), "\n")
+}
+
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// unifiedDiff renders a unified diff of two sets of lines or an empty string
+// if both sets match.
+func unifiedDiff(a, b []string) string {
+	ops := diffLines(a, b)
+	changed := false
+	for _, op := range ops {
+		if op.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var buff strings.Builder
+	fmt.Fprintln(&buff, "--- live")
+	fmt.Fprintln(&buff, "+++ local")
+	for start := 0; start < len(ops); {
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		from := max(start-diffContext, 0)
+		end, equal := start, 0
+		for end < len(ops) && equal <= 2*diffContext {
+			if ops[end].kind == ' ' {
+				equal++
+			} else {
+				equal = 0
+			}
+			end++
+		}
+		to := min(end-equal+diffContext, len(ops))
+		writeHunk(&buff, ops, from, to)
+		start = to
+	}
+
+	return buff.String()
+}
+
+func writeHunk(b *strings.Builder, ops []diffOp, from, to int) {
+	var aStart, bStart int
+	for _, op := range ops[:from] {
+		if op.kind != '+' {
+			aStart++
+		}
+		if op.kind != '-' {
+			bStart++
+		}
+	}
+	var aLen, bLen int
+	for _, op := range ops[from:to] {
+		if op.kind != '+' {
+			aLen++
+		}
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+	// Empty ranges point at the line preceding the hunk.
+	if aLen > 0 {
+		aStart++
+	}
+	if bLen > 0 {
+		bStart++
+	}
+	fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+	for _, op := range ops[from:to] {
+		fmt.Fprintf(b, "%c%s\n", op.kind, op.line)
+	}
+}
+
+// diffLines computes a line edit script using the longest common subsequence.
+func diffLines(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+
+	return ops
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,236 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestSplitManifests(t *testing.T) {
+	uu := map[string]struct {
+		raw   string
+		names []string
+		err   bool
+	}{
+		"single": {
+			raw:   "kind: Pod\nmetadata:\n  name: p1\n",
+			names: []string{"p1"},
+		},
+		"multi": {
+			raw:   "---\nkind: Pod\nmetadata:\n  name: p1\n---\n\n--- # comment\nkind: Pod\nmetadata:\n  name: p2\n",
+			names: []string{"p1", "p2"},
+		},
+		"list": {
+			raw:   "kind: List\nitems:\n- kind: Pod\n  metadata:\n    name: p1\n- kind: Service\n  metadata:\n    name: s1\n",
+			names: []string{"p1", "s1"},
+		},
+		"empty": {
+			raw: "---\n",
+			err: true,
+		},
+		"toast": {
+			raw: "kind: [\n",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			docs, err := splitManifests([]byte(u.raw))
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			names := make([]string, 0, len(docs))
+			for _, d := range docs {
+				names = append(names, d["metadata"].(map[string]interface{})["name"].(string))
+			}
+			assert.Equal(t, u.names, names)
+		})
+	}
+}
+
+func TestDiffObjects(t *testing.T) {
+	uu := map[string]struct {
+		live, local string
+		diff        string
+	}{
+		"same": {
+			live: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: p1
+  resourceVersion: "10"
+  uid: fred
+  creationTimestamp: "2020-01-01T00:00:00Z"
+  managedFields:
+  - manager: kubectl
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+spec:
+  containers:
+  - name: c1
+    image: nginx
+status:
+  phase: Running
+`,
+			local: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: p1
+spec:
+  containers:
+  - name: c1
+    image: nginx
+`,
+		},
+		"reordered": {
+			live: `
+kind: Pod
+spec:
+  containers:
+  - name: c2
+    image: redis
+  - name: c1
+    image: nginx
+`,
+			local: `
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    image: nginx
+  - name: c2
+    image: redis
+`,
+		},
+		"defaulted": {
+			live: `
+kind: Pod
+spec:
+  restartPolicy: Always
+  containers:
+  - name: c1
+    image: nginx
+    imagePullPolicy: Always
+    ports:
+    - containerPort: 80
+      protocol: TCP
+`,
+			local: `
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    image: nginx
+    ports:
+    - containerPort: 80
+`,
+		},
+		"changed": {
+			live: `
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    image: nginx:1.0
+    imagePullPolicy: Always
+`,
+			local: `
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    image: nginx:1.1
+`,
+			diff: `--- live
++++ local
+@@ -1,5 +1,5 @@
+ kind: Pod
+ spec:
+   containers:
+-  - image: nginx:1.0
++  - image: nginx:1.1
+     name: c1
+`,
+		},
+		"extraContainer": {
+			live: `
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    image: nginx
+`,
+			local: `
+kind: Pod
+spec:
+  containers:
+  - name: c0
+    image: redis
+  - name: c1
+    image: nginx
+`,
+			diff: `--- live
++++ local
+@@ -1,5 +1,7 @@
+ kind: Pod
+ spec:
+   containers:
++  - image: redis
++    name: c0
+   - image: nginx
+     name: c1
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var live, local map[string]interface{}
+			assert.Nil(t, yaml.Unmarshal([]byte(u.live), &live))
+			assert.Nil(t, yaml.Unmarshal([]byte(u.local), &local))
+			diff, err := diffObjects(live, local)
+			assert.Nil(t, err)
+			assert.Equal(t, u.diff, diff)
+		})
+	}
+}
+
+func TestDiffObjectsMissing(t *testing.T) {
+	diff, err := diffObjects(nil, map[string]interface{}{"kind": "Pod"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "--- live\n+++ local\n@@ -0,0 +1,1 @@\n+kind: Pod\n", diff)
+}
+
+func TestUnifiedDiffHunks(t *testing.T) {
+	a := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}
+	b := []string{"1", "2", "x", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "y", "15"}
+
+	assert.Equal(t, `--- live
++++ local
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++x
+ 4
+ 5
+ 6
+@@ -11,5 +11,5 @@
+ 11
+ 12
+ 13
+-14
++y
+ 15
+`, unifiedDiff(a, b))
+	assert.Equal(t, "", unifiedDiff(a, a))
+}
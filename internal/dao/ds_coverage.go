@@ -0,0 +1,262 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const podTemplateGenerationLabel = "pod-template-generation"
+
+// daemonSetTolerations lists the tolerations the daemonset controller adds to its pods.
+var daemonSetTolerations = []v1.Toleration{
+	{Key: "node.kubernetes.io/not-ready", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+	{Key: "node.kubernetes.io/unreachable", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+	{Key: "node.kubernetes.io/disk-pressure", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/memory-pressure", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/pid-pressure", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/unschedulable", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+}
+
+// NodeCoverage represents a daemonset pod status on a given node.
+type NodeCoverage struct {
+	Node      string
+	Pod       string
+	Scheduled bool
+	Ready     bool
+	Updated   bool
+	Excluded  string
+}
+
+// Status returns a coverage summary.
+func (n NodeCoverage) Status() string {
+	switch {
+	case n.Excluded != "" && n.Pod == "":
+		return "Excluded"
+	case n.Pod == "":
+		return "Missing"
+	case !n.Ready:
+		return "NotReady"
+	case !n.Updated:
+		return "Outdated"
+	default:
+		return "Ready"
+	}
+}
+
+// DaemonSetCoverage reports the daemonset pod status on every node.
+func DaemonSetCoverage(f Factory, path string) ([]NodeCoverage, error) {
+	o, err := f.Get("apps/v1/daemonsets", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ds appsv1.DaemonSet
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ds)
+	if err != nil {
+		return nil, err
+	}
+
+	oo, err := f.List("v1/nodes", "", true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]v1.Node, 0, len(oo))
+	for _, o := range oo {
+		var no v1.Node
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &no)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, no)
+	}
+
+	oo, err = f.List("v1/pods", ds.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	return nodeCoverage(ds, nodes, pods), nil
+}
+
+func nodeCoverage(ds appsv1.DaemonSet, nodes []v1.Node, pods []v1.Pod) []NodeCoverage {
+	owned := make(map[string]v1.Pod)
+	for _, po := range pods {
+		if ownedBy(po, ds.UID) && po.Spec.NodeName != "" {
+			owned[po.Spec.NodeName] = po
+		}
+	}
+
+	gen := fmt.Sprintf("%d", ds.Generation)
+	cc := make([]NodeCoverage, 0, len(nodes))
+	for _, no := range nodes {
+		c := NodeCoverage{
+			Node:     no.Name,
+			Excluded: nodeExclusion(ds.Spec.Template.Spec, no),
+		}
+		if po, ok := owned[no.Name]; ok {
+			c.Pod = po.Namespace + "/" + po.Name
+			c.Scheduled = true
+			c.Ready = podReady(po)
+			c.Updated = po.Labels[podTemplateGenerationLabel] == gen
+		}
+		cc = append(cc, c)
+	}
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].Node < cc[j].Node
+	})
+
+	return cc
+}
+
+func ownedBy(po v1.Pod, uid types.UID) bool {
+	for _, r := range po.OwnerReferences {
+		if r.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func podReady(po v1.Pod) bool {
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// nodeExclusion returns the reason a node is not eligible for a pod or blank if eligible.
+func nodeExclusion(spec v1.PodSpec, no v1.Node) string {
+	kk := make([]string, 0, len(spec.NodeSelector))
+	for k := range spec.NodeSelector {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		if lv, ok := no.Labels[k]; !ok || lv != spec.NodeSelector[k] {
+			return fmt.Sprintf("nodeSelector %s=%s mismatch", k, spec.NodeSelector[k])
+		}
+	}
+	if reason := nodeAffinityExclusion(spec.Affinity, no); reason != "" {
+		return reason
+	}
+
+	tt := make([]v1.Toleration, 0, len(spec.Tolerations)+len(daemonSetTolerations))
+	tt = append(append(tt, spec.Tolerations...), daemonSetTolerations...)
+	for _, t := range no.Spec.Taints {
+		if t.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !toleratedBy(t, tt) {
+			return fmt.Sprintf("taint %s not tolerated", taintStr(t))
+		}
+	}
+
+	return ""
+}
+
+func nodeAffinityExclusion(a *v1.Affinity, no v1.Node) string {
+	if a == nil || a.NodeAffinity == nil || a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+	terms := a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		return ""
+	}
+	for _, t := range terms {
+		if nodeTermMatches(t, no.Labels) {
+			return ""
+		}
+	}
+
+	return "nodeAffinity mismatch"
+}
+
+var nodeSelectorOps = map[v1.NodeSelectorOperator]selection.Operator{
+	v1.NodeSelectorOpIn:           selection.In,
+	v1.NodeSelectorOpNotIn:        selection.NotIn,
+	v1.NodeSelectorOpExists:       selection.Exists,
+	v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	v1.NodeSelectorOpGt:           selection.GreaterThan,
+	v1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+// nodeTermMatches checks a node labels against a term. Terms with no label
+// expressions never match.
+func nodeTermMatches(t v1.NodeSelectorTerm, ll map[string]string) bool {
+	if len(t.MatchExpressions) == 0 {
+		return false
+	}
+	sel := labels.NewSelector()
+	for _, e := range t.MatchExpressions {
+		op, ok := nodeSelectorOps[e.Operator]
+		if !ok {
+			return false
+		}
+		r, err := labels.NewRequirement(e.Key, op, e.Values)
+		if err != nil {
+			return false
+		}
+		sel = sel.Add(*r)
+	}
+
+	return sel.Matches(labels.Set(ll))
+}
+
+func toleratedBy(t v1.Taint, tt []v1.Toleration) bool {
+	for _, to := range tt {
+		if tolerates(to, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tolerates(to v1.Toleration, t v1.Taint) bool {
+	if to.Effect != "" && to.Effect != t.Effect {
+		return false
+	}
+	if to.Key != "" && to.Key != t.Key {
+		return false
+	}
+	switch to.Operator {
+	case v1.TolerationOpExists:
+		return true
+	case v1.TolerationOpEqual, "":
+		return to.Key != "" && to.Value == t.Value
+	default:
+		return false
+	}
+}
+
+func taintStr(t v1.Taint) string {
+	var b strings.Builder
+	b.WriteString(t.Key)
+	if t.Value != "" {
+		b.WriteString("=" + t.Value)
+	}
+	b.WriteString(":" + string(t.Effect))
+
+	return b.String()
+}
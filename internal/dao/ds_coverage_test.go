@@ -0,0 +1,192 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNodeExclusion(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		node v1.Node
+		e    string
+	}{
+		"eligible": {
+			node: makeCovNode("n1", nil),
+		},
+		"selectorMatch": {
+			spec: v1.PodSpec{NodeSelector: map[string]string{"disk": "ssd"}},
+			node: makeCovNode("n1", map[string]string{"disk": "ssd"}),
+		},
+		"selectorMismatch": {
+			spec: v1.PodSpec{NodeSelector: map[string]string{"disk": "ssd"}},
+			node: makeCovNode("n1", map[string]string{"disk": "hdd"}),
+			e:    "nodeSelector disk=ssd mismatch",
+		},
+		"selectorMissing": {
+			spec: v1.PodSpec{NodeSelector: map[string]string{"disk": "ssd", "arch": "arm"}},
+			node: makeCovNode("n1", map[string]string{"disk": "ssd"}),
+			e:    "nodeSelector arch=arm mismatch",
+		},
+		"affinityMatch": {
+			spec: v1.PodSpec{Affinity: makeNodeAffinity("zone", v1.NodeSelectorOpIn, "a", "b")},
+			node: makeCovNode("n1", map[string]string{"zone": "b"}),
+		},
+		"affinityMismatch": {
+			spec: v1.PodSpec{Affinity: makeNodeAffinity("zone", v1.NodeSelectorOpIn, "a")},
+			node: makeCovNode("n1", map[string]string{"zone": "b"}),
+			e:    "nodeAffinity mismatch",
+		},
+		"taintNotTolerated": {
+			node: makeCovNode("n1", nil, v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}),
+			e:    "taint dedicated=gpu:NoSchedule not tolerated",
+		},
+		"taintPreferNoSchedule": {
+			node: makeCovNode("n1", nil, v1.Taint{Key: "dedicated", Effect: v1.TaintEffectPreferNoSchedule}),
+		},
+		"tolerationEqual": {
+			spec: v1.PodSpec{Tolerations: []v1.Toleration{
+				{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+			}},
+			node: makeCovNode("n1", nil, v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}),
+		},
+		"tolerationValueMismatch": {
+			spec: v1.PodSpec{Tolerations: []v1.Toleration{
+				{Key: "dedicated", Value: "cpu"},
+			}},
+			node: makeCovNode("n1", nil, v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoExecute}),
+			e:    "taint dedicated=gpu:NoExecute not tolerated",
+		},
+		"tolerationEffectMismatch": {
+			spec: v1.PodSpec{Tolerations: []v1.Toleration{
+				{Key: "dedicated", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+			}},
+			node: makeCovNode("n1", nil, v1.Taint{Key: "dedicated", Effect: v1.TaintEffectNoExecute}),
+			e:    "taint dedicated:NoExecute not tolerated",
+		},
+		"tolerationExistsAll": {
+			spec: v1.PodSpec{Tolerations: []v1.Toleration{
+				{Operator: v1.TolerationOpExists},
+			}},
+			node: makeCovNode("n1", nil, v1.Taint{Key: "node-role.kubernetes.io/master", Effect: v1.TaintEffectNoSchedule}),
+		},
+		"unschedulable": {
+			node: makeCovNode("n1", nil, v1.Taint{Key: "node.kubernetes.io/unschedulable", Effect: v1.TaintEffectNoSchedule}),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nodeExclusion(u.spec, u.node))
+		})
+	}
+}
+
+func TestNodeCoverage(t *testing.T) {
+	ds := appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred", UID: "ds1", Generation: 2},
+		Spec: appsv1.DaemonSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{NodeSelector: map[string]string{"disk": "ssd"}},
+			},
+		},
+	}
+	nodes := []v1.Node{
+		makeCovNode("n4", map[string]string{"disk": "hdd"}),
+		makeCovNode("n3", map[string]string{"disk": "ssd"}),
+		makeCovNode("n2", map[string]string{"disk": "ssd"}),
+		makeCovNode("n1", map[string]string{"disk": "ssd"}),
+	}
+	pods := []v1.Pod{
+		makeCovPod("fred-1", "n1", "ds1", "2", true),
+		makeCovPod("fred-2", "n2", "ds1", "1", true),
+		makeCovPod("blee-3", "n3", "ds2", "2", true),
+	}
+
+	cc := nodeCoverage(ds, nodes, pods)
+	assert.Equal(t, 4, len(cc))
+
+	e := []struct {
+		node, pod, status string
+	}{
+		{"n1", "default/fred-1", "Ready"},
+		{"n2", "default/fred-2", "Outdated"},
+		{"n3", "", "Missing"},
+		{"n4", "", "Excluded"},
+	}
+	for i, c := range cc {
+		assert.Equal(t, e[i].node, c.Node)
+		assert.Equal(t, e[i].pod, c.Pod)
+		assert.Equal(t, e[i].status, c.Status())
+	}
+	assert.Equal(t, "nodeSelector disk=ssd mismatch", cc[3].Excluded)
+}
+
+func TestNodeCoverageStatus(t *testing.T) {
+	uu := map[string]struct {
+		c NodeCoverage
+		e string
+	}{
+		"excluded": {c: NodeCoverage{Excluded: "nodeAffinity mismatch"}, e: "Excluded"},
+		"missing":  {c: NodeCoverage{}, e: "Missing"},
+		"notReady": {c: NodeCoverage{Pod: "default/p1", Scheduled: true}, e: "NotReady"},
+		"outdated": {c: NodeCoverage{Pod: "default/p1", Scheduled: true, Ready: true}, e: "Outdated"},
+		"ready":    {c: NodeCoverage{Pod: "default/p1", Scheduled: true, Ready: true, Updated: true}, e: "Ready"},
+		"stray":    {c: NodeCoverage{Pod: "default/p1", Scheduled: true, Ready: true, Updated: true, Excluded: "nodeAffinity mismatch"}, e: "Ready"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.c.Status())
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeCovNode(n string, ll map[string]string, tt ...v1.Taint) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Labels: ll},
+		Spec:       v1.NodeSpec{Taints: tt},
+	}
+}
+
+func makeNodeAffinity(k string, op v1.NodeSelectorOperator, vv ...string) *v1.Affinity {
+	return &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{MatchExpressions: []v1.NodeSelectorRequirement{{Key: k, Operator: op, Values: vv}}},
+				},
+			},
+		},
+	}
+}
+
+func makeCovPod(n, node, owner, gen string, ready bool) v1.Pod {
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            n,
+			Labels:          map[string]string{podTemplateGenerationLabel: gen},
+			OwnerReferences: []metav1.OwnerReference{{UID: types.UID(owner)}},
+		},
+		Spec: v1.PodSpec{NodeName: node},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+		},
+	}
+}
@@ -0,0 +1,225 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1alpha1 "k8s.io/api/discovery/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const serviceNameLabel = "kubernetes.io/service-name"
+
+// endpointSliceGVRs lists known endpoint slices versions, most recent first.
+var endpointSliceGVRs = []string{
+	"discovery.k8s.io/v1/endpointslices",
+	"discovery.k8s.io/v1beta1/endpointslices",
+	"discovery.k8s.io/v1alpha1/endpointslices",
+}
+
+// EndpointTarget represents an endpoint address and its backing pod if any.
+type EndpointTarget struct {
+	IP  string
+	Pod string
+}
+
+// String returns the address and its backing pod.
+func (e EndpointTarget) String() string {
+	if e.Pod == "" {
+		return e.IP
+	}
+
+	return e.IP + "(" + e.Pod + ")"
+}
+
+// ServiceEndpoints represents a service endpoints readiness breakdown.
+type ServiceEndpoints struct {
+	Namespace, Service string
+	Ports              []string
+	Ready, NotReady    []EndpointTarget
+	CreationTimestamp  metav1.Time
+}
+
+// FQN returns the service fully qualified name.
+func (s ServiceEndpoints) FQN() string {
+	return client.FQN(s.Namespace, s.Service)
+}
+
+// NotReadyPods returns the pods backing not ready addresses.
+func (s ServiceEndpoints) NotReadyPods() []string {
+	pp := make([]string, 0, len(s.NotReady))
+	for _, t := range s.NotReady {
+		if t.Pod != "" {
+			pp = append(pp, t.Pod)
+		}
+	}
+
+	return pp
+}
+
+// EndpointSliceGVR returns the preferred endpoint slices resource if the cluster serves it.
+func EndpointSliceGVR() (string, bool) {
+	for _, gvr := range endpointSliceGVRs {
+		if _, err := MetaFor(client.NewGVR(gvr)); err == nil {
+			return gvr, true
+		}
+	}
+
+	return "", false
+}
+
+// ListServiceEndpoints returns the endpoints breakdown for all services in a namespace.
+// Endpoint slices are used when available otherwise falls back to endpoints.
+func ListServiceEndpoints(f Factory, ns string, sel labels.Selector) ([]ServiceEndpoints, error) {
+	if gvr, ok := EndpointSliceGVR(); ok {
+		oo, err := f.List(gvr, ns, true, sel)
+		if err == nil {
+			return fromEndpointSlices(oo)
+		}
+		log.Warn().Err(err).Msgf("Unable to list %s. Falling back to endpoints", gvr)
+	}
+
+	oo, err := f.List("v1/endpoints", ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromEndpoints(oo)
+}
+
+// ServiceEndpointsFor returns the endpoints breakdown for a given service.
+func ServiceEndpointsFor(f Factory, path string) (ServiceEndpoints, error) {
+	ns, _ := client.Namespaced(path)
+	ss, err := ListServiceEndpoints(f, ns, labels.Everything())
+	if err != nil {
+		return ServiceEndpoints{}, err
+	}
+	for _, s := range ss {
+		if s.FQN() == path {
+			return s, nil
+		}
+	}
+
+	return ServiceEndpoints{}, fmt.Errorf("no endpoints found for service %s", path)
+}
+
+func fromEndpoints(oo []runtime.Object) ([]ServiceEndpoints, error) {
+	ss := make([]ServiceEndpoints, 0, len(oo))
+	for _, o := range oo {
+		var ep v1.Endpoints
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ep)
+		if err != nil {
+			return nil, err
+		}
+		s := ServiceEndpoints{
+			Namespace:         ep.Namespace,
+			Service:           ep.Name,
+			CreationTimestamp: ep.CreationTimestamp,
+		}
+		for _, sub := range ep.Subsets {
+			for _, p := range sub.Ports {
+				s.Ports = addPort(s.Ports, p.Name, p.Port)
+			}
+			for _, a := range sub.Addresses {
+				s.Ready = append(s.Ready, EndpointTarget{IP: a.IP, Pod: podRef(a.TargetRef)})
+			}
+			for _, a := range sub.NotReadyAddresses {
+				s.NotReady = append(s.NotReady, EndpointTarget{IP: a.IP, Pod: podRef(a.TargetRef)})
+			}
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
+
+// fromEndpointSlices merges slices by owning service. Later slice versions
+// decode into the alpha types since the fields used here did not change.
+func fromEndpointSlices(oo []runtime.Object) ([]ServiceEndpoints, error) {
+	index := make(map[string]*ServiceEndpoints)
+	for _, o := range oo {
+		var sl discoveryv1alpha1.EndpointSlice
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sl)
+		if err != nil {
+			return nil, err
+		}
+		svc, ok := sl.Labels[serviceNameLabel]
+		if !ok {
+			continue
+		}
+		fqn := client.FQN(sl.Namespace, svc)
+		s, ok := index[fqn]
+		if !ok {
+			s = &ServiceEndpoints{
+				Namespace:         sl.Namespace,
+				Service:           svc,
+				CreationTimestamp: sl.CreationTimestamp,
+			}
+			index[fqn] = s
+		}
+		if sl.CreationTimestamp.Before(&s.CreationTimestamp) {
+			s.CreationTimestamp = sl.CreationTimestamp
+		}
+		for _, p := range sl.Ports {
+			if p.Port == nil {
+				continue
+			}
+			var n string
+			if p.Name != nil {
+				n = *p.Name
+			}
+			s.Ports = addPort(s.Ports, n, *p.Port)
+		}
+		for _, e := range sl.Endpoints {
+			pod := podRef(e.TargetRef)
+			// A nil ready condition must be interpreted as ready.
+			ready := e.Conditions.Ready == nil || *e.Conditions.Ready
+			for _, ip := range e.Addresses {
+				if ready {
+					s.Ready = append(s.Ready, EndpointTarget{IP: ip, Pod: pod})
+				} else {
+					s.NotReady = append(s.NotReady, EndpointTarget{IP: ip, Pod: pod})
+				}
+			}
+		}
+	}
+
+	ss := make([]ServiceEndpoints, 0, len(index))
+	for _, s := range index {
+		ss = append(ss, *s)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].FQN() < ss[j].FQN()
+	})
+
+	return ss, nil
+}
+
+func podRef(r *v1.ObjectReference) string {
+	if r == nil || r.Kind != "Pod" {
+		return ""
+	}
+
+	return r.Name
+}
+
+func addPort(pp []string, name string, port int32) []string {
+	p := strconv.Itoa(int(port))
+	if name != "" {
+		p = name + ":" + p
+	}
+	for _, e := range pp {
+		if e == p {
+			return pp
+		}
+	}
+
+	return append(pp, p)
+}
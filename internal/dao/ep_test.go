@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFromEndpoints(t *testing.T) {
+	oo := []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"namespace": "default", "name": "fred"},
+			"subsets": []interface{}{
+				map[string]interface{}{
+					"addresses": []interface{}{
+						map[string]interface{}{"ip": "10.0.0.1", "targetRef": map[string]interface{}{"kind": "Pod", "name": "p1"}},
+					},
+					"notReadyAddresses": []interface{}{
+						map[string]interface{}{"ip": "10.0.0.2", "targetRef": map[string]interface{}{"kind": "Pod", "name": "p2"}},
+						map[string]interface{}{"ip": "10.0.0.3"},
+					},
+					"ports": []interface{}{
+						map[string]interface{}{"name": "http", "port": int64(80)},
+					},
+				},
+			},
+		}},
+	}
+
+	ss, err := fromEndpoints(oo)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ss))
+	s := ss[0]
+	assert.Equal(t, "default/fred", s.FQN())
+	assert.Equal(t, []string{"http:80"}, s.Ports)
+	assert.Equal(t, []EndpointTarget{{IP: "10.0.0.1", Pod: "p1"}}, s.Ready)
+	assert.Equal(t, []EndpointTarget{{IP: "10.0.0.2", Pod: "p2"}, {IP: "10.0.0.3"}}, s.NotReady)
+	assert.Equal(t, []string{"p2"}, s.NotReadyPods())
+}
+
+func TestFromEndpointSlices(t *testing.T) {
+	oo := []runtime.Object{
+		makeSlice("fred-abc", "fred", true,
+			makeSliceEP("10.0.0.1", "p1", true),
+			makeSliceEP("10.0.0.2", "p2", false),
+		),
+		makeSlice("fred-def", "fred", true,
+			makeSliceEP("10.0.0.3", "p3", nil),
+		),
+		makeSlice("blee-abc", "blee", false,
+			makeSliceEP("10.0.0.4", "", true),
+		),
+		makeSlice("orphan", "", false),
+	}
+
+	ss, err := fromEndpointSlices(oo)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ss))
+
+	assert.Equal(t, "default/blee", ss[0].FQN())
+	assert.Equal(t, []EndpointTarget{{IP: "10.0.0.4"}}, ss[0].Ready)
+	assert.Empty(t, ss[0].NotReady)
+
+	assert.Equal(t, "default/fred", ss[1].FQN())
+	assert.Equal(t, []string{"http:80"}, ss[1].Ports)
+	assert.Equal(t, []EndpointTarget{{IP: "10.0.0.1", Pod: "p1"}, {IP: "10.0.0.3", Pod: "p3"}}, ss[1].Ready)
+	assert.Equal(t, []EndpointTarget{{IP: "10.0.0.2", Pod: "p2"}}, ss[1].NotReady)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeSlice(n, svc string, withPort bool, ee ...interface{}) *unstructured.Unstructured {
+	m := map[string]interface{}{"namespace": "default", "name": n}
+	if svc != "" {
+		m["labels"] = map[string]interface{}{serviceNameLabel: svc}
+	}
+	o := map[string]interface{}{
+		"metadata":    m,
+		"addressType": "IPv4",
+		"endpoints":   ee,
+	}
+	if withPort {
+		o["ports"] = []interface{}{map[string]interface{}{"name": "http", "port": int64(80)}}
+	}
+
+	return &unstructured.Unstructured{Object: o}
+}
+
+func makeSliceEP(ip, pod string, ready interface{}) map[string]interface{} {
+	e := map[string]interface{}{
+		"addresses":  []interface{}{ip},
+		"conditions": map[string]interface{}{},
+	}
+	if ready != nil {
+		e["conditions"] = map[string]interface{}{"ready": ready}
+	}
+	if pod != "" {
+		e["targetRef"] = map[string]interface{}{"kind": "Pod", "name": pod}
+	}
+
+	return e
+}
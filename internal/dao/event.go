@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EventsFor returns the events involving a given resource, latest first.
+func EventsFor(f Factory, gvr client.GVR, path string) ([]v1.Event, error) {
+	m, err := MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(path)
+	oo, err := f.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var e v1.Event
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &e)
+		if err != nil {
+			return nil, err
+		}
+		if involves(e, m.Kind, n) {
+			ee = append(ee, e)
+		}
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].LastTimestamp.After(ee[j].LastTimestamp.Time)
+	})
+
+	return ee, nil
+}
+
+// EventReport renders events one per line.
+func EventReport(ee []v1.Event) string {
+	if len(ee) == 0 {
+		return "No events found."
+	}
+	lines := make([]string, 0, len(ee))
+	for _, e := range ee {
+		lines = append(lines, fmt.Sprintf("%-8s %-8s %-20s %s", eventAge(e), e.Type, e.Reason, strings.TrimSpace(e.Message)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// EventDetails renders an event in full along with its involved object.
+func EventDetails(e v1.Event) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-10s %s\n", "Type:", e.Type)
+	fmt.Fprintf(&b, "%-10s %s\n", "Reason:", e.Reason)
+	fmt.Fprintf(&b, "%-10s %s\n", "Object:", involvedRef(e.InvolvedObject))
+	if e.InvolvedObject.FieldPath != "" {
+		fmt.Fprintf(&b, "%-10s %s\n", "Field:", e.InvolvedObject.FieldPath)
+	}
+	fmt.Fprintf(&b, "%-10s %s\n", "Source:", strings.TrimSpace(e.Source.Component+" "+e.Source.Host))
+	fmt.Fprintf(&b, "%-10s %d\n", "Count:", e.Count)
+	fmt.Fprintf(&b, "%-10s %s\n", "First:", eventStamp(e.FirstTimestamp))
+	fmt.Fprintf(&b, "%-10s %s\n", "Last:", eventStamp(e.LastTimestamp))
+	fmt.Fprintf(&b, "\n%s\n", strings.TrimSpace(e.Message))
+
+	return b.String()
+}
+
+// InvolvedObject returns the resource and path of an event involved object.
+func InvolvedObject(e v1.Event) (client.GVR, string, error) {
+	ref := e.InvolvedObject
+	gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+	gvr, namespaced, err := resourceForKind(gvk)
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	path := ref.Name
+	if namespaced {
+		ns := ref.Namespace
+		if ns == "" {
+			ns = e.Namespace
+		}
+		path = client.FQN(ns, ref.Name)
+	}
+
+	return client.FromGVAndR(gvr.GroupVersion().String(), gvr.Resource), path, nil
+}
+
+func involvedRef(ref v1.ObjectReference) string {
+	if ref.Namespace == "" {
+		return ref.Kind + " " + ref.Name
+	}
+
+	return ref.Kind + " " + client.FQN(ref.Namespace, ref.Name)
+}
+
+func eventStamp(t metav1.Time) string {
+	if t.IsZero() {
+		return "n/a"
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func involves(e v1.Event, kind, name string) bool {
+	return e.InvolvedObject.Kind == kind && e.InvolvedObject.Name == name
+}
@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestInvolves(t *testing.T) {
+	uu := map[string]struct {
+		kind, name string
+		e          bool
+	}{
+		"match":     {kind: "Pod", name: "p1", e: true},
+		"wrongKind": {kind: "Deployment", name: "p1"},
+		"wrongName": {kind: "Pod", name: "p2"},
+	}
+
+	ev := v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1"}}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, involves(ev, u.kind, u.name))
+		})
+	}
+}
+
+func TestEventReport(t *testing.T) {
+	uu := map[string]struct {
+		ee []v1.Event
+		e  string
+	}{
+		"none": {e: "No events found."},
+		"one": {
+			ee: []v1.Event{{Type: "Warning", Reason: "BackOff", Message: " Back-off restarting \n"}},
+			e:  "n/a      Warning  BackOff              Back-off restarting",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, EventReport(u.ee))
+		})
+	}
+}
+
+func TestEventDetails(t *testing.T) {
+	ev := v1.Event{
+		Type:           "Warning",
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container\n",
+		Count:          12,
+		Source:         v1.EventSource{Component: "kubelet", Host: "n1"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "p1", FieldPath: "spec.containers{c1}"},
+	}
+
+	e := `Type:      Warning
+Reason:    BackOff
+Object:    Pod default/p1
+Field:     spec.containers{c1}
+Source:    kubelet n1
+Count:     12
+First:     n/a
+Last:      n/a
+
+Back-off restarting failed container
+`
+	assert.Equal(t, e, EventDetails(ev))
+}
+
+func TestInvolvedObject(t *testing.T) {
+	defer func(mm ResourceMetas) { resMetas = mm }(resMetas)
+	resMetas = ResourceMetas{
+		client.NewGVR("v1/pods"):             {Name: "pods", Kind: "Pod", Namespaced: true},
+		client.NewGVR("v1/nodes"):            {Name: "nodes", Kind: "Node"},
+		client.NewGVR("apps/v1/replicasets"): {Name: "replicasets", Kind: "ReplicaSet", Namespaced: true},
+	}
+
+	uu := map[string]struct {
+		ev   v1.Event
+		gvr  string
+		path string
+		err  bool
+	}{
+		"pod": {
+			ev:   v1.Event{InvolvedObject: v1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "ns1", Name: "p1"}},
+			gvr:  "v1/pods",
+			path: "ns1/p1",
+		},
+		"eventNamespace": {
+			ev: v1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: "ns1"},
+				InvolvedObject: v1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs1"},
+			},
+			gvr:  "apps/v1/replicasets",
+			path: "ns1/rs1",
+		},
+		"clusterScoped": {
+			ev:   v1.Event{InvolvedObject: v1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "n1"}},
+			gvr:  "v1/nodes",
+			path: "n1",
+		},
+		"unknown": {
+			ev:  v1.Event{InvolvedObject: v1.ObjectReference{APIVersion: "v1", Kind: "Blee", Name: "b1"}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, path, err := InvolvedObject(u.ev)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.gvr, gvr.String())
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
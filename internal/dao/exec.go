@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ErrNoExecutable indicates the requested binary is not present in the container.
+var ErrNoExecutable = errors.New("executable not found in container")
+
+// Executor runs a non interactive command in a container.
+type Executor interface {
+	// Exec runs a command in a pod container and streams its outputs.
+	Exec(path, co string, cmd []string, stdout, stderr io.Writer) error
+}
+
+// RemoteExecutor runs commands via the api server exec endpoint.
+type RemoteExecutor struct {
+	client.Connection
+}
+
+var _ Executor = (*RemoteExecutor)(nil)
+
+// NewRemoteExecutor returns a new executor.
+func NewRemoteExecutor(c client.Connection) *RemoteExecutor {
+	return &RemoteExecutor{Connection: c}
+}
+
+// Exec runs a command in a pod container and streams its outputs.
+func (r *RemoteExecutor) Exec(path, co string, cmd []string, stdout, stderr io.Writer) error {
+	ns, n := client.Namespaced(path)
+	auth, err := r.CanI(ns, "v1/pods:exec", []string{"create"})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to exec into pod %s", path)
+	}
+
+	req := r.DialOrDie().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(n).
+		Namespace(ns).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(r.RestConfigOrDie(), "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return exec.Stream(remotecommand.StreamOptions{
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+}
+
+// ExecCapture runs a command in a container and returns its standard output.
+func ExecCapture(e Executor, path, co string, cmd []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	if err := e.Exec(path, co, cmd, &stdout, &stderr); err != nil {
+		if isNotFound(err.Error()) || isNotFound(stderr.String()) {
+			return "", ErrNoExecutable
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return stdout.String(), nil
+}
+
+func isNotFound(s string) bool {
+	s = strings.ToLower(s)
+	return strings.Contains(s, "executable file not found") ||
+		strings.Contains(s, "no such file or directory") ||
+		strings.Contains(s, "command not found") ||
+		strings.Contains(s, "exit code 127")
+}
@@ -0,0 +1,427 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ForeachUsage describes the bulk operation command.
+const ForeachUsage = "Usage: foreach -l <selector> [-n <ns>|0] <resource> delete|restart|label <key>=<value>|<key>-..."
+
+// A collection of bulk operation verbs.
+const (
+	// ForeachDelete deletes the matching resources.
+	ForeachDelete = "delete"
+	// ForeachRestart restarts the matching resources owners.
+	ForeachRestart = "restart"
+	// ForeachLabel adds, updates or removes labels on the matching resources.
+	ForeachLabel = "label"
+)
+
+// A collection of bulk operation target states.
+const (
+	ForeachPending = render.ForeachPending
+	ForeachRunning = render.ForeachRunning
+	ForeachOK      = render.ForeachOK
+	ForeachFailed  = render.ForeachFailed
+	ForeachSkipped = render.ForeachSkipped
+)
+
+// restartables lists the resources supporting a rollout restart.
+var restartables = map[string]struct{}{
+	"apps/v1/deployments":  {},
+	"apps/v1/statefulsets": {},
+	"apps/v1/daemonsets":   {},
+}
+
+// restartOwners maps owner kinds to their resource. Replicasets are followed
+// up to their deployment.
+var restartOwners = map[string]string{
+	"Deployment":  "apps/v1/deployments",
+	"StatefulSet": "apps/v1/statefulsets",
+	"DaemonSet":   "apps/v1/daemonsets",
+	"ReplicaSet":  "apps/v1/replicasets",
+}
+
+// ForeachCmd represents a bulk operation on the resources matching a label
+// selector.
+type ForeachCmd struct {
+	Resource  string
+	Selector  string
+	Namespace string
+	Verb      string
+	// Labels lists the labels to set. Nil values remove the label.
+	Labels map[string]*string
+}
+
+// ParseForeach parses bulk operation arguments ie -l app=fred -n 0 po delete.
+// A blank namespace denotes the active namespace.
+func ParseForeach(args []string) (*ForeachCmd, error) {
+	var (
+		cmd ForeachCmd
+		pos []string
+	)
+	for i := 0; i < len(args); i++ {
+		switch a := args[i]; a {
+		case "":
+		case "-l", "-n":
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("missing value for %s", a)
+			}
+			i++
+			if a == "-l" {
+				cmd.Selector = args[i]
+			} else {
+				cmd.Namespace = args[i]
+			}
+		default:
+			pos = append(pos, a)
+		}
+	}
+
+	if strings.TrimSpace(cmd.Selector) == "" {
+		return nil, errors.New("a label selector is required")
+	}
+	if _, err := labels.Parse(cmd.Selector); err != nil {
+		return nil, err
+	}
+	if cmd.Namespace == "0" {
+		cmd.Namespace = client.NamespaceAll
+	}
+	if len(pos) < 2 {
+		return nil, errors.New("a resource and a verb are required")
+	}
+	cmd.Resource, cmd.Verb = pos[0], pos[1]
+	switch cmd.Verb {
+	case ForeachDelete, ForeachRestart:
+		if len(pos) > 2 {
+			return nil, fmt.Errorf("%s takes no arguments", cmd.Verb)
+		}
+	case ForeachLabel:
+		ll, err := parseLabelArgs(pos[2:])
+		if err != nil {
+			return nil, err
+		}
+		cmd.Labels = ll
+	default:
+		return nil, fmt.Errorf("unknown verb %q", cmd.Verb)
+	}
+
+	return &cmd, nil
+}
+
+// Summary describes the operation in plain words.
+func (c *ForeachCmd) Summary() string {
+	if c.Verb != ForeachLabel {
+		return c.Verb
+	}
+
+	kk := make([]string, 0, len(c.Labels))
+	for k := range c.Labels {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ll := make([]string, 0, len(kk))
+	for _, k := range kk {
+		if v := c.Labels[k]; v != nil {
+			ll = append(ll, k+"="+*v)
+		} else {
+			ll = append(ll, k+"-")
+		}
+	}
+
+	return c.Verb + " " + strings.Join(ll, " ")
+}
+
+// ForeachTarget represents a resource a bulk operation applies to.
+type ForeachTarget struct {
+	GVR, Path       string
+	Status, Message string
+}
+
+// ID returns the target identifier.
+func (t ForeachTarget) ID() string {
+	return client.NewGVR(t.GVR).ToR() + ":" + t.Path
+}
+
+// ForeachTargets lists the resources a bulk operation applies to. Restarts
+// apply to the resources owners. Resources without a restartable owner are
+// skipped.
+func ForeachTargets(f Factory, gvr string, cmd *ForeachCmd) ([]ForeachTarget, error) {
+	sel, err := labels.Parse(cmd.Selector)
+	if err != nil {
+		return nil, err
+	}
+	ns := cmd.Namespace
+	if ns == client.NamespaceAll {
+		ns = client.AllNamespaces
+	}
+	oo, err := f.List(gvr, ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	tt := make([]ForeachTarget, 0, len(oo))
+	seen := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		t := ForeachTarget{GVR: gvr, Path: client.FQN(u.GetNamespace(), u.GetName()), Status: ForeachPending}
+		if cmd.Verb == ForeachRestart {
+			t = restartTarget(f, gvr, u)
+		}
+		if _, ok := seen[t.ID()]; ok {
+			continue
+		}
+		seen[t.ID()] = struct{}{}
+		tt = append(tt, t)
+	}
+	sort.Slice(tt, func(i, j int) bool {
+		return tt[i].ID() < tt[j].ID()
+	})
+
+	return tt, nil
+}
+
+// ForeachExec returns the operation to run against each target.
+func ForeachExec(f Factory, cmd *ForeachCmd) func(ForeachTarget) error {
+	return func(t ForeachTarget) error {
+		if cmd.Verb == ForeachLabel {
+			return patchLabels(f, t.GVR, t.Path, cmd.Labels)
+		}
+		res, err := AccessorFor(f, client.NewGVR(t.GVR))
+		if err != nil {
+			return err
+		}
+		if cmd.Verb == ForeachDelete {
+			return res.Delete(t.Path, true, false)
+		}
+		r, ok := res.(Restartable)
+		if !ok {
+			return fmt.Errorf("%s is not restartable", client.NewGVR(t.GVR).ToR())
+		}
+
+		return r.Restart(t.Path)
+	}
+}
+
+// ForeachRun tracks a bulk operation progress.
+type ForeachRun struct {
+	Cmd ForeachCmd
+
+	mx      sync.RWMutex
+	targets []ForeachTarget
+}
+
+// NewForeachRun returns a new bulk operation run.
+func NewForeachRun(cmd ForeachCmd, tt []ForeachTarget) *ForeachRun {
+	return &ForeachRun{Cmd: cmd, targets: tt}
+}
+
+// Targets returns the targets state.
+func (r *ForeachRun) Targets() []ForeachTarget {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	tt := make([]ForeachTarget, len(r.targets))
+	copy(tt, r.targets)
+
+	return tt
+}
+
+// Skip skips the pending targets matching the given predicate.
+func (r *ForeachRun) Skip(reason string, skip func(ForeachTarget) bool) int {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	var count int
+	for i, t := range r.targets {
+		if t.Status == ForeachPending && skip(t) {
+			r.targets[i].Status, r.targets[i].Message = ForeachSkipped, reason
+			count++
+		}
+	}
+
+	return count
+}
+
+// Tally counts the targets per state.
+func (r *ForeachRun) Tally() map[string]int {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	m := make(map[string]int)
+	for _, t := range r.targets {
+		m[t.Status]++
+	}
+
+	return m
+}
+
+// Exec runs the operation on the pending targets using a bounded pool of
+// workers. A failure is recorded on its target and does not stop the run.
+// Targets yet to run once the context is canceled are skipped.
+func (r *ForeachRun) Exec(ctx context.Context, workers int, fn func(ForeachTarget) error) {
+	if workers < 1 {
+		workers = 1
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				r.done(i, fn(r.target(i)))
+			}
+		}()
+	}
+
+	r.dispatch(ctx, jobs)
+	close(jobs)
+	wg.Wait()
+}
+
+func (r *ForeachRun) dispatch(ctx context.Context, jobs chan<- int) {
+	for _, i := range r.pending() {
+		r.set(i, ForeachRunning, "")
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			r.Skip("canceled", func(ForeachTarget) bool { return true })
+			r.set(i, ForeachSkipped, "canceled")
+			return
+		}
+	}
+}
+
+func (r *ForeachRun) pending() []int {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	ii := make([]int, 0, len(r.targets))
+	for i, t := range r.targets {
+		if t.Status == ForeachPending {
+			ii = append(ii, i)
+		}
+	}
+
+	return ii
+}
+
+func (r *ForeachRun) target(i int) ForeachTarget {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return r.targets[i]
+}
+
+func (r *ForeachRun) set(i int, status, msg string) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.targets[i].Status, r.targets[i].Message = status, msg
+}
+
+func (r *ForeachRun) done(i int, err error) {
+	if err != nil {
+		r.set(i, ForeachFailed, err.Error())
+		return
+	}
+	r.set(i, ForeachOK, "")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// restartTarget walks up a resource controllers to the first restartable one.
+func restartTarget(f Factory, gvr string, u *unstructured.Unstructured) ForeachTarget {
+	path := client.FQN(u.GetNamespace(), u.GetName())
+	for {
+		if _, ok := restartables[gvr]; ok {
+			return ForeachTarget{GVR: gvr, Path: path, Status: ForeachPending}
+		}
+		ref := controllerRef(u.GetOwnerReferences())
+		if ref == nil {
+			return ForeachTarget{GVR: gvr, Path: path, Status: ForeachSkipped, Message: "no restartable owner"}
+		}
+		ogvr, ok := restartOwners[ref.Kind]
+		if !ok {
+			return ForeachTarget{GVR: gvr, Path: path, Status: ForeachSkipped, Message: ref.Kind + " owner is not restartable"}
+		}
+		o, err := f.Get(ogvr, client.FQN(u.GetNamespace(), ref.Name), true, labels.Everything())
+		if err != nil {
+			return ForeachTarget{GVR: gvr, Path: path, Status: ForeachSkipped, Message: err.Error()}
+		}
+		if u, ok = o.(*unstructured.Unstructured); !ok {
+			return ForeachTarget{GVR: gvr, Path: path, Status: ForeachSkipped, Message: fmt.Sprintf("expecting unstructured but got %T", o)}
+		}
+		gvr, path = ogvr, client.FQN(u.GetNamespace(), u.GetName())
+	}
+}
+
+func controllerRef(rr []metav1.OwnerReference) *metav1.OwnerReference {
+	for i := range rr {
+		if rr[i].Controller != nil && *rr[i].Controller {
+			return &rr[i]
+		}
+	}
+
+	return nil
+}
+
+// parseLabelArgs parses key=value label settings and key- label removals.
+func parseLabelArgs(args []string) (map[string]*string, error) {
+	if len(args) == 0 {
+		return nil, errors.New("label requires key=value or key- arguments")
+	}
+	ll := make(map[string]*string, len(args))
+	for _, a := range args {
+		if strings.HasSuffix(a, "-") && !strings.Contains(a, "=") {
+			ll[strings.TrimSuffix(a, "-")] = nil
+			continue
+		}
+		tokens := strings.SplitN(a, "=", 2)
+		if len(tokens) != 2 || tokens[0] == "" {
+			return nil, fmt.Errorf("invalid label %q", a)
+		}
+		v := tokens[1]
+		ll[tokens[0]] = &v
+	}
+
+	return ll, nil
+}
+
+// patchLabels merges the given labels into a resource labels.
+func patchLabels(f Factory, gvr, path string, ll map[string]*string) error {
+	raw, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": ll},
+	})
+	if err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	dial := f.Client().DynDialOrDie().Resource(client.NewGVR(gvr).AsGVR())
+	if ns != "" {
+		_, err = dial.Namespace(ns).Patch(n, types.MergePatchType, raw, metav1.PatchOptions{})
+		return err
+	}
+	_, err = dial.Patch(n, types.MergePatchType, raw, metav1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,259 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestParseForeach(t *testing.T) {
+	fred, blee := "fred", ""
+	uu := map[string]struct {
+		args []string
+		e    *ForeachCmd
+		err  string
+	}{
+		"delete": {
+			args: []string{"-l", "app=payments", "po", "delete"},
+			e:    &ForeachCmd{Resource: "po", Selector: "app=payments", Verb: ForeachDelete},
+		},
+		"allNS": {
+			args: []string{"-l", "app=payments", "-n", "0", "po", "restart"},
+			e:    &ForeachCmd{Resource: "po", Selector: "app=payments", Namespace: client.NamespaceAll, Verb: ForeachRestart},
+		},
+		"label": {
+			args: []string{"dp", "label", "team=fred", "tier=", "old-", "-l", "app in (a,b)"},
+			e: &ForeachCmd{
+				Resource: "dp",
+				Selector: "app in (a,b)",
+				Verb:     ForeachLabel,
+				Labels:   map[string]*string{"team": &fred, "tier": &blee, "old": nil},
+			},
+		},
+		"noSelector": {
+			args: []string{"po", "delete"},
+			err:  "a label selector is required",
+		},
+		"badSelector": {
+			args: []string{"-l", "app==(", "po", "delete"},
+			err:  "unable to parse requirement",
+		},
+		"noValue": {
+			args: []string{"po", "delete", "-l"},
+			err:  "missing value for -l",
+		},
+		"noVerb": {
+			args: []string{"-l", "app=fred", "po"},
+			err:  "a resource and a verb are required",
+		},
+		"unknownVerb": {
+			args: []string{"-l", "app=fred", "po", "scale"},
+			err:  `unknown verb "scale"`,
+		},
+		"extraArgs": {
+			args: []string{"-l", "app=fred", "po", "delete", "now"},
+			err:  "delete takes no arguments",
+		},
+		"noLabels": {
+			args: []string{"-l", "app=fred", "po", "label"},
+			err:  "label requires key=value or key- arguments",
+		},
+		"badLabel": {
+			args: []string{"-l", "app=fred", "po", "label", "fred"},
+			err:  `invalid label "fred"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, err := ParseForeach(u.args)
+			if u.err != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, cmd)
+		})
+	}
+}
+
+func TestForeachCmdSummary(t *testing.T) {
+	cmd, err := ParseForeach([]string{"-l", "app=fred", "po", "label", "tier=web", "old-"})
+	assert.Nil(t, err)
+	assert.Equal(t, "label old- tier=web", cmd.Summary())
+
+	cmd, err = ParseForeach([]string{"-l", "app=fred", "po", "delete"})
+	assert.Nil(t, err)
+	assert.Equal(t, "delete", cmd.Summary())
+}
+
+func TestForeachTargets(t *testing.T) {
+	f := makeForeachFactory()
+
+	uu := map[string]struct {
+		verb, ns string
+		e        []ForeachTarget
+	}{
+		"delete": {
+			verb: ForeachDelete,
+			ns:   "ns1",
+			e: []ForeachTarget{
+				{GVR: "v1/pods", Path: "ns1/p1", Status: ForeachPending},
+				{GVR: "v1/pods", Path: "ns1/p2", Status: ForeachPending},
+				{GVR: "v1/pods", Path: "ns1/p3", Status: ForeachPending},
+			},
+		},
+		"allNS": {
+			verb: ForeachDelete,
+			ns:   client.NamespaceAll,
+			e: []ForeachTarget{
+				{GVR: "v1/pods", Path: "ns1/p1", Status: ForeachPending},
+				{GVR: "v1/pods", Path: "ns1/p2", Status: ForeachPending},
+				{GVR: "v1/pods", Path: "ns1/p3", Status: ForeachPending},
+				{GVR: "v1/pods", Path: "ns2/p4", Status: ForeachPending},
+			},
+		},
+		"restart": {
+			verb: ForeachRestart,
+			ns:   client.NamespaceAll,
+			e: []ForeachTarget{
+				{GVR: "apps/v1/deployments", Path: "ns1/dp1", Status: ForeachPending},
+				{GVR: "v1/pods", Path: "ns1/p3", Status: ForeachSkipped, Message: "no restartable owner"},
+				{GVR: "apps/v1/statefulsets", Path: "ns2/sts1", Status: ForeachPending},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd := ForeachCmd{Resource: "po", Selector: "app=fred", Namespace: u.ns, Verb: u.verb}
+			tt, err := ForeachTargets(f, "v1/pods", &cmd)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, tt)
+		})
+	}
+}
+
+func TestForeachRunExec(t *testing.T) {
+	tt := make([]ForeachTarget, 0, 20)
+	for i := 0; i < 20; i++ {
+		tt = append(tt, ForeachTarget{GVR: "v1/pods", Path: fmt.Sprintf("ns1/p%02d", i), Status: ForeachPending})
+	}
+	tt[3].Status, tt[3].Message = ForeachSkipped, "no restartable owner"
+	r := NewForeachRun(ForeachCmd{Verb: ForeachDelete}, tt)
+	assert.Equal(t, 1, r.Skip("namespace protected", func(t ForeachTarget) bool {
+		return t.Path == "ns1/p04"
+	}))
+
+	var active, peak, calls int32
+	r.Exec(context.Background(), 3, func(t ForeachTarget) error {
+		atomic.AddInt32(&calls, 1)
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		if t.Path == "ns1/p07" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	assert.Equal(t, int32(18), calls)
+	assert.True(t, peak <= 3)
+	assert.Equal(t, map[string]int{ForeachOK: 17, ForeachFailed: 1, ForeachSkipped: 2}, r.Tally())
+	res := r.Targets()
+	assert.Equal(t, ForeachFailed, res[7].Status)
+	assert.Equal(t, "boom", res[7].Message)
+	assert.Equal(t, "namespace protected", res[4].Message)
+}
+
+func TestForeachRunCanceled(t *testing.T) {
+	tt := []ForeachTarget{
+		{GVR: "v1/pods", Path: "ns1/p1", Status: ForeachPending},
+		{GVR: "v1/pods", Path: "ns1/p2", Status: ForeachPending},
+	}
+	r := NewForeachRun(ForeachCmd{Verb: ForeachDelete}, tt)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r.Exec(ctx, 1, func(ForeachTarget) error {
+		return nil
+	})
+
+	tally := r.Tally()
+	assert.Equal(t, 0, tally[ForeachRunning]+tally[ForeachPending])
+	assert.Equal(t, 2, tally[ForeachOK]+tally[ForeachSkipped])
+}
+
+// Helpers...
+
+type listFactory struct {
+	getFactory
+
+	ll []runtime.Object
+}
+
+func (f listFactory) List(_, ns string, _ bool, sel labels.Selector) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, len(f.ll))
+	for _, o := range f.ll {
+		u := o.(*unstructured.Unstructured)
+		if ns != client.AllNamespaces && u.GetNamespace() != ns {
+			continue
+		}
+		if sel.Matches(labels.Set(u.GetLabels())) {
+			oo = append(oo, o)
+		}
+	}
+	sort.Slice(oo, func(i, j int) bool {
+		return oo[i].(*unstructured.Unstructured).GetName() > oo[j].(*unstructured.Unstructured).GetName()
+	})
+
+	return oo, nil
+}
+
+func makeForeachFactory() listFactory {
+	owned := func(ns, n, kind, owner string) *unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetNamespace(ns)
+		u.SetName(n)
+		u.SetLabels(map[string]string{"app": "fred"})
+		if kind != "" {
+			yes := true
+			u.SetOwnerReferences([]metav1.OwnerReference{{Kind: kind, Name: owner, Controller: &yes}})
+		}
+		return &u
+	}
+
+	return listFactory{
+		getFactory: getFactory{oo: map[string]runtime.Object{
+			"apps/v1/replicasets:ns1/rs1":   owned("ns1", "rs1", "Deployment", "dp1"),
+			"apps/v1/deployments:ns1/dp1":   owned("ns1", "dp1", "", ""),
+			"apps/v1/statefulsets:ns2/sts1": owned("ns2", "sts1", "", ""),
+		}},
+		ll: []runtime.Object{
+			owned("ns1", "p1", "ReplicaSet", "rs1"),
+			owned("ns1", "p2", "ReplicaSet", "rs1"),
+			owned("ns1", "p3", "", ""),
+			owned("ns2", "p4", "StatefulSet", "sts1"),
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "ns1", "name": "p5"},
+			}},
+		},
+	}
+}
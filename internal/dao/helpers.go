@@ -3,10 +3,12 @@ package dao
 import (
 	"math"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func toPerc(v1, v2 float64) float64 {
@@ -21,6 +23,19 @@ func Truncate(str string, width int) string {
 	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
 }
 
+// FetchLive fetches a resource from the api server. The informers cache strips
+// managed fields and last applied configurations so views showing the full
+// resource must fetch it live.
+func FetchLive(f Factory, gvr, path string) (runtime.Object, error) {
+	ns, n := client.Namespaced(path)
+	req := f.Client().DynDialOrDie().Resource(client.NewGVR(gvr).AsGVR())
+	if ns == "" {
+		return req.Get(n, metav1.GetOptions{})
+	}
+
+	return req.Namespace(ns).Get(n, metav1.GetOptions{})
+}
+
 // FetchNodes returns a collection of nodes.
 func FetchNodes(f Factory) (*v1.NodeList, error) {
 	auth, err := f.Client().CanI("", "v1/nodes", []string{"list"})
@@ -0,0 +1,150 @@
+package dao
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ImageDrift tracks workloads pods not running their template images. Pods
+// and replicasets are listed once from the informer cache per instance.
+type ImageDrift struct {
+	owned map[types.UID][]v1.Pod
+}
+
+// NewImageDrift indexes the pods in a namespace by owning workload. Pods
+// owned by a replicaset are indexed under the replicaset controller.
+func NewImageDrift(f Factory, ns string) (*ImageDrift, error) {
+	oo, err := f.List("apps/v1/replicasets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rsOwners := make(map[types.UID]types.UID, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if ref := controllerOf(u.GetOwnerReferences()); ref != "" {
+			rsOwners[u.GetUID()] = ref
+		}
+	}
+
+	oo, err = f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	d := ImageDrift{owned: make(map[types.UID][]v1.Pod)}
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		owner := controllerOf(po.OwnerReferences)
+		if uid, ok := rsOwners[owner]; ok {
+			owner = uid
+		}
+		if owner != "" {
+			d.owned[owner] = append(d.owned[owner], po)
+		}
+	}
+
+	return &d, nil
+}
+
+// OldPods returns the number of pods owned by a workload that are not running
+// its template images.
+func (d *ImageDrift) OldPods(u *unstructured.Unstructured) (int, error) {
+	raw, ok, err := unstructured.NestedMap(u.Object, "spec", "template", "spec")
+	if err != nil || !ok {
+		return 0, err
+	}
+	var spec v1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &spec); err != nil {
+		return 0, err
+	}
+
+	return oldPods(spec, d.owned[u.GetUID()]), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func controllerOf(rr []metav1.OwnerReference) types.UID {
+	for _, r := range rr {
+		if r.Controller != nil && *r.Controller {
+			return r.UID
+		}
+	}
+
+	return ""
+}
+
+// oldPods counts pods running images other than the template's. Terminating
+// pods are on their way out and are skipped.
+func oldPods(spec v1.PodSpec, pp []v1.Pod) int {
+	var count int
+	for _, po := range pp {
+		if po.DeletionTimestamp != nil {
+			continue
+		}
+		if podDrifted(spec, po) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func podDrifted(spec v1.PodSpec, po v1.Pod) bool {
+	specs := make(map[string]string, len(po.Spec.Containers))
+	for _, c := range po.Spec.Containers {
+		specs[c.Name] = c.Image
+	}
+	statuses := make(map[string]v1.ContainerStatus, len(po.Status.ContainerStatuses))
+	for _, s := range po.Status.ContainerStatuses {
+		statuses[s.Name] = s
+	}
+
+	for _, c := range spec.Containers {
+		if img, ok := specs[c.Name]; !ok || img != c.Image {
+			return true
+		}
+		s, ok := statuses[c.Name]
+		if !ok || s.Image == "" {
+			continue
+		}
+		if !sameImage(c.Image, s.Image, s.ImageID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameImage checks a running image against a declared one. Digest pinned
+// images are matched against the running image id.
+func sameImage(want, image, imageID string) bool {
+	if i := strings.Index(want, "@"); i >= 0 {
+		return strings.HasSuffix(imageID, want[i:])
+	}
+
+	return normalizeImage(want) == normalizeImage(image)
+}
+
+// normalizeImage expands short image names as reported by the runtime.
+func normalizeImage(img string) string {
+	img = strings.TrimPrefix(img, "docker.io/")
+	img = strings.TrimPrefix(img, "library/")
+	if i := strings.LastIndex(img, ":"); i < 0 || strings.Contains(img[i:], "/") {
+		img += ":latest"
+	}
+
+	return img
+}
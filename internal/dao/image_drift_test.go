@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOldPods(t *testing.T) {
+	spec := v1.PodSpec{Containers: []v1.Container{
+		{Name: "app", Image: "nginx:1.17"},
+		{Name: "sidecar", Image: "envoy:1.14"},
+	}}
+	now := metav1.Now()
+
+	uu := map[string]struct {
+		pods []v1.Pod
+		e    int
+	}{
+		"none": {},
+		"synced": {
+			pods: []v1.Pod{
+				makeDriftPod("p1", "nginx:1.17", "envoy:1.14"),
+				makeDriftPod("p2", "nginx:1.17", "envoy:1.14"),
+			},
+		},
+		"oldSpec": {
+			pods: []v1.Pod{
+				makeDriftPod("p1", "nginx:1.17", "envoy:1.14"),
+				makeDriftPod("p2", "nginx:1.16", "envoy:1.14"),
+			},
+			e: 1,
+		},
+		"oldSidecar": {
+			pods: []v1.Pod{
+				makeDriftPod("p1", "nginx:1.17", "envoy:1.13"),
+				makeDriftPod("p2", "nginx:1.16", "envoy:1.13"),
+			},
+			e: 2,
+		},
+		"terminating": {
+			pods: []v1.Pod{
+				func() v1.Pod {
+					po := makeDriftPod("p1", "nginx:1.16", "envoy:1.14")
+					po.DeletionTimestamp = &now
+					return po
+				}(),
+				makeDriftPod("p2", "nginx:1.17", "envoy:1.14"),
+			},
+		},
+		"staleStatus": {
+			pods: []v1.Pod{
+				func() v1.Pod {
+					po := makeDriftPod("p1", "nginx:1.17", "envoy:1.14")
+					po.Status.ContainerStatuses[0].Image = "nginx:1.16"
+					return po
+				}(),
+			},
+			e: 1,
+		},
+		"noStatus": {
+			pods: []v1.Pod{
+				func() v1.Pod {
+					po := makeDriftPod("p1", "nginx:1.17", "envoy:1.14")
+					po.Status.ContainerStatuses = nil
+					return po
+				}(),
+			},
+		},
+		"missingContainer": {
+			pods: []v1.Pod{
+				func() v1.Pod {
+					po := makeDriftPod("p1", "nginx:1.17", "envoy:1.14")
+					po.Spec.Containers = po.Spec.Containers[:1]
+					return po
+				}(),
+			},
+			e: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, oldPods(spec, u.pods))
+		})
+	}
+}
+
+func TestSameImage(t *testing.T) {
+	uu := map[string]struct {
+		want, image, id string
+		e               bool
+	}{
+		"same":      {want: "nginx:1.17", image: "nginx:1.17", e: true},
+		"expanded":  {want: "nginx:1.17", image: "docker.io/library/nginx:1.17", e: true},
+		"latest":    {want: "nginx", image: "docker.io/library/nginx:latest", e: true},
+		"registry":  {want: "reg:5000/fred", image: "reg:5000/fred:latest", e: true},
+		"tag":       {want: "nginx:1.17", image: "nginx:1.16"},
+		"digest":    {want: "nginx@sha256:abc", image: "nginx:1.17", id: "docker-pullable://nginx@sha256:abc", e: true},
+		"digestOld": {want: "nginx@sha256:abc", image: "nginx:1.17", id: "docker-pullable://nginx@sha256:def"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sameImage(u.want, u.image, u.id))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeDriftPod(n, app, sidecar string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "default"},
+		Spec: v1.PodSpec{Containers: []v1.Container{
+			{Name: "app", Image: app},
+			{Name: "sidecar", Image: sidecar},
+		}},
+		Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+			{Name: "app", Image: app},
+			{Name: "sidecar", Image: sidecar},
+		}},
+	}
+}
@@ -0,0 +1,222 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// ingressClassGVRs lists known ingress classes versions, most recent first.
+var ingressClassGVRs = []string{
+	"networking.k8s.io/v1/ingressclasses",
+	"networking.k8s.io/v1beta1/ingressclasses",
+}
+
+// IngressBackend represents an ingress rule backend validation.
+type IngressBackend struct {
+	Namespace string
+	Host      string
+	Path      string
+	Service   string
+	Port      string
+	Ready     int
+	// Default flags the ingress default backend.
+	Default bool
+	Issues  []string
+}
+
+// OK checks if the backend is fully wired.
+func (b IngressBackend) OK() bool {
+	return len(b.Issues) == 0
+}
+
+// ServiceFQN returns the backend service fully qualified name.
+func (b IngressBackend) ServiceFQN() string {
+	return client.FQN(b.Namespace, b.Service)
+}
+
+// IngressBackends validates an ingress rules backends against the services
+// and endpoints caches.
+func IngressBackends(f Factory, path string) ([]IngressBackend, error) {
+	ing, _, err := getIngress(f, path)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := f.List("v1/services", ing.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	svcs := make(map[string]v1.Service, len(oo))
+	for _, o := range oo {
+		var svc v1.Service
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc)
+		if err != nil {
+			return nil, err
+		}
+		svcs[svc.Name] = svc
+	}
+	ee, err := ListServiceEndpoints(f, ing.Namespace, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ready := make(map[string]int, len(ee))
+	for _, e := range ee {
+		ready[e.Service] = len(e.Ready)
+	}
+
+	return ingressBackends(ing, svcs, ready), nil
+}
+
+// IngressClassIssue returns a warning if an ingress class is missing or blank
+// if the class checks out.
+func IngressClassIssue(f Factory, path string) (string, error) {
+	ing, raw, err := getIngress(f, path)
+	if err != nil {
+		return "", err
+	}
+	class := ingressClass(ing, raw)
+	if class == "" {
+		return "no ingress class", nil
+	}
+	gvr, ok := ingressClassGVR()
+	if !ok {
+		return "", nil
+	}
+	oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list %s", gvr)
+		return "", nil
+	}
+	for _, o := range oo {
+		if o.(*unstructured.Unstructured).GetName() == class {
+			return "", nil
+		}
+	}
+
+	return fmt.Sprintf("ingress class %q not found", class), nil
+}
+
+func getIngress(f Factory, path string) (v1beta1.Ingress, *unstructured.Unstructured, error) {
+	var ing v1beta1.Ingress
+	o, err := f.Get("extensions/v1beta1/ingresses", path, true, labels.Everything())
+	if err != nil {
+		return ing, nil, err
+	}
+	raw := o.(*unstructured.Unstructured)
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ing)
+
+	return ing, raw, err
+}
+
+func ingressClassGVR() (string, bool) {
+	for _, gvr := range ingressClassGVRs {
+		if _, err := MetaFor(client.NewGVR(gvr)); err == nil {
+			return gvr, true
+		}
+	}
+
+	return "", false
+}
+
+// ingressClass returns the ingress class name. The spec field is read off the
+// raw resource since it postdates the ingress types in use.
+func ingressClass(ing v1beta1.Ingress, raw *unstructured.Unstructured) string {
+	if raw != nil {
+		if c, ok, _ := unstructured.NestedString(raw.Object, "spec", "ingressClassName"); ok && c != "" {
+			return c
+		}
+	}
+
+	return ing.Annotations[ingressClassAnnotation]
+}
+
+func ingressBackends(ing v1beta1.Ingress, svcs map[string]v1.Service, ready map[string]int) []IngressBackend {
+	var bb []IngressBackend
+	if b := ing.Spec.Backend; b != nil {
+		be := ingressBackend(ing.Namespace, "*", "", *b, svcs, ready)
+		be.Default = true
+		bb = append(bb, be)
+	}
+	rr := make([]IngressBackend, 0, len(ing.Spec.Rules))
+	for _, r := range ing.Spec.Rules {
+		host := r.Host
+		if host == "" {
+			host = "*"
+		}
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			path := p.Path
+			if path == "" {
+				path = "/"
+			}
+			rr = append(rr, ingressBackend(ing.Namespace, host, path, p.Backend, svcs, ready))
+		}
+	}
+	sort.SliceStable(rr, func(i, j int) bool {
+		if rr[i].Host == rr[j].Host {
+			return rr[i].Path < rr[j].Path
+		}
+		return rr[i].Host < rr[j].Host
+	})
+
+	return append(bb, rr...)
+}
+
+func ingressBackend(ns, host, path string, b v1beta1.IngressBackend, svcs map[string]v1.Service, ready map[string]int) IngressBackend {
+	be := IngressBackend{
+		Namespace: ns,
+		Host:      host,
+		Path:      path,
+		Service:   b.ServiceName,
+		Port:      b.ServicePort.String(),
+	}
+	svc, ok := svcs[b.ServiceName]
+	if !ok {
+		be.Issues = append(be.Issues, "service not found")
+		return be
+	}
+	// External names have neither ports nor endpoints to check.
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return be
+	}
+	if !hasServicePort(svc, b.ServicePort) {
+		be.Issues = append(be.Issues, fmt.Sprintf("no service port %s", be.Port))
+	}
+	be.Ready = ready[b.ServiceName]
+	if be.Ready == 0 {
+		be.Issues = append(be.Issues, "no ready endpoints")
+	}
+
+	return be
+}
+
+// hasServicePort checks a backend port against a service ports. Numeric ports
+// match the service port number, named ports match the service port name.
+func hasServicePort(svc v1.Service, port intstr.IntOrString) bool {
+	for _, p := range svc.Spec.Ports {
+		switch port.Type {
+		case intstr.Int:
+			if p.Port == port.IntVal {
+				return true
+			}
+		case intstr.String:
+			if p.Name == port.StrVal {
+				return true
+			}
+		}
+	}
+
+	return false
+}
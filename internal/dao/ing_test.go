@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestIngressBackends(t *testing.T) {
+	svcs := map[string]v1.Service{
+		"web": makeIngSvc("web", v1.ServicePort{Name: "http", Port: 80}),
+		"api": makeIngSvc("api", v1.ServicePort{Port: 8080}),
+		"ext": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ext"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeExternalName, ExternalName: "fred.com"},
+		},
+	}
+	ready := map[string]int{"web": 2}
+
+	uu := map[string]struct {
+		port   intstr.IntOrString
+		svc    string
+		ready  int
+		issues []string
+	}{
+		"namedOK": {
+			port:  intstr.FromString("http"),
+			svc:   "web",
+			ready: 2,
+		},
+		"numericOK": {
+			port:  intstr.FromInt(80),
+			svc:   "web",
+			ready: 2,
+		},
+		"namedMissing": {
+			port:   intstr.FromString("https"),
+			svc:    "web",
+			ready:  2,
+			issues: []string{"no service port https"},
+		},
+		"numericMissing": {
+			port:   intstr.FromInt(443),
+			svc:    "web",
+			ready:  2,
+			issues: []string{"no service port 443"},
+		},
+		"unnamedByName": {
+			port:   intstr.FromString("http"),
+			svc:    "api",
+			issues: []string{"no service port http", "no ready endpoints"},
+		},
+		"noService": {
+			port:   intstr.FromInt(80),
+			svc:    "blee",
+			issues: []string{"service not found"},
+		},
+		"externalName": {
+			port: intstr.FromInt(443),
+			svc:  "ext",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := makeIngress(nil, v1beta1.IngressRule{
+				Host: "fred.com",
+				IngressRuleValue: v1beta1.IngressRuleValue{HTTP: &v1beta1.HTTPIngressRuleValue{
+					Paths: []v1beta1.HTTPIngressPath{{Path: "/a", Backend: v1beta1.IngressBackend{ServiceName: u.svc, ServicePort: u.port}}},
+				}},
+			})
+			bb := ingressBackends(ing, svcs, ready)
+			assert.Equal(t, 1, len(bb))
+			assert.Equal(t, "fred.com", bb[0].Host)
+			assert.Equal(t, "/a", bb[0].Path)
+			assert.Equal(t, u.ready, bb[0].Ready)
+			assert.Equal(t, u.issues, bb[0].Issues)
+			assert.Equal(t, len(u.issues) == 0, bb[0].OK())
+		})
+	}
+}
+
+func TestIngressBackendsDefaultOnly(t *testing.T) {
+	svcs := map[string]v1.Service{
+		"web": makeIngSvc("web", v1.ServicePort{Name: "http", Port: 80}),
+	}
+	ing := makeIngress(&v1beta1.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)})
+
+	bb := ingressBackends(ing, svcs, map[string]int{"web": 1})
+	assert.Equal(t, 1, len(bb))
+	assert.True(t, bb[0].Default)
+	assert.True(t, bb[0].OK())
+	assert.Equal(t, "*", bb[0].Host)
+	assert.Equal(t, "default/web", bb[0].ServiceFQN())
+}
+
+func TestIngressBackendsOrder(t *testing.T) {
+	be := v1beta1.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)}
+	rule := func(host string, pp ...string) v1beta1.IngressRule {
+		r := v1beta1.IngressRule{Host: host, IngressRuleValue: v1beta1.IngressRuleValue{HTTP: &v1beta1.HTTPIngressRuleValue{}}}
+		for _, p := range pp {
+			r.HTTP.Paths = append(r.HTTP.Paths, v1beta1.HTTPIngressPath{Path: p, Backend: be})
+		}
+		return r
+	}
+	ing := makeIngress(&be, rule("b.com", "/z", ""), rule("", "/x"), rule("a.com", "/y"))
+
+	bb := ingressBackends(ing, map[string]v1.Service{}, nil)
+	hh := make([]string, 0, len(bb))
+	for _, b := range bb {
+		hh = append(hh, b.Host+b.Path)
+	}
+	assert.Equal(t, []string{"*", "*/x", "a.com/y", "b.com/", "b.com/z"}, hh)
+}
+
+func TestIngressClass(t *testing.T) {
+	uu := map[string]struct {
+		ann  map[string]string
+		spec string
+		e    string
+	}{
+		"none": {},
+		"annotation": {
+			ann: map[string]string{ingressClassAnnotation: "nginx"},
+			e:   "nginx",
+		},
+		"spec": {
+			ann:  map[string]string{ingressClassAnnotation: "nginx"},
+			spec: "traefik",
+			e:    "traefik",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := makeIngress(nil)
+			ing.Annotations = u.ann
+			raw := unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{}}}
+			if u.spec != "" {
+				assert.Nil(t, unstructured.SetNestedField(raw.Object, u.spec, "spec", "ingressClassName"))
+			}
+			assert.Equal(t, u.e, ingressClass(ing, &raw))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeIngress(def *v1beta1.IngressBackend, rr ...v1beta1.IngressRule) v1beta1.Ingress {
+	return v1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing"},
+		Spec:       v1beta1.IngressSpec{Backend: def, Rules: rr},
+	}
+}
+
+func makeIngSvc(n string, pp ...v1.ServicePort) v1.Service {
+	return v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Spec:       v1.ServiceSpec{Type: v1.ServiceTypeClusterIP, Ports: pp},
+	}
+}
@@ -0,0 +1,202 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ContainerExit represents a container termination.
+type ContainerExit struct {
+	Container string
+	Code      int32
+	Reason    string
+	// Previous flags a termination reported by a restarted container.
+	Previous bool
+}
+
+// JobPod represents a job pod termination summary.
+type JobPod struct {
+	Namespace string
+	Name      string
+	Node      string
+	Phase     string
+	Restarts  int32
+	Created   metav1.Time
+	Exits     []ContainerExit
+}
+
+// Failed checks if the pod failed or any of its containers exited in error.
+func (j JobPod) Failed() bool {
+	if j.Phase == string(v1.PodFailed) {
+		return true
+	}
+	for _, e := range j.Exits {
+		if e.Code != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FailedExit returns the first container termination in error if any.
+func (j JobPod) FailedExit() (ContainerExit, bool) {
+	return failedExit(j.Exits)
+}
+
+// JobPods returns the pods owned by a job.
+func JobPods(f Factory, path string) ([]JobPod, error) {
+	job, err := getJob(f, path)
+	if err != nil {
+		return nil, err
+	}
+	sel := labels.Everything()
+	if job.Spec.Selector != nil {
+		if sel, err = metav1.LabelSelectorAsSelector(job.Spec.Selector); err != nil {
+			return nil, err
+		}
+	}
+	oo, err := f.List("v1/pods", job.Namespace, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	return jobPods(job, pods), nil
+}
+
+// PodFailedExit returns a pod first container termination in error if any.
+func PodFailedExit(f Factory, path string) (ContainerExit, bool, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return ContainerExit{}, false, err
+	}
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return ContainerExit{}, false, err
+	}
+	ee, _ := podExits(po)
+	e, ok := failedExit(ee)
+
+	return e, ok, nil
+}
+
+// JobFailure returns a job failed condition summary or blank if the job did not fail.
+func JobFailure(f Factory, path string) (string, error) {
+	job, err := getJob(f, path)
+	if err != nil {
+		return "", err
+	}
+
+	return jobFailure(job), nil
+}
+
+func getJob(f Factory, path string) (batchv1.Job, error) {
+	var job batchv1.Job
+	o, err := f.Get("batch/v1/jobs", path, true, labels.Everything())
+	if err != nil {
+		return job, err
+	}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &job)
+
+	return job, err
+}
+
+func jobFailure(job batchv1.Job) string {
+	for _, c := range job.Status.Conditions {
+		if c.Type != batchv1.JobFailed || c.Status != v1.ConditionTrue {
+			continue
+		}
+		if c.Message == "" {
+			return c.Reason
+		}
+		return fmt.Sprintf("%s: %s", c.Reason, c.Message)
+	}
+
+	return ""
+}
+
+func jobPods(job batchv1.Job, pods []v1.Pod) []JobPod {
+	jj := make([]JobPod, 0, len(pods))
+	for _, po := range pods {
+		if !ownedBy(po, job.UID) {
+			continue
+		}
+		j := JobPod{
+			Namespace: po.Namespace,
+			Name:      po.Name,
+			Node:      po.Spec.NodeName,
+			Phase:     string(po.Status.Phase),
+			Created:   po.CreationTimestamp,
+		}
+		j.Exits, j.Restarts = podExits(po)
+		jj = append(jj, j)
+	}
+	sort.Slice(jj, func(i, j int) bool {
+		return jj[i].Name < jj[j].Name
+	})
+
+	return jj
+}
+
+// podExits returns a pod init and regular containers terminations along with
+// the total restarts count.
+func podExits(po v1.Pod) ([]ContainerExit, int32) {
+	var (
+		ee       []ContainerExit
+		restarts int32
+	)
+	ss := append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...)
+	for _, cs := range ss {
+		restarts += cs.RestartCount
+		if e, ok := containerExit(cs); ok {
+			ee = append(ee, e)
+		}
+	}
+
+	return ee, restarts
+}
+
+func failedExit(ee []ContainerExit) (ContainerExit, bool) {
+	for _, e := range ee {
+		if e.Code != 0 {
+			return e, true
+		}
+	}
+
+	return ContainerExit{}, false
+}
+
+// containerExit returns a container current termination or its last one if
+// the container was restarted.
+func containerExit(cs v1.ContainerStatus) (ContainerExit, bool) {
+	t, prev := cs.State.Terminated, false
+	if t == nil {
+		t, prev = cs.LastTerminationState.Terminated, true
+	}
+	if t == nil {
+		return ContainerExit{}, false
+	}
+	reason := t.Reason
+	if reason == "" && t.Signal != 0 {
+		reason = fmt.Sprintf("Signal:%d", t.Signal)
+	}
+
+	return ContainerExit{Container: cs.Name, Code: t.ExitCode, Reason: reason, Previous: prev}, true
+}
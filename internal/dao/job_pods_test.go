@@ -0,0 +1,123 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestJobPods(t *testing.T) {
+	job := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "j1", UID: types.UID("j1")}}
+	pods := []v1.Pod{
+		makeJobPod("p2", "j1", v1.PodFailed, v1.ContainerStatus{
+			Name:  "c1",
+			State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+		}),
+		makeJobPod("p1", "j1", v1.PodRunning, v1.ContainerStatus{
+			Name:                 "c1",
+			RestartCount:         2,
+			State:                v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+		}),
+		makeJobPod("p3", "j2", v1.PodFailed),
+		makeJobPod("p4", "j1", v1.PodSucceeded, v1.ContainerStatus{
+			Name:  "c1",
+			State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+		}),
+	}
+
+	jj := jobPods(job, pods)
+	assert.Equal(t, 3, len(jj))
+	assert.Equal(t, []string{"p1", "p2", "p4"}, []string{jj[0].Name, jj[1].Name, jj[2].Name})
+
+	assert.True(t, jj[0].Failed())
+	assert.Equal(t, int32(2), jj[0].Restarts)
+	e, ok := jj[0].FailedExit()
+	assert.True(t, ok)
+	assert.Equal(t, ContainerExit{Container: "c1", Code: 137, Reason: "OOMKilled", Previous: true}, e)
+
+	assert.True(t, jj[1].Failed())
+	e, ok = jj[1].FailedExit()
+	assert.True(t, ok)
+	assert.Equal(t, ContainerExit{Container: "c1", Code: 1, Reason: "Error"}, e)
+
+	assert.False(t, jj[2].Failed())
+	_, ok = jj[2].FailedExit()
+	assert.False(t, ok)
+}
+
+func TestContainerExit(t *testing.T) {
+	uu := map[string]struct {
+		cs v1.ContainerStatus
+		e  ContainerExit
+		ok bool
+	}{
+		"running": {
+			cs: v1.ContainerStatus{Name: "c1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+		},
+		"signaled": {
+			cs: v1.ContainerStatus{
+				Name:  "c1",
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 143, Signal: 15}},
+			},
+			e:  ContainerExit{Container: "c1", Code: 143, Reason: "Signal:15"},
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, ok := containerExit(u.cs)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, e)
+		})
+	}
+}
+
+func TestJobFailure(t *testing.T) {
+	uu := map[string]struct {
+		cc []batchv1.JobCondition
+		e  string
+	}{
+		"none": {},
+		"complete": {
+			cc: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+		},
+		"backoff": {
+			cc: []batchv1.JobCondition{{
+				Type:    batchv1.JobFailed,
+				Status:  v1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			}},
+			e: "BackoffLimitExceeded: Job has reached the specified backoff limit",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			job := batchv1.Job{Status: batchv1.JobStatus{Conditions: u.cc}}
+			assert.Equal(t, u.e, jobFailure(job))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeJobPod(n, owner string, phase v1.PodPhase, ss ...v1.ContainerStatus) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            n,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Job", Name: owner, UID: types.UID(owner)}},
+		},
+		Status: v1.PodStatus{Phase: phase, ContainerStatuses: ss},
+	}
+}
@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// killPollInterval represents how often a signaled container is checked for exit.
+var killPollInterval = time.Second
+
+// ContainerRestartable checks the kubelet will restart a container once its main process exits.
+func ContainerRestartable(f Factory, path, co string) error {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return err
+	}
+
+	return restartable(po, co)
+}
+
+func restartable(po v1.Pod, co string) error {
+	for _, c := range po.Spec.InitContainers {
+		if c.Name == co {
+			return fmt.Errorf("init container %s would not be restarted", co)
+		}
+	}
+	if _, ok := findContainer(po.Spec, co); !ok {
+		return fmt.Errorf("no container named %q found on pod %s", co, po.Name)
+	}
+	if p := po.Spec.RestartPolicy; p != "" && p != v1.RestartPolicyAlways {
+		return fmt.Errorf("pod restart policy %s would not restart container %s", p, co)
+	}
+
+	return nil
+}
+
+// ContainerStateFunc reports a container id and whether it is running.
+type ContainerStateFunc func() (id string, running bool, err error)
+
+// ContainerState returns a function reporting a pod container state.
+func ContainerState(f Factory, path, co string) ContainerStateFunc {
+	return func() (string, bool, error) {
+		o, err := f.Get("v1/pods", path, true, labels.Everything())
+		if err != nil {
+			return "", false, err
+		}
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return "", false, err
+		}
+		for _, cs := range po.Status.ContainerStatuses {
+			if cs.Name == co {
+				return cs.ContainerID, cs.State.Running != nil, nil
+			}
+		}
+
+		return "", false, fmt.Errorf("no status found for container %s", co)
+	}
+}
+
+// KillContainer sends SIGTERM to a container main process and SIGKILL if the
+// container was neither terminated nor replaced after the given timeout. It
+// returns true if SIGKILL was needed.
+func KillContainer(e Executor, state ContainerStateFunc, path, co string, timeout time.Duration) (bool, error) {
+	id, _, err := state()
+	if err != nil {
+		return false, err
+	}
+	if err := signalInit(e, path, co, "TERM"); err != nil {
+		return false, err
+	}
+	if exited(state, id, timeout) {
+		return false, nil
+	}
+
+	log.Debug().Msgf("Container %s did not exit on SIGTERM. Sending SIGKILL", co)
+	if err := signalInit(e, path, co, "KILL"); err != nil {
+		return true, err
+	}
+	if !exited(state, id, timeout) {
+		return true, fmt.Errorf("container %s ignored SIGTERM and SIGKILL", co)
+	}
+
+	return true, nil
+}
+
+// exited waits for a container to stop running or to be replaced.
+func exited(state ContainerStateFunc, id string, timeout time.Duration) bool {
+	for elapsed := time.Duration(0); elapsed < timeout; elapsed += killPollInterval {
+		time.Sleep(killPollInterval)
+		cid, running, err := state()
+		if err != nil {
+			continue
+		}
+		if cid != id || !running {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signalCmds lists ways to signal a process, from a kill binary down to the
+// shell builtin and busybox.
+func signalCmds(sig string, pid int) [][]string {
+	p := strconv.Itoa(pid)
+	return [][]string{
+		{"kill", "-" + sig, p},
+		{"sh", "-c", "kill -" + sig + " " + p},
+		{"busybox", "kill", "-" + sig, p},
+	}
+}
+
+func signalInit(e Executor, path, co, sig string) error {
+	for _, cmd := range signalCmds(sig, 1) {
+		_, err := ExecCapture(e, path, co, cmd)
+		if !errors.Is(err, ErrNoExecutable) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("container %s has no kill, sh or busybox to signal PID 1", co)
+}
@@ -0,0 +1,144 @@
+package dao
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func init() {
+	killPollInterval = time.Millisecond
+}
+
+func TestRestartable(t *testing.T) {
+	uu := map[string]struct {
+		policy v1.RestartPolicy
+		co     string
+		err    string
+	}{
+		"always":    {policy: v1.RestartPolicyAlways, co: "c1"},
+		"default":   {co: "c1"},
+		"never":     {policy: v1.RestartPolicyNever, co: "c1", err: "pod restart policy Never would not restart container c1"},
+		"onFailure": {policy: v1.RestartPolicyOnFailure, co: "c1", err: "pod restart policy OnFailure would not restart container c1"},
+		"init":      {policy: v1.RestartPolicyAlways, co: "i1", err: "init container i1 would not be restarted"},
+		"missing":   {policy: v1.RestartPolicyAlways, co: "c2", err: `no container named "c2" found on pod p1`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{Spec: v1.PodSpec{
+				RestartPolicy:  u.policy,
+				InitContainers: []v1.Container{{Name: "i1"}},
+				Containers:     []v1.Container{{Name: "c1"}},
+			}}
+			po.Name = "p1"
+			err := restartable(po, u.co)
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestKillContainer(t *testing.T) {
+	uu := map[string]struct {
+		bins   []string
+		states []containerState
+		forced bool
+		err    string
+		cmds   []string
+	}{
+		"terminated": {
+			bins:   []string{"kill"},
+			states: []containerState{{"c-1", true}, {"c-1", true}, {"c-2", true}},
+			cmds:   []string{"kill -TERM 1"},
+		},
+		"shellBuiltin": {
+			bins:   []string{"sh"},
+			states: []containerState{{"c-1", true}, {"c-1", false}},
+			cmds:   []string{"sh -c kill -TERM 1"},
+		},
+		"forced": {
+			bins:   []string{"busybox"},
+			states: []containerState{{"c-1", true}, {"c-1", true}, {"c-1", true}, {"c-1", true}, {"c-2", true}},
+			forced: true,
+			cmds:   []string{"busybox kill -TERM 1", "busybox kill -KILL 1"},
+		},
+		"ignored": {
+			bins:   []string{"kill"},
+			states: []containerState{{"c-1", true}},
+			forced: true,
+			err:    "container c1 ignored SIGTERM and SIGKILL",
+			cmds:   []string{"kill -TERM 1", "kill -KILL 1"},
+		},
+		"noTools": {
+			states: []containerState{{"c-1", true}},
+			err:    "container c1 has no kill, sh or busybox to signal PID 1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := recExecutor{bins: u.bins}
+			forced, err := KillContainer(&e, stateSeq(u.states), "fred/p1", "c1", 3*time.Millisecond)
+
+			assert.Equal(t, u.forced, forced)
+			if u.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, u.err)
+			}
+			assert.Equal(t, u.cmds, e.cmds)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type containerState struct {
+	id      string
+	running bool
+}
+
+// stateSeq replays container states, repeating the last one.
+func stateSeq(ss []containerState) ContainerStateFunc {
+	var i int
+	return func() (string, bool, error) {
+		if len(ss) == 0 {
+			return "", false, errors.New("no state")
+		}
+		s := ss[i]
+		if i < len(ss)-1 {
+			i++
+		}
+		return s.id, s.running, nil
+	}
+}
+
+type recExecutor struct {
+	bins []string
+	cmds []string
+}
+
+func (r *recExecutor) Exec(path, co string, cmd []string, stdout, stderr io.Writer) error {
+	for _, b := range r.bins {
+		if b == cmd[0] {
+			r.cmds = append(r.cmds, strings.Join(cmd, " "))
+			return nil
+		}
+	}
+	if _, err := io.WriteString(stderr, cmd[0]+": not found"); err != nil {
+		return err
+	}
+	return errors.New("command terminated with exit code 127")
+}
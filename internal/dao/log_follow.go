@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const logReopenRetries = 10
+
+// logRestartPoll represents the container restart check interval.
+var logRestartPoll = 1 * time.Second
+
+// logSource represents a container logs provider.
+type logSource interface {
+	// Open opens a log stream on the current container instance.
+	Open(ctx context.Context) (io.ReadCloser, error)
+
+	// Restarts returns the container restart count and last termination if any.
+	Restarts() (int32, *v1.ContainerStateTerminated, error)
+}
+
+// followLogs streams container logs, reopening the stream on the new
+// instance every time the container restarts.
+func followLogs(ctx context.Context, stream io.ReadCloser, src logSource, c chan<- string, opts LogOptions) {
+	count, _, err := src.Restarts()
+	if err != nil {
+		readLogs(ctx, stream, c, opts)
+		return
+	}
+	for {
+		readLogs(ctx, stream, c, opts)
+		var term *v1.ContainerStateTerminated
+		if count, term = waitRestart(ctx, src, count); term == nil {
+			return
+		}
+		c <- opts.DecorateLog(restartSeparator(term))
+		if stream = reopenLogs(ctx, src); stream == nil {
+			return
+		}
+	}
+}
+
+// waitRestart blocks until the container restarts. It returns a nil
+// termination if the wait was canceled or the container is gone.
+func waitRestart(ctx context.Context, src logSource, count int32) (int32, *v1.ContainerStateTerminated) {
+	for {
+		select {
+		case <-ctx.Done():
+			return count, nil
+		case <-time.After(logRestartPoll):
+			n, term, err := src.Restarts()
+			if err != nil {
+				log.Debug().Err(err).Msg("Log restart check bailing out")
+				return count, nil
+			}
+			if n <= count {
+				continue
+			}
+			if term == nil {
+				term = &v1.ContainerStateTerminated{}
+			}
+			return n, term
+		}
+	}
+}
+
+func reopenLogs(ctx context.Context, src logSource) io.ReadCloser {
+	for i := 0; i < logReopenRetries; i++ {
+		stream, err := src.Open(ctx)
+		if err == nil {
+			return stream
+		}
+		log.Debug().Err(err).Msgf("Log stream reopen attempt %d failed", i+1)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(logRestartPoll):
+		}
+	}
+
+	return nil
+}
+
+func restartSeparator(t *v1.ContainerStateTerminated) string {
+	if t.Reason == "" {
+		return fmt.Sprintf("---- container restarted (exit code %d) ----", t.ExitCode)
+	}
+
+	return fmt.Sprintf("---- container restarted (exit code %d, %s) ----", t.ExitCode, t.Reason)
+}
+
+// containerLogs tails a container logs via a logger using the informer
+// cache to track restarts.
+type containerLogs struct {
+	factory Factory
+	logger  Logger
+	opts    LogOptions
+}
+
+// Open opens a log stream on the current container instance.
+func (c containerLogs) Open(ctx context.Context) (io.ReadCloser, error) {
+	return openLogs(ctx, c.logger, c.opts, nil)
+}
+
+// Restarts returns the container restart count and last termination if any.
+func (c containerLogs) Restarts() (int32, *v1.ContainerStateTerminated, error) {
+	o, err := c.factory.Get("v1/pods", c.opts.Path, false, labels.Everything())
+	if err != nil {
+		return 0, nil, err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return 0, nil, err
+	}
+	for _, s := range po.Status.ContainerStatuses {
+		if s.Name == c.opts.Container {
+			return s.RestartCount, s.LastTerminationState.Terminated, nil
+		}
+	}
+
+	return 0, nil, fmt.Errorf("no container %s found on pod %s", c.opts.Container, c.opts.Path)
+}
@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func init() {
+	logRestartPoll = 5 * time.Millisecond
+}
+
+func TestFollowLogsRestart(t *testing.T) {
+	src := newFakeLogSource(
+		[]string{"i1-l1", "i1-l2"},
+		[]string{"i2-l1"},
+		[]string{"i3-l1", "i3-l2"},
+	)
+	src.terms = []*v1.ContainerStateTerminated{
+		{ExitCode: 137, Reason: "OOMKilled"},
+		{ExitCode: 1},
+	}
+	opts := LogOptions{Path: "default/p1", Container: "c1", SingleContainer: true, Reopen: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, _ := src.Open(ctx)
+	c := make(chan string, 20)
+	go followLogs(ctx, stream, src, c, opts)
+
+	assert.Equal(t, []string{
+		"i1-l1",
+		"i1-l2",
+		"---- container restarted (exit code 137, OOMKilled) ----",
+		"i2-l1",
+		"---- container restarted (exit code 1) ----",
+		"i3-l1",
+		"i3-l2",
+	}, drain(c, 7))
+}
+
+func TestFollowLogsNoStatus(t *testing.T) {
+	src := newFakeLogSource([]string{"l1"})
+	src.gone = true
+	opts := LogOptions{Path: "default/p1", Container: "c1", SingleContainer: true, Reopen: true}
+
+	c := make(chan string, 10)
+	stream, _ := src.Open(context.Background())
+	followLogs(context.Background(), stream, src, c, opts)
+	close(c)
+
+	var ll []string
+	for l := range c {
+		ll = append(ll, l)
+	}
+	assert.Equal(t, []string{"l1"}, ll)
+}
+
+func TestRestartSeparator(t *testing.T) {
+	uu := map[string]struct {
+		t *v1.ContainerStateTerminated
+		e string
+	}{
+		"reason":   {&v1.ContainerStateTerminated{ExitCode: 2, Reason: "Error"}, "---- container restarted (exit code 2, Error) ----"},
+		"noReason": {&v1.ContainerStateTerminated{}, "---- container restarted (exit code 0) ----"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, restartSeparator(u.t))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func drain(c <-chan string, n int) []string {
+	ll := make([]string, 0, n)
+	for len(ll) < n {
+		select {
+		case l := <-c:
+			ll = append(ll, l)
+		case <-time.After(time.Second):
+			return ll
+		}
+	}
+
+	return ll
+}
+
+// fakeLogSource simulates a container restarting each time its current
+// instance logs were consumed.
+type fakeLogSource struct {
+	instances [][]string
+	terms     []*v1.ContainerStateTerminated
+	gone      bool
+	polled    bool
+	opened    int
+	mx        sync.Mutex
+}
+
+func newFakeLogSource(ii ...[]string) *fakeLogSource {
+	return &fakeLogSource{instances: ii}
+}
+
+func (f *fakeLogSource) Open(context.Context) (io.ReadCloser, error) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if f.opened >= len(f.instances) {
+		return nil, errors.New("no more instances")
+	}
+	ll := f.instances[f.opened]
+	f.opened++
+
+	return ioutil.NopCloser(strings.NewReader(strings.Join(ll, "\n"))), nil
+}
+
+// Restarts reports the instance currently opened. The restart count is bumped
+// once the current instance ends unless it is the last one.
+func (f *fakeLogSource) Restarts() (int32, *v1.ContainerStateTerminated, error) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if f.gone {
+		return 0, nil, errors.New("pod is gone")
+	}
+	count := f.opened - 1
+	if f.polled && f.opened < len(f.instances) {
+		count++
+	}
+	f.polled = true
+	if count == 0 {
+		return 0, nil, nil
+	}
+
+	return int32(count), f.terms[count-1], nil
+}
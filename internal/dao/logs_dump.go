@@ -0,0 +1,174 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// LogFetcher fetches the last lines of a container logs.
+type LogFetcher func(ctx context.Context, ns, po, co string, lines int64) ([]byte, error)
+
+// LogsDumpProgress reports how many containers logs were processed so far.
+type LogsDumpProgress func(done, total int)
+
+// LogsDump tallies a namespace logs dump.
+type LogsDump struct {
+	Dir      string
+	Total    int
+	Written  int
+	Canceled bool
+	Failures map[string]error
+}
+
+// Summary returns a one line dump recap.
+func (d *LogsDump) Summary() string {
+	s := fmt.Sprintf("%d/%d log files written to %s", d.Written, d.Total, d.Dir)
+	if n := len(d.Failures); n > 0 {
+		s += fmt.Sprintf(" (%d failed: %s)", n, strings.Join(d.Failed(), ", "))
+	}
+	if d.Canceled {
+		s += " [canceled]"
+	}
+
+	return s
+}
+
+// Failed returns the containers which logs could not be dumped.
+func (d *LogsDump) Failed() []string {
+	ss := make([]string, 0, len(d.Failures))
+	for k := range d.Failures {
+		ss = append(ss, k)
+	}
+	sort.Strings(ss)
+
+	return ss
+}
+
+// NewLogFetcher returns a fetcher pulling logs from the api server.
+func NewLogFetcher(c kubernetes.Interface) LogFetcher {
+	return func(ctx context.Context, ns, po, co string, lines int64) ([]byte, error) {
+		opts := v1.PodLogOptions{Container: co, TailLines: &lines}
+		return c.CoreV1().Pods(ns).GetLogs(po, &opts).Context(ctx).DoRaw()
+	}
+}
+
+type logsDumpTask struct {
+	ns, po, co string
+}
+
+func (t logsDumpTask) String() string {
+	return t.ns + "/" + t.po + ":" + t.co
+}
+
+func (t logsDumpTask) fileName() string {
+	return t.po + "-" + t.co + ".log"
+}
+
+// DumpNamespaceLogs writes the last lines of every container logs in a
+// namespace to dir, one file per container, using a bounded worker pool.
+// A failed container does not abort the dump and canceling the context stops
+// pending fetches.
+func DumpNamespaceLogs(ctx context.Context, f Factory, fetch LogFetcher, ns, dir string, lines int64, workers int, progress LogsDumpProgress) (*LogsDump, error) {
+	tt, err := logsDumpTasks(f, ns)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return nil, err
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+
+	d := LogsDump{Dir: dir, Total: len(tt), Failures: make(map[string]error)}
+	var (
+		mx   sync.Mutex
+		wg   sync.WaitGroup
+		done int
+	)
+	tasks := make(chan logsDumpTask)
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for t := range tasks {
+				err := dumpContainerLogs(ctx, fetch, t, dir, lines)
+				mx.Lock()
+				done++
+				if err != nil {
+					d.Failures[t.String()] = err
+				} else {
+					d.Written++
+				}
+				n := done
+				mx.Unlock()
+				if progress != nil {
+					progress(n, d.Total)
+				}
+			}
+		}()
+	}
+
+	for _, t := range tt {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case tasks <- t:
+		case <-ctx.Done():
+		}
+	}
+	close(tasks)
+	wg.Wait()
+	d.Canceled = ctx.Err() != nil
+
+	return &d, nil
+}
+
+func dumpContainerLogs(ctx context.Context, fetch LogFetcher, t logsDumpTask, dir string, lines int64) error {
+	bb, err := fetch(ctx, t.ns, t.po, t.co, lines)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, t.fileName()), bb, 0600)
+}
+
+func logsDumpTasks(f Factory, ns string) ([]logsDumpTask, error) {
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	tt := make([]logsDumpTask, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range po.Spec.InitContainers {
+			tt = append(tt, logsDumpTask{ns: po.Namespace, po: po.Name, co: c.Name})
+		}
+		for _, c := range po.Spec.Containers {
+			tt = append(tt, logsDumpTask{ns: po.Namespace, po: po.Name, co: c.Name})
+		}
+	}
+	sort.Slice(tt, func(i, j int) bool {
+		return tt[i].String() < tt[j].String()
+	})
+
+	return tt, nil
+}
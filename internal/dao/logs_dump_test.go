@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDumpNamespaceLogs(t *testing.T) {
+	f := podsFactory{pods: []runtime.Object{
+		makeDumpPod(t, "p1", "c1", "c2"),
+		makeDumpPod(t, "p2", "c1"),
+	}}
+	fetch := func(_ context.Context, ns, po, co string, lines int64) ([]byte, error) {
+		if po == "p2" {
+			return nil, errors.New("boom")
+		}
+		return []byte(ns + "/" + po + ":" + co), nil
+	}
+
+	dir, err := ioutil.TempDir("", "k9s-logs")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var calls int32
+	d, err := DumpNamespaceLogs(context.Background(), f, fetch, "ns1", dir, 10, 2, func(done, total int) {
+		atomic.AddInt32(&calls, 1)
+		assert.Equal(t, 3, total)
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, int32(3), calls)
+	assert.Equal(t, 3, d.Total)
+	assert.Equal(t, 2, d.Written)
+	assert.False(t, d.Canceled)
+	assert.Equal(t, []string{"ns1/p2:c1"}, d.Failed())
+	bb, err := ioutil.ReadFile(filepath.Join(dir, "p1-c2.log"))
+	assert.Nil(t, err)
+	assert.Equal(t, "ns1/p1:c2", string(bb))
+	assert.Equal(t, "2/3 log files written to "+dir+" (1 failed: ns1/p2:c1)", d.Summary())
+}
+
+func TestDumpNamespaceLogsCanceled(t *testing.T) {
+	f := podsFactory{pods: []runtime.Object{
+		makeDumpPod(t, "p1", "c1"),
+		makeDumpPod(t, "p2", "c1"),
+		makeDumpPod(t, "p3", "c1"),
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(context.Context, string, string, string, int64) ([]byte, error) {
+		cancel()
+		return []byte("blee"), nil
+	}
+
+	dir, err := ioutil.TempDir("", "k9s-logs")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	d, err := DumpNamespaceLogs(ctx, f, fetch, "ns1", dir, 10, 1, nil)
+	assert.Nil(t, err)
+	assert.True(t, d.Canceled)
+	assert.True(t, d.Written < d.Total)
+}
+
+// Helpers...
+
+func makeDumpPod(t *testing.T, n string, cc ...string) *unstructured.Unstructured {
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n}}
+	for _, c := range cc {
+		po.Spec.Containers = append(po.Spec.Containers, v1.Container{Name: c})
+	}
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+	assert.Nil(t, err)
+
+	return &unstructured.Unstructured{Object: o}
+}
@@ -0,0 +1,319 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// forwardWorkloads maps workload kinds to their resources.
+var forwardWorkloads = map[string]string{
+	"deploy":      "apps/v1/deployments",
+	"deployment":  "apps/v1/deployments",
+	"sts":         "apps/v1/statefulsets",
+	"statefulset": "apps/v1/statefulsets",
+	"ds":          "apps/v1/daemonsets",
+	"daemonset":   "apps/v1/daemonsets",
+	"rs":          "apps/v1/replicasets",
+	"replicaset":  "apps/v1/replicasets",
+}
+
+// ManagedForward represents a port-forward declared in the cluster configuration.
+type ManagedForward struct {
+	Spec config.PortForward
+
+	fqn       string
+	err       error
+	since     time.Time
+	starting  bool
+	suspended bool
+}
+
+// ManagedForwards tracks the configured port-forwards states.
+type ManagedForwards struct {
+	forwards []*ManagedForward
+	mx       sync.RWMutex
+}
+
+// NewManagedForwards returns a new instance.
+func NewManagedForwards(specs []config.PortForward) *ManagedForwards {
+	m := ManagedForwards{forwards: make([]*ManagedForward, 0, len(specs))}
+	for _, s := range specs {
+		m.forwards = append(m.forwards, &ManagedForward{Spec: s, since: time.Now()})
+	}
+
+	return &m
+}
+
+// Claim returns the indexes of the forwards that need to be established and
+// marks them as starting.
+func (m *ManagedForwards) Claim() []int {
+	if m == nil {
+		return nil
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	var ii []int
+	for i, f := range m.forwards {
+		if f.suspended || f.starting || f.fqn != "" {
+			continue
+		}
+		f.starting = true
+		ii = append(ii, i)
+	}
+
+	return ii
+}
+
+// Spec returns a managed forward specification.
+func (m *ManagedForwards) Spec(i int) config.PortForward {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.forwards[i].Spec
+}
+
+// Started records a managed forward is active.
+func (m *ManagedForwards) Started(i int, fqn string) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	f := m.forwards[i]
+	f.fqn, f.err, f.starting, f.since = fqn, nil, false, time.Now()
+}
+
+// Stopped records a managed forward is no longer active.
+func (m *ManagedForwards) Stopped(i int) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	f := m.forwards[i]
+	f.fqn, f.starting = "", false
+}
+
+// Failed records a managed forward failure. It returns true if the failure
+// reason changed.
+func (m *ManagedForwards) Failed(i int, err error) bool {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	f := m.forwards[i]
+	changed := f.err == nil || f.err.Error() != err.Error()
+	if changed {
+		f.since = time.Now()
+	}
+	f.fqn, f.err, f.starting = "", err, false
+
+	return changed
+}
+
+// Active returns the active managed forwards indexed by forwarder path.
+func (m *ManagedForwards) Active() map[string]int {
+	if m == nil {
+		return nil
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	aa := make(map[string]int)
+	for i, f := range m.forwards {
+		if f.fqn != "" && !f.suspended {
+			aa[f.fqn] = i
+		}
+	}
+
+	return aa
+}
+
+// Managed checks if a forwarder path or a failed forward path is managed.
+func (m *ManagedForwards) Managed(path string) bool {
+	if m == nil {
+		return false
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.find(path) != nil
+}
+
+// Suspend stops managing a forward for the rest of the session.
+func (m *ManagedForwards) Suspend(path string) bool {
+	if m == nil {
+		return false
+	}
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	f := m.find(path)
+	if f == nil {
+		return false
+	}
+	f.suspended = true
+
+	return true
+}
+
+// Failures returns the managed forwards that could not be established.
+func (m *ManagedForwards) Failures() []FailedForward {
+	if m == nil {
+		return nil
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	var ff []FailedForward
+	for _, f := range m.forwards {
+		if f.err != nil && f.fqn == "" && !f.suspended {
+			ff = append(ff, FailedForward{Spec: f.Spec, Err: f.err, Since: f.since})
+		}
+	}
+
+	return ff
+}
+
+func (m *ManagedForwards) find(path string) *ManagedForward {
+	for _, f := range m.forwards {
+		if f.suspended {
+			continue
+		}
+		if f.fqn == path || (f.fqn == "" && f.Spec.Path() == path) {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// FailedForward represents a managed port-forward that could not be established.
+type FailedForward struct {
+	Spec  config.PortForward
+	Err   error
+	Since time.Time
+}
+
+// Path returns the forward target path.
+func (f FailedForward) Path() string {
+	return f.Spec.Path()
+}
+
+// Container returns the targeted container.
+func (f FailedForward) Container() string {
+	return f.Spec.Container
+}
+
+// Ports returns the forwarded ports mappings.
+func (f FailedForward) Ports() []string {
+	return f.Spec.Ports
+}
+
+// Active returns the forward status.
+func (FailedForward) Active() bool {
+	return false
+}
+
+// Age returns the failure age.
+func (f FailedForward) Age() string {
+	return time.Since(f.Since).String()
+}
+
+// TTL returns the forward time to live.
+func (FailedForward) TTL() string {
+	return ""
+}
+
+// Traffic returns the forward traffic.
+func (FailedForward) Traffic() (uint64, uint64) {
+	return 0, 0
+}
+
+// ResolveForwardPod returns the first ready pod matching a port-forward spec.
+func ResolveForwardPod(f Factory, spec config.PortForward) (string, error) {
+	if err := spec.Validate(); err != nil {
+		return "", err
+	}
+	sel, err := forwardSelector(f, spec)
+	if err != nil {
+		return "", err
+	}
+	oo, err := f.List("v1/pods", spec.Namespace, true, sel)
+	if err != nil {
+		return "", err
+	}
+
+	nn := make([]string, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return "", err
+		}
+		if forwardable(po) {
+			nn = append(nn, po.Name)
+		}
+	}
+	if len(nn) == 0 {
+		return "", fmt.Errorf("no ready pods matching %s", spec.Target())
+	}
+	sort.Strings(nn)
+
+	return client.FQN(spec.Namespace, nn[0]), nil
+}
+
+// ForwardPodLive checks if a forwarded pod is still ready.
+func ForwardPodLive(f Factory, path string) bool {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return false
+	}
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return false
+	}
+
+	return forwardable(po)
+}
+
+func forwardable(po v1.Pod) bool {
+	return po.DeletionTimestamp == nil && po.Status.Phase == v1.PodRunning && podReady(po)
+}
+
+func forwardSelector(f Factory, spec config.PortForward) (labels.Selector, error) {
+	if spec.Selector != "" {
+		return labels.Parse(spec.Selector)
+	}
+
+	tokens := strings.Split(spec.Workload, "/")
+	gvr, ok := forwardWorkloads[strings.TrimSuffix(strings.ToLower(tokens[0]), "s")]
+	if !ok {
+		return nil, fmt.Errorf("unsupported workload kind %q", tokens[0])
+	}
+	o, err := f.Get(gvr, client.FQN(spec.Namespace, tokens[1]), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	m, ok, err := unstructured.NestedMap(o.(*unstructured.Unstructured).Object, "spec", "selector")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no pod selector found on %s", spec.Workload)
+	}
+	var sel metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &sel); err != nil {
+		return nil, err
+	}
+
+	return metav1.LabelSelectorAsSelector(&sel)
+}
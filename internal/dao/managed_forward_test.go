@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestManagedForwards(t *testing.T) {
+	m := NewManagedForwards([]config.PortForward{
+		{Namespace: "default", Workload: "deploy/fred", Container: "c1", Ports: []string{"8080:80"}},
+		{Namespace: "default", Selector: "app=blee", Container: "c2", Ports: []string{"9090:90"}},
+	})
+
+	assert.Equal(t, []int{0, 1}, m.Claim())
+	assert.Empty(t, m.Claim())
+
+	m.Started(0, "default/fred-1:c1")
+	assert.True(t, m.Failed(1, errors.New("no ready pods")))
+	assert.False(t, m.Failed(1, errors.New("no ready pods")))
+	assert.Equal(t, map[string]int{"default/fred-1:c1": 0}, m.Active())
+	assert.True(t, m.Managed("default/fred-1:c1"))
+	assert.True(t, m.Managed("default/app=blee:c2"))
+	assert.False(t, m.Managed("default/zorg:c1"))
+
+	ff := m.Failures()
+	assert.Equal(t, 1, len(ff))
+	assert.Equal(t, "default/app=blee:c2", ff[0].Path())
+	assert.False(t, ff[0].Active())
+
+	// Failed forwards are retried, active ones are left alone.
+	assert.Equal(t, []int{1}, m.Claim())
+	m.Stopped(0)
+	assert.Equal(t, []int{0}, m.Claim())
+
+	assert.True(t, m.Suspend("default/app=blee:c2"))
+	m.Failed(1, errors.New("boom"))
+	assert.Empty(t, m.Failures())
+	assert.False(t, m.Managed("default/app=blee:c2"))
+	m.Stopped(0)
+	assert.Equal(t, []int{0}, m.Claim())
+}
+
+func TestManagedForwardsNil(t *testing.T) {
+	var m *ManagedForwards
+
+	assert.Empty(t, m.Claim())
+	assert.False(t, m.Managed("default/fred:c1"))
+	assert.Empty(t, m.Failures())
+}
+
+func TestForwardable(t *testing.T) {
+	now := metav1.Now()
+	ready := v1.Pod{
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+		},
+	}
+	notReady := *ready.DeepCopy()
+	notReady.Status.Conditions[0].Status = v1.ConditionFalse
+	terminating := *ready.DeepCopy()
+	terminating.DeletionTimestamp = &now
+
+	assert.True(t, forwardable(ready))
+	assert.False(t, forwardable(notReady))
+	assert.False(t, forwardable(terminating))
+}
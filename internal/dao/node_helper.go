@@ -0,0 +1,235 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	nodeHelperContainer = "helper"
+	nodeHelperLabel     = "k9s.derailed.io/helper"
+	// nodeHelperDeadline bounds a helper pod lifetime should cleanup ever fail.
+	nodeHelperDeadline = int64(3600)
+)
+
+var (
+	// nodeHelperPollInterval represents how often a launching helper pod is checked.
+	nodeHelperPollInterval = time.Second
+
+	containerIDRX = regexp.MustCompile(`\A[a-f0-9]{12,64}\z`)
+)
+
+// NodeHelper represents a privileged pod sharing a node PID namespace.
+type NodeHelper struct {
+	client.Connection
+
+	Namespace, Name, Node string
+}
+
+// LaunchNodeHelper schedules a privileged helper pod on a node and waits for it to run.
+// The helper pod is deleted if it does not come up in time.
+func LaunchNodeHelper(c client.Connection, ns, node, image string, timeout time.Duration) (*NodeHelper, error) {
+	auth, err := c.CanI(ns, "v1/pods", []string{"create", "delete"})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to create helper pods in namespace %s", ns)
+	}
+
+	po, err := c.DialOrDie().CoreV1().Pods(ns).Create(nodeHelperPod(ns, node, image))
+	if err != nil {
+		return nil, err
+	}
+	h := NodeHelper{Connection: c, Namespace: ns, Name: po.Name, Node: node}
+	log.Debug().Msgf("Launched node helper %s on %s", h.Path(), node)
+	if err := h.waitRunning(timeout); err != nil {
+		if e := h.Delete(); e != nil {
+			log.Error().Err(e).Msgf("Node helper %s cleanup failed", h.Path())
+		}
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Path returns the helper pod path.
+func (h *NodeHelper) Path() string {
+	return client.FQN(h.Namespace, h.Name)
+}
+
+// Container returns the helper container name.
+func (h *NodeHelper) Container() string {
+	return nodeHelperContainer
+}
+
+// Delete removes the helper pod.
+func (h *NodeHelper) Delete() error {
+	var grace int64
+	log.Debug().Msgf("Deleting node helper %s", h.Path())
+
+	return h.DialOrDie().CoreV1().Pods(h.Namespace).Delete(h.Name, &metav1.DeleteOptions{GracePeriodSeconds: &grace})
+}
+
+// ContainerPID returns a container main process PID as seen from the node.
+func (h *NodeHelper) ContainerPID(e Executor, containerID string) (int, error) {
+	cmd, err := pidLookupCmd(containerID)
+	if err != nil {
+		return 0, err
+	}
+	out, err := ExecCapture(e, h.Path(), nodeHelperContainer, cmd)
+	if err != nil {
+		return 0, err
+	}
+
+	return parsePID(out, containerID)
+}
+
+func (h *NodeHelper) waitRunning(timeout time.Duration) error {
+	for elapsed := time.Duration(0); elapsed < timeout; elapsed += nodeHelperPollInterval {
+		po, err := h.DialOrDie().CoreV1().Pods(h.Namespace).Get(h.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		switch po.Status.Phase {
+		case v1.PodRunning:
+			return nil
+		case v1.PodFailed, v1.PodSucceeded:
+			return fmt.Errorf("node helper %s exited with phase %s", h.Path(), po.Status.Phase)
+		}
+		time.Sleep(nodeHelperPollInterval)
+	}
+
+	return fmt.Errorf("node helper %s did not start within %s", h.Path(), timeout)
+}
+
+// NetnsShellArgs returns the helper command entering a process network
+// namespace while keeping the node mount namespace and tooling.
+func NetnsShellArgs(pid int, shell string) []string {
+	return []string{
+		"nsenter",
+		"--mount=/proc/1/ns/mnt",
+		"--net=/proc/" + strconv.Itoa(pid) + "/ns/net",
+		"--",
+		"sh", "-c", shell,
+	}
+}
+
+// PodNetTarget returns a pod node and the runtime id of a running container.
+func PodNetTarget(f Factory, path string) (string, string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return "", "", err
+	}
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return "", "", err
+	}
+
+	return podNetTarget(po)
+}
+
+func podNetTarget(po v1.Pod) (string, string, error) {
+	if po.Spec.HostNetwork {
+		return "", "", fmt.Errorf("pod %s uses the host network", po.Name)
+	}
+	if po.Spec.NodeName == "" {
+		return "", "", fmt.Errorf("pod %s is not scheduled", po.Name)
+	}
+	for _, cs := range po.Status.ContainerStatuses {
+		if cs.State.Running == nil || cs.ContainerID == "" {
+			continue
+		}
+		id, err := runtimeID(cs.ContainerID)
+		if err != nil {
+			return "", "", err
+		}
+		return po.Spec.NodeName, id, nil
+	}
+
+	return "", "", fmt.Errorf("pod %s has no running containers", po.Name)
+}
+
+// runtimeID strips the runtime scheme off a container id ie docker://abc.
+func runtimeID(cid string) (string, error) {
+	if i := strings.Index(cid, "://"); i != -1 {
+		cid = cid[i+3:]
+	}
+	if !containerIDRX.MatchString(cid) {
+		return "", fmt.Errorf("unexpected container id %q", cid)
+	}
+
+	return cid, nil
+}
+
+// pidLookupCmd finds a container process via its cgroups membership which
+// works across container runtimes.
+func pidLookupCmd(id string) ([]string, error) {
+	if !containerIDRX.MatchString(id) {
+		return nil, fmt.Errorf("unexpected container id %q", id)
+	}
+
+	return []string{"sh", "-c", "grep -l " + id + " /proc/[0-9]*/cgroup 2>/dev/null | head -n 1"}, nil
+}
+
+func parsePID(out, id string) (int, error) {
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return 0, fmt.Errorf("no process found for container %s", id)
+	}
+	tokens := strings.Split(out, "/")
+	if len(tokens) < 3 {
+		return 0, fmt.Errorf("unexpected process path %q", out)
+	}
+	pid, err := strconv.Atoi(tokens[2])
+	if err != nil || pid <= 0 {
+		return 0, errors.New("invalid process id " + tokens[2])
+	}
+
+	return pid, nil
+}
+
+func nodeHelperPod(ns, node, image string) *v1.Pod {
+	var grace int64
+	deadline, privileged := nodeHelperDeadline, true
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "k9s-node-helper-",
+			Namespace:    ns,
+			Labels: map[string]string{
+				nodeHelperLabel:                "node-helper",
+				"app.kubernetes.io/managed-by": "k9s",
+			},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			HostPID:                       true,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: &grace,
+			ActiveDeadlineSeconds:         &deadline,
+			Tolerations:                   []v1.Toleration{{Operator: v1.TolerationOpExists}},
+			Containers: []v1.Container{
+				{
+					Name:            nodeHelperContainer,
+					Image:           image,
+					Command:         []string{"sleep", strconv.FormatInt(nodeHelperDeadline, 10)},
+					SecurityContext: &v1.SecurityContext{Privileged: &privileged},
+				},
+			},
+		},
+	}
+}
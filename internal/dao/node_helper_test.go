@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+const testCID = "3f4a9c0d2b1e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a"
+
+func TestPodNetTarget(t *testing.T) {
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	uu := map[string]struct {
+		po       v1.Pod
+		node, id string
+		err      error
+	}{
+		"docker": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{NodeName: "n1"},
+				Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", ContainerID: "docker://" + testCID, State: running},
+				}},
+			},
+			node: "n1",
+			id:   testCID,
+		},
+		"skipWaiting": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{NodeName: "n1"},
+				Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1"},
+					{Name: "c2", ContainerID: "containerd://" + testCID, State: running},
+				}},
+			},
+			node: "n1",
+			id:   testCID,
+		},
+		"hostNetwork": {
+			po:  v1.Pod{Spec: v1.PodSpec{NodeName: "n1", HostNetwork: true}},
+			err: errors.New("pod  uses the host network"),
+		},
+		"unscheduled": {
+			err: errors.New("pod  is not scheduled"),
+		},
+		"notRunning": {
+			po:  v1.Pod{Spec: v1.PodSpec{NodeName: "n1"}},
+			err: errors.New("pod  has no running containers"),
+		},
+		"badID": {
+			po: v1.Pod{
+				Spec: v1.PodSpec{NodeName: "n1"},
+				Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", ContainerID: "docker://abc;rm -rf /", State: running},
+				}},
+			},
+			err: errors.New(`unexpected container id "abc;rm -rf /"`),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			node, id, err := podNetTarget(u.po)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.node, node)
+			assert.Equal(t, u.id, id)
+		})
+	}
+}
+
+func TestParsePID(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		pid int
+		err bool
+	}{
+		"ok":      {out: "/proc/4242/cgroup\n", pid: 4242},
+		"none":    {out: "", err: true},
+		"garbled": {out: "/proc/fred/cgroup", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pid, err := parsePID(u.out, testCID)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.pid, pid)
+		})
+	}
+}
+
+func TestNetnsShellArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"nsenter", "--mount=/proc/1/ns/mnt", "--net=/proc/42/ns/net", "--", "sh", "-c", "exec sh"},
+		NetnsShellArgs(42, "exec sh"),
+	)
+}
+
+func TestNodeHelperPod(t *testing.T) {
+	po := nodeHelperPod("default", "n1", "busybox:1.31")
+
+	assert.Equal(t, "n1", po.Spec.NodeName)
+	assert.True(t, po.Spec.HostPID)
+	assert.True(t, *po.Spec.Containers[0].SecurityContext.Privileged)
+	assert.Equal(t, "busybox:1.31", po.Spec.Containers[0].Image)
+	assert.Equal(t, nodeHelperDeadline, *po.Spec.ActiveDeadlineSeconds)
+}
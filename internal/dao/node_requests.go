@@ -0,0 +1,132 @@
+package dao
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Requests represents cpu (millicores) and memory (bytes) resource requests.
+type Requests struct {
+	CPU, MEM int64
+}
+
+func (r *Requests) add(o Requests) {
+	r.CPU += o.CPU
+	r.MEM += o.MEM
+}
+
+type podRequests struct {
+	version string
+	node    string
+	Requests
+}
+
+// NodeRequests aggregates the resource requests of pods scheduled on each
+// node. Pods requests are memoized by resource version so only changed pods
+// are converted on refresh.
+type NodeRequests struct {
+	pods map[string]podRequests
+	mx   sync.Mutex
+}
+
+// NewNodeRequests returns a new instance.
+func NewNodeRequests() *NodeRequests {
+	return &NodeRequests{pods: make(map[string]podRequests)}
+}
+
+// Refresh returns the pods resource requests sums keyed by node name.
+func (n *NodeRequests) Refresh(f Factory) (map[string]Requests, error) {
+	oo, err := f.List("v1/pods", "", true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	pods := make(map[string]podRequests, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		uid, version := string(u.GetUID()), u.GetResourceVersion()
+		if p, ok := n.pods[uid]; ok && p.version == version {
+			pods[uid] = p
+			continue
+		}
+		var po v1.Pod
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		p := podRequests{version: version}
+		if scheduled(po) {
+			p.node, p.Requests = po.Spec.NodeName, podRequestsFor(po.Spec)
+		}
+		pods[uid] = p
+	}
+	n.pods = pods
+
+	return sumByNode(pods), nil
+}
+
+func sumByNode(pods map[string]podRequests) map[string]Requests {
+	rr := make(map[string]Requests)
+	for _, p := range pods {
+		if p.node == "" {
+			continue
+		}
+		r := rr[p.node]
+		r.add(p.Requests)
+		rr[p.node] = r
+	}
+
+	return rr
+}
+
+// scheduled checks if a pod holds resources on a node. Terminated pods do not.
+func scheduled(po v1.Pod) bool {
+	if po.Spec.NodeName == "" {
+		return false
+	}
+
+	return po.Status.Phase != v1.PodSucceeded && po.Status.Phase != v1.PodFailed
+}
+
+// podRequestsFor computes a pod effective requests the way the scheduler does
+// ie the max of the containers requests sum and any single init container
+// requests, plus the pod overhead.
+func podRequestsFor(spec v1.PodSpec) Requests {
+	var r Requests
+	for _, c := range spec.Containers {
+		r.add(containerRequests(c.Resources.Requests))
+	}
+	for _, c := range spec.InitContainers {
+		ir := containerRequests(c.Resources.Requests)
+		if ir.CPU > r.CPU {
+			r.CPU = ir.CPU
+		}
+		if ir.MEM > r.MEM {
+			r.MEM = ir.MEM
+		}
+	}
+	r.add(containerRequests(spec.Overhead))
+
+	return r
+}
+
+func containerRequests(rl v1.ResourceList) Requests {
+	var r Requests
+	if q, ok := rl[v1.ResourceCPU]; ok {
+		r.CPU = q.MilliValue()
+	}
+	if q, ok := rl[v1.ResourceMemory]; ok {
+		r.MEM = q.Value()
+	}
+
+	return r
+}
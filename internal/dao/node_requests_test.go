@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPodRequestsFor(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    Requests
+	}{
+		"noRequests": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		},
+		"partial": {
+			spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "c1", Resources: makeRequests("100m", "")},
+				{Name: "c2"},
+			}},
+			e: Requests{CPU: 100},
+		},
+		"containers": {
+			spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "c1", Resources: makeRequests("100m", "64Mi")},
+				{Name: "c2", Resources: makeRequests("200m", "64Mi")},
+			}},
+			e: Requests{CPU: 300, MEM: 128 * 1024 * 1024},
+		},
+		"initDominated": {
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					{Name: "i1", Resources: makeRequests("1", "32Mi")},
+					{Name: "i2", Resources: makeRequests("500m", "1Gi")},
+				},
+				Containers: []v1.Container{
+					{Name: "c1", Resources: makeRequests("100m", "64Mi")},
+				},
+			},
+			e: Requests{CPU: 1000, MEM: 1024 * 1024 * 1024},
+		},
+		"overhead": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1", Resources: makeRequests("100m", "")}},
+				Overhead:   makeRequests("10m", "").Requests,
+			},
+			e: Requests{CPU: 110},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, podRequestsFor(u.spec))
+		})
+	}
+}
+
+func TestNodeRequestsRefresh(t *testing.T) {
+	f := podsFactory{pods: []runtime.Object{
+		makeReqPod(t, "p1", "n1", "1", v1.PodRunning, "100m"),
+		makeReqPod(t, "p2", "n1", "1", v1.PodRunning, "200m"),
+		makeReqPod(t, "p3", "n2", "1", v1.PodPending, "1"),
+		makeReqPod(t, "p4", "n2", "1", v1.PodSucceeded, "1"),
+		makeReqPod(t, "p5", "", "1", v1.PodPending, "1"),
+	}}
+	n := NewNodeRequests()
+
+	rr, err := n.Refresh(f)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]Requests{"n1": {CPU: 300}, "n2": {CPU: 1000}}, rr)
+
+	// Unchanged resource versions are served from the memo.
+	f.pods[0] = makeReqPod(t, "p1", "n1", "1", v1.PodRunning, "500m")
+	rr, _ = n.Refresh(f)
+	assert.Equal(t, int64(300), rr["n1"].CPU)
+
+	f.pods[0] = makeReqPod(t, "p1", "n1", "2", v1.PodRunning, "500m")
+	f.pods = f.pods[:1]
+	rr, _ = n.Refresh(f)
+	assert.Equal(t, map[string]Requests{"n1": {CPU: 500}}, rr)
+	assert.Equal(t, 1, len(n.pods))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type podsFactory struct {
+	Factory
+
+	pods []runtime.Object
+}
+
+func (f podsFactory) List(_, _ string, _ bool, _ labels.Selector) ([]runtime.Object, error) {
+	return f.pods, nil
+}
+
+func makeRequests(cpu, mem string) v1.ResourceRequirements {
+	rl := make(v1.ResourceList)
+	if cpu != "" {
+		rl[v1.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if mem != "" {
+		rl[v1.ResourceMemory] = resource.MustParse(mem)
+	}
+
+	return v1.ResourceRequirements{Requests: rl}
+}
+
+func makeReqPod(t *testing.T, n, node, version string, phase v1.PodPhase, cpu string) *unstructured.Unstructured {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n, UID: types.UID(n), ResourceVersion: version},
+		Spec: v1.PodSpec{
+			NodeName:   node,
+			Containers: []v1.Container{{Name: "c1", Resources: makeRequests(cpu, "")}},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+	assert.Nil(t, err)
+
+	return &unstructured.Unstructured{Object: o}
+}
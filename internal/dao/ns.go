@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NamespaceTerminationReport lists what is holding up a namespace deletion.
+func NamespaceTerminationReport(f Factory, n string) (string, error) {
+	o, err := f.Get("v1/namespaces", n, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured namespace but got %T", o)
+	}
+
+	return terminationReport(u), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// terminationReport works off the raw resource as condition types vary
+// across cluster versions.
+func terminationReport(u *unstructured.Unstructured) string {
+	var b strings.Builder
+
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	fmt.Fprintf(&b, "namespace: %s\n", u.GetName())
+	fmt.Fprintf(&b, "phase: %s\n", orNone(phase))
+	if ts := u.GetDeletionTimestamp(); ts != nil {
+		fmt.Fprintf(&b, "deletionRequested: %s\n", ts.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+
+	ff, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "finalizers")
+	writeList(&b, "specFinalizers", ff, "")
+	writeList(&b, "metadataFinalizers", u.GetFinalizers(), "")
+
+	cc, ok, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if !ok {
+		return b.String()
+	}
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if status, _, _ := unstructured.NestedString(m, "status"); status != "True" {
+			continue
+		}
+		t, _, _ := unstructured.NestedString(m, "type")
+		msg, _, _ := unstructured.NestedString(m, "message")
+		ss = append(ss, fmt.Sprintf("%s: %s", t, strings.TrimSpace(msg)))
+	}
+	writeList(&b, "blockingConditions", ss, "")
+
+	return b.String()
+}
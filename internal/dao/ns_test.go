@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTerminationReport(t *testing.T) {
+	uu := map[string]struct {
+		o map[string]interface{}
+		e string
+	}{
+		"active": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "fred"},
+				"spec":     map[string]interface{}{"finalizers": []interface{}{"kubernetes"}},
+				"status":   map[string]interface{}{"phase": "Active"},
+			},
+			e: `namespace: fred
+phase: Active
+specFinalizers:
+  - kubernetes
+metadataFinalizers: <none>
+`,
+		},
+		"legacy": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":              "fred",
+					"deletionTimestamp": "2019-12-10T10:00:00Z",
+				},
+				"spec":   map[string]interface{}{"finalizers": []interface{}{"kubernetes"}},
+				"status": map[string]interface{}{"phase": "Terminating"},
+			},
+			e: `namespace: fred
+phase: Terminating
+deletionRequested: 2019-12-10T10:00:00Z
+specFinalizers:
+  - kubernetes
+metadataFinalizers: <none>
+`,
+		},
+		"conditions": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":       "fred",
+					"finalizers": []interface{}{"example.com/cleanup"},
+				},
+				"spec": map[string]interface{}{"finalizers": []interface{}{"kubernetes"}},
+				"status": map[string]interface{}{
+					"phase": "Terminating",
+					"conditions": []interface{}{
+						map[string]interface{}{
+							"type":    "NamespaceDeletionDiscoveryFailure",
+							"status":  "False",
+							"message": "All resources successfully discovered",
+						},
+						map[string]interface{}{
+							"type":    "NamespaceContentRemaining",
+							"status":  "True",
+							"message": "Some resources are remaining: pods. has 2 resource instances",
+						},
+						map[string]interface{}{
+							"type":    "NamespaceFinalizersRemaining",
+							"status":  "True",
+							"message": "Some content in the namespace has finalizers remaining: example.com/cleanup in 1 resource instances",
+						},
+					},
+				},
+			},
+			e: `namespace: fred
+phase: Terminating
+specFinalizers:
+  - kubernetes
+metadataFinalizers:
+  - example.com/cleanup
+blockingConditions:
+  - NamespaceContentRemaining: Some resources are remaining: pods. has 2 resource instances
+  - NamespaceFinalizersRemaining: Some content in the namespace has finalizers remaining: example.com/cleanup in 1 resource instances
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, terminationReport(&unstructured.Unstructured{Object: u.o}))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PDBSelector returns a disruption budget pod selector. As of policy/v1beta1
+// an empty selector matches no pods.
+func PDBSelector(pdb *v1beta1.PodDisruptionBudget) (labels.Selector, error) {
+	sel := pdb.Spec.Selector
+	if sel == nil || (len(sel.MatchLabels) == 0 && len(sel.MatchExpressions) == 0) {
+		return labels.Nothing(), nil
+	}
+
+	return metav1.LabelSelectorAsSelector(sel)
+}
+
+// PodCoverage tracks pods covered by disruption budgets. Pods are listed at
+// most once per namespace for the lifetime of the instance.
+type PodCoverage struct {
+	factory Factory
+	pods    map[string][]v1.Pod
+	mx      sync.Mutex
+}
+
+// NewPodCoverage returns a new instance.
+func NewPodCoverage(f Factory) *PodCoverage {
+	return &PodCoverage{factory: f, pods: make(map[string][]v1.Pod)}
+}
+
+// Covered returns the pods matching a disruption budget selector.
+func (p *PodCoverage) Covered(pdb *v1beta1.PodDisruptionBudget) ([]v1.Pod, error) {
+	sel, err := PDBSelector(pdb)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := p.podsIn(pdb.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return coveredPods(sel, pods), nil
+}
+
+func (p *PodCoverage) podsIn(ns string) ([]v1.Pod, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if pp, ok := p.pods[ns]; ok {
+		return pp, nil
+	}
+	oo, err := p.factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+	p.pods[ns] = pp
+
+	return pp, nil
+}
+
+func coveredPods(sel labels.Selector, pp []v1.Pod) []v1.Pod {
+	var cc []v1.Pod
+	for _, po := range pp {
+		if sel.Matches(labels.Set(po.Labels)) {
+			cc = append(cc, po)
+		}
+	}
+
+	return cc
+}
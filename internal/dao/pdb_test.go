@@ -0,0 +1,101 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPDBCovered(t *testing.T) {
+	pods := []v1.Pod{
+		makeLabeledPod("p1", map[string]string{"app": "nginx", "tier": "web"}),
+		makeLabeledPod("p2", map[string]string{"app": "nginx", "tier": "cache"}),
+		makeLabeledPod("p3", map[string]string{"app": "redis"}),
+		makeLabeledPod("p4", nil),
+	}
+
+	uu := map[string]struct {
+		sel *metav1.LabelSelector
+		e   []string
+	}{
+		"nilSelector": {},
+		"emptySelector": {
+			sel: &metav1.LabelSelector{},
+		},
+		"matchLabels": {
+			sel: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			e:   []string{"p1", "p2"},
+		},
+		"matchLabelsNone": {
+			sel: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "blee"}},
+		},
+		"in": {
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"nginx", "redis"}},
+			}},
+			e: []string{"p1", "p2", "p3"},
+		},
+		"notIn": {
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"web"}},
+			}},
+			e: []string{"p2", "p3", "p4"},
+		},
+		"exists": {
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: metav1.LabelSelectorOpExists},
+			}},
+			e: []string{"p1", "p2"},
+		},
+		"doesNotExist": {
+			sel: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpDoesNotExist},
+			}},
+			e: []string{"p4"},
+		},
+		"combined": {
+			sel: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "nginx"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"cache"}},
+				},
+			},
+			e: []string{"p2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pdb := v1beta1.PodDisruptionBudget{Spec: v1beta1.PodDisruptionBudgetSpec{Selector: u.sel}}
+			sel, err := PDBSelector(&pdb)
+			assert.Nil(t, err)
+			var nn []string
+			for _, po := range coveredPods(sel, pods) {
+				nn = append(nn, po.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestPDBSelectorInvalid(t *testing.T) {
+	pdb := v1beta1.PodDisruptionBudget{Spec: v1beta1.PodDisruptionBudgetSpec{
+		Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "app", Operator: metav1.LabelSelectorOpIn},
+		}},
+	}}
+	_, err := PDBSelector(&pdb)
+
+	assert.Error(t, err)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeLabeledPod(n string, ll map[string]string) v1.Pod {
+	return v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n, Labels: ll}}
+}
@@ -12,12 +12,16 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 )
 
@@ -31,6 +35,52 @@ type Pod struct {
 var _ Accessor = (*Pod)(nil)
 var _ Loggable = (*Pod)(nil)
 
+// ServiceAccountFor returns the fully qualified service account a pod runs
+// as. It errors out if the service account no longer exists.
+func ServiceAccountFor(f Factory, path string) (string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return "", err
+	}
+
+	sa := client.FQN(po.Namespace, render.PodServiceAccount(po.Spec))
+	if _, err := f.Get("v1/serviceaccounts", sa, true, labels.Everything()); err != nil {
+		if kerrors.IsNotFound(err) {
+			return "", fmt.Errorf("service account %s no longer exists", sa)
+		}
+		return "", err
+	}
+
+	return sa, nil
+}
+
+// DeleteEvictedPods deletes all the pods evicted by their node in a namespace
+// and returns the deleted pods paths.
+func DeleteEvictedPods(c kubernetes.Interface, ns string) ([]string, error) {
+	ll, err := c.CoreV1().Pods(ns).List(metav1.ListOptions{FieldSelector: "status.phase=" + string(v1.PodFailed)})
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]string, 0, len(ll.Items))
+	for i := range ll.Items {
+		po := &ll.Items[i]
+		if !render.IsEvicted(po) {
+			continue
+		}
+		if err := c.CoreV1().Pods(po.Namespace).Delete(po.Name, &metav1.DeleteOptions{}); err != nil {
+			return pp, err
+		}
+		pp = append(pp, client.FQN(po.Namespace, po.Name))
+	}
+
+	return pp, nil
+}
+
 // Logs fetch container logs for a given pod and container.
 func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, _ := client.Namespaced(path)
@@ -119,15 +169,32 @@ func (p *Pod) logs(ctx context.Context, c chan<- string, opts LogOptions) error
 
 func tailLogs(ctx context.Context, logger Logger, c chan<- string, opts LogOptions) error {
 	log.Debug().Msgf("Tailing logs for %q -- %q", opts.Path, opts.Container)
+	stream, err := openLogs(ctx, logger, opts, &opts.Lines)
+	if err != nil {
+		return err
+	}
+
+	fac, ok := ctx.Value(internal.KeyFactory).(Factory)
+	if opts.Previous || !opts.Reopen || !ok {
+		go readLogs(ctx, stream, c, opts)
+		return nil
+	}
+	go followLogs(ctx, stream, containerLogs{factory: fac, logger: logger, opts: opts}, c, opts)
+
+	return nil
+}
+
+// openLogs opens a log stream. All lines are streamed if no tail is given.
+func openLogs(ctx context.Context, logger Logger, opts LogOptions, tail *int64) (io.ReadCloser, error) {
 	o := v1.PodLogOptions{
 		Container: opts.Container,
 		Follow:    true,
-		TailLines: &opts.Lines,
+		TailLines: tail,
 		Previous:  opts.Previous,
 	}
 	req, err := logger.Logs(opts.Path, &o)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ctxt, cancelFunc := context.WithCancel(ctx)
 	req.Context(ctxt)
@@ -140,11 +207,10 @@ func tailLogs(ctx context.Context, logger Logger, c chan<- string, opts LogOptio
 	atomic.StoreInt32(&blocked, 0)
 	if err != nil {
 		log.Error().Err(err).Msgf("Log stream failed for `%s", opts.Path)
-		return fmt.Errorf("Unable to obtain log stream for %s", opts.Path)
+		return nil, fmt.Errorf("Unable to obtain log stream for %s", opts.Path)
 	}
-	go readLogs(ctx, stream, c, opts)
 
-	return nil
+	return stream, nil
 }
 
 func logsTimeout(cancel context.CancelFunc, blocked *int32) {
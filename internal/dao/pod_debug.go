@@ -0,0 +1,155 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DebugCopyLabel marks the pod copies K9s creates for debugging.
+	DebugCopyLabel = "k9s.io/debug-copy"
+	// DebugSourceAnnotation tracks the pod a debug copy originates from.
+	DebugSourceAnnotation = "k9s.io/debug-source"
+
+	debugSuffix = "-debug"
+	maxNameLen  = 253
+)
+
+// DebugOptions represents the overrides of a pod debug copy.
+type DebugOptions struct {
+	// Container names the container to override. Defaults to the first one.
+	Container string
+	// Image overrides the container image if set.
+	Image string
+	// Command overrides the container entrypoint and arguments if set.
+	Command []string
+	// DisableProbes drops the probes of all containers.
+	DisableProbes bool
+}
+
+// PodImages returns the container names of a pod in spec order along with
+// their images.
+func PodImages(f Factory, path string) ([]string, map[string]string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return nil, nil, err
+	}
+
+	cc, ii := make([]string, 0, len(po.Spec.Containers)), make(map[string]string, len(po.Spec.Containers))
+	for _, co := range po.Spec.Containers {
+		cc, ii[co.Name] = append(cc, co.Name), co.Image
+	}
+
+	return cc, ii, nil
+}
+
+// CreateDebugCopy creates a standalone copy of a pod with the given overrides
+// similar to kubectl debug --copy-to.
+func CreateDebugCopy(c kubernetes.Interface, path string, opts DebugOptions) (*v1.Pod, error) {
+	ns, n := client.Namespaced(path)
+	po, err := c.CoreV1().Pods(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cp, err := debugCopy(po, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CoreV1().Pods(ns).Create(cp)
+}
+
+// DeleteDebugCopies deletes all the debug copies in a namespace and returns
+// the deleted pods paths.
+func DeleteDebugCopies(c kubernetes.Interface, ns string) ([]string, error) {
+	ll, err := c.CoreV1().Pods(ns).List(metav1.ListOptions{LabelSelector: DebugCopyLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]string, 0, len(ll.Items))
+	for _, po := range ll.Items {
+		if err := c.CoreV1().Pods(po.Namespace).Delete(po.Name, &metav1.DeleteOptions{}); err != nil {
+			return pp, err
+		}
+		pp = append(pp, client.FQN(po.Namespace, po.Name))
+	}
+
+	return pp, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// debugCopy sanitizes a pod so it can be created as a standalone pod. The copy
+// drops its owners, labels, scheduling decision and status so controllers do
+// not adopt it and the scheduler places it anew.
+func debugCopy(po *v1.Pod, opts DebugOptions) (*v1.Pod, error) {
+	if len(po.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("pod %s has no containers", client.FQN(po.Namespace, po.Name))
+	}
+	co := opts.Container
+	if co == "" {
+		co = po.Spec.Containers[0].Name
+	}
+
+	cp := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        debugName(po.Name),
+			Namespace:   po.Namespace,
+			Labels:      map[string]string{DebugCopyLabel: "true"},
+			Annotations: make(map[string]string, len(po.Annotations)+1),
+		},
+		Spec: *po.Spec.DeepCopy(),
+	}
+	for k, v := range po.Annotations {
+		if k != lastAppliedKey {
+			cp.Annotations[k] = v
+		}
+	}
+	cp.Annotations[DebugSourceAnnotation] = po.Name
+	cp.Spec.NodeName = ""
+	cp.Spec.EphemeralContainers = nil
+
+	var found bool
+	for i := range cp.Spec.Containers {
+		c := &cp.Spec.Containers[i]
+		if opts.DisableProbes {
+			c.LivenessProbe, c.ReadinessProbe, c.StartupProbe = nil, nil, nil
+		}
+		if c.Name != co {
+			continue
+		}
+		found = true
+		if opts.Image != "" {
+			c.Image = opts.Image
+		}
+		if len(opts.Command) > 0 {
+			c.Command, c.Args = opts.Command, nil
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no container %q found on pod %s", co, client.FQN(po.Namespace, po.Name))
+	}
+
+	return &cp, nil
+}
+
+func debugName(n string) string {
+	if len(n)+len(debugSuffix) > maxNameLen {
+		n = n[:maxNameLen-len(debugSuffix)]
+	}
+
+	return n + debugSuffix
+}
@@ -0,0 +1,156 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateDebugCopy(t *testing.T) {
+	uu := map[string]struct {
+		opts  DebugOptions
+		image string
+		cmd   []string
+		args  []string
+		probe bool
+		err   string
+	}{
+		"plain": {
+			image: "fred:1.0",
+			cmd:   []string{"fred"},
+			args:  []string{"--blee"},
+			probe: true,
+		},
+		"overrides": {
+			opts: DebugOptions{
+				Image:         "busybox",
+				Command:       []string{"sleep", "infinity"},
+				DisableProbes: true,
+			},
+			image: "busybox",
+			cmd:   []string{"sleep", "infinity"},
+		},
+		"noContainer": {
+			opts: DebugOptions{Container: "zorg"},
+			err:  `no container "zorg" found on pod ns1/p1`,
+		},
+		"noPod": {
+			err: `pods "p1" not found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := fake.NewSimpleClientset()
+			if k != "noPod" {
+				c = fake.NewSimpleClientset(debugPod())
+			}
+
+			po, err := CreateDebugCopy(c, "ns1/p1", u.opts)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			live, err := c.CoreV1().Pods("ns1").Get("p1-debug", metav1.GetOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, po, live)
+			assert.Equal(t, map[string]string{DebugCopyLabel: "true"}, po.Labels)
+			assert.Equal(t, map[string]string{"blee": "duh", DebugSourceAnnotation: "p1"}, po.Annotations)
+			assert.Empty(t, po.OwnerReferences)
+			assert.Empty(t, po.GenerateName)
+			assert.Empty(t, po.UID)
+			assert.Empty(t, po.Spec.NodeName)
+			assert.Equal(t, v1.PodStatus{}, po.Status)
+			assert.Equal(t, u.image, po.Spec.Containers[0].Image)
+			assert.Equal(t, u.cmd, po.Spec.Containers[0].Command)
+			assert.Equal(t, u.args, po.Spec.Containers[0].Args)
+			assert.Equal(t, u.probe, po.Spec.Containers[0].LivenessProbe != nil)
+			assert.Equal(t, u.probe, po.Spec.Containers[1].ReadinessProbe != nil)
+			assert.Equal(t, "sidecar:1.0", po.Spec.Containers[1].Image)
+		})
+	}
+}
+
+func TestDeleteDebugCopies(t *testing.T) {
+	cp := func(ns, n string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      n,
+			Labels:    map[string]string{DebugCopyLabel: "true"},
+		}}
+	}
+	c := fake.NewSimpleClientset(debugPod(), cp("ns1", "p1-debug"), cp("ns1", "p2-debug"), cp("ns2", "p3-debug"))
+
+	pp, err := DeleteDebugCopies(c, "ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1/p1-debug", "ns1/p2-debug"}, pp)
+
+	ll, err := c.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ll.Items))
+
+	pp, err = DeleteDebugCopies(c, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns2/p3-debug"}, pp)
+}
+
+func TestDebugName(t *testing.T) {
+	uu := map[string]struct {
+		n, e string
+	}{
+		"short": {n: "fred", e: "fred-debug"},
+		"long":  {n: strings.Repeat("a", 250), e: strings.Repeat("a", 247) + "-debug"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, debugName(u.n))
+		})
+	}
+}
+
+// Helpers...
+
+func debugPod() *v1.Pod {
+	probe := v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"true"}}}}
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    "ns1",
+			Name:         "p1",
+			GenerateName: "p-",
+			UID:          "uid-1",
+			Labels:       map[string]string{"app": "fred", "pod-template-hash": "abc"},
+			Annotations: map[string]string{
+				"blee":         "duh",
+				lastAppliedKey: "{}",
+			},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs1"}},
+		},
+		Spec: v1.PodSpec{
+			NodeName: "n1",
+			Containers: []v1.Container{
+				{
+					Name:          "fred",
+					Image:         "fred:1.0",
+					Command:       []string{"fred"},
+					Args:          []string{"--blee"},
+					LivenessProbe: &probe,
+				},
+				{
+					Name:           "sidecar",
+					Image:          "sidecar:1.0",
+					ReadinessProbe: &probe,
+				},
+			},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning, PodIP: "10.0.0.1"},
+	}
+}
@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDeleteEvictedPods(t *testing.T) {
+	po := func(ns, n string, phase v1.PodPhase, reason string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+			Status:     v1.PodStatus{Phase: phase, Reason: reason},
+		}
+	}
+	c := fake.NewSimpleClientset(
+		po("ns1", "p1", v1.PodFailed, "Evicted"),
+		po("ns1", "p2", v1.PodRunning, ""),
+		po("ns1", "p3", v1.PodFailed, "DeadlineExceeded"),
+		po("ns1", "p4", v1.PodFailed, "Evicted"),
+		po("ns2", "p5", v1.PodFailed, "Evicted"),
+	)
+
+	pp, err := DeleteEvictedPods(c, "ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1/p1", "ns1/p4"}, pp)
+
+	ll, err := c.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(ll.Items))
+
+	pp, err = DeleteEvictedPods(c, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns2/p5"}, pp)
+}
+
+func TestServiceAccountFor(t *testing.T) {
+	uu := map[string]struct {
+		sa, path string
+		e        string
+		err      string
+	}{
+		"named": {
+			sa:   "fred",
+			path: "ns1/p1",
+			e:    "ns1/fred",
+		},
+		"default": {
+			path: "ns1/p1",
+			e:    "ns1/default",
+		},
+		"deleted": {
+			sa:   "blee",
+			path: "ns1/p1",
+			err:  "service account ns1/blee no longer exists",
+		},
+		"noPod": {
+			path: "ns1/p2",
+			err:  `pods "p2" not found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := getFactory{oo: map[string]runtime.Object{
+				"v1/pods:ns1/p1":                 makeSAPod(t, u.sa),
+				"v1/serviceaccounts:ns1/fred":    &unstructured.Unstructured{},
+				"v1/serviceaccounts:ns1/default": &unstructured.Unstructured{},
+			}}
+
+			sa, err := ServiceAccountFor(f, u.path)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, sa)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type getFactory struct {
+	Factory
+
+	oo map[string]runtime.Object
+}
+
+func (f getFactory) Get(gvr, path string, _ bool, _ labels.Selector) (runtime.Object, error) {
+	o, ok := f.oo[gvr+":"+path]
+	if !ok {
+		_, n := client.Namespaced(path)
+		return nil, kerrors.NewNotFound(schema.GroupResource{Resource: client.NewGVR(gvr).ToR()}, n)
+	}
+
+	return o, nil
+}
+
+func makeSAPod(t *testing.T, sa string) *unstructured.Unstructured {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Spec:       v1.PodSpec{ServiceAccountName: sa},
+	}
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+	assert.Nil(t, err)
+
+	return &unstructured.Unstructured{Object: o}
+}
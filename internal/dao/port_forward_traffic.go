@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+// Traffic tracks bytes exchanged over port forward connections.
+type Traffic struct {
+	rx, tx     uint64
+	lastActive int64
+}
+
+// NewTraffic returns a new instance.
+func NewTraffic() *Traffic {
+	return &Traffic{lastActive: time.Now().UnixNano()}
+}
+
+// Received records bytes read from the pod.
+func (t *Traffic) Received(n int) {
+	if n <= 0 {
+		return
+	}
+	atomic.AddUint64(&t.rx, uint64(n))
+	atomic.StoreInt64(&t.lastActive, time.Now().UnixNano())
+}
+
+// Sent records bytes written to the pod.
+func (t *Traffic) Sent(n int) {
+	if n <= 0 {
+		return
+	}
+	atomic.AddUint64(&t.tx, uint64(n))
+	atomic.StoreInt64(&t.lastActive, time.Now().UnixNano())
+}
+
+// Stats returns the bytes received and sent.
+func (t *Traffic) Stats() (uint64, uint64) {
+	return atomic.LoadUint64(&t.rx), atomic.LoadUint64(&t.tx)
+}
+
+// LastActive returns the last time bytes were exchanged.
+func (t *Traffic) LastActive() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&t.lastActive))
+}
+
+// trafficDialer tracks traffic on the forwarded streams.
+type trafficDialer struct {
+	httpstream.Dialer
+
+	traffic *Traffic
+}
+
+// Dial opens a connection tracking its streams traffic.
+func (d trafficDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, proto, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, proto, err
+	}
+
+	return trafficConn{Connection: conn, traffic: d.traffic}, proto, nil
+}
+
+type trafficConn struct {
+	httpstream.Connection
+
+	traffic *Traffic
+}
+
+// CreateStream creates a stream tracking bytes read or written.
+func (c trafficConn) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	s, err := c.Connection.CreateStream(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return trafficStream{Stream: s, traffic: c.traffic}, nil
+}
+
+type trafficStream struct {
+	httpstream.Stream
+
+	traffic *Traffic
+}
+
+// Read reads from the stream.
+func (s trafficStream) Read(b []byte) (int, error) {
+	n, err := s.Stream.Read(b)
+	s.traffic.Received(n)
+
+	return n, err
+}
+
+// Write writes to the stream.
+func (s trafficStream) Write(b []byte) (int, error) {
+	n, err := s.Stream.Write(b)
+	s.traffic.Sent(n)
+
+	return n, err
+}
@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+func TestTrafficLoopback(t *testing.T) {
+	tr := NewTraffic()
+	d := trafficDialer{Dialer: echoDialer{}, traffic: tr}
+	conn, _, err := d.Dial()
+	assert.Nil(t, err)
+
+	const streams, size = 10, 4096
+	var wg sync.WaitGroup
+	wg.Add(streams)
+	for i := 0; i < streams; i++ {
+		go func() {
+			defer wg.Done()
+			s, err := conn.CreateStream(http.Header{})
+			assert.Nil(t, err)
+			defer s.Close()
+
+			out, sent := bytes.Repeat([]byte("k"), size), make(chan struct{})
+			go func() {
+				defer close(sent)
+				_, err := s.Write(out)
+				assert.Nil(t, err)
+			}()
+			in := make([]byte, size)
+			_, err = io.ReadFull(s, in)
+			assert.Nil(t, err)
+			assert.Equal(t, out, in)
+			<-sent
+		}()
+	}
+	wg.Wait()
+
+	rx, tx := tr.Stats()
+	assert.Equal(t, uint64(streams*size), rx)
+	assert.Equal(t, uint64(streams*size), tx)
+}
+
+func TestTrafficLastActive(t *testing.T) {
+	tr := NewTraffic()
+	tr.lastActive = time.Now().Add(-time.Hour).UnixNano()
+	tr.Received(0)
+	assert.True(t, time.Since(tr.LastActive()) >= time.Hour)
+
+	tr.Sent(10)
+	assert.True(t, time.Since(tr.LastActive()) < time.Minute)
+	rx, tx := tr.Stats()
+	assert.Equal(t, uint64(0), rx)
+	assert.Equal(t, uint64(10), tx)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type echoDialer struct{}
+
+func (echoDialer) Dial(...string) (httpstream.Connection, string, error) {
+	return echoConn{}, "", nil
+}
+
+type echoConn struct {
+	httpstream.Connection
+}
+
+// CreateStream returns a stream echoing back whatever is written to it.
+func (echoConn) CreateStream(http.Header) (httpstream.Stream, error) {
+	local, remote := net.Pipe()
+	go func() {
+		_, _ = io.Copy(remote, remote)
+	}()
+
+	return echoStream{Conn: local}, nil
+}
+
+type echoStream struct {
+	net.Conn
+}
+
+func (echoStream) Reset() error         { return nil }
+func (echoStream) Headers() http.Header { return nil }
+func (echoStream) Identifier() uint32   { return 0 }
@@ -34,6 +34,8 @@ type PortForwarder struct {
 	container           string
 	ports               []string
 	age                 time.Time
+	ttl, idleTimeout    time.Duration
+	traffic             *Traffic
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -42,14 +44,49 @@ func NewPortForwarder(c client.Connection) *PortForwarder {
 		Connection: c,
 		stopChan:   make(chan struct{}),
 		readyChan:  make(chan struct{}),
+		traffic:    NewTraffic(),
 	}
 }
 
+// Ready returns a channel closed once the local ports are bound.
+func (p *PortForwarder) Ready() <-chan struct{} {
+	return p.readyChan
+}
+
 // Age returns the port forward age.
 func (p *PortForwarder) Age() string {
 	return time.Since(p.age).String()
 }
 
+// SetExpiry sets the forward time to live and idle timeout. A zero value
+// disables the corresponding check.
+func (p *PortForwarder) SetExpiry(ttl, idle time.Duration) {
+	p.ttl, p.idleTimeout = ttl, idle
+}
+
+// TTL returns the forward remaining time to live or blank if none.
+func (p *PortForwarder) TTL() string {
+	if p.ttl == 0 {
+		return ""
+	}
+	rem := p.ttl - time.Since(p.age)
+	if rem < 0 {
+		rem = 0
+	}
+
+	return rem.Round(time.Second).String()
+}
+
+// Expired returns the reason a forward should be stopped if any.
+func (p *PortForwarder) Expired() (string, bool) {
+	return forwardExpiry(time.Now(), p.age, p.traffic.LastActive(), p.ttl, p.idleTimeout)
+}
+
+// Traffic returns the bytes received and sent since the forward started.
+func (p *PortForwarder) Traffic() (uint64, uint64) {
+	return p.traffic.Stats()
+}
+
 // Active returns the forward status.
 func (p *PortForwarder) Active() bool {
 	return p.active
@@ -90,6 +127,7 @@ func (p *PortForwarder) FQN() string {
 // Start initiates a port forward session for a given pod and ports.
 func (p *PortForwarder) Start(path, co, address string, ports []string) (*portforward.PortForwarder, error) {
 	p.path, p.container, p.ports, p.age = path, co, ports, time.Now()
+	p.traffic = NewTraffic()
 
 	ns, n := client.Namespaced(path)
 	auth, err := p.CanI(ns, "v1/pods", []string{"get"})
@@ -138,7 +176,10 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string
 		return nil, err
 	}
 
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, method, url)
+	dialer := trafficDialer{
+		Dialer:  spdy.NewDialer(upgrader, &http.Client{Transport: transport}, method, url),
+		traffic: p.traffic,
+	}
 	if address == "" {
 		address = localhost
 	}
@@ -149,6 +190,17 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func forwardExpiry(now, start, last time.Time, ttl, idle time.Duration) (string, bool) {
+	if ttl > 0 && now.Sub(start) >= ttl {
+		return fmt.Sprintf("TTL %s expired", ttl), true
+	}
+	if idle > 0 && now.Sub(last) >= idle {
+		return fmt.Sprintf("idle for %s", idle), true
+	}
+
+	return "", false
+}
+
 func codec() (serializer.CodecFactory, runtime.ParameterCodec) {
 	scheme := runtime.NewScheme()
 	gv := schema.GroupVersion{Group: "", Version: "v1"}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardExpiry(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		start, last time.Time
+		ttl, idle   time.Duration
+		reason      string
+		expired     bool
+	}{
+		"none": {
+			start: now.Add(-10 * time.Hour),
+			last:  now.Add(-10 * time.Hour),
+		},
+		"live": {
+			start: now.Add(-10 * time.Minute),
+			last:  now.Add(-time.Minute),
+			ttl:   time.Hour,
+			idle:  5 * time.Minute,
+		},
+		"ttl": {
+			start:   now.Add(-2 * time.Hour),
+			last:    now,
+			ttl:     time.Hour,
+			reason:  "TTL 1h0m0s expired",
+			expired: true,
+		},
+		"idle": {
+			start:   now.Add(-time.Hour),
+			last:    now.Add(-10 * time.Minute),
+			ttl:     2 * time.Hour,
+			idle:    5 * time.Minute,
+			reason:  "idle for 5m0s",
+			expired: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			reason, ok := forwardExpiry(now, u.start, u.last, u.ttl, u.idle)
+			assert.Equal(t, u.expired, ok)
+			assert.Equal(t, u.reason, reason)
+		})
+	}
+}
+
+func TestPortForwarderTTL(t *testing.T) {
+	var p PortForwarder
+	p.age = time.Now().Add(-10 * time.Minute)
+	assert.Equal(t, "", p.TTL())
+
+	p.SetExpiry(time.Hour, 0)
+	assert.Equal(t, "50m0s", p.TTL())
+
+	p.SetExpiry(5*time.Minute, 0)
+	assert.Equal(t, "0s", p.TTL())
+}
@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// ProbeReport describes a container probes and related events.
+func ProbeReport(f Factory, path, co string) (string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return "", err
+	}
+
+	c, ok := findContainer(po.Spec, co)
+	if !ok {
+		return "", fmt.Errorf("no container named %q found on pod %q", co, path)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "container: %s\n", c.Name)
+	if s, ok := findContainerStatus(po.Status, co); ok {
+		fmt.Fprintf(&b, "ready: %t\n", s.Ready)
+		fmt.Fprintf(&b, "restarts: %d\n", s.RestartCount)
+	}
+	if c.LivenessProbe == nil && c.ReadinessProbe == nil && c.StartupProbe == nil {
+		fmt.Fprintln(&b, "warning: container defines no probes!")
+	}
+	fmt.Fprintln(&b, "probes:")
+	writeProbe(&b, "liveness", c.LivenessProbe)
+	writeProbe(&b, "readiness", c.ReadinessProbe)
+	writeProbe(&b, "startup", c.StartupProbe)
+
+	ee, err := probeEvents(f, po.Namespace, po.Name, co)
+	if err != nil {
+		return "", err
+	}
+	if len(ee) == 0 {
+		fmt.Fprintf(&b, "events: %s\n", noneValue)
+		return b.String(), nil
+	}
+	fmt.Fprintln(&b, "events:")
+	for _, e := range ee {
+		fmt.Fprintf(&b, "  - %s (x%d, %s ago) %s\n", e.Reason, e.Count, eventAge(e), strings.TrimSpace(e.Message))
+	}
+
+	return b.String(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func findContainer(spec v1.PodSpec, n string) (v1.Container, bool) {
+	for _, c := range append(spec.InitContainers, spec.Containers...) {
+		if c.Name == n {
+			return c, true
+		}
+	}
+
+	return v1.Container{}, false
+}
+
+func findContainerStatus(st v1.PodStatus, n string) (v1.ContainerStatus, bool) {
+	for _, s := range append(st.InitContainerStatuses, st.ContainerStatuses...) {
+		if s.Name == n {
+			return s, true
+		}
+	}
+
+	return v1.ContainerStatus{}, false
+}
+
+func writeProbe(b *strings.Builder, kind string, p *v1.Probe) {
+	if p == nil {
+		fmt.Fprintf(b, "  %s: %s\n", kind, noneValue)
+		return
+	}
+
+	fmt.Fprintf(b, "  %s:\n", kind)
+	t, target := probeTarget(p.Handler)
+	fmt.Fprintf(b, "    type: %s\n", t)
+	fmt.Fprintf(b, "    target: %s\n", target)
+	fmt.Fprintf(b, "    initialDelaySeconds: %d\n", p.InitialDelaySeconds)
+	fmt.Fprintf(b, "    periodSeconds: %d\n", p.PeriodSeconds)
+	fmt.Fprintf(b, "    timeoutSeconds: %d\n", p.TimeoutSeconds)
+	fmt.Fprintf(b, "    successThreshold: %d\n", p.SuccessThreshold)
+	fmt.Fprintf(b, "    failureThreshold: %d\n", p.FailureThreshold)
+}
+
+func probeTarget(h v1.Handler) (string, string) {
+	switch {
+	case h.HTTPGet != nil:
+		scheme := strings.ToLower(string(h.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		return "httpGet", fmt.Sprintf("%s://%s:%s%s", scheme, h.HTTPGet.Host, h.HTTPGet.Port.String(), h.HTTPGet.Path)
+	case h.TCPSocket != nil:
+		return "tcpSocket", fmt.Sprintf("%s:%s", h.TCPSocket.Host, h.TCPSocket.Port.String())
+	case h.Exec != nil:
+		return "exec", strings.Join(h.Exec.Command, " ")
+	default:
+		return "unknown", noneValue
+	}
+}
+
+func probeEvents(f Factory, ns, po, co string) ([]v1.Event, error) {
+	oo, err := f.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var e v1.Event
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &e)
+		if err != nil {
+			return nil, err
+		}
+		if e.InvolvedObject.Kind != "Pod" || e.InvolvedObject.Name != po {
+			continue
+		}
+		if fp := e.InvolvedObject.FieldPath; fp != "" && !strings.Contains(fp, "{"+co+"}") {
+			continue
+		}
+		if !isProbeEvent(e) {
+			continue
+		}
+		ee = append(ee, e)
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].LastTimestamp.After(ee[j].LastTimestamp.Time)
+	})
+
+	return ee, nil
+}
+
+func isProbeEvent(e v1.Event) bool {
+	if e.Reason == "Unhealthy" || e.Reason == "ProbeWarning" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(e.Message), "probe")
+}
+
+func eventAge(e v1.Event) string {
+	if e.LastTimestamp.IsZero() {
+		return "n/a"
+	}
+	return duration.HumanDuration(time.Since(e.LastTimestamp.Time))
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestProbeTarget(t *testing.T) {
+	uu := map[string]struct {
+		h       v1.Handler
+		kind, e string
+	}{
+		"http": {
+			h:    v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)}},
+			kind: "httpGet",
+			e:    "http://:8080/healthz",
+		},
+		"tcp": {
+			h:    v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromString("http")}},
+			kind: "tcpSocket",
+			e:    ":http",
+		},
+		"exec": {
+			h:    v1.Handler{Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/ok"}}},
+			kind: "exec",
+			e:    "cat /tmp/ok",
+		},
+		"none": {
+			kind: "unknown",
+			e:    noneValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kind, target := probeTarget(u.h)
+			assert.Equal(t, u.kind, kind)
+			assert.Equal(t, u.e, target)
+		})
+	}
+}
+
+func TestIsProbeEvent(t *testing.T) {
+	uu := map[string]struct {
+		e  v1.Event
+		ok bool
+	}{
+		"unhealthy": {e: v1.Event{Reason: "Unhealthy"}, ok: true},
+		"message":   {e: v1.Event{Reason: "Killing", Message: "Container failed liveness probe"}, ok: true},
+		"pulled":    {e: v1.Event{Reason: "Pulled", Message: "Successfully pulled image"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, isProbeEvent(u.e))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	clockTicks = 100
+	pageSize   = 4096
+)
+
+var (
+	psCmd   = []string{"ps", "aux"}
+	procCmd = []string{"sh", "-c", "cat /proc/uptime /proc/meminfo /proc/[0-9]*/stat 2>/dev/null; exit 0"}
+)
+
+// Process represents a container process.
+type Process struct {
+	PID     int
+	User    string
+	CPU     float64
+	MEM     float64
+	RSS     int64
+	Command string
+}
+
+// TopProcesses lists the processes running in a container sorted by cpu usage.
+func TopProcesses(e Executor, path, co string) ([]Process, error) {
+	out, err := ExecCapture(e, path, co, psCmd)
+	if err == nil {
+		pp, perr := parsePS(out)
+		if perr == nil {
+			return sortProcesses(pp), nil
+		}
+		err = perr
+	}
+	if !errors.Is(err, ErrNoExecutable) {
+		return nil, err
+	}
+
+	out, err = ExecCapture(e, path, co, procCmd)
+	if err != nil {
+		if errors.Is(err, ErrNoExecutable) {
+			return nil, errors.New("container has neither ps nor sh to list processes")
+		}
+		return nil, err
+	}
+	pp, err := parseProc(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortProcesses(pp), nil
+}
+
+// KillProcess sends a signal to a container process.
+func KillProcess(e Executor, path, co string, pid int, sig string) error {
+	_, err := ExecCapture(e, path, co, []string{"kill", "-" + sig, strconv.Itoa(pid)})
+	if errors.Is(err, ErrNoExecutable) {
+		return errors.New("no kill command available in container")
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func sortProcesses(pp []Process) []Process {
+	sort.SliceStable(pp, func(i, j int) bool {
+		return pp[i].CPU > pp[j].CPU
+	})
+
+	return pp
+}
+
+// parsePS parses ps output using its header to locate columns. Busybox ps
+// ignores the aux flags and yields a reduced set of columns.
+func parsePS(out string) ([]Process, error) {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) == 0 || lines[0] == "" {
+		return nil, ErrNoExecutable
+	}
+
+	header := strings.Fields(lines[0])
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.ToUpper(h)] = i
+	}
+	pidCol, ok := cols["PID"]
+	if !ok {
+		return nil, fmt.Errorf("unable to parse ps output header %q", lines[0])
+	}
+	cmdCol, ok := cols["COMMAND"]
+	if !ok {
+		if cmdCol, ok = cols["CMD"]; !ok {
+			cmdCol = len(header) - 1
+		}
+	}
+
+	pp := make([]Process, 0, len(lines)-1)
+	for _, l := range lines[1:] {
+		ff := strings.Fields(l)
+		if len(ff) <= cmdCol {
+			continue
+		}
+		pid, err := strconv.Atoi(ff[pidCol])
+		if err != nil {
+			continue
+		}
+		p := Process{PID: pid, Command: strings.Join(ff[cmdCol:], " ")}
+		if i, ok := cols["USER"]; ok {
+			p.User = ff[i]
+		}
+		if i, ok := cols["%CPU"]; ok {
+			p.CPU, _ = strconv.ParseFloat(ff[i], 64)
+		}
+		if i, ok := cols["%MEM"]; ok {
+			p.MEM, _ = strconv.ParseFloat(ff[i], 64)
+		}
+		if i, ok := cols["RSS"]; ok {
+			p.RSS, _ = strconv.ParseInt(ff[i], 10, 64)
+		}
+		pp = append(pp, p)
+	}
+
+	return pp, nil
+}
+
+// parseProc parses the concatenation of /proc/uptime, /proc/meminfo and
+// every /proc/<pid>/stat.
+func parseProc(out string) ([]Process, error) {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) == 0 {
+		return nil, errors.New("no process information available")
+	}
+	uptime, err := strconv.ParseFloat(strings.Fields(lines[0] + " 0")[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse uptime %q", lines[0])
+	}
+
+	var (
+		memTotal int64
+		pp       []Process
+	)
+	for _, l := range lines[1:] {
+		if strings.HasPrefix(l, "MemTotal:") {
+			ff := strings.Fields(l)
+			if len(ff) > 1 {
+				memTotal, _ = strconv.ParseInt(ff[1], 10, 64)
+			}
+			continue
+		}
+		p, ok := parseStat(l, uptime, memTotal)
+		if !ok {
+			continue
+		}
+		pp = append(pp, p)
+	}
+
+	return pp, nil
+}
+
+func parseStat(l string, uptime float64, memTotal int64) (Process, bool) {
+	start, end := strings.Index(l, "("), strings.LastIndex(l, ")")
+	if start <= 0 || end < start {
+		return Process{}, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(l[:start]))
+	if err != nil {
+		return Process{}, false
+	}
+	// Fields after the command name start at stat field #3 (state).
+	ff := strings.Fields(l[end+1:])
+	if len(ff) < 22 {
+		return Process{}, false
+	}
+	utime, _ := strconv.ParseFloat(ff[11], 64)
+	stime, _ := strconv.ParseFloat(ff[12], 64)
+	started, _ := strconv.ParseFloat(ff[19], 64)
+	rss, _ := strconv.ParseInt(ff[21], 10, 64)
+
+	p := Process{
+		PID:     pid,
+		Command: l[start+1 : end],
+		RSS:     rss * pageSize / 1024,
+	}
+	if elapsed := uptime - started/clockTicks; elapsed > 0 {
+		p.CPU = (utime + stime) / clockTicks / elapsed * 100
+	}
+	if memTotal > 0 {
+		p.MEM = float64(p.RSS) / float64(memTotal) * 100
+	}
+
+	return p, true
+}
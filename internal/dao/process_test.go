@@ -0,0 +1,149 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecCapture(t *testing.T) {
+	uu := map[string]struct {
+		e   fakeExecutor
+		out string
+		err error
+	}{
+		"ok": {
+			e:   fakeExecutor{"ps": {stdout: "blee"}},
+			out: "blee",
+		},
+		"missing": {
+			e:   fakeExecutor{},
+			err: ErrNoExecutable,
+		},
+		"failed": {
+			e:   fakeExecutor{"ps": {stderr: "boom", err: errors.New("exit code 1")}},
+			err: errors.New("exit code 1: boom"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			out, err := ExecCapture(u.e, "fred/p1", "c1", psCmd)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.out, out)
+		})
+	}
+}
+
+func TestTopProcesses(t *testing.T) {
+	uu := map[string]struct {
+		e    fakeExecutor
+		pids []int
+		err  error
+	}{
+		"ps": {
+			e:    fakeExecutor{"ps": {stdout: psAux}},
+			pids: []int{12, 1},
+		},
+		"busybox": {
+			e:    fakeExecutor{"ps": {stdout: psBusybox}},
+			pids: []int{1, 7},
+		},
+		"proc": {
+			e:    fakeExecutor{"sh": {stdout: procStat}},
+			pids: []int{7, 1},
+		},
+		"none": {
+			e:   fakeExecutor{},
+			err: errors.New("container has neither ps nor sh to list processes"),
+		},
+		"forbidden": {
+			e:   fakeExecutor{"ps": {err: errors.New("user is not authorized to exec into pod fred/p1")}},
+			err: errors.New("user is not authorized to exec into pod fred/p1"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, err := TopProcesses(u.e, "fred/p1", "c1")
+			assert.Equal(t, u.err, err)
+			pids := make([]int, 0, len(pp))
+			for _, p := range pp {
+				pids = append(pids, p.PID)
+			}
+			if u.err == nil {
+				assert.Equal(t, u.pids, pids)
+			}
+		})
+	}
+}
+
+func TestParsePS(t *testing.T) {
+	pp, err := parsePS(psAux)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pp))
+	assert.Equal(t, Process{PID: 1, User: "root", CPU: 0.1, MEM: 0.5, RSS: 4200, Command: "/bin/app --port 80"}, pp[0])
+}
+
+func TestParseProc(t *testing.T) {
+	pp, err := parseProc(procStat)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pp))
+	assert.Equal(t, "my app", pp[1].Command)
+	assert.Equal(t, int64(400), pp[1].RSS)
+	assert.InDelta(t, 40.0, pp[1].CPU, 0.01)
+	assert.InDelta(t, 40.0, pp[1].MEM, 0.01)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type execResult struct {
+	stdout, stderr string
+	err            error
+}
+
+// fakeExecutor maps a binary name to a canned result. Unknown binaries
+// mimic the api server response for a missing executable.
+type fakeExecutor map[string]execResult
+
+func (f fakeExecutor) Exec(path, co string, cmd []string, stdout, stderr io.Writer) error {
+	r, ok := f[cmd[0]]
+	if !ok {
+		return fmt.Errorf("exec: %q: executable file not found in $PATH", cmd[0])
+	}
+	if _, err := io.Copy(stdout, strings.NewReader(r.stdout)); err != nil {
+		return err
+	}
+	if _, err := io.Copy(stderr, strings.NewReader(r.stderr)); err != nil {
+		return err
+	}
+
+	return r.err
+}
+
+const psAux = `USER       PID %CPU %MEM    VSZ   RSS TTY      STAT START   TIME COMMAND
+root         1  0.1  0.5  10000  4200 ?        Ss   10:00   0:01 /bin/app --port 80
+root        12 12.5  0.1   2000   800 pts/0    R+   10:05   0:00 ps aux
+`
+
+const psBusybox = `PID   USER     TIME  COMMAND
+    1 root      0:00 /bin/sh
+    7 root      0:00 ps aux
+`
+
+// uptime 100s, 1000KiB memory, pid 7 started at 50s using 20s of cpu.
+const procStat = `100.00 90.00
+MemTotal:           1000 kB
+MemFree:             500 kB
+1 (init) S 0 1 1 0 -1 4194560 100 0 0 0 100 0 0 0 20 0 1 0 0 1000 10 18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0
+7 (my app) R 1 7 1 0 -1 4194560 100 0 0 0 1500 500 0 0 20 0 1 0 5000 1000 100 18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0
+`
@@ -0,0 +1,133 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PersistentVolume represents a K8s persistent volume resource.
+type PersistentVolume struct {
+	Generic
+}
+
+var _ Accessor = (*PersistentVolume)(nil)
+
+// Load returns a persistent volume.
+func (p *PersistentVolume) Load(path string) (*v1.PersistentVolume, error) {
+	o, err := p.Get(p.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var pv v1.PersistentVolume
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pv, nil
+}
+
+// Release clears a released volume claim reference making it available for
+// new claims. The call fails if the volume changed since version was read.
+func (p *PersistentVolume) Release(path, version string) error {
+	pv, err := p.Load(path)
+	if err != nil {
+		return err
+	}
+	if err := CanRelease(pv); err != nil {
+		return err
+	}
+
+	return p.patch(path, version, map[string]interface{}{"claimRef": nil})
+}
+
+// SetReclaimPolicy updates a volume reclaim policy. The call fails if the
+// volume changed since version was read.
+func (p *PersistentVolume) SetReclaimPolicy(path string, policy v1.PersistentVolumeReclaimPolicy, version string) error {
+	pv, err := p.Load(path)
+	if err != nil {
+		return err
+	}
+	if err := CanReclaim(pv, policy); err != nil {
+		return err
+	}
+
+	return p.patch(path, version, map[string]interface{}{"persistentVolumeReclaimPolicy": policy})
+}
+
+func (p *PersistentVolume) patch(path, version string, spec map[string]interface{}) error {
+	auth, err := p.Client().CanI(client.AllNamespaces, p.gvr.String(), []string{"patch"})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch persistent volumes")
+	}
+	raw, err := pvPatch(version, spec)
+	if err != nil {
+		return err
+	}
+	_, n := client.Namespaced(path)
+	_, err = p.Client().DialOrDie().CoreV1().PersistentVolumes().Patch(n, types.MergePatchType, raw)
+	if errors.IsConflict(err) {
+		return fmt.Errorf("persistent volume %s changed since it was read. Please retry", n)
+	}
+
+	return err
+}
+
+// CanRelease checks if a volume claim reference can be cleared.
+func CanRelease(pv *v1.PersistentVolume) error {
+	switch {
+	case pv.Status.Phase == v1.VolumeBound:
+		return fmt.Errorf("persistent volume %s is bound", pv.Name)
+	case pv.Status.Phase != v1.VolumeReleased:
+		return fmt.Errorf("persistent volume %s is %s, expecting Released", pv.Name, pv.Status.Phase)
+	case pv.Spec.ClaimRef == nil:
+		return fmt.Errorf("persistent volume %s has no claim reference", pv.Name)
+	}
+
+	return nil
+}
+
+// CanReclaim checks if a volume reclaim policy can be changed. Bound volumes
+// may not switch to Delete as removing their claim would lose the data.
+func CanReclaim(pv *v1.PersistentVolume, policy v1.PersistentVolumeReclaimPolicy) error {
+	switch policy {
+	case v1.PersistentVolumeReclaimRetain, v1.PersistentVolumeReclaimDelete:
+	default:
+		return fmt.Errorf("unsupported reclaim policy %q", policy)
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy == policy {
+		return fmt.Errorf("persistent volume %s reclaim policy is already %s", pv.Name, policy)
+	}
+	if pv.Status.Phase == v1.VolumeBound && policy == v1.PersistentVolumeReclaimDelete {
+		return fmt.Errorf("persistent volume %s is bound", pv.Name)
+	}
+
+	return nil
+}
+
+// ClaimPath returns a volume claim reference path or blank if none.
+func ClaimPath(pv *v1.PersistentVolume) string {
+	if pv.Spec.ClaimRef == nil {
+		return ""
+	}
+
+	return client.FQN(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+}
+
+func pvPatch(version string, spec map[string]interface{}) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": version},
+		"spec":     spec,
+	})
+}
@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCanRelease(t *testing.T) {
+	uu := map[string]struct {
+		pv  *v1.PersistentVolume
+		err string
+	}{
+		"released": {
+			pv: makePV(v1.VolumeReleased, v1.PersistentVolumeReclaimRetain, true),
+		},
+		"bound": {
+			pv:  makePV(v1.VolumeBound, v1.PersistentVolumeReclaimRetain, true),
+			err: "persistent volume pv1 is bound",
+		},
+		"available": {
+			pv:  makePV(v1.VolumeAvailable, v1.PersistentVolumeReclaimRetain, false),
+			err: "persistent volume pv1 is Available, expecting Released",
+		},
+		"noClaim": {
+			pv:  makePV(v1.VolumeReleased, v1.PersistentVolumeReclaimRetain, false),
+			err: "persistent volume pv1 has no claim reference",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := CanRelease(u.pv)
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestCanReclaim(t *testing.T) {
+	uu := map[string]struct {
+		pv     *v1.PersistentVolume
+		policy v1.PersistentVolumeReclaimPolicy
+		err    string
+	}{
+		"releasedToDelete": {
+			pv:     makePV(v1.VolumeReleased, v1.PersistentVolumeReclaimRetain, true),
+			policy: v1.PersistentVolumeReclaimDelete,
+		},
+		"boundToRetain": {
+			pv:     makePV(v1.VolumeBound, v1.PersistentVolumeReclaimDelete, true),
+			policy: v1.PersistentVolumeReclaimRetain,
+		},
+		"boundToDelete": {
+			pv:     makePV(v1.VolumeBound, v1.PersistentVolumeReclaimRetain, true),
+			policy: v1.PersistentVolumeReclaimDelete,
+			err:    "persistent volume pv1 is bound",
+		},
+		"same": {
+			pv:     makePV(v1.VolumeAvailable, v1.PersistentVolumeReclaimRetain, false),
+			policy: v1.PersistentVolumeReclaimRetain,
+			err:    "persistent volume pv1 reclaim policy is already Retain",
+		},
+		"recycle": {
+			pv:     makePV(v1.VolumeAvailable, v1.PersistentVolumeReclaimRetain, false),
+			policy: v1.PersistentVolumeReclaimRecycle,
+			err:    `unsupported reclaim policy "Recycle"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := CanReclaim(u.pv, u.policy)
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestClaimPath(t *testing.T) {
+	assert.Equal(t, "default/pvc1", ClaimPath(makePV(v1.VolumeReleased, v1.PersistentVolumeReclaimRetain, true)))
+	assert.Equal(t, "", ClaimPath(makePV(v1.VolumeAvailable, v1.PersistentVolumeReclaimRetain, false)))
+}
+
+func TestPVPatch(t *testing.T) {
+	raw, err := pvPatch("42", map[string]interface{}{"claimRef": nil})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"metadata":{"resourceVersion":"42"},"spec":{"claimRef":null}}`, string(raw))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makePV(phase v1.PersistentVolumePhase, policy v1.PersistentVolumeReclaimPolicy, claimed bool) *v1.PersistentVolume {
+	pv := v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv1"},
+		Spec:       v1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: policy},
+		Status:     v1.PersistentVolumeStatus{Phase: phase},
+	}
+	if claimed {
+		pv.Spec.ClaimRef = &v1.ObjectReference{Namespace: "default", Name: "pvc1"}
+	}
+
+	return &pv
+}
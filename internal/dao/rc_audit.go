@@ -0,0 +1,178 @@
+package dao
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	dcGVR               = "apps.openshift.io/v1/deploymentconfigs"
+	dcVersionAnnotation = "openshift.io/deployment-config.latest-version"
+)
+
+// ReplicationControllerAudit flags replication controllers left behind by
+// their deployment configs. Replication controllers without a controller are
+// standalone by design and are never flagged.
+type ReplicationControllerAudit struct {
+	states map[types.UID]string
+}
+
+// NewReplicationControllerAudit evaluates the replication controllers in a
+// namespace against their deployment configs.
+func NewReplicationControllerAudit(f Factory, ns string) (*ReplicationControllerAudit, error) {
+	oo, err := f.List("v1/replicationcontrollers", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rcs := make([]v1.ReplicationController, 0, len(oo))
+	for _, o := range oo {
+		var rc v1.ReplicationController
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rc)
+		if err != nil {
+			return nil, err
+		}
+		rcs = append(rcs, rc)
+	}
+
+	var dcs []deploymentConfig
+	if _, err := MetaFor(client.NewGVR(dcGVR)); err != nil {
+		log.Debug().Msgf("No deployment configs on cluster. Skipping!")
+	} else {
+		oo, err = f.List(dcGVR, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		dcs = make([]deploymentConfig, 0, len(oo))
+		for _, o := range oo {
+			dcs = append(dcs, toDeploymentConfig(o.(*unstructured.Unstructured)))
+		}
+	}
+
+	return &ReplicationControllerAudit{states: auditReplicationControllers(rcs, dcs)}, nil
+}
+
+// State returns a replication controller audit state or blank if it is
+// accounted for.
+func (a *ReplicationControllerAudit) State(u *unstructured.Unstructured) string {
+	return a.states[u.GetUID()]
+}
+
+// ReplicationControllerState returns the audit state of a given replication
+// controller.
+func ReplicationControllerState(f Factory, path string) (string, error) {
+	o, err := f.Get("v1/replicationcontrollers", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	ns, _ := client.Namespaced(path)
+	a, err := NewReplicationControllerAudit(f, ns)
+	if err != nil {
+		return "", err
+	}
+
+	return a.State(o.(*unstructured.Unstructured)), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// deploymentConfig tracks the deployment config fields an audit needs.
+type deploymentConfig struct {
+	uid     types.UID
+	version int64
+	limit   int
+}
+
+func toDeploymentConfig(u *unstructured.Unstructured) deploymentConfig {
+	dc := deploymentConfig{uid: u.GetUID(), limit: defaultHistoryLimit}
+	dc.version, _, _ = unstructured.NestedInt64(u.Object, "status", "latestVersion")
+	if l, ok, _ := unstructured.NestedInt64(u.Object, "spec", "revisionHistoryLimit"); ok {
+		dc.limit = int(l)
+	}
+
+	return dc
+}
+
+// auditReplicationControllers flags orphans ie replication controllers whose
+// deployment config is gone and stale ones ie scaled down versions past their
+// deployment config history limit. Replication controllers controlled by
+// other kinds are left alone.
+func auditReplicationControllers(rcs []v1.ReplicationController, dcs []deploymentConfig) map[types.UID]string {
+	owners := make(map[types.UID]deploymentConfig, len(dcs))
+	for _, dc := range dcs {
+		owners[dc.uid] = dc
+	}
+
+	states := make(map[types.UID]string)
+	history := make(map[types.UID][]v1.ReplicationController)
+	for _, rc := range rcs {
+		ref := metav1.GetControllerOf(&rc)
+		if ref == nil || ref.Kind != "DeploymentConfig" {
+			continue
+		}
+		dc, ok := owners[ref.UID]
+		if !ok {
+			states[rc.UID] = rcOrphanState(rc)
+			continue
+		}
+		if rcDesiredReplicas(rc) == 0 && rc.Status.Replicas == 0 && rcVersion(rc) != dc.version {
+			history[dc.uid] = append(history[dc.uid], rc)
+		}
+	}
+
+	for uid, hh := range history {
+		limit := owners[uid].limit
+		if len(hh) <= limit {
+			continue
+		}
+		sort.Slice(hh, func(i, j int) bool {
+			vi, vj := rcVersion(hh[i]), rcVersion(hh[j])
+			if vi == vj {
+				return hh[i].CreationTimestamp.Before(&hh[j].CreationTimestamp)
+			}
+			return vi < vj
+		})
+		for _, rc := range hh[:len(hh)-limit] {
+			states[rc.UID] = render.RSStale
+		}
+	}
+
+	return states
+}
+
+// rcOrphanState flags a replication controller whose deployment config is
+// gone. Scaled down ones no longer serve any rollback.
+func rcOrphanState(rc v1.ReplicationController) string {
+	if rcDesiredReplicas(rc) > 0 {
+		return render.RSOrphan
+	}
+
+	return render.RSStale
+}
+
+func rcDesiredReplicas(rc v1.ReplicationController) int32 {
+	if rc.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *rc.Spec.Replicas
+}
+
+func rcVersion(rc v1.ReplicationController) int64 {
+	v, err := strconv.ParseInt(rc.Annotations[dcVersionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
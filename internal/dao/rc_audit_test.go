@@ -0,0 +1,156 @@
+package dao
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAuditReplicationControllers(t *testing.T) {
+	dc := makeAuditDC("dc1", 3, 2)
+
+	uu := map[string]struct {
+		rcs []v1.ReplicationController
+		dcs []deploymentConfig
+		e   map[types.UID]string
+	}{
+		"none": {
+			dcs: []deploymentConfig{dc},
+			e:   map[types.UID]string{},
+		},
+		"withinHistory": {
+			rcs: []v1.ReplicationController{
+				makeAuditRC("rc1", 1, 0, &dc),
+				makeAuditRC("rc2", 2, 0, &dc),
+				makeAuditRC("rc3", 3, 2, &dc),
+			},
+			dcs: []deploymentConfig{dc},
+			e:   map[types.UID]string{},
+		},
+		"pastHistory": {
+			rcs: []v1.ReplicationController{
+				makeAuditRC("rc4", 4, 0, &dc),
+				makeAuditRC("rc1", 1, 0, &dc),
+				makeAuditRC("rc3", 3, 2, &dc),
+				makeAuditRC("rc2", 2, 0, &dc),
+				makeAuditRC("rc0", 0, 0, &dc),
+			},
+			dcs: []deploymentConfig{dc},
+			e: map[types.UID]string{
+				"rc0": render.RSStale,
+				"rc1": render.RSStale,
+			},
+		},
+		"scaledDownCurrent": {
+			rcs: []v1.ReplicationController{
+				makeAuditRC("rc1", 1, 0, &dc),
+				makeAuditRC("rc2", 2, 0, &dc),
+				makeAuditRC("rc3", 3, 0, &dc),
+			},
+			dcs: []deploymentConfig{dc},
+			e:   map[types.UID]string{},
+		},
+		"deletedDeploymentConfig": {
+			rcs: []v1.ReplicationController{
+				makeAuditRC("rc1", 1, 0, &dc),
+				makeAuditRC("rc2", 2, 3, &dc),
+			},
+			e: map[types.UID]string{
+				"rc1": render.RSStale,
+				"rc2": render.RSOrphan,
+			},
+		},
+		"standalone": {
+			rcs: []v1.ReplicationController{
+				makeAuditRC("rc1", 0, 2, nil),
+				makeAuditRC("rc2", 0, 0, nil),
+			},
+			dcs: []deploymentConfig{dc},
+			e:   map[types.UID]string{},
+		},
+		"otherController": {
+			rcs: []v1.ReplicationController{
+				func() v1.ReplicationController {
+					rc := makeAuditRC("rc1", 1, 2, &dc)
+					rc.OwnerReferences[0].Kind = "Fred"
+					return rc
+				}(),
+			},
+			e: map[types.UID]string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, auditReplicationControllers(u.rcs, u.dcs))
+		})
+	}
+}
+
+func TestToDeploymentConfig(t *testing.T) {
+	uu := map[string]struct {
+		o map[string]interface{}
+		e deploymentConfig
+	}{
+		"full": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"uid": "dc1"},
+				"spec":     map[string]interface{}{"revisionHistoryLimit": int64(2)},
+				"status":   map[string]interface{}{"latestVersion": int64(3)},
+			},
+			e: deploymentConfig{uid: "dc1", version: 3, limit: 2},
+		},
+		"defaultLimit": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"uid": "dc1"},
+			},
+			e: deploymentConfig{uid: "dc1", limit: defaultHistoryLimit},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, toDeploymentConfig(&unstructured.Unstructured{Object: u.o}))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeAuditDC(n string, version int64, limit int) deploymentConfig {
+	return deploymentConfig{uid: types.UID(n), version: version, limit: limit}
+}
+
+func makeAuditRC(n string, version int64, replicas int32, owner *deploymentConfig) v1.ReplicationController {
+	rc := v1.ReplicationController{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        n,
+			Namespace:   "default",
+			UID:         types.UID(n),
+			Annotations: map[string]string{dcVersionAnnotation: strconv.FormatInt(version, 10)},
+		},
+		Spec:   v1.ReplicationControllerSpec{Replicas: &replicas},
+		Status: v1.ReplicationControllerStatus{Replicas: replicas},
+	}
+	if owner != nil {
+		ctrl := true
+		rc.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps.openshift.io/v1",
+			Kind:       "DeploymentConfig",
+			Name:       string(owner.uid),
+			UID:        owner.uid,
+			Controller: &ctrl,
+		}}
+	}
+
+	return rc
+}
@@ -0,0 +1,167 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Reference types.
+const (
+	RefVolume          = "Volume"
+	RefProjected       = "ProjectedVolume"
+	RefEnv             = "Env"
+	RefEnvFrom         = "EnvFrom"
+	RefImagePullSecret = "ImagePullSecret"
+	RefMountableSecret = "MountableSecret"
+)
+
+// Ref represents a resource consumer.
+type Ref struct {
+	Kind   string
+	Path   string
+	Type   string
+	Detail string
+}
+
+// ID returns a unique reference id.
+func (r Ref) ID() string {
+	return r.Kind + ":" + r.Path + ":" + r.Type + ":" + r.Detail
+}
+
+// RefIndex indexes secrets and configmaps consumers in a namespace. An index
+// reflects the cache content at the time it was built.
+type RefIndex struct {
+	secrets    map[string][]Ref
+	configMaps map[string][]Ref
+}
+
+// NewRefIndex scans a namespace pods and service accounts.
+func NewRefIndex(f Factory, ns string) (*RefIndex, error) {
+	idx := newRefIndex()
+
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		idx.indexPod(po)
+	}
+
+	oo, err = f.List("v1/serviceaccounts", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		var sa v1.ServiceAccount
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sa)
+		if err != nil {
+			return nil, err
+		}
+		idx.indexServiceAccount(sa)
+	}
+
+	return idx, nil
+}
+
+func newRefIndex() *RefIndex {
+	return &RefIndex{
+		secrets:    make(map[string][]Ref),
+		configMaps: make(map[string][]Ref),
+	}
+}
+
+// Refs returns the consumers of a secret or configmap.
+func (r *RefIndex) Refs(gvr, path string) ([]Ref, error) {
+	_, n := client.Namespaced(path)
+	switch gvr {
+	case "v1/secrets":
+		return r.secrets[n], nil
+	case "v1/configmaps":
+		return r.configMaps[n], nil
+	default:
+		return nil, fmt.Errorf("no references tracked for %s", gvr)
+	}
+}
+
+func (r *RefIndex) indexPod(po v1.Pod) {
+	ref := func(t, d string) Ref {
+		return Ref{Kind: "Pod", Path: client.FQN(po.Namespace, po.Name), Type: t, Detail: d}
+	}
+
+	for _, v := range po.Spec.Volumes {
+		switch {
+		case v.Secret != nil:
+			r.addSecret(v.Secret.SecretName, ref(RefVolume, v.Name))
+		case v.ConfigMap != nil:
+			r.addConfigMap(v.ConfigMap.Name, ref(RefVolume, v.Name))
+		case v.Projected != nil:
+			for _, s := range v.Projected.Sources {
+				if s.Secret != nil {
+					r.addSecret(s.Secret.Name, ref(RefProjected, v.Name))
+				}
+				if s.ConfigMap != nil {
+					r.addConfigMap(s.ConfigMap.Name, ref(RefProjected, v.Name))
+				}
+			}
+		}
+	}
+
+	cc := append(append([]v1.Container{}, po.Spec.InitContainers...), po.Spec.Containers...)
+	for _, c := range cc {
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if s := e.ValueFrom.SecretKeyRef; s != nil {
+				r.addSecret(s.Name, ref(RefEnv, c.Name+"/"+e.Name))
+			}
+			if s := e.ValueFrom.ConfigMapKeyRef; s != nil {
+				r.addConfigMap(s.Name, ref(RefEnv, c.Name+"/"+e.Name))
+			}
+		}
+		for _, e := range c.EnvFrom {
+			if e.SecretRef != nil {
+				r.addSecret(e.SecretRef.Name, ref(RefEnvFrom, c.Name))
+			}
+			if e.ConfigMapRef != nil {
+				r.addConfigMap(e.ConfigMapRef.Name, ref(RefEnvFrom, c.Name))
+			}
+		}
+	}
+
+	for _, s := range po.Spec.ImagePullSecrets {
+		r.addSecret(s.Name, ref(RefImagePullSecret, ""))
+	}
+}
+
+func (r *RefIndex) indexServiceAccount(sa v1.ServiceAccount) {
+	path := client.FQN(sa.Namespace, sa.Name)
+	for _, s := range sa.Secrets {
+		r.addSecret(s.Name, Ref{Kind: "ServiceAccount", Path: path, Type: RefMountableSecret})
+	}
+	for _, s := range sa.ImagePullSecrets {
+		r.addSecret(s.Name, Ref{Kind: "ServiceAccount", Path: path, Type: RefImagePullSecret})
+	}
+}
+
+func (r *RefIndex) addSecret(n string, ref Ref) {
+	if n != "" {
+		r.secrets[n] = append(r.secrets[n], ref)
+	}
+}
+
+func (r *RefIndex) addConfigMap(n string, ref Ref) {
+	if n != "" {
+		r.configMaps[n] = append(r.configMaps[n], ref)
+	}
+}
@@ -0,0 +1,150 @@
+package dao
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRefIndexPod(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		gvr  string
+		e    []Ref
+	}{
+		"none": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			gvr:  "v1/secrets",
+		},
+		"secretVolume": {
+			spec: v1.PodSpec{Volumes: []v1.Volume{
+				{Name: "v1", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "s1"}}},
+			}},
+			gvr: "v1/secrets",
+			e:   []Ref{makeRef(RefVolume, "v1")},
+		},
+		"cmVolume": {
+			spec: v1.PodSpec{Volumes: []v1.Volume{
+				{Name: "v1", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{Name: "s1"},
+				}}},
+			}},
+			gvr: "v1/configmaps",
+			e:   []Ref{makeRef(RefVolume, "v1")},
+		},
+		"projected": {
+			spec: v1.PodSpec{Volumes: []v1.Volume{
+				{Name: "v1", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{
+					Sources: []v1.VolumeProjection{
+						{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+						{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "s2"}}},
+					},
+				}}},
+			}},
+			gvr: "v1/secrets",
+			e:   []Ref{makeRef(RefProjected, "v1")},
+		},
+		"secretEnv": {
+			spec: v1.PodSpec{InitContainers: []v1.Container{{Name: "i1", Env: []v1.EnvVar{
+				{Name: "PWD", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "s1"},
+					Key:                  "pwd",
+				}}},
+				{Name: "USER", Value: "fred"},
+			}}}},
+			gvr: "v1/secrets",
+			e:   []Ref{makeRef(RefEnv, "i1/PWD")},
+		},
+		"cmEnv": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1", Env: []v1.EnvVar{
+				{Name: "LEVEL", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "s1"},
+					Key:                  "level",
+				}}},
+			}}}},
+			gvr: "v1/configmaps",
+			e:   []Ref{makeRef(RefEnv, "c1/LEVEL")},
+		},
+		"envFrom": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1", EnvFrom: []v1.EnvFromSource{
+				{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+				{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+			}}}},
+			gvr: "v1/configmaps",
+			e:   []Ref{makeRef(RefEnvFrom, "c1")},
+		},
+		"imagePull": {
+			spec: v1.PodSpec{ImagePullSecrets: []v1.LocalObjectReference{{Name: "s1"}}},
+			gvr:  "v1/secrets",
+			e:    []Ref{makeRef(RefImagePullSecret, "")},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			idx := newRefIndex()
+			idx.indexPod(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"}, Spec: u.spec})
+			rr, err := idx.Refs(u.gvr, "default/s1")
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, rr)
+		})
+	}
+}
+
+func TestRefIndexServiceAccount(t *testing.T) {
+	idx := newRefIndex()
+	idx.indexServiceAccount(v1.ServiceAccount{
+		ObjectMeta:       metav1.ObjectMeta{Namespace: "default", Name: "sa1"},
+		Secrets:          []v1.ObjectReference{{Name: "s1"}},
+		ImagePullSecrets: []v1.LocalObjectReference{{Name: "s1"}, {Name: "s2"}},
+	})
+
+	rr, err := idx.Refs("v1/secrets", "default/s1")
+	assert.Nil(t, err)
+	assert.Equal(t, []Ref{
+		{Kind: "ServiceAccount", Path: "default/sa1", Type: RefMountableSecret},
+		{Kind: "ServiceAccount", Path: "default/sa1", Type: RefImagePullSecret},
+	}, rr)
+	rr, _ = idx.Refs("v1/secrets", "default/s2")
+	assert.Equal(t, 1, len(rr))
+	rr, _ = idx.Refs("v1/configmaps", "default/s1")
+	assert.Equal(t, 0, len(rr))
+	_, err = idx.Refs("v1/pods", "default/p1")
+	assert.NotNil(t, err)
+}
+
+func BenchmarkRefIndex(b *testing.B) {
+	pods := make([]v1.Pod, 0, 5000)
+	for i := 0; i < cap(pods); i++ {
+		pods = append(pods, v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("p%d", i)},
+			Spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{Name: "v1", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: fmt.Sprintf("s%d", i%50)}}},
+				},
+				Containers: []v1.Container{{Name: "c1", EnvFrom: []v1.EnvFromSource{
+					{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+				}}},
+			},
+		})
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		idx := newRefIndex()
+		for _, po := range pods {
+			idx.indexPod(po)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeRef(t, d string) Ref {
+	return Ref{Kind: "Pod", Path: "default/p1", Type: t, Detail: d}
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -18,7 +19,10 @@ type ResourceMetas map[client.GVR]metav1.APIResource
 // Accessors represents a collection of dao accessors.
 type Accessors map[client.GVR]Accessor
 
-var resMetas = ResourceMetas{}
+var (
+	resMetas  = ResourceMetas{}
+	printCols = map[client.GVR]render.PrinterColumns{}
+)
 
 // AccessorFor returns a client accessor for a resource if registered.
 // Otherwise it returns a generic accessor.
@@ -38,6 +42,7 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("apps/v1/statefulsets"):          &StatefulSet{},
 		client.NewGVR("batch/v1beta1/cronjobs"):        &CronJob{},
 		client.NewGVR("batch/v1/jobs"):                 &Job{},
+		client.NewGVR("v1/persistentvolumes"):          &PersistentVolume{},
 	}
 
 	r, ok := m[gvr]
@@ -75,6 +80,11 @@ func MetaFor(gvr client.GVR) (metav1.APIResource, error) {
 	return m, nil
 }
 
+// PrinterColumnsFor returns the additional printer columns declared by a CRD.
+func PrinterColumnsFor(gvr client.GVR) render.PrinterColumns {
+	return printCols[gvr]
+}
+
 // IsK9sMeta checks for non resource meta.
 func IsK9sMeta(m metav1.APIResource) bool {
 	for _, c := range m.Categories {
@@ -89,6 +99,7 @@ func IsK9sMeta(m metav1.APIResource) bool {
 // LoadResources hydrates server preferred+CRDs resource metadata.
 func LoadResources(f Factory) error {
 	resMetas = make(ResourceMetas, 100)
+	printCols = make(map[client.GVR]render.PrinterColumns)
 	if err := loadPreferred(f, resMetas); err != nil {
 		return err
 	}
@@ -133,11 +144,62 @@ func loadNonResource(m ResourceMetas) {
 		Verbs:      []string{"delete"},
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("watches")] = metav1.APIResource{
+		Name:       "watches",
+		Kind:       "Watches",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("snapshotdiffs")] = metav1.APIResource{
+		Name:       "snapshotdiffs",
+		Kind:       "SnapshotDiffs",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("foreach")] = metav1.APIResource{
+		Name:       "foreach",
+		Kind:       "Foreach",
+		Categories: []string{"k9s"},
+	}
 	m[client.NewGVR("containers")] = metav1.APIResource{
 		Name:       "containers",
 		Kind:       "Containers",
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("envvars")] = metav1.APIResource{
+		Name:       "envvars",
+		Kind:       "EnvVars",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("processes")] = metav1.APIResource{
+		Name:       "processes",
+		Kind:       "Processes",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("dscoverage")] = metav1.APIResource{
+		Name:       "dscoverage",
+		Kind:       "NodeCoverage",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("jobpods")] = metav1.APIResource{
+		Name:       "jobpods",
+		Kind:       "JobPods",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("ingbackends")] = metav1.APIResource{
+		Name:       "ingbackends",
+		Kind:       "IngressBackends",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("audits")] = metav1.APIResource{
+		Name:       "audits",
+		Kind:       "Audits",
+		ShortNames: []string{"audit"},
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("refs")] = metav1.APIResource{
+		Name:       "refs",
+		Kind:       "References",
+		Categories: []string{"k9s"},
+	}
 
 	loadRBAC(m)
 }
@@ -180,12 +242,26 @@ func loadPreferred(f Factory, m ResourceMetas) error {
 			gvr := client.FromGVAndR(r.GroupVersion, res.Name)
 			res.Group, res.Version = gvr.ToG(), gvr.ToV()
 			m[gvr] = res
+			recordDeprecation(f, gvr, res)
 		}
 	}
 
 	return nil
 }
 
+func recordDeprecation(f Factory, gvr client.GVR, m metav1.APIResource) {
+	rep, ok := client.DeprecatedAPI(gvr.String())
+	if !ok || f.Client() == nil || f.Client().Config() == nil {
+		return
+	}
+	f.Client().Config().Deprecations().Record(client.Deprecation{
+		GVR:         gvr.String(),
+		Kind:        m.Kind,
+		Replacement: rep,
+		Message:     fmt.Sprintf("%s %s is deprecated, use %s", gvr.AsGV(), m.Kind, rep),
+	})
+}
+
 func loadCRDs(f Factory, m ResourceMetas) {
 	log.Debug().Msgf("Loading CRDs...")
 	const crdGVR = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
@@ -204,6 +280,9 @@ func loadCRDs(f Factory, m ResourceMetas) {
 		}
 		gvr := client.NewGVRFromMeta(meta)
 		m[gvr] = meta
+		if cc := extractPrinterColumns(o, meta.Version); len(cc) > 0 {
+			printCols[gvr] = cc
+		}
 	}
 }
 
@@ -243,6 +322,60 @@ func extractMeta(o runtime.Object) (metav1.APIResource, []error) {
 	return m, errs
 }
 
+// extractPrinterColumns collects a CRD additional printer columns either at the
+// spec level or for the given version.
+func extractPrinterColumns(o runtime.Object, version string) render.PrinterColumns {
+	crd, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	spec, ok := crd.Object["spec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	ii, _ := spec["additionalPrinterColumns"].([]interface{})
+	if len(ii) == 0 {
+		vv, _ := spec["versions"].([]interface{})
+		for _, v := range vv {
+			m, ok := v.(map[string]interface{})
+			if !ok || m["name"] != version {
+				continue
+			}
+			ii, _ = m["additionalPrinterColumns"].([]interface{})
+		}
+	}
+
+	cc := make(render.PrinterColumns, 0, len(ii))
+	for _, i := range ii {
+		m, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var c render.PrinterColumn
+		c.Name, _ = m["name"].(string)
+		c.Type, _ = m["type"].(string)
+		c.Format, _ = m["format"].(string)
+		c.Description, _ = m["description"].(string)
+		// v1beta1 and v1 CRDs spell the path key differently.
+		if c.JSONPath, ok = m["JSONPath"].(string); !ok {
+			c.JSONPath, _ = m["jsonPath"].(string)
+		}
+		switch p := m["priority"].(type) {
+		case int64:
+			c.Priority = int32(p)
+		case float64:
+			c.Priority = int32(p)
+		}
+		if c.Name == "" || c.JSONPath == "" {
+			continue
+		}
+		cc = append(cc, c)
+	}
+
+	return cc
+}
+
 func isNamespaced(scope string) bool {
 	return scope == "Namespaced"
 }
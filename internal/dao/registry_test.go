@@ -27,6 +27,18 @@ func TestExtractMeta(t *testing.T) {
 	assert.Equal(t, vv, m.Verbs)
 }
 
+func TestExtractPrinterColumns(t *testing.T) {
+	cc := extractPrinterColumns(load(t, "dr"), "v1alpha3")
+
+	assert.Equal(t, 2, len(cc))
+	assert.Equal(t, "Host", cc[0].Name)
+	assert.Equal(t, "string", cc[0].Type)
+	assert.Equal(t, ".spec.host", cc[0].JSONPath)
+	assert.Equal(t, "Age", cc[1].Name)
+	assert.Equal(t, "date", cc[1].Type)
+	assert.Equal(t, 0, len(extractPrinterColumns(load(t, "dr"), "v1")))
+}
+
 func TestExtractSlice(t *testing.T) {
 	uu := map[string]struct {
 		m  map[string]interface{}
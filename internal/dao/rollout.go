@@ -0,0 +1,167 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	podTemplateHashLabel   = "pod-template-hash"
+	progressDeadlineReason = "ProgressDeadlineExceeded"
+	rolloutWaitGeneration  = "Waiting for deployment spec update to be observed..."
+)
+
+// RolloutReplicaSet represents a replicaset taking part in a rollout.
+type RolloutReplicaSet struct {
+	Name                    string
+	Desired, Current, Ready int32
+}
+
+// String returns the replicaset pod counts.
+func (r RolloutReplicaSet) String() string {
+	return fmt.Sprintf("%s %d/%d ready (%d desired)", r.Name, r.Ready, r.Current, r.Desired)
+}
+
+// RolloutStatus represents a deployment rollout progress.
+type RolloutStatus struct {
+	Desired, Updated, Ready, Available, Unavailable int32
+
+	// NewRS is the replicaset of the current revision if created yet.
+	NewRS *RolloutReplicaSet
+	// OldRS lists the previous revisions still running pods.
+	OldRS []RolloutReplicaSet
+	// Selector selects the pods of the current revision.
+	Selector string
+	// Workload selects all the deployment pods regardless of revision.
+	Workload string
+	// Message is the deployment progressing condition message.
+	Message string
+
+	Complete, Failed bool
+}
+
+// DeploymentRollout computes a deployment rollout progress from the informer
+// cache.
+func DeploymentRollout(f Factory, path string) (*RolloutStatus, error) {
+	o, err := f.Get("apps/v1/deployments", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var dp appsv1.Deployment
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &dp)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, _ := client.Namespaced(path)
+	oo, err := f.List("apps/v1/replicasets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rss := make([]appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		var rs appsv1.ReplicaSet
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rs)
+		if err != nil {
+			return nil, err
+		}
+		rss = append(rss, rs)
+	}
+
+	return rolloutStatus(dp, rss)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rolloutStatus mirrors kubectl rollout status using the deployment and the
+// replicasets it controls.
+func rolloutStatus(dp appsv1.Deployment, rss []appsv1.ReplicaSet) (*RolloutStatus, error) {
+	s := RolloutStatus{
+		Desired:     desiredDeployReplicas(dp),
+		Updated:     dp.Status.UpdatedReplicas,
+		Ready:       dp.Status.ReadyReplicas,
+		Available:   dp.Status.AvailableReplicas,
+		Unavailable: dp.Status.UnavailableReplicas,
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(dp.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	s.Workload = sel.String()
+	for _, rs := range rss {
+		if ref := metav1.GetControllerOf(&rs); ref == nil || ref.UID != dp.UID {
+			continue
+		}
+		r := RolloutReplicaSet{
+			Name:    rs.Name,
+			Desired: desiredReplicas(rs),
+			Current: rs.Status.Replicas,
+			Ready:   rs.Status.ReadyReplicas,
+		}
+		if isCurrentRevision(rs, dp) {
+			s.NewRS = &r
+			if h, ok := rs.Labels[podTemplateHashLabel]; ok {
+				req, err := labels.NewRequirement(podTemplateHashLabel, "=", []string{h})
+				if err != nil {
+					return nil, err
+				}
+				sel = sel.Add(*req)
+			}
+			continue
+		}
+		if r.Current > 0 {
+			s.OldRS = append(s.OldRS, r)
+		}
+	}
+	sort.Slice(s.OldRS, func(i, j int) bool {
+		return s.OldRS[i].Name < s.OldRS[j].Name
+	})
+	s.Selector = sel.String()
+
+	for _, c := range dp.Status.Conditions {
+		if c.Type != appsv1.DeploymentProgressing {
+			continue
+		}
+		s.Message = c.Message
+		s.Failed = c.Reason == progressDeadlineReason
+	}
+	s.Complete = !s.Failed && rolloutComplete(dp)
+	if dp.Generation > dp.Status.ObservedGeneration {
+		s.Message = rolloutWaitGeneration
+	}
+
+	return &s, nil
+}
+
+func rolloutComplete(dp appsv1.Deployment) bool {
+	st := dp.Status
+	switch {
+	case dp.Generation > st.ObservedGeneration:
+		return false
+	case st.UpdatedReplicas < desiredDeployReplicas(dp):
+		return false
+	case st.Replicas > st.UpdatedReplicas:
+		return false
+	case st.AvailableReplicas < st.UpdatedReplicas:
+		return false
+	default:
+		return true
+	}
+}
+
+func desiredDeployReplicas(dp appsv1.Deployment) int32 {
+	if dp.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *dp.Spec.Replicas
+}
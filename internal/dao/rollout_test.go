@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRolloutStatus(t *testing.T) {
+	uu := map[string]struct {
+		dp       appsv1.Deployment
+		rss      []appsv1.ReplicaSet
+		newRS    string
+		old      []string
+		sel      string
+		msg      string
+		complete bool
+		failed   bool
+	}{
+		"complete": {
+			dp: makeRolloutDP(3, appsv1.DeploymentStatus{
+				Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3,
+			}, "NewReplicaSetAvailable", "rs3 has successfully progressed."),
+			rss:      makeRolloutRSS(0, 0, 3),
+			newRS:    "rs3",
+			sel:      "app=dp1,pod-template-hash=rs3",
+			msg:      "rs3 has successfully progressed.",
+			complete: true,
+		},
+		"progressing": {
+			dp: makeRolloutDP(3, appsv1.DeploymentStatus{
+				Replicas: 4, UpdatedReplicas: 1, ReadyReplicas: 3, AvailableReplicas: 3, UnavailableReplicas: 1,
+			}, "ReplicaSetUpdated", "rs3 is progressing."),
+			rss:   makeRolloutRSS(0, 3, 1),
+			newRS: "rs3",
+			old:   []string{"rs2"},
+			sel:   "app=dp1,pod-template-hash=rs3",
+			msg:   "rs3 is progressing.",
+		},
+		"deadline": {
+			dp: makeRolloutDP(3, appsv1.DeploymentStatus{
+				Replicas: 4, UpdatedReplicas: 1, ReadyReplicas: 3, AvailableReplicas: 3, UnavailableReplicas: 1,
+			}, progressDeadlineReason, "rs3 has timed out progressing."),
+			rss:    makeRolloutRSS(1, 3, 1),
+			newRS:  "rs3",
+			old:    []string{"rs1", "rs2"},
+			sel:    "app=dp1,pod-template-hash=rs3",
+			msg:    "rs3 has timed out progressing.",
+			failed: true,
+		},
+		"noNewRS": {
+			dp: func() appsv1.Deployment {
+				dp := makeRolloutDP(3, appsv1.DeploymentStatus{
+					Replicas: 3, UpdatedReplicas: 0, ReadyReplicas: 3, AvailableReplicas: 3,
+				}, "", "")
+				dp.Generation = 2
+				return dp
+			}(),
+			rss: makeRolloutRSS(0, 3, 0)[:2],
+			old: []string{"rs2"},
+			sel: "app=dp1",
+			msg: rolloutWaitGeneration,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := rolloutStatus(u.dp, u.rss)
+			assert.Nil(t, err)
+			if u.newRS == "" {
+				assert.Nil(t, s.NewRS)
+			} else {
+				assert.Equal(t, u.newRS, s.NewRS.Name)
+			}
+			old := make([]string, 0, len(s.OldRS))
+			for _, rs := range s.OldRS {
+				old = append(old, rs.Name)
+			}
+			assert.Equal(t, len(u.old), len(old))
+			for i := range u.old {
+				assert.Equal(t, u.old[i], old[i])
+			}
+			assert.Equal(t, u.sel, s.Selector)
+			assert.Equal(t, "app=dp1", s.Workload)
+			assert.Equal(t, u.msg, s.Message)
+			assert.Equal(t, u.complete, s.Complete)
+			assert.Equal(t, u.failed, s.Failed)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeRolloutDP(replicas int32, st appsv1.DeploymentStatus, reason, msg string) appsv1.Deployment {
+	dp := makeAuditDP("dp1", 3, 10)
+	dp.Generation, st.ObservedGeneration = 1, 1
+	dp.Spec.Replicas = &replicas
+	if reason != "" {
+		st.Conditions = []appsv1.DeploymentCondition{
+			{Type: appsv1.DeploymentAvailable, Status: v1.ConditionTrue, Message: "Deployment has minimum availability."},
+			{Type: appsv1.DeploymentProgressing, Status: v1.ConditionTrue, Reason: reason, Message: msg},
+		}
+	}
+	dp.Status = st
+
+	return dp
+}
+
+// makeRolloutRSS returns revisions 1 to 3 of dp1 with the given pod counts.
+func makeRolloutRSS(counts ...int32) []appsv1.ReplicaSet {
+	dp := makeAuditDP("dp1", 3, 10)
+	rss := make([]appsv1.ReplicaSet, 0, len(counts))
+	for i, c := range counts {
+		rs := makeAuditRS("rs"+strconv.Itoa(i+1), int64(i+1), c, &dp)
+		rs.Labels = map[string]string{"app": "dp1", podTemplateHashLabel: rs.Name}
+		rss = append(rss, rs)
+	}
+
+	return rss
+}
@@ -0,0 +1,194 @@
+package dao
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	// defaultHistoryLimit matches the apps/v1 deployment default.
+	defaultHistoryLimit = 10
+)
+
+// ReplicaSetAudit flags replicasets left behind by their deployments.
+// Replicasets and deployments are listed once from the informer cache per
+// instance.
+type ReplicaSetAudit struct {
+	states map[types.UID]string
+}
+
+// NewReplicaSetAudit evaluates the replicasets in a namespace against their
+// deployments.
+func NewReplicaSetAudit(f Factory, ns string) (*ReplicaSetAudit, error) {
+	oo, err := f.List("apps/v1/replicasets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rss := make([]appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		var rs appsv1.ReplicaSet
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rs)
+		if err != nil {
+			return nil, err
+		}
+		rss = append(rss, rs)
+	}
+
+	oo, err = f.List("apps/v1/deployments", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	dps := make([]appsv1.Deployment, 0, len(oo))
+	for _, o := range oo {
+		var dp appsv1.Deployment
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &dp)
+		if err != nil {
+			return nil, err
+		}
+		dps = append(dps, dp)
+	}
+
+	return &ReplicaSetAudit{states: auditReplicaSets(rss, dps)}, nil
+}
+
+// State returns a replicaset audit state or blank if it is accounted for.
+func (a *ReplicaSetAudit) State(u *unstructured.Unstructured) string {
+	return a.states[u.GetUID()]
+}
+
+// ReplicaSetState returns the audit state of a given replicaset.
+func ReplicaSetState(f Factory, path string) (string, error) {
+	o, err := f.Get("apps/v1/replicasets", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	ns, _ := client.Namespaced(path)
+	a, err := NewReplicaSetAudit(f, ns)
+	if err != nil {
+		return "", err
+	}
+
+	return a.State(o.(*unstructured.Unstructured)), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// auditReplicaSets flags orphans ie replicasets wanting pods without an owning
+// deployment and stale ones ie scaled down revisions past their deployment
+// history limit. Unowned replicasets matching a deployment selector are about
+// to be adopted and replicasets controlled by other kinds are left alone.
+func auditReplicaSets(rss []appsv1.ReplicaSet, dps []appsv1.Deployment) map[types.UID]string {
+	owners := make(map[types.UID]appsv1.Deployment, len(dps))
+	for _, dp := range dps {
+		owners[dp.UID] = dp
+	}
+
+	states := make(map[types.UID]string)
+	history := make(map[types.UID][]appsv1.ReplicaSet)
+	for _, rs := range rss {
+		ref := metav1.GetControllerOf(&rs)
+		switch {
+		case ref == nil:
+			if desiredReplicas(rs) > 0 && !adoptable(rs, dps) {
+				states[rs.UID] = render.RSOrphan
+			}
+		case ref.Kind != "Deployment":
+		default:
+			dp, ok := owners[ref.UID]
+			if !ok {
+				states[rs.UID] = orphanState(rs)
+				continue
+			}
+			if desiredReplicas(rs) == 0 && rs.Status.Replicas == 0 && !isCurrentRevision(rs, dp) {
+				history[dp.UID] = append(history[dp.UID], rs)
+			}
+		}
+	}
+
+	for uid, hh := range history {
+		limit := historyLimit(owners[uid])
+		if len(hh) <= limit {
+			continue
+		}
+		sort.Slice(hh, func(i, j int) bool {
+			ri, rj := revision(hh[i]), revision(hh[j])
+			if ri == rj {
+				return hh[i].CreationTimestamp.Before(&hh[j].CreationTimestamp)
+			}
+			return ri < rj
+		})
+		for _, rs := range hh[:len(hh)-limit] {
+			states[rs.UID] = render.RSStale
+		}
+	}
+
+	return states
+}
+
+// orphanState flags a replicaset whose deployment is gone. Scaled down ones
+// no longer serve any rollback.
+func orphanState(rs appsv1.ReplicaSet) string {
+	if desiredReplicas(rs) > 0 {
+		return render.RSOrphan
+	}
+
+	return render.RSStale
+}
+
+func adoptable(rs appsv1.ReplicaSet, dps []appsv1.Deployment) bool {
+	for _, dp := range dps {
+		if dp.Namespace != rs.Namespace || dp.DeletionTimestamp != nil || dp.Spec.Selector == nil {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(dp.Spec.Selector)
+		if err != nil || sel.Empty() {
+			continue
+		}
+		if sel.Matches(labels.Set(rs.Labels)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isCurrentRevision(rs appsv1.ReplicaSet, dp appsv1.Deployment) bool {
+	rev, ok := dp.Annotations[revisionAnnotation]
+	return ok && rev == rs.Annotations[revisionAnnotation]
+}
+
+func historyLimit(dp appsv1.Deployment) int {
+	if dp.Spec.RevisionHistoryLimit == nil {
+		return defaultHistoryLimit
+	}
+
+	return int(*dp.Spec.RevisionHistoryLimit)
+}
+
+func desiredReplicas(rs appsv1.ReplicaSet) int32 {
+	if rs.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *rs.Spec.Replicas
+}
+
+func revision(rs appsv1.ReplicaSet) int64 {
+	rev, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return rev
+}
@@ -0,0 +1,190 @@
+package dao
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAuditReplicaSets(t *testing.T) {
+	dp := makeAuditDP("dp1", 3, 2)
+
+	uu := map[string]struct {
+		rss []appsv1.ReplicaSet
+		dps []appsv1.Deployment
+		e   map[types.UID]string
+	}{
+		"none": {
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{},
+		},
+		"withinHistory": {
+			rss: []appsv1.ReplicaSet{
+				makeAuditRS("rs1", 1, 0, &dp),
+				makeAuditRS("rs2", 2, 0, &dp),
+				makeAuditRS("rs3", 3, 2, &dp),
+			},
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{},
+		},
+		"pastHistory": {
+			rss: []appsv1.ReplicaSet{
+				makeAuditRS("rs4", 4, 0, &dp),
+				makeAuditRS("rs1", 1, 0, &dp),
+				makeAuditRS("rs3", 3, 2, &dp),
+				makeAuditRS("rs2", 2, 0, &dp),
+				makeAuditRS("rs0", 0, 0, &dp),
+			},
+			dps: []appsv1.Deployment{makeAuditDP("dp1", 3, 2)},
+			e: map[types.UID]string{
+				"rs0": render.RSStale,
+				"rs1": render.RSStale,
+			},
+		},
+		"scaledDownCurrent": {
+			rss: []appsv1.ReplicaSet{
+				makeAuditRS("rs1", 1, 0, &dp),
+				makeAuditRS("rs2", 2, 0, &dp),
+				makeAuditRS("rs3", 3, 0, &dp),
+			},
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{},
+		},
+		"rollingOut": {
+			rss: []appsv1.ReplicaSet{
+				makeAuditRS("rs1", 1, 0, &dp),
+				makeAuditRS("rs2", 2, 1, &dp),
+				makeAuditRS("rs3", 3, 1, &dp),
+			},
+			dps: []appsv1.Deployment{makeAuditDP("dp1", 3, 0)},
+			e:   map[types.UID]string{"rs1": render.RSStale},
+		},
+		"defaultLimit": {
+			rss: func() []appsv1.ReplicaSet {
+				rr := []appsv1.ReplicaSet{makeAuditRS("rs12", 12, 2, &dp)}
+				for i := 0; i < 11; i++ {
+					rr = append(rr, makeAuditRS("rs"+strconv.Itoa(i), int64(i), 0, &dp))
+				}
+				return rr
+			}(),
+			dps: []appsv1.Deployment{func() appsv1.Deployment {
+				d := makeAuditDP("dp1", 12, 0)
+				d.Spec.RevisionHistoryLimit = nil
+				return d
+			}()},
+			e: map[types.UID]string{"rs0": render.RSStale},
+		},
+		"deletedDeployment": {
+			rss: []appsv1.ReplicaSet{
+				makeAuditRS("rs1", 1, 0, &dp),
+				makeAuditRS("rs2", 2, 3, &dp),
+			},
+			e: map[types.UID]string{
+				"rs1": render.RSStale,
+				"rs2": render.RSOrphan,
+			},
+		},
+		"unowned": {
+			rss: []appsv1.ReplicaSet{
+				makeAuditRS("rs1", 0, 2, nil),
+				makeAuditRS("rs2", 0, 0, nil),
+			},
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{"rs1": render.RSOrphan},
+		},
+		"pendingAdoption": {
+			rss: []appsv1.ReplicaSet{
+				func() appsv1.ReplicaSet {
+					rs := makeAuditRS("rs1", 0, 2, nil)
+					rs.Labels = map[string]string{"app": "dp1", "pod-template-hash": "abc"}
+					return rs
+				}(),
+			},
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{},
+		},
+		"adoptionOtherNS": {
+			rss: []appsv1.ReplicaSet{
+				func() appsv1.ReplicaSet {
+					rs := makeAuditRS("rs1", 0, 2, nil)
+					rs.Namespace = "fred"
+					rs.Labels = map[string]string{"app": "dp1"}
+					return rs
+				}(),
+			},
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{"rs1": render.RSOrphan},
+		},
+		"adopted": {
+			rss: []appsv1.ReplicaSet{
+				func() appsv1.ReplicaSet {
+					rs := makeAuditRS("rs1", 0, 2, &dp)
+					delete(rs.Annotations, revisionAnnotation)
+					return rs
+				}(),
+			},
+			dps: []appsv1.Deployment{dp},
+			e:   map[types.UID]string{},
+		},
+		"otherController": {
+			rss: []appsv1.ReplicaSet{
+				func() appsv1.ReplicaSet {
+					rs := makeAuditRS("rs1", 1, 2, &dp)
+					rs.OwnerReferences[0].Kind = "Rollout"
+					return rs
+				}(),
+			},
+			e: map[types.UID]string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, auditReplicaSets(u.rss, u.dps))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeAuditDP(n string, rev int64, limit int32) appsv1.Deployment {
+	return appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        n,
+			Namespace:   "default",
+			UID:         types.UID(n),
+			Annotations: map[string]string{revisionAnnotation: strconv.FormatInt(rev, 10)},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": n}},
+			RevisionHistoryLimit: &limit,
+		},
+	}
+}
+
+func makeAuditRS(n string, rev int64, replicas int32, owner *appsv1.Deployment) appsv1.ReplicaSet {
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        n,
+			Namespace:   "default",
+			UID:         types.UID(n),
+			Annotations: map[string]string{revisionAnnotation: strconv.FormatInt(rev, 10)},
+		},
+		Spec:   appsv1.ReplicaSetSpec{Replicas: &replicas},
+		Status: appsv1.ReplicaSetStatus{Replicas: replicas},
+	}
+	if owner != nil {
+		rs.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(owner, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+		}
+	}
+
+	return rs
+}
@@ -0,0 +1,251 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	noneValue       = "<none>"
+	failedScheduled = "FailedScheduling"
+)
+
+// SchedulingReport summarizes a pod scheduling constraints.
+func SchedulingReport(f Factory, path string) (string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return "", err
+	}
+
+	var ev *v1.Event
+	if po.Status.Phase == v1.PodPending {
+		if ev, err = lastFailedScheduling(f, po.Namespace, po.Name); err != nil {
+			return "", err
+		}
+	}
+
+	return schedulingReport(po.Spec, ev), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func schedulingReport(spec v1.PodSpec, ev *v1.Event) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "node: %s\n", orNone(spec.NodeName))
+	fmt.Fprintf(&b, "nodeSelector: %s\n", orNone(mapToStr(spec.NodeSelector)))
+	writeList(&b, "tolerations", tolerations(spec.Tolerations), "")
+	writeAffinity(&b, spec.Affinity)
+	writeList(&b, "topologySpreadConstraints", spreads(spec.TopologySpreadConstraints), "")
+	if ev != nil {
+		fmt.Fprintf(&b, "lastSchedulingFailure: %q\n", strings.TrimSpace(ev.Message))
+	}
+
+	return b.String()
+}
+
+func writeAffinity(b *strings.Builder, a *v1.Affinity) {
+	if a == nil || (a.NodeAffinity == nil && a.PodAffinity == nil && a.PodAntiAffinity == nil) {
+		fmt.Fprintf(b, "affinity: %s\n", noneValue)
+		return
+	}
+
+	fmt.Fprintln(b, "affinity:")
+	if na := a.NodeAffinity; na != nil {
+		fmt.Fprintln(b, "  nodeAffinity:")
+		var req []string
+		if na.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			for _, t := range na.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				req = append(req, nodeTerm(t))
+			}
+		}
+		writeList(b, "required", req, "    ")
+		pref := make([]string, 0, len(na.PreferredDuringSchedulingIgnoredDuringExecution))
+		for _, t := range na.PreferredDuringSchedulingIgnoredDuringExecution {
+			pref = append(pref, fmt.Sprintf("weight %d: %s", t.Weight, nodeTerm(t.Preference)))
+		}
+		writeList(b, "preferred", pref, "    ")
+	}
+	if pa := a.PodAffinity; pa != nil {
+		fmt.Fprintln(b, "  podAffinity:")
+		writePodTerms(b, pa.RequiredDuringSchedulingIgnoredDuringExecution, pa.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if pa := a.PodAntiAffinity; pa != nil {
+		fmt.Fprintln(b, "  podAntiAffinity:")
+		writePodTerms(b, pa.RequiredDuringSchedulingIgnoredDuringExecution, pa.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+}
+
+func writePodTerms(b *strings.Builder, rr []v1.PodAffinityTerm, pp []v1.WeightedPodAffinityTerm) {
+	req := make([]string, 0, len(rr))
+	for _, t := range rr {
+		req = append(req, podTerm(t))
+	}
+	writeList(b, "required", req, "    ")
+
+	pref := make([]string, 0, len(pp))
+	for _, t := range pp {
+		pref = append(pref, fmt.Sprintf("weight %d: %s", t.Weight, podTerm(t.PodAffinityTerm)))
+	}
+	writeList(b, "preferred", pref, "    ")
+}
+
+func writeList(b *strings.Builder, title string, ss []string, indent string) {
+	if len(ss) == 0 {
+		fmt.Fprintf(b, "%s%s: %s\n", indent, title, noneValue)
+		return
+	}
+	fmt.Fprintf(b, "%s%s:\n", indent, title)
+	for _, s := range ss {
+		fmt.Fprintf(b, "%s  - %s\n", indent, s)
+	}
+}
+
+func nodeTerm(t v1.NodeSelectorTerm) string {
+	ss := make([]string, 0, len(t.MatchExpressions)+len(t.MatchFields))
+	for _, r := range t.MatchExpressions {
+		ss = append(ss, selectorExpr(r.Key, string(r.Operator), r.Values))
+	}
+	for _, r := range t.MatchFields {
+		ss = append(ss, selectorExpr(r.Key, string(r.Operator), r.Values))
+	}
+	if len(ss) == 0 {
+		return noneValue
+	}
+
+	return strings.Join(ss, " && ")
+}
+
+func podTerm(t v1.PodAffinityTerm) string {
+	s := labelSelector(t.LabelSelector)
+	if len(t.Namespaces) > 0 {
+		s += " in " + strings.Join(t.Namespaces, ",")
+	}
+
+	return s + " @ " + t.TopologyKey
+}
+
+func labelSelector(sel *metav1.LabelSelector) string {
+	if sel == nil {
+		return noneValue
+	}
+
+	kk := make([]string, 0, len(sel.MatchLabels))
+	for k := range sel.MatchLabels {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ss := make([]string, 0, len(kk)+len(sel.MatchExpressions))
+	for _, k := range kk {
+		ss = append(ss, selectorExpr(k, string(metav1.LabelSelectorOpIn), []string{sel.MatchLabels[k]}))
+	}
+	for _, r := range sel.MatchExpressions {
+		ss = append(ss, selectorExpr(r.Key, string(r.Operator), r.Values))
+	}
+	if len(ss) == 0 {
+		return "<all>"
+	}
+
+	return strings.Join(ss, " && ")
+}
+
+func selectorExpr(k, op string, vv []string) string {
+	if len(vv) == 0 {
+		return k + " " + op
+	}
+
+	return fmt.Sprintf("%s %s [%s]", k, op, strings.Join(vv, ", "))
+}
+
+func tolerations(tt []v1.Toleration) []string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		s := t.Key
+		if t.Key == "" {
+			s = "*"
+		}
+		if t.Value != "" {
+			s += "=" + t.Value
+		}
+		if t.Effect != "" {
+			s += ":" + string(t.Effect)
+		}
+		if t.Operator != "" {
+			s += " (" + string(t.Operator) + ")"
+		}
+		if t.TolerationSeconds != nil {
+			s += fmt.Sprintf(" for %ds", *t.TolerationSeconds)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+func spreads(cc []v1.TopologySpreadConstraint) []string {
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		ss = append(ss, fmt.Sprintf("maxSkew %d @ %s (%s): %s", c.MaxSkew, c.TopologyKey, c.WhenUnsatisfiable, labelSelector(c.LabelSelector)))
+	}
+
+	return ss
+}
+
+func mapToStr(m map[string]string) string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, k+"="+m[k])
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return noneValue
+	}
+	return s
+}
+
+func lastFailedScheduling(f Factory, ns, po string) (*v1.Event, error) {
+	oo, err := f.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *v1.Event
+	for _, o := range oo {
+		var e v1.Event
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &e)
+		if err != nil {
+			return nil, err
+		}
+		if e.Reason != failedScheduled || e.InvolvedObject.Kind != "Pod" || e.InvolvedObject.Name != po {
+			continue
+		}
+		if last == nil || e.LastTimestamp.After(last.LastTimestamp.Time) {
+			ev := e
+			last = &ev
+		}
+	}
+
+	return last, nil
+}
@@ -0,0 +1,132 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSchedulingReport(t *testing.T) {
+	secs := int64(300)
+	uu := map[string]struct {
+		spec v1.PodSpec
+		ev   *v1.Event
+		e    string
+	}{
+		"empty": {
+			e: `node: <none>
+nodeSelector: <none>
+tolerations: <none>
+affinity: <none>
+topologySpreadConstraints: <none>
+`,
+		},
+		"required": {
+			spec: v1.PodSpec{
+				NodeSelector: map[string]string{"disk": "ssd", "arch": "amd64"},
+				Affinity: &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+							NodeSelectorTerms: []v1.NodeSelectorTerm{
+								{MatchExpressions: []v1.NodeSelectorRequirement{
+									{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"a", "b"}},
+									{Key: "gpu", Operator: v1.NodeSelectorOpExists},
+								}},
+							},
+						},
+					},
+					PodAntiAffinity: &v1.PodAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+								TopologyKey:   "kubernetes.io/hostname",
+							},
+						},
+					},
+				},
+			},
+			ev: &v1.Event{Message: "0/3 nodes are available: 3 node(s) didn't match node selector."},
+			e: `node: <none>
+nodeSelector: arch=amd64,disk=ssd
+tolerations: <none>
+affinity:
+  nodeAffinity:
+    required:
+      - zone In [a, b] && gpu Exists
+    preferred: <none>
+  podAntiAffinity:
+    required:
+      - app In [web] @ kubernetes.io/hostname
+    preferred: <none>
+topologySpreadConstraints: <none>
+lastSchedulingFailure: "0/3 nodes are available: 3 node(s) didn't match node selector."
+`,
+		},
+		"preferred": {
+			spec: v1.PodSpec{
+				NodeName: "n1",
+				Tolerations: []v1.Toleration{
+					{Key: "node.kubernetes.io/not-ready", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute, TolerationSeconds: &secs},
+					{Operator: v1.TolerationOpExists},
+				},
+				Affinity: &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{
+							{Weight: 10, Preference: v1.NodeSelectorTerm{
+								MatchExpressions: []v1.NodeSelectorRequirement{
+									{Key: "zone", Operator: v1.NodeSelectorOpNotIn, Values: []string{"c"}},
+								},
+							}},
+						},
+					},
+					PodAffinity: &v1.PodAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{
+							{Weight: 100, PodAffinityTerm: v1.PodAffinityTerm{
+								LabelSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"cache"}},
+									},
+								},
+								TopologyKey: "zone",
+							}},
+						},
+					},
+				},
+				TopologySpreadConstraints: []v1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "zone",
+						WhenUnsatisfiable: v1.DoNotSchedule,
+						LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					},
+				},
+			},
+			e: `node: n1
+nodeSelector: <none>
+tolerations:
+  - node.kubernetes.io/not-ready:NoExecute (Exists) for 300s
+  - * (Exists)
+affinity:
+  nodeAffinity:
+    required: <none>
+    preferred:
+      - weight 10: zone NotIn [c]
+  podAffinity:
+    required: <none>
+    preferred:
+      - weight 100: tier In [cache] @ zone
+topologySpreadConstraints:
+  - maxSkew 1 @ zone (DoNotSchedule): app In [web]
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, schedulingReport(u.spec, u.ev))
+		})
+	}
+}
@@ -36,7 +36,7 @@ type Factory interface {
 	DeleteForwarder(path string)
 
 	// Forwards returns all portforwards.
-	Forwarders() watch.Forwarders
+	Forwarders() *watch.Forwarders
 }
 
 // Accessor represents an accessible k8s resource.
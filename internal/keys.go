@@ -5,24 +5,36 @@ type ContextKey string
 
 // A collection of context keys.
 const (
-	KeyFactory     ContextKey = "factory"
-	KeyLabels      ContextKey = "labels"
-	KeyFields      ContextKey = "fields"
-	KeyTable       ContextKey = "table"
-	KeyDir         ContextKey = "dir"
-	KeyPath        ContextKey = "path"
-	KeySubject     ContextKey = "subject"
-	KeyGVR         ContextKey = "gvr"
-	KeyForwards    ContextKey = "forwards"
-	KeyContainers  ContextKey = "containers"
-	KeyBenchCfg    ContextKey = "benchcfg"
-	KeyAliases     ContextKey = "aliases"
-	KeyUID         ContextKey = "uid"
-	KeySubjectKind ContextKey = "subjectKind"
-	KeySubjectName ContextKey = "subjectName"
-	KeyNamespace   ContextKey = "namespace"
-	KeyCluster     ContextKey = "cluster"
-	KeyApp         ContextKey = "app"
-	KeyStyles      ContextKey = "styles"
-	KeyMetrics     ContextKey = "metrics"
+	KeyFactory         ContextKey = "factory"
+	KeyLabels          ContextKey = "labels"
+	KeyFields          ContextKey = "fields"
+	KeyTable           ContextKey = "table"
+	KeyDir             ContextKey = "dir"
+	KeyPath            ContextKey = "path"
+	KeySubject         ContextKey = "subject"
+	KeyGVR             ContextKey = "gvr"
+	KeyForwards        ContextKey = "forwards"
+	KeyContainers      ContextKey = "containers"
+	KeyBenchCfg        ContextKey = "benchcfg"
+	KeyBenchHistory    ContextKey = "benchHistory"
+	KeyAliases         ContextKey = "aliases"
+	KeyAccess          ContextKey = "access"
+	KeyUID             ContextKey = "uid"
+	KeySubjectKind     ContextKey = "subjectKind"
+	KeySubjectName     ContextKey = "subjectName"
+	KeyNamespace       ContextKey = "namespace"
+	KeyCluster         ContextKey = "cluster"
+	KeyApp             ContextKey = "app"
+	KeyStyles          ContextKey = "styles"
+	KeyMetrics         ContextKey = "metrics"
+	KeyDecode          ContextKey = "decode"
+	KeyEvents          ContextKey = "events"
+	KeyPins            ContextKey = "pins"
+	KeySnapshot        ContextKey = "snapshot"
+	KeyForeach         ContextKey = "foreach"
+	KeyPodHistory      ContextKey = "podHistory"
+	KeyProblemRestarts ContextKey = "problemRestarts"
+	KeyEvicted         ContextKey = "evicted"
+	KeyOrphans         ContextKey = "orphans"
+	KeyVersion         ContextKey = "version"
 )
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
@@ -33,11 +34,31 @@ func (b *Alias) List(ctx context.Context) ([]runtime.Object, error) {
 		}
 	}
 
+	access, _ := ctx.Value(internal.KeyAccess).(*dao.Access)
+	ns, _ := ctx.Value(internal.KeyNamespace).(string)
 	oo := make([]runtime.Object, 0, len(m))
 	for gvr, aliases := range m {
 		sort.StringSlice(aliases).Sort()
-		oo = append(oo, render.AliasRes{GVR: gvr, Aliases: aliases})
+		oo = append(oo, render.AliasRes{GVR: gvr, Aliases: aliases, Denied: isDenied(access, ns, gvr)})
 	}
 
 	return oo, nil
 }
+
+// isDenied checks if a resource is known to be off limits. Permissions are
+// checked lazily hence resources never opened are assumed accessible.
+func isDenied(access *dao.Access, ns, gvr string) bool {
+	if access == nil {
+		return false
+	}
+	meta, err := dao.MetaFor(client.NewGVR(gvr))
+	if err != nil || dao.IsK9sMeta(meta) {
+		return false
+	}
+	if !meta.Namespaced {
+		ns = client.AllNamespaces
+	}
+	ok, known := access.Known(ns, gvr, dao.ListVerbs)
+
+	return known && !ok
+}
@@ -78,8 +78,8 @@ func (f testFactory) CanForResource(ns, gvr string, verbs []string) (informers.G
 	return nil, nil
 }
 func (f testFactory) WaitForCacheSync() {}
-func (f testFactory) Forwarders() watch.Forwarders {
-	return nil
+func (f testFactory) Forwarders() *watch.Forwarders {
+	return watch.NewForwarders()
 }
 func (f testFactory) DeleteForwarder(string) {}
 
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Audit represents the current cluster action log model.
+type Audit struct {
+	Resource
+}
+
+// List returns the mutations performed through K9s on the current cluster.
+func (a *Audit) List(ctx context.Context) ([]runtime.Object, error) {
+	cluster, err := a.factory.Client().Config().CurrentClusterName()
+	if err != nil {
+		return nil, err
+	}
+	ee, err := dao.ReadAudit(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ee))
+	for i, e := range ee {
+		oo = append(oo, render.AuditRes{
+			Index:   len(ee) - i,
+			Time:    e.Time,
+			Context: e.Context,
+			User:    e.User,
+			Action:  e.Action,
+			Target:  e.Target,
+			Outcome: e.Outcome,
+		})
+	}
+
+	return oo, nil
+}
@@ -42,7 +42,7 @@ func (c *Container) List(ctx context.Context) ([]runtime.Object, error) {
 		return nil, err
 	}
 	c.pod = &po
-	res := make([]runtime.Object, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	res := make([]runtime.Object, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers)+len(po.Spec.EphemeralContainers))
 	mx := client.NewMetricsServer(c.factory.Client())
 	var pmx *mv1beta1.PodMetrics
 	if c.factory.Client() != nil {
@@ -53,11 +53,23 @@ func (c *Container) List(ctx context.Context) ([]runtime.Object, error) {
 		}
 	}
 
+	names := make(map[string]struct{}, len(po.Spec.Containers)+len(po.Spec.EphemeralContainers))
+	for _, co := range po.Spec.Containers {
+		names[co.Name] = struct{}{}
+	}
+	for _, co := range po.Spec.EphemeralContainers {
+		names[co.Name] = struct{}{}
+	}
 	for _, co := range po.Spec.InitContainers {
-		res = append(res, makeContainerRes(co, po, pmx, true))
+		cres := makeContainerRes(co, po, pmx, render.InitContainer)
+		_, cres.Shadowed = names[co.Name]
+		res = append(res, cres)
 	}
 	for _, co := range po.Spec.Containers {
-		res = append(res, makeContainerRes(co, po, pmx, false))
+		res = append(res, makeContainerRes(co, po, pmx, render.RegularContainer))
+	}
+	for _, co := range po.Spec.EphemeralContainers {
+		res = append(res, makeContainerRes(v1.Container(co.EphemeralContainerCommon), po, pmx, render.EphemeralContainer))
 	}
 
 	return res, nil
@@ -66,19 +78,26 @@ func (c *Container) List(ctx context.Context) ([]runtime.Object, error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func makeContainerRes(co v1.Container, po v1.Pod, pmx *mv1beta1.PodMetrics, isInit bool) render.ContainerRes {
+// makeContainerRes joins a container with its status and metrics. Metrics
+// are reported by container name for running containers only so they are
+// only joined on measurable containers.
+func makeContainerRes(co v1.Container, po v1.Pod, pmx *mv1beta1.PodMetrics, t render.ContainerType) render.ContainerRes {
+	cres := render.ContainerRes{
+		Container: co,
+		Status:    getContainerStatus(co.Name, t, po.Status),
+		Type:      t,
+		Age:       po.ObjectMeta.CreationTimestamp,
+	}
+	if !cres.Measurable() {
+		return cres
+	}
 	cmx, err := containerMetrics(co.Name, pmx)
 	if err != nil {
 		log.Warn().Err(err).Msgf("Container metrics for %s", co.Name)
 	}
+	cres.Metrics = cmx
 
-	return render.ContainerRes{
-		Container: co,
-		Status:    getContainerStatus(co.Name, po.Status),
-		Metrics:   cmx,
-		IsInit:    isInit,
-		Age:       po.ObjectMeta.CreationTimestamp,
-	}
+	return cres
 }
 
 func containerMetrics(n string, mx runtime.Object) (*mv1beta1.ContainerMetrics, error) {
@@ -97,14 +116,15 @@ func containerMetrics(n string, mx runtime.Object) (*mv1beta1.ContainerMetrics,
 	return nil, nil
 }
 
-func getContainerStatus(co string, status v1.PodStatus) *v1.ContainerStatus {
-	for _, c := range status.ContainerStatuses {
-		if c.Name == co {
-			return &c
-		}
+func getContainerStatus(co string, t render.ContainerType, status v1.PodStatus) *v1.ContainerStatus {
+	ss := status.ContainerStatuses
+	switch t {
+	case render.InitContainer:
+		ss = status.InitContainerStatuses
+	case render.EphemeralContainer:
+		ss = status.EphemeralContainerStatuses
 	}
-
-	for _, c := range status.InitContainerStatuses {
+	for _, c := range ss {
 		if c.Name == co {
 			return &c
 		}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestMakeContainerRes(t *testing.T) {
+	po := makeSameNamePod()
+	pmx := &mv1beta1.PodMetrics{
+		Containers: []mv1beta1.ContainerMetrics{
+			{
+				Name: "fred",
+				Usage: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("10m"),
+					v1.ResourceMemory: resource.MustParse("20Mi"),
+				},
+			},
+			{
+				Name: "debug",
+				Usage: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("1m"),
+					v1.ResourceMemory: resource.MustParse("2Mi"),
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		co      v1.Container
+		t       render.ContainerType
+		state   string
+		metrics string
+	}{
+		"init": {
+			co:    po.Spec.InitContainers[0],
+			t:     render.InitContainer,
+			state: "Completed",
+		},
+		"regular": {
+			co:      po.Spec.Containers[0],
+			t:       render.RegularContainer,
+			state:   "running",
+			metrics: "fred",
+		},
+		"ephemeral": {
+			co:      v1.Container(po.Spec.EphemeralContainers[0].EphemeralContainerCommon),
+			t:       render.EphemeralContainer,
+			state:   "running",
+			metrics: "debug",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cres := makeContainerRes(u.co, po, pmx, u.t)
+			assert.Equal(t, u.t, cres.Type)
+			assert.NotNil(t, cres.Status)
+			if s := cres.Status.State.Terminated; s != nil {
+				assert.Equal(t, u.state, s.Reason)
+			} else {
+				assert.NotNil(t, cres.Status.State.Running)
+			}
+			if u.metrics == "" {
+				assert.Nil(t, cres.Metrics)
+				return
+			}
+			assert.Equal(t, u.metrics, cres.Metrics.Name)
+		})
+	}
+}
+
+func TestGetContainerStatus(t *testing.T) {
+	po := makeSameNamePod()
+
+	assert.NotNil(t, getContainerStatus("fred", render.InitContainer, po.Status).State.Terminated)
+	assert.NotNil(t, getContainerStatus("fred", render.RegularContainer, po.Status).State.Running)
+	assert.NotNil(t, getContainerStatus("debug", render.EphemeralContainer, po.Status).State.Running)
+	assert.Nil(t, getContainerStatus("debug", render.RegularContainer, po.Status))
+}
+
+// Helpers...
+
+func makeSameNamePod() v1.Pod {
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	return v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "fred", Image: "init"}},
+			Containers:     []v1.Container{{Name: "fred", Image: "app"}},
+			EphemeralContainers: []v1.EphemeralContainer{
+				{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debug", Image: "busybox"}},
+			},
+		},
+		Status: v1.PodStatus{
+			InitContainerStatuses: []v1.ContainerStatus{
+				{Name: "fred", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}}},
+			},
+			ContainerStatuses:          []v1.ContainerStatus{{Name: "fred", State: running}},
+			EphemeralContainerStatuses: []v1.ContainerStatus{{Name: "debug", State: running}},
+		},
+	}
+}
@@ -40,12 +40,100 @@ func TestContainerHydrate(t *testing.T) {
 	assert.Nil(t, c.Hydrate(oo, rr, render.Container{}))
 	assert.Equal(t, 1, len(rr))
 	assert.Equal(t, "fred", rr[0].ID)
-	assert.Equal(t, render.Fields{"fred", "blee", "false", "Running", "false", "0", "off:off", "n/a", "n/a", "n/a", "n/a", ""}, rr[0].Fields[0:len(rr[0].Fields)-1])
+	assert.Equal(t, render.Fields{"fred", "blee", "false", "Running", "false", "0", "", "", "", "off:off", "n/a", "n/a", "n/a", "n/a", ""}, rr[0].Fields[0:len(rr[0].Fields)-1])
+}
+
+func TestContainerHydrateSameNames(t *testing.T) {
+	c := model.Container{}
+	c.Init(render.ClusterScope, "containers", sameNamePodFactory{})
+
+	ctx := context.WithValue(context.Background(), internal.KeyPath, "blee/fred")
+	oo, err := c.List(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(oo))
+
+	rr := make(render.Rows, len(oo))
+	assert.Nil(t, c.Hydrate(oo, rr, render.Container{}))
+	ee := []struct {
+		id, image, state, init, cpu string
+	}{
+		{id: "init:fred", image: "init", state: "Completed", init: "true", cpu: "-"},
+		{id: "fred", image: "app", state: "Running", init: "false", cpu: "n/a"},
+		{id: "debug", image: "busybox", state: "Running", init: "false", cpu: "n/a"},
+	}
+	for i, e := range ee {
+		assert.Equal(t, e.id, rr[i].ID)
+		assert.Equal(t, e.image, rr[i].Fields[1])
+		assert.Equal(t, e.state, rr[i].Fields[3])
+		assert.Equal(t, e.init, rr[i].Fields[4])
+		assert.Equal(t, e.cpu, rr[i].Fields[10])
+	}
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+type sameNamePodFactory struct {
+	podFactory
+}
+
+func (f sameNamePodFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	var m map[string]interface{}
+	if err := yaml.Unmarshal([]byte(sameNamePoYaml()), &m); err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: m}, nil
+}
+
+func sameNamePoYaml() string {
+	return `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: blee
+spec:
+  initContainers:
+  - image: init
+    name: fred
+  containers:
+  - image: app
+    name: fred
+  ephemeralContainers:
+  - image: busybox
+    name: debug
+status:
+  initContainerStatuses:
+  - image: init
+    imageID: ""
+    name: fred
+    ready: true
+    restartCount: 0
+    state:
+      terminated:
+        exitCode: 0
+        reason: Completed
+  containerStatuses:
+  - image: app
+    imageID: ""
+    name: fred
+    ready: true
+    restartCount: 0
+    state:
+      running:
+        startedAt: null
+  ephemeralContainerStatuses:
+  - image: busybox
+    imageID: ""
+    name: debug
+    ready: false
+    restartCount: 0
+    state:
+      running:
+        startedAt: null
+  phase: Running
+`
+}
+
 type podFactory struct{}
 
 var _ dao.Factory = testFactory{}
@@ -67,9 +155,9 @@ func (f podFactory) ForResource(ns, gvr string) informers.GenericInformer { retu
 func (f podFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
 	return nil, nil
 }
-func (f podFactory) WaitForCacheSync()            {}
-func (f podFactory) Forwarders() watch.Forwarders { return nil }
-func (f podFactory) DeleteForwarder(string)       {}
+func (f podFactory) WaitForCacheSync()             {}
+func (f podFactory) Forwarders() *watch.Forwarders { return watch.NewForwarders() }
+func (f podFactory) DeleteForwarder(string)        {}
 
 func makePodFactory() dao.Factory {
 	return podFactory{}
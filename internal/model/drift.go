@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Drift represents a workload model tracking its pods image drift.
+type Drift struct {
+	Resource
+}
+
+// List returns a collection of workloads and their drift.
+func (d *Drift) List(ctx context.Context) ([]runtime.Object, error) {
+	oo, err := d.Resource.List(ctx)
+	if err != nil {
+		return oo, err
+	}
+
+	drift, err := dao.NewImageDrift(d.factory, d.namespace)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Image drift unavailable for %s", d.gvr)
+		return oo, nil
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		old, err := drift.OldPods(u)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &render.WorkloadWithDrift{Raw: u, OldPods: old})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// NodeCoverage represents a daemonset node coverage model.
+type NodeCoverage struct {
+	Resource
+}
+
+// List returns the daemonset pod status on every node.
+func (n *NodeCoverage) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", n.gvr)
+	}
+
+	cc, err := dao.DaemonSetCoverage(n.factory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, c := range cc {
+		oo = append(oo, render.NodeCoverageRes{
+			Node:      c.Node,
+			Pod:       c.Pod,
+			Scheduled: c.Scheduled,
+			Ready:     c.Ready,
+			Updated:   c.Updated,
+			Excluded:  c.Excluded,
+			Status:    c.Status(),
+		})
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,182 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	secretGVR = "v1/secrets"
+	cmGVR     = "v1/configmaps"
+)
+
+// EnvVar represents a container environment model.
+type EnvVar struct {
+	Resource
+}
+
+// List returns a collection of environment variables for a given container.
+func (e *EnvVar) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", e.gvr)
+	}
+	resolve, _ := ctx.Value(internal.KeyDecode).(bool)
+
+	tokens := strings.Split(path, ":")
+	if len(tokens) != 2 {
+		return nil, fmt.Errorf("expecting a pod:container path but got %q", path)
+	}
+	co, err := e.fetchContainer(tokens[0], tokens[1])
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(tokens[0])
+
+	oo := make([]runtime.Object, 0, len(co.Env))
+	for _, src := range co.EnvFrom {
+		oo = append(oo, e.envFrom(ns, src, resolve)...)
+	}
+	for _, env := range co.Env {
+		res := render.EnvVarRes{
+			Name:   env.Name,
+			Value:  env.Value,
+			Ref:    render.EnvRef(env.ValueFrom),
+			Source: render.EnvSourceSpec,
+		}
+		if resolve && env.ValueFrom != nil {
+			res.Value, res.Resolved = e.resolveRef(ns, env.ValueFrom)
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (e *EnvVar) fetchContainer(path, co string) (*v1.Container, error) {
+	o, err := e.factory.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var po v1.Pod
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+		if c.Name == co {
+			return &c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no container named %q found on pod %q", co, path)
+}
+
+func (e *EnvVar) envFrom(ns string, src v1.EnvFromSource, resolve bool) []runtime.Object {
+	source := render.EnvFromRef(src)
+	ref := "<" + strings.Replace(strings.TrimPrefix(source, "envFrom:"), "/", ":", 1) + ">"
+	if !resolve {
+		return []runtime.Object{render.EnvVarRes{Name: src.Prefix + "*", Ref: ref, Source: source}}
+	}
+
+	var (
+		data map[string]string
+		err  error
+	)
+	switch {
+	case src.SecretRef != nil:
+		data, err = e.secretData(ns, src.SecretRef.Name)
+	case src.ConfigMapRef != nil:
+		data, err = e.configMapData(ns, src.ConfigMapRef.Name)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to resolve %s", source)
+		return []runtime.Object{render.EnvVarRes{Name: src.Prefix + "*", Ref: ref, Source: source}}
+	}
+
+	oo := make([]runtime.Object, 0, len(data))
+	for k, v := range data {
+		oo = append(oo, render.EnvVarRes{
+			Name:     src.Prefix + k,
+			Value:    v,
+			Ref:      ref,
+			Source:   source,
+			Resolved: true,
+		})
+	}
+
+	return oo
+}
+
+func (e *EnvVar) resolveRef(ns string, src *v1.EnvVarSource) (string, bool) {
+	var (
+		data map[string]string
+		key  string
+		err  error
+	)
+	switch {
+	case src.SecretKeyRef != nil:
+		key = src.SecretKeyRef.Key
+		data, err = e.secretData(ns, src.SecretKeyRef.Name)
+	case src.ConfigMapKeyRef != nil:
+		key = src.ConfigMapKeyRef.Key
+		data, err = e.configMapData(ns, src.ConfigMapKeyRef.Name)
+	default:
+		return "", false
+	}
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to resolve env ref %s", render.EnvRef(src))
+		return "", false
+	}
+	v, ok := data[key]
+
+	return v, ok
+}
+
+func (e *EnvVar) secretData(ns, n string) (map[string]string, error) {
+	o, err := e.factory.Get(secretGVR, client.FQN(ns, n), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var sec v1.Secret
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sec)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]string, len(sec.Data))
+	for k, v := range sec.Data {
+		data[k] = string(v)
+	}
+
+	return data, nil
+}
+
+func (e *EnvVar) configMapData(ns, n string) (map[string]string, error) {
+	o, err := e.factory.Get(cmGVR, client.FQN(ns, n), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var cm v1.ConfigMap
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return cm.Data, nil
+}
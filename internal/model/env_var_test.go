@@ -0,0 +1,34 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvVarList(t *testing.T) {
+	e := model.EnvVar{}
+	e.Init(render.ClusterScope, "envvars", makePodFactory())
+
+	ctx := context.WithValue(context.Background(), internal.KeyPath, "blee/fred:fred")
+	oo, err := e.List(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	rr := make(render.Rows, len(oo))
+	assert.Nil(t, e.Hydrate(oo, rr, render.EnvVar{}))
+	assert.Equal(t, render.Fields{"fred", "<configmap:/blee>", "env"}, rr[0].Fields)
+}
+
+func TestEnvVarListNoContainer(t *testing.T) {
+	e := model.EnvVar{}
+	e.Init(render.ClusterScope, "envvars", makePodFactory())
+
+	ctx := context.WithValue(context.Background(), internal.KeyPath, "blee/fred:zorg")
+	_, err := e.List(ctx)
+	assert.NotNil(t, err)
+}
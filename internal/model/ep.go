@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Endpoints represents a service endpoints readiness model.
+type Endpoints struct {
+	Resource
+}
+
+// List returns a readiness breakdown of all services endpoints.
+func (e *Endpoints) List(ctx context.Context) ([]runtime.Object, error) {
+	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
+	lsel := labels.Everything()
+	if sel, err := labels.ConvertSelectorToLabelsMap(strLabel); ok && err == nil {
+		lsel = sel.AsSelector()
+	}
+
+	ss, err := dao.ListServiceEndpoints(e.factory, e.namespace, lsel)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		oo = append(oo, render.EndpointsRes{
+			Namespace:         s.Namespace,
+			Name:              s.Service,
+			Ports:             s.Ports,
+			Ready:             targetsToStrs(s.Ready),
+			NotReady:          targetsToStrs(s.NotReady),
+			CreationTimestamp: s.CreationTimestamp,
+		})
+	}
+
+	return oo, nil
+}
+
+func targetsToStrs(tt []dao.EndpointTarget) []string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		ss = append(ss, t.String())
+	}
+
+	return ss
+}
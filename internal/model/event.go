@@ -0,0 +1,334 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// DefaultEventsCap tracks the maximum number of buffered events.
+	DefaultEventsCap = 500
+
+	warningEvent = "Warning"
+)
+
+// Event represents an events model.
+type Event struct {
+	Resource
+}
+
+// List returns a collection of events. When an event feed is present,
+// events are appended as the informer delivers them.
+func (e *Event) List(ctx context.Context) ([]runtime.Object, error) {
+	feed, ok := ctx.Value(internal.KeyEvents).(*EventFeed)
+	if !ok {
+		oo, err := e.Resource.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return DedupEvents(oo), nil
+	}
+
+	ns := e.namespace
+	if ns == render.ClusterScope {
+		ns = render.AllNamespaces
+	}
+	inf, err := e.factory.CanForResource(ns, e.gvr, []string{"list", "watch"})
+	if err != nil {
+		return nil, err
+	}
+	feed.Attach(inf.Informer())
+
+	return DedupEvents(feed.List(ns)), nil
+}
+
+// DedupEvents folds events sharing an involved object, reason and message
+// into their latest occurrence. Counts are summed and the first and last
+// timestamps span the whole group. Groups keep their first position.
+func DedupEvents(oo []runtime.Object) []runtime.Object {
+	type group struct {
+		latest      *unstructured.Unstructured
+		count       int64
+		first, last time.Time
+		size        int
+	}
+
+	gg := make(map[string]*group, len(oo))
+	kk := make([]string, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			log.Error().Msgf("Expecting unstructured event but got %T", o)
+			continue
+		}
+		k, first, last := eventKey(u), eventTime(u, "firstTimestamp"), eventTime(u, "lastTimestamp")
+		g, ok := gg[k]
+		if !ok {
+			g = &group{latest: u, first: first, last: last}
+			gg[k] = g
+			kk = append(kk, k)
+		}
+		g.size++
+		g.count += eventCount(u)
+		if ok && last.After(g.last) {
+			g.latest, g.last = u, last
+		}
+		if !first.IsZero() && (g.first.IsZero() || first.Before(g.first)) {
+			g.first = first
+		}
+	}
+
+	res := make([]runtime.Object, 0, len(kk))
+	for _, k := range kk {
+		g := gg[k]
+		if g.size == 1 {
+			res = append(res, g.latest)
+			continue
+		}
+		u := g.latest.DeepCopy()
+		u.Object["count"] = g.count
+		if !g.first.IsZero() {
+			u.Object["firstTimestamp"] = g.first.UTC().Format(time.RFC3339)
+		}
+		if !g.last.IsZero() {
+			u.Object["lastTimestamp"] = g.last.UTC().Format(time.RFC3339)
+		}
+		res = append(res, u)
+	}
+
+	return res
+}
+
+func eventKey(u *unstructured.Unstructured) string {
+	ref, _, _ := unstructured.NestedStringMap(u.Object, "involvedObject")
+	reason, _, _ := unstructured.NestedString(u.Object, "reason")
+	msg, _, _ := unstructured.NestedString(u.Object, "message")
+
+	return strings.Join([]string{
+		u.GetNamespace(),
+		ref["kind"],
+		ref["namespace"],
+		ref["name"],
+		reason,
+		msg,
+	}, "\x00")
+}
+
+func eventCount(u *unstructured.Unstructured) int64 {
+	if n, ok, _ := unstructured.NestedInt64(u.Object, "count"); ok && n > 0 {
+		return n
+	}
+
+	return 1
+}
+
+func eventTime(u *unstructured.Unstructured, field string) time.Time {
+	s, _, _ := unstructured.NestedString(u.Object, field)
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// EventBuffer tracks events in arrival order. A buffer is shared by the
+// events views and registers once per informer.
+type EventBuffer struct {
+	capacity  int
+	seq       uint64
+	events    map[string]bufferedEvent
+	informers map[cache.SharedIndexInformer]struct{}
+	mx        sync.RWMutex
+}
+
+type bufferedEvent struct {
+	seq uint64
+	o   *unstructured.Unstructured
+}
+
+// NewEventBuffer returns a new buffer.
+func NewEventBuffer(capacity int) *EventBuffer {
+	return &EventBuffer{
+		capacity:  capacity,
+		events:    make(map[string]bufferedEvent, capacity),
+		informers: make(map[cache.SharedIndexInformer]struct{}),
+	}
+}
+
+// Attach registers the buffer with an events informer.
+func (b *EventBuffer) Attach(inf cache.SharedIndexInformer) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if _, ok := b.informers[inf]; ok {
+		return
+	}
+	b.informers[inf] = struct{}{}
+	inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    b.Add,
+		UpdateFunc: func(_, o interface{}) { b.Add(o) },
+		DeleteFunc: b.Delete,
+	})
+}
+
+// Reset clears out the buffer and its informers ie on context switch.
+func (b *EventBuffer) Reset() {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	b.seq = 0
+	b.events = make(map[string]bufferedEvent, b.capacity)
+	b.informers = make(map[cache.SharedIndexInformer]struct{})
+}
+
+// Add appends or refreshes an event. Oldest events are evicted past capacity.
+func (b *EventBuffer) Add(o interface{}) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		log.Error().Msgf("Expecting unstructured event but got %T", o)
+		return
+	}
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	b.seq++
+	b.events[eventFQN(u)] = bufferedEvent{seq: b.seq, o: u}
+	if len(b.events) <= b.capacity {
+		return
+	}
+
+	var (
+		victim string
+		oldest uint64
+	)
+	for k, e := range b.events {
+		if victim == "" || e.seq < oldest {
+			victim, oldest = k, e.seq
+		}
+	}
+	delete(b.events, victim)
+}
+
+// Delete removes an expired event.
+func (b *EventBuffer) Delete(o interface{}) {
+	if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = d.Obj
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		log.Error().Msgf("Expecting unstructured event but got %T", o)
+		return
+	}
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	delete(b.events, eventFQN(u))
+}
+
+// List returns buffered events in a given namespace, latest first.
+func (b *EventBuffer) List(ns string) []runtime.Object {
+	b.mx.RLock()
+	ee := make([]bufferedEvent, 0, len(b.events))
+	for _, e := range b.events {
+		if ns != render.AllNamespaces && e.o.GetNamespace() != ns {
+			continue
+		}
+		ee = append(ee, e)
+	}
+	b.mx.RUnlock()
+
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].seq > ee[j].seq
+	})
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e.o)
+	}
+
+	return oo
+}
+
+func eventFQN(u *unstructured.Unstructured) string {
+	return u.GetNamespace() + "/" + u.GetName()
+}
+
+// EventFeed presents a shared event buffer to a view.
+type EventFeed struct {
+	buff         *EventBuffer
+	warningsOnly bool
+	suppressed   int
+	mx           sync.RWMutex
+}
+
+// NewEventFeed returns a new feed.
+func NewEventFeed(b *EventBuffer) *EventFeed {
+	return &EventFeed{buff: b}
+}
+
+// Attach registers the underlying buffer with an events informer.
+func (f *EventFeed) Attach(inf cache.SharedIndexInformer) {
+	f.buff.Attach(inf)
+}
+
+// ToggleWarnings toggles warning only events.
+func (f *EventFeed) ToggleWarnings() bool {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.warningsOnly = !f.warningsOnly
+
+	return f.warningsOnly
+}
+
+// WarningsOnly returns true if only warnings are listed.
+func (f *EventFeed) WarningsOnly() bool {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.warningsOnly
+}
+
+// Suppressed returns the number of events hidden by the last listing.
+func (f *EventFeed) Suppressed() int {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.suppressed
+}
+
+// List returns buffered events in a given namespace, latest first.
+func (f *EventFeed) List(ns string) []runtime.Object {
+	oo := f.buff.List(ns)
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.suppressed = 0
+	if !f.warningsOnly {
+		return oo
+	}
+	ww := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u := o.(*unstructured.Unstructured)
+		if t, _, _ := unstructured.NestedString(u.Object, "type"); t != warningEvent {
+			f.suppressed++
+			continue
+		}
+		ww = append(ww, o)
+	}
+
+	return ww
+}
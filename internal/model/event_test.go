@@ -0,0 +1,194 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestEventBufferList(t *testing.T) {
+	b := model.NewEventBuffer(10)
+	b.Add(makeEvent("ns1", "e1", "Normal"))
+	b.Add(makeEvent("ns2", "e2", "Warning"))
+	b.Add(makeEvent("ns1", "e3", "Warning"))
+
+	assert.Equal(t, []string{"e3", "e2", "e1"}, eventNames(b.List(render.AllNamespaces)))
+	assert.Equal(t, []string{"e3", "e1"}, eventNames(b.List("ns1")))
+
+	// Updates move events back to the top.
+	b.Add(makeEvent("ns1", "e1", "Normal"))
+	assert.Equal(t, []string{"e1", "e3", "e2"}, eventNames(b.List(render.AllNamespaces)))
+}
+
+func TestEventBufferDelete(t *testing.T) {
+	b := model.NewEventBuffer(10)
+	b.Add(makeEvent("ns1", "e1", "Normal"))
+	b.Add(makeEvent("ns1", "e2", "Normal"))
+	b.Add(makeEvent("ns1", "e3", "Normal"))
+
+	b.Delete(makeEvent("ns1", "e2", "Normal"))
+	b.Delete(cache.DeletedFinalStateUnknown{Key: "ns1/e3", Obj: makeEvent("ns1", "e3", "Normal")})
+	assert.Equal(t, []string{"e1"}, eventNames(b.List("ns1")))
+
+	b.Reset()
+	assert.Equal(t, 0, len(b.List(render.AllNamespaces)))
+}
+
+func TestEventFeedWarnings(t *testing.T) {
+	b := model.NewEventBuffer(10)
+	b.Add(makeEvent("ns1", "e1", "Normal"))
+	b.Add(makeEvent("ns1", "e2", "Warning"))
+	b.Add(makeEvent("ns1", "e3", "Normal"))
+	f1, f2 := model.NewEventFeed(b), model.NewEventFeed(b)
+
+	assert.True(t, f1.ToggleWarnings())
+	assert.Equal(t, []string{"e2"}, eventNames(f1.List("ns1")))
+	assert.Equal(t, 2, f1.Suppressed())
+
+	// Feeds share events but not their filters.
+	assert.Equal(t, 3, len(f2.List("ns1")))
+	assert.Equal(t, 0, f2.Suppressed())
+
+	assert.False(t, f1.ToggleWarnings())
+	assert.Equal(t, 3, len(f1.List("ns1")))
+	assert.Equal(t, 0, f1.Suppressed())
+}
+
+func TestEventBufferCap(t *testing.T) {
+	b := model.NewEventBuffer(2)
+	b.Add(makeEvent("ns1", "e1", "Normal"))
+	b.Add(makeEvent("ns1", "e2", "Normal"))
+	b.Add(makeEvent("ns1", "e1", "Normal"))
+	b.Add(makeEvent("ns1", "e3", "Normal"))
+
+	assert.Equal(t, []string{"e3", "e1"}, eventNames(b.List("ns1")))
+}
+
+func TestDedupEvents(t *testing.T) {
+	uu := map[string]struct {
+		oo    []runtime.Object
+		names []string
+		count []int64
+	}{
+		"none": {},
+		"recurring": {
+			oo: []runtime.Object{
+				makeFullEvent("e3", "p1", "BackOff", "Back-off restarting failed container", 2, "2019-08-30T20:45:00Z"),
+				makeFullEvent("e2", "p2", "BackOff", "Back-off restarting failed container", 1, "2019-08-30T20:44:00Z"),
+				makeFullEvent("e1", "p1", "BackOff", "Back-off restarting failed container", 3, "2019-08-30T20:43:00Z"),
+			},
+			names: []string{"e3", "e2"},
+			count: []int64{5, 1},
+		},
+		"messageSuffix": {
+			oo: []runtime.Object{
+				makeFullEvent("e2", "p1", "Failed", "Error: ImagePullBackOff", 1, "2019-08-30T20:44:00Z"),
+				makeFullEvent("e1", "p1", "Failed", "Error: ImagePullBackOff for c2", 1, "2019-08-30T20:43:00Z"),
+			},
+			names: []string{"e2", "e1"},
+			count: []int64{1, 1},
+		},
+		"reason": {
+			oo: []runtime.Object{
+				makeFullEvent("e2", "p1", "Pulled", "nginx", 1, "2019-08-30T20:44:00Z"),
+				makeFullEvent("e1", "p1", "Pulling", "nginx", 1, "2019-08-30T20:43:00Z"),
+			},
+			names: []string{"e2", "e1"},
+			count: []int64{1, 1},
+		},
+		"latestWins": {
+			oo: []runtime.Object{
+				makeFullEvent("e1", "p1", "BackOff", "boom", 0, "2019-08-30T20:43:00Z"),
+				makeFullEvent("e2", "p1", "BackOff", "boom", 4, "2019-08-30T20:49:00Z"),
+			},
+			names: []string{"e2"},
+			count: []int64{5},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo := model.DedupEvents(u.oo)
+			assert.Equal(t, len(u.names), len(oo))
+			for i, o := range oo {
+				ev := o.(*unstructured.Unstructured)
+				assert.Equal(t, u.names[i], ev.GetName())
+				n, _, _ := unstructured.NestedInt64(ev.Object, "count")
+				assert.Equal(t, u.count[i], n)
+			}
+		})
+	}
+}
+
+func TestDedupEventsTimestamps(t *testing.T) {
+	e1 := makeFullEvent("e1", "p1", "BackOff", "boom", 1, "2019-08-30T20:43:00Z")
+	e2 := makeFullEvent("e2", "p1", "BackOff", "boom", 1, "2019-08-30T20:49:00Z")
+
+	oo := model.DedupEvents([]runtime.Object{e2, e1})
+	assert.Equal(t, 1, len(oo))
+	ev := oo[0].(*unstructured.Unstructured)
+	first, _, _ := unstructured.NestedString(ev.Object, "firstTimestamp")
+	last, _, _ := unstructured.NestedString(ev.Object, "lastTimestamp")
+	assert.Equal(t, "2019-08-30T20:43:00Z", first)
+	assert.Equal(t, "2019-08-30T20:49:00Z", last)
+
+	// Listed events are left untouched.
+	n, _, _ := unstructured.NestedInt64(e2.Object, "count")
+	assert.Equal(t, int64(1), n)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeFullEvent(n, po, reason, msg string, count int64, at string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      n,
+			},
+			"involvedObject": map[string]interface{}{
+				"kind":      "Pod",
+				"namespace": "default",
+				"name":      po,
+			},
+			"reason":         reason,
+			"message":        msg,
+			"count":          count,
+			"firstTimestamp": at,
+			"lastTimestamp":  at,
+			"type":           "Warning",
+		},
+	}
+}
+
+func makeEvent(ns, n, kind string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"namespace": ns,
+				"name":      n,
+			},
+			"type": kind,
+		},
+	}
+}
+
+func eventNames(oo []runtime.Object) []string {
+	nn := make([]string, 0, len(oo))
+	for _, o := range oo {
+		nn = append(nn, o.(*unstructured.Unstructured).GetName())
+	}
+
+	return nn
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Foreach represents a bulk operation targets.
+type Foreach struct {
+	Resource
+}
+
+// List returns the bulk operation targets state.
+func (f *Foreach) List(ctx context.Context) ([]runtime.Object, error) {
+	run, ok := ctx.Value(internal.KeyForeach).(*dao.ForeachRun)
+	if !ok {
+		return nil, errors.New("no bulk operation found in context")
+	}
+
+	tt := run.Targets()
+	oo := make([]runtime.Object, 0, len(tt))
+	for _, t := range tt {
+		oo = append(oo, render.ForeachResult{
+			Resource: client.NewGVR(t.GVR).ToR(),
+			Path:     t.Path,
+			Action:   run.Cmd.Summary(),
+			Status:   t.Status,
+			Message:  t.Message,
+		})
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,35 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestForeachList(t *testing.T) {
+	run := dao.NewForeachRun(dao.ForeachCmd{Verb: dao.ForeachRestart}, []dao.ForeachTarget{
+		{GVR: "apps/v1/deployments", Path: "ns1/dp1", Status: dao.ForeachPending},
+		{GVR: "v1/pods", Path: "ns1/p3", Status: dao.ForeachSkipped, Message: "no restartable owner"},
+	})
+	ctx := context.WithValue(context.Background(), internal.KeyForeach, run)
+
+	var f model.Foreach
+	oo, err := f.List(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []runtime.Object{
+		render.ForeachResult{Resource: "deployments", Path: "ns1/dp1", Action: "restart", Status: dao.ForeachPending},
+		render.ForeachResult{Resource: "pods", Path: "ns1/p3", Action: "restart", Status: dao.ForeachSkipped, Message: "no restartable owner"},
+	}, oo)
+}
+
+func TestForeachListNoRun(t *testing.T) {
+	var f model.Foreach
+	_, err := f.List(context.Background())
+	assert.Error(t, err)
+}
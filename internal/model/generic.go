@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,16 +27,7 @@ type Generic struct {
 
 // List returns a collection of node resources.
 func (g *Generic) Get(ctx context.Context, path string) (runtime.Object, error) {
-	var opts metav1.GetOptions
-
-	ns, n := client.Namespaced(path)
-	gvr := client.NewGVR(g.gvr)
-	req := g.factory.Client().DynDialOrDie().Resource(gvr.AsGVR())
-	if ns == "" {
-		return req.Get(n, opts)
-	}
-
-	return req.Namespace(ns).Get(n, opts)
+	return dao.FetchLive(g.factory, g.gvr, path)
 }
 
 // List returns a collection of node resources.
@@ -67,12 +59,12 @@ func (g *Generic) List(ctx context.Context) ([]runtime.Object, error) {
 		Namespace(ns).
 		Do().
 		Get()
-	if err != nil {
-		return nil, err
-	}
 	table, ok := o.(*metav1beta1.Table)
-	if !ok {
-		return nil, fmt.Errorf("expecting table but got %T", o)
+	if err != nil || !ok {
+		log.Warn().Err(err).Msgf("No server side table for %q (%T). Using printer columns", g.gvr, o)
+		if table, err = g.printerTable(ctx); err != nil {
+			return nil, err
+		}
 	}
 	g.table = table
 	res := make([]runtime.Object, len(g.table.Rows))
@@ -80,11 +72,26 @@ func (g *Generic) List(ctx context.Context) ([]runtime.Object, error) {
 		res[i] = RowRes{&g.table.Rows[i]}
 	}
 
-	return res, err
+	return res, nil
+}
+
+// printerTable evaluates the resource printer columns client side, defaulting
+// to name and age when none are declared.
+func (g *Generic) printerTable(ctx context.Context) (*metav1beta1.Table, error) {
+	oo, err := g.Resource.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return render.NewPrinterTable(dao.PrinterColumnsFor(client.NewGVR(g.gvr)), oo)
 }
 
 // Hydrate returns nodes as rows.
 func (g *Generic) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
+	// Server side tables render human ages so there is no need to stamp rows.
+	if s, ok := re.(stampRenderer); ok {
+		re = s.Renderer
+	}
 	gr, ok := re.(*render.Generic)
 	if !ok {
 		return fmt.Errorf("expecting generic renderer for %s but got %T", g.gvr, re)
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+)
+
+func TestGenericPrinterTable(t *testing.T) {
+	var g Generic
+	g.Init("ns1", "blee.io/v1/fred", crFactory{})
+
+	table, err := g.printerTable(context.Background())
+	assert.Nil(t, err)
+	g.table = table
+
+	oo := make([]runtime.Object, len(table.Rows))
+	for i := range table.Rows {
+		oo[i] = RowRes{&table.Rows[i]}
+	}
+	var re render.Generic
+	rr := make(render.Rows, len(oo))
+	assert.Nil(t, g.Hydrate(oo, rr, &re))
+
+	assert.Equal(t, 2, len(re.Header("ns1")))
+	assert.Equal(t, "ns1/cr1", rr[0].ID)
+	assert.Equal(t, "cr1", rr[0].Fields[0])
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type crFactory struct{}
+
+var _ dao.Factory = crFactory{}
+
+func (f crFactory) Client() client.Connection {
+	return nil
+}
+func (f crFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	return nil, nil
+}
+func (f crFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	return []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "blee.io/v1",
+			"kind":       "Fred",
+			"metadata": map[string]interface{}{
+				"name":      "cr1",
+				"namespace": "ns1",
+			},
+		}},
+	}, nil
+}
+func (f crFactory) ForResource(ns, gvr string) informers.GenericInformer { return nil }
+func (f crFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
+	return nil, nil
+}
+func (f crFactory) WaitForCacheSync()             {}
+func (f crFactory) Forwarders() *watch.Forwarders { return watch.NewForwarders() }
+func (f crFactory) DeleteForwarder(string)        {}
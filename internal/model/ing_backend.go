@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// IngressBackend represents an ingress backends validation model.
+type IngressBackend struct {
+	Resource
+}
+
+// List returns an ingress rules backends along with their validation status.
+func (i *IngressBackend) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", i.gvr)
+	}
+
+	bb, err := dao.IngressBackends(i.factory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(bb))
+	for _, b := range bb {
+		oo = append(oo, render.IngressBackendRes{
+			Namespace: b.Namespace,
+			Host:      b.Host,
+			Path:      b.Path,
+			Service:   b.Service,
+			Port:      b.Port,
+			Ready:     b.Ready,
+			Default:   b.Default,
+			Issues:    b.Issues,
+		})
+	}
+
+	return oo, nil
+}
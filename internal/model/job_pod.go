@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// JobPod represents a job pods termination model.
+type JobPod struct {
+	Resource
+}
+
+// List returns the pods owned by a job along with their containers terminations.
+func (j *JobPod) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", j.gvr)
+	}
+
+	pp, err := dao.JobPods(j.factory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		ee := make([]render.ExitRes, 0, len(p.Exits))
+		for _, e := range p.Exits {
+			ee = append(ee, render.ExitRes{Container: e.Container, Code: e.Code, Reason: e.Reason})
+		}
+		oo = append(oo, render.JobPodRes{
+			Namespace: p.Namespace,
+			Name:      p.Name,
+			Phase:     p.Phase,
+			Node:      p.Node,
+			Restarts:  p.Restarts,
+			Age:       p.Created,
+			Exits:     ee,
+		})
+	}
+
+	return oo, nil
+}
@@ -3,11 +3,15 @@ package model
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -18,19 +22,32 @@ type NodeMetricsFunc func() (*mv1beta1.NodeMetricsList, error)
 // Node represents a node model.
 type Node struct {
 	Resource
+
+	requests     *dao.NodeRequests
+	requestsOnce sync.Once
 }
 
 // List returns a collection of node resources.
 func (n *Node) List(ctx context.Context) ([]runtime.Object, error) {
 	nmx, ok := ctx.Value(internal.KeyMetrics).(*mv1beta1.NodeMetricsList)
-	if !ok {
-		log.Warn().Msgf("No node metrics available in context")
+	if !ok && n.factory.Client() != nil {
+		var err error
+		if nmx, err = client.NewMetricsServer(n.factory.Client()).FetchNodesMetrics(); err != nil {
+			log.Warn().Err(err).Msgf("No node metrics")
+		}
 	}
 
 	nn, err := dao.FetchNodes(n.factory)
 	if err != nil {
 		return nil, err
 	}
+	n.requestsOnce.Do(func() {
+		n.requests = dao.NewNodeRequests()
+	})
+	reqs, err := n.requests.Refresh(n.factory)
+	if err != nil {
+		log.Warn().Err(err).Msgf("No node requests")
+	}
 
 	oo := make([]runtime.Object, len(nn.Items))
 	for i, no := range nn.Items {
@@ -38,10 +55,14 @@ func (n *Node) List(ctx context.Context) ([]runtime.Object, error) {
 		if err != nil {
 			return nil, err
 		}
-		oo[i] = &render.NodeWithMetrics{
+		nwm := render.NodeWithMetrics{
 			Raw: &unstructured.Unstructured{Object: o},
 			MX:  nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
 		}
+		if reqs != nil {
+			nwm.Requested = toResourceList(reqs[no.Name])
+		}
+		oo[i] = &nwm
 	}
 
 	return oo, nil
@@ -68,7 +89,17 @@ func (n *Node) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func toResourceList(r dao.Requests) v1.ResourceList {
+	return v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewMilliQuantity(r.CPU, resource.DecimalSI),
+		v1.ResourceMemory: *resource.NewQuantity(r.MEM, resource.BinarySI),
+	}
+}
+
 func nodeMetricsFor(fqn string, mmx *mv1beta1.NodeMetricsList) *mv1beta1.NodeMetrics {
+	if mmx == nil {
+		return nil
+	}
 	for _, mx := range mmx.Items {
 		if MetaFQN(mx.ObjectMeta) == fqn {
 			return &mx
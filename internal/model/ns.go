@@ -0,0 +1,43 @@
+package model
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	favNSIndicator     = "+"
+	defaultNSIndicator = "(*)"
+)
+
+// DecorateNamespaces lists the all namespace and flags favorite and active
+// namespaces.
+func DecorateNamespaces(data render.TableData, favs []string, active string) render.TableData {
+	if len(data.RowEvents) == 0 {
+		return data
+	}
+
+	// checks if all ns is in the list if not add it.
+	if _, ok := data.RowEvents.FindIndex(render.NamespaceAll); !ok {
+		data.RowEvents = append(data.RowEvents,
+			render.RowEvent{
+				Kind: render.EventUnchanged,
+				Row: render.Row{
+					ID:     render.NamespaceAll,
+					Fields: render.Fields{render.NamespaceAll, "Active", "0"},
+				},
+			},
+		)
+	}
+
+	for _, re := range data.RowEvents {
+		if config.InList(favs, re.Row.ID) {
+			re.Row.Fields[0] += favNSIndicator
+		}
+		if active == re.Row.ID {
+			re.Row.Fields[0] += defaultNSIndicator
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PodDisruptionBudget represents a pdb resource model.
+type PodDisruptionBudget struct {
+	Resource
+}
+
+// List returns a collection of pdbs along with the number of pods they cover.
+func (p *PodDisruptionBudget) List(ctx context.Context) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx)
+	if err != nil {
+		return oo, err
+	}
+
+	cov := dao.NewPodCoverage(p.factory)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var pdb v1beta1.PodDisruptionBudget
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pdb); err != nil {
+			return nil, err
+		}
+		pp, err := cov.Covered(&pdb)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &render.PDBWithCoverage{Raw: u, Covered: len(pp)})
+	}
+
+	return res, nil
+}
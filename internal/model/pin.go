@@ -0,0 +1,211 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Watch represents a collection of watched resources.
+type Watch struct {
+	Resource
+}
+
+// List returns a collection of pins.
+func (w *Watch) List(ctx context.Context) ([]runtime.Object, error) {
+	pins, ok := ctx.Value(internal.KeyPins).(*Pins)
+	if !ok {
+		return nil, errors.New("no pins found in context")
+	}
+
+	pp := pins.List()
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, render.WatchRes{
+			ID:         p.ID(),
+			GVR:        p.GVR,
+			Path:       p.Path,
+			LastChange: p.LastChange,
+			Since:      p.Since,
+		})
+	}
+
+	return oo, nil
+}
+
+// PinNotifyFunc reports changes on a pinned resource.
+type PinNotifyFunc func(p Pin, changes []string)
+
+// Pin represents a watched resource.
+type Pin struct {
+	GVR        string
+	Path       string
+	LastChange string
+	Since      time.Time
+}
+
+// ID returns the pin identifier.
+func (p Pin) ID() string {
+	return p.GVR + ":" + p.Path
+}
+
+// Pins tracks resources watched for changes.
+type Pins struct {
+	pins      map[string]Pin
+	informers map[cache.SharedIndexInformer]struct{}
+	notifyFn  PinNotifyFunc
+	mx        sync.RWMutex
+}
+
+// NewPins returns a new pins tracker.
+func NewPins(f PinNotifyFunc) *Pins {
+	return &Pins{
+		pins:      make(map[string]Pin),
+		informers: make(map[cache.SharedIndexInformer]struct{}),
+		notifyFn:  f,
+	}
+}
+
+// Add watches a given resource.
+func (p *Pins) Add(f dao.Factory, gvr, path string) error {
+	ns, _ := client.Namespaced(path)
+	inf, err := f.CanForResource(ns, gvr, []string{"get", "list", "watch"})
+	if err != nil {
+		return err
+	}
+	pin := Pin{GVR: gvr, Path: path, Since: time.Now()}
+	p.attach(inf.Informer(), gvr)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.pins[pin.ID()] = pin
+
+	return nil
+}
+
+// Remove stops watching a given resource.
+func (p *Pins) Remove(id string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	delete(p.pins, id)
+}
+
+// Get returns a pin given its id.
+func (p *Pins) Get(id string) (Pin, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	pin, ok := p.pins[id]
+	return pin, ok
+}
+
+// Clear removes all pins.
+func (p *Pins) Clear() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.pins = make(map[string]Pin)
+	p.informers = make(map[cache.SharedIndexInformer]struct{})
+}
+
+// Has returns true if a resource is pinned.
+func (p *Pins) Has(gvr, path string) bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	_, ok := p.pins[Pin{GVR: gvr, Path: path}.ID()]
+	return ok
+}
+
+// List returns all pins ordered by id.
+func (p *Pins) List() []Pin {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	pp := make([]Pin, 0, len(p.pins))
+	for _, pin := range p.pins {
+		pp = append(pp, pin)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		return pp[i].ID() < pp[j].ID()
+	})
+
+	return pp
+}
+
+// Update notifies a pinned resource changed.
+func (p *Pins) Update(gvr string, prev, curr interface{}) {
+	pu, ok1 := prev.(*unstructured.Unstructured)
+	cu, ok2 := curr.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		log.Error().Msgf("Expecting unstructured pins but got %T -- %T", prev, curr)
+		return
+	}
+	pin, ok := p.pinFor(gvr, cu)
+	if !ok {
+		return
+	}
+	if cc := dao.ChangeSummary(pu.Object, cu.Object); len(cc) > 0 {
+		p.notify(pin, cc)
+	}
+}
+
+// Delete notifies a pinned resource was deleted.
+func (p *Pins) Delete(gvr string, o interface{}) {
+	if t, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = t.Obj
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	if pin, ok := p.pinFor(gvr, u); ok {
+		p.notify(pin, []string{"deleted"})
+	}
+}
+
+func (p *Pins) notify(pin Pin, cc []string) {
+	pin.LastChange = strings.Join(cc, ", ")
+	p.mx.Lock()
+	if _, ok := p.pins[pin.ID()]; ok {
+		p.pins[pin.ID()] = pin
+	}
+	p.mx.Unlock()
+
+	p.notifyFn(pin, cc)
+}
+
+func (p *Pins) pinFor(gvr string, u *unstructured.Unstructured) (Pin, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	pin, ok := p.pins[Pin{GVR: gvr, Path: client.FQN(u.GetNamespace(), u.GetName())}.ID()]
+	return pin, ok
+}
+
+func (p *Pins) attach(inf cache.SharedIndexInformer, gvr string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if _, ok := p.informers[inf]; ok {
+		return
+	}
+	p.informers[inf] = struct{}{}
+	inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(prev, curr interface{}) { p.Update(gvr, prev, curr) },
+		DeleteFunc: func(o interface{}) { p.Delete(gvr, o) },
+	})
+}
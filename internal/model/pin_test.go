@@ -0,0 +1,105 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestPinsAddRemove(t *testing.T) {
+	p := model.NewPins(func(model.Pin, []string) {})
+	f := pinFactory{}
+
+	assert.Nil(t, p.Add(f, "apps/v1/deployments", "ns1/fred"))
+	assert.Nil(t, p.Add(f, "v1/pods", "ns1/blee"))
+	assert.True(t, p.Has("v1/pods", "ns1/blee"))
+	assert.False(t, p.Has("v1/pods", "ns1/fred"))
+	assert.Equal(t, []string{"apps/v1/deployments:ns1/fred", "v1/pods:ns1/blee"}, pinIDs(p.List()))
+
+	p.Remove("v1/pods:ns1/blee")
+	assert.Equal(t, []string{"apps/v1/deployments:ns1/fred"}, pinIDs(p.List()))
+
+	p.Clear()
+	assert.Equal(t, 0, len(p.List()))
+}
+
+func TestPinsAddDenied(t *testing.T) {
+	p := model.NewPins(func(model.Pin, []string) {})
+
+	assert.Error(t, p.Add(pinFactory{err: errors.New("denied")}, "v1/pods", "ns1/blee"))
+	assert.Equal(t, 0, len(p.List()))
+}
+
+func TestPinsNotify(t *testing.T) {
+	var changes []string
+	p := model.NewPins(func(_ model.Pin, cc []string) {
+		changes = append(changes, cc...)
+	})
+	assert.Nil(t, p.Add(pinFactory{}, "apps/v1/deployments", "ns1/fred"))
+
+	p.Update("apps/v1/deployments", makeDeployment("ns1", "fred", 3), makeDeployment("ns1", "fred", 5))
+	p.Update("apps/v1/deployments", makeDeployment("ns1", "blee", 3), makeDeployment("ns1", "blee", 5))
+	p.Update("v1/pods", makeDeployment("ns1", "fred", 3), makeDeployment("ns1", "fred", 5))
+	p.Update("apps/v1/deployments", makeDeployment("ns1", "fred", 5), makeDeployment("ns1", "fred", 5))
+	p.Delete("apps/v1/deployments", cache.DeletedFinalStateUnknown{Obj: makeDeployment("ns1", "fred", 5)})
+
+	assert.Equal(t, []string{"replicas 3→5", "deleted"}, changes)
+	pin, ok := p.Get("apps/v1/deployments:ns1/fred")
+	assert.True(t, ok)
+	assert.Equal(t, "deleted", pin.LastChange)
+}
+
+// Helpers...
+
+type pinFactory struct {
+	testFactory
+	err error
+}
+
+func (f pinFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return pinInformer{
+		SharedIndexInformer: cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{}),
+	}, nil
+}
+
+type pinInformer struct {
+	cache.SharedIndexInformer
+}
+
+func (i pinInformer) Informer() cache.SharedIndexInformer {
+	return i.SharedIndexInformer
+}
+
+func (i pinInformer) Lister() cache.GenericLister {
+	return nil
+}
+
+func pinIDs(pp []model.Pin) []string {
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		ss = append(ss, p.ID())
+	}
+
+	return ss
+}
+
+func makeDeployment(ns, n string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Deployment",
+		"metadata": map[string]interface{}{
+			"namespace": ns,
+			"name":      n,
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+	}}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,10 +27,35 @@ func (p *Pod) List(ctx context.Context) ([]runtime.Object, error) {
 		return oo, err
 	}
 
-	pmx, ok := ctx.Value(internal.KeyMetrics).(*mv1beta1.PodMetricsList)
+	mx := ctx.Value(internal.KeyMetrics)
+	if mx == nil && p.factory.Client() != nil {
+		mx = client.NewMetricsServer(p.factory.Client())
+	}
+	pmx, ok := mx.(*mv1beta1.PodMetricsList)
+	if f, isFetcher := mx.(PodsMetricsFetcher); isFetcher {
+		if pmx, err = f.FetchPodsMetrics(p.namespace); err != nil {
+			log.Warn().Err(err).Msgf("No pods metrics")
+		}
+		ok = pmx != nil
+	}
 	if !ok {
 		log.Warn().Msgf("expecting context PodMetricsList")
 	}
+	hist, _ := ctx.Value(internal.KeyPodHistory).(*PodHistory)
+	if hist != nil {
+		hist.Record(pmx)
+	}
+
+	if threshold, ok := ctx.Value(internal.KeyProblemRestarts).(int); ok {
+		if oo, err = problemPods(oo, threshold); err != nil {
+			return nil, err
+		}
+	}
+	if evicted, ok := ctx.Value(internal.KeyEvicted).(bool); ok && evicted {
+		if oo, err = evictedPods(oo); err != nil {
+			return nil, err
+		}
+	}
 
 	sel, ok := ctx.Value(internal.KeyFields).(string)
 	if !ok {
@@ -47,7 +74,7 @@ func (p *Pod) List(ctx context.Context) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+			res = append(res, podWithMetrics(u, pmx, hist))
 			continue
 		}
 
@@ -56,7 +83,7 @@ func (p *Pod) List(ctx context.Context) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+			res = append(res, podWithMetrics(u, pmx, hist))
 		}
 	}
 
@@ -85,7 +112,70 @@ func (p *Pod) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// problemPods retains pods that are neither running nor completed or restarted
+// more than the given threshold.
+func problemPods(oo []runtime.Object, threshold int) ([]runtime.Object, error) {
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		if isProblemPod(&po, threshold) {
+			res = append(res, o)
+		}
+	}
+
+	return res, nil
+}
+
+// evictedPods retains pods evicted by their node.
+func evictedPods(oo []runtime.Object) ([]runtime.Object, error) {
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		if render.IsEvicted(&po) {
+			res = append(res, o)
+		}
+	}
+
+	return res, nil
+}
+
+func isProblemPod(po *v1.Pod, threshold int) bool {
+	switch render.PodStatus(po) {
+	case "Running", "Completed":
+	default:
+		return true
+	}
+
+	return render.PodRestarts(po) > threshold
+}
+
+func podWithMetrics(u *unstructured.Unstructured, pmx *mv1beta1.PodMetricsList, hist *PodHistory) *render.PodWithMetrics {
+	po := render.PodWithMetrics{Raw: u, MX: podMetricsFor(u, pmx)}
+	if hist != nil {
+		po.CPUHist, po.MEMHist = hist.Samples(extractFQN(u))
+	}
+
+	return &po
+}
+
 func podMetricsFor(o runtime.Object, mmx *mv1beta1.PodMetricsList) *mv1beta1.PodMetrics {
+	if mmx == nil {
+		return nil
+	}
 	fqn := extractFQN(o)
 	for _, mx := range mmx.Items {
 		if MetaFQN(mx.ObjectMeta) == fqn {
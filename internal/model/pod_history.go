@@ -0,0 +1,127 @@
+package model
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// DefaultPodHistorySize tracks the number of metrics samples kept per pod.
+const DefaultPodHistorySize = 30
+
+// PodsMetricsFetcher fetches pods metrics in a given namespace.
+type PodsMetricsFetcher interface {
+	FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, error)
+}
+
+var _ PodsMetricsFetcher = (*client.MetricsServer)(nil)
+
+// Ring represents a fixed size collection of samples.
+type Ring struct {
+	samples []int64
+	start   int
+	count   int
+}
+
+// NewRing returns a new ring buffer.
+func NewRing(size int) *Ring {
+	return &Ring{samples: make([]int64, size)}
+}
+
+// Add appends a sample, evicting the oldest one past capacity.
+func (r *Ring) Add(v int64) {
+	if len(r.samples) == 0 {
+		return
+	}
+	r.samples[(r.start+r.count)%len(r.samples)] = v
+	if r.count < len(r.samples) {
+		r.count++
+		return
+	}
+	r.start = (r.start + 1) % len(r.samples)
+}
+
+// Values returns samples oldest first.
+func (r *Ring) Values() []int64 {
+	vv := make([]int64, 0, r.count)
+	for i := 0; i < r.count; i++ {
+		vv = append(vv, r.samples[(r.start+i)%len(r.samples)])
+	}
+
+	return vv
+}
+
+// PodHistory tracks pods cpu and memory samples.
+type PodHistory struct {
+	size    int
+	samples map[string]*podSamples
+	mx      sync.RWMutex
+}
+
+type podSamples struct {
+	cpu, mem *Ring
+}
+
+// NewPodHistory returns a new pods history.
+func NewPodHistory(size int) *PodHistory {
+	return &PodHistory{
+		size:    size,
+		samples: make(map[string]*podSamples),
+	}
+}
+
+// Record adds a sample for each pod in the metrics list. Pods no longer
+// reporting metrics are dropped.
+func (h *PodHistory) Record(mmx *mv1beta1.PodMetricsList) {
+	if mmx == nil {
+		return
+	}
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	seen := make(map[string]struct{}, len(mmx.Items))
+	for _, mx := range mmx.Items {
+		fqn := MetaFQN(mx.ObjectMeta)
+		seen[fqn] = struct{}{}
+		s, ok := h.samples[fqn]
+		if !ok {
+			s = &podSamples{cpu: NewRing(h.size), mem: NewRing(h.size)}
+			h.samples[fqn] = s
+		}
+		var cpu, mem int64
+		for _, c := range mx.Containers {
+			cpu += c.Usage.Cpu().MilliValue()
+			mem += c.Usage.Memory().Value()
+		}
+		s.cpu.Add(cpu)
+		s.mem.Add(mem)
+	}
+	for fqn := range h.samples {
+		if _, ok := seen[fqn]; !ok {
+			delete(h.samples, fqn)
+		}
+	}
+}
+
+// Samples returns a pod cpu (millicores) and memory (bytes) samples.
+func (h *PodHistory) Samples(fqn string) ([]int64, []int64) {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	s, ok := h.samples[fqn]
+	if !ok {
+		return nil, nil
+	}
+
+	return s.cpu.Values(), s.mem.Values()
+}
+
+// Len returns the number of tracked pods.
+func (h *PodHistory) Len() int {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return len(h.samples)
+}
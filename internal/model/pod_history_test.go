@@ -0,0 +1,93 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestRing(t *testing.T) {
+	uu := map[string]struct {
+		size int
+		vv   []int64
+		e    []int64
+	}{
+		"empty": {
+			size: 3,
+			e:    []int64{},
+		},
+		"partial": {
+			size: 3,
+			vv:   []int64{1, 2},
+			e:    []int64{1, 2},
+		},
+		"full": {
+			size: 3,
+			vv:   []int64{1, 2, 3},
+			e:    []int64{1, 2, 3},
+		},
+		"wrapped": {
+			size: 3,
+			vv:   []int64{1, 2, 3, 4, 5, 6, 7},
+			e:    []int64{5, 6, 7},
+		},
+		"zero": {
+			vv: []int64{1, 2},
+			e:  []int64{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model.NewRing(u.size)
+			for _, v := range u.vv {
+				r.Add(v)
+			}
+			assert.Equal(t, u.e, r.Values())
+		})
+	}
+}
+
+func TestPodHistoryRecord(t *testing.T) {
+	h := model.NewPodHistory(2)
+
+	h.Record(makePodsMetrics(map[string][2]string{"p1": {"100m", "10Mi"}, "p2": {"1", "1Gi"}}))
+	h.Record(makePodsMetrics(map[string][2]string{"p1": {"200m", "20Mi"}, "p2": {"2", "2Gi"}}))
+	h.Record(makePodsMetrics(map[string][2]string{"p1": {"300m", "30Mi"}}))
+	h.Record(nil)
+
+	assert.Equal(t, 1, h.Len())
+	cpu, mem := h.Samples("default/p1")
+	assert.Equal(t, []int64{400, 600}, cpu)
+	assert.Equal(t, []int64{40 * 1024 * 1024, 60 * 1024 * 1024}, mem)
+	cpu, mem = h.Samples("default/p2")
+	assert.Nil(t, cpu)
+	assert.Nil(t, mem)
+}
+
+// Helpers...
+
+func makePodsMetrics(mm map[string][2]string) *mv1beta1.PodMetricsList {
+	var l mv1beta1.PodMetricsList
+	for n, u := range mm {
+		usage := v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(u[0]),
+			v1.ResourceMemory: resource.MustParse(u[1]),
+		}
+		l.Items = append(l.Items, mv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+			Containers: []mv1beta1.ContainerMetrics{
+				{Name: "c1", Usage: usage},
+				{Name: "c2", Usage: usage},
+			},
+		})
+	}
+
+	return &l
+}
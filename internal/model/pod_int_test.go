@@ -0,0 +1,57 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestIsProblemPod(t *testing.T) {
+	uu := map[string]struct {
+		po v1.Pod
+		e  bool
+	}{
+		"running": {
+			po: makeProblemPod(v1.PodRunning, v1.ContainerState{Running: &v1.ContainerStateRunning{}}, 0),
+		},
+		"completed": {
+			po: makeProblemPod(v1.PodSucceeded, v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}}, 0),
+		},
+		"pending": {
+			po: makeProblemPod(v1.PodPending, v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}, 0),
+			e:  true,
+		},
+		"crashLoop": {
+			po: makeProblemPod(v1.PodRunning, v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}, 1),
+			e:  true,
+		},
+		"restartsAtThreshold": {
+			po: makeProblemPod(v1.PodRunning, v1.ContainerState{Running: &v1.ContainerStateRunning{}}, 3),
+		},
+		"restartsAboveThreshold": {
+			po: makeProblemPod(v1.PodRunning, v1.ContainerState{Running: &v1.ContainerStateRunning{}}, 12),
+			e:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isProblemPod(&u.po, 3))
+		})
+	}
+}
+
+// Helpers...
+
+func makeProblemPod(phase v1.PodPhase, state v1.ContainerState, restarts int32) v1.Pod {
+	return v1.Pod{
+		Status: v1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", State: state, Ready: state.Running != nil, RestartCount: restarts},
+			},
+		},
+	}
+}
@@ -32,7 +32,8 @@ func TestPodHydrate(t *testing.T) {
 		"10.44.0.229",
 		"gke-k9s-default-pool-0fa2fb89-lbtf",
 		"GA",
-	}, rr[0].Fields[:len(rr[0].Fields)-1])
+	}, rr[0].Fields[:len(rr[0].Fields)-6])
+	assert.Equal(t, render.Fields{"n/a", "n/a", "default", "false", "ClusterFirst"}, rr[0].Fields[len(rr[0].Fields)-5:])
 }
 
 func BenchmarkPodHydrate(b *testing.B) {
@@ -3,8 +3,10 @@ package model
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
@@ -64,7 +66,7 @@ func (p *Policy) loadClusterRoleBinding(kind, name string) (render.Policies, err
 	var nn []string
 	for _, crb := range crbs {
 		for _, s := range crb.Subjects {
-			if s.Kind == kind && s.Name == name {
+			if isSubject(kind, name, s) {
 				nn = append(nn, crb.RoleRef.Name)
 			}
 		}
@@ -118,6 +120,19 @@ func (p *Policy) loadRoleBinding(kind, name string) (render.Policies, error) {
 	return rows, nil
 }
 
+// isSubject checks if a binding subject matches. Service accounts may be
+// qualified with their namespace ie ns/name.
+func isSubject(kind, name string, s rbacv1.Subject) bool {
+	if s.Kind != kind {
+		return false
+	}
+	if kind == rbacv1.ServiceAccountKind && strings.Contains(name, "/") {
+		return client.FQN(s.Namespace, s.Name) == name
+	}
+
+	return s.Name == name
+}
+
 func fetchClusterRoleBindings(f dao.Factory) ([]rbacv1.ClusterRoleBinding, error) {
 	oo, err := f.List(crbGVR, render.ClusterScope, true, labels.Everything())
 	if err != nil {
@@ -162,7 +177,7 @@ func (p *Policy) fetchRoleBindingSubjects(kind, name string) ([]string, error) {
 	ss := make([]string, 0, len(rbs))
 	for _, rb := range rbs {
 		for _, s := range rb.Subjects {
-			if s.Kind == kind && s.Name == name {
+			if isSubject(kind, name, s) {
 				ss = append(ss, rb.RoleRef.Kind+":"+rb.Name)
 			}
 		}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestIsSubject(t *testing.T) {
+	uu := map[string]struct {
+		kind, name string
+		s          rbacv1.Subject
+		e          bool
+	}{
+		"user": {
+			kind: "User", name: "fred",
+			s: rbacv1.Subject{Kind: "User", Name: "fred"},
+			e: true,
+		},
+		"kind": {
+			kind: "User", name: "fred",
+			s: rbacv1.Subject{Kind: "Group", Name: "fred"},
+		},
+		"sa": {
+			kind: "ServiceAccount", name: "default",
+			s: rbacv1.Subject{Kind: "ServiceAccount", Namespace: "ns1", Name: "default"},
+			e: true,
+		},
+		"saFQN": {
+			kind: "ServiceAccount", name: "ns1/default",
+			s: rbacv1.Subject{Kind: "ServiceAccount", Namespace: "ns1", Name: "default"},
+			e: true,
+		},
+		"saOtherNS": {
+			kind: "ServiceAccount", name: "ns2/default",
+			s: rbacv1.Subject{Kind: "ServiceAccount", Namespace: "ns1", Name: "default"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isSubject(u.kind, u.name, u.s))
+		})
+	}
+}
@@ -4,14 +4,21 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// benchTrendRuns is the number of benchmark runs shown in latency trends.
+const benchTrendRuns = 10
+
 // PortForward represents a portforward model.
 type PortForward struct {
 	Resource
@@ -24,20 +31,24 @@ func (c *PortForward) List(ctx context.Context) ([]runtime.Object, error) {
 		return nil, fmt.Errorf("no benchconfig found in context")
 	}
 
-	cc := config.Benchmarks.Containers
-	oo := make([]runtime.Object, 0, len(c.factory.Forwarders()))
-	for _, f := range c.factory.Forwarders() {
-		cfg := render.BenchCfg{
-			C: config.Benchmarks.Defaults.C,
-			N: config.Benchmarks.Defaults.N,
-		}
-		if config, ok := cc[containerID(f.Path(), f.Container())]; ok {
-			cfg.C, cfg.N = config.C, config.N
-			cfg.Host, cfg.Path = config.HTTP.Host, config.HTTP.Path
-		}
+	managed, _ := ctx.Value(internal.KeyForwards).(*dao.ManagedForwards)
+	trends := benchTrends(ctx)
+	ff := c.factory.Forwarders().List()
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		oo = append(oo, render.ForwardRes{
+			Forwarder: f,
+			Config:    benchCfgFor(config, f),
+			Managed:   managed.Managed(f.Path()),
+			Latencies: trends[containerID(f.Path(), f.Container())],
+		})
+	}
+	for _, f := range managed.Failures() {
 		oo = append(oo, render.ForwardRes{
 			Forwarder: f,
-			Config:    cfg,
+			Config:    benchCfgFor(config, f),
+			Managed:   true,
+			Err:       f.Err.Error(),
 		})
 	}
 
@@ -47,6 +58,47 @@ func (c *PortForward) List(ctx context.Context) ([]runtime.Object, error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// benchTrends returns the p99 latencies of the last benchmark runs keyed by
+// container ID so the trends carry over pod restarts.
+func benchTrends(ctx context.Context) map[string][]time.Duration {
+	h, ok := ctx.Value(internal.KeyBenchHistory).(*perf.History)
+	if !ok {
+		return nil
+	}
+	cluster, _ := ctx.Value(internal.KeyCluster).(string)
+	tt, err := h.Trends(cluster, benchTrendRuns, benchTarget)
+	if err != nil {
+		log.Warn().Err(err).Msg("Benchmark trends")
+		return nil
+	}
+
+	return tt
+}
+
+// benchTarget maps a benchmark name to its container ID. Benchmarks are named
+// after the port-forward path ie ns/po:co.
+func benchTarget(name string) string {
+	i := strings.LastIndex(name, ":")
+	if i == -1 {
+		return name
+	}
+
+	return containerID(name, name[i+1:])
+}
+
+func benchCfgFor(config *config.Bench, f render.Forwarder) render.BenchCfg {
+	cfg := render.BenchCfg{
+		C: config.Benchmarks.Defaults.C,
+		N: config.Benchmarks.Defaults.N,
+	}
+	if config, ok := config.Benchmarks.Containers[containerID(f.Path(), f.Container())]; ok {
+		cfg.C, cfg.N = config.C, config.N
+		cfg.Host, cfg.Path = config.HTTP.Host, config.HTTP.Path
+	}
+
+	return cfg
+}
+
 // ContainerID computes container ID based on ns/po/co.
 func containerID(path, co string) string {
 	ns, n := client.Namespaced(path)
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchTarget(t *testing.T) {
+	uu := map[string]struct {
+		name, e string
+	}{
+		"pod":     {name: "default/fred-6c4bc8b5b-xk2lp:nginx", e: "default/fred:nginx"},
+		"restart": {name: "default/fred-6c4bc8b5b-zz9qq:nginx", e: "default/fred:nginx"},
+		"url":     {name: "fred", e: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, benchTarget(u.name))
+		})
+	}
+}
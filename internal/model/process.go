@@ -0,0 +1,41 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Process represents a container processes model.
+type Process struct {
+	Resource
+}
+
+// List returns a collection of processes running in a given container.
+func (p *Process) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", p.gvr)
+	}
+	tokens := strings.Split(path, ":")
+	if len(tokens) != 2 {
+		return nil, fmt.Errorf("expecting a pod:container path but got %q", path)
+	}
+
+	pp, err := dao.TopProcesses(dao.NewRemoteExecutor(p.factory.Client()), tokens[0], tokens[1])
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, pr := range pp {
+		oo = append(oo, render.ProcessRes(pr))
+	}
+
+	return oo, nil
+}
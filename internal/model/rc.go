@@ -0,0 +1,31 @@
+package model
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReplicationController represents a replication controller model flagging
+// orphaned and stale versions.
+type ReplicationController struct {
+	Resource
+}
+
+// List returns a collection of replication controllers and their audit state.
+func (r *ReplicationController) List(ctx context.Context) ([]runtime.Object, error) {
+	oo, err := r.Resource.List(ctx)
+	if err != nil {
+		return oo, err
+	}
+
+	audit, err := dao.NewReplicationControllerAudit(r.factory, r.namespace)
+	if err != nil {
+		log.Warn().Err(err).Msgf("ReplicationController audit unavailable for %s", r.gvr)
+		return oo, nil
+	}
+
+	return withAudit(ctx, oo, audit.State)
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ref represents a secret or configmap consumers model.
+type Ref struct {
+	Resource
+}
+
+// List returns the consumers of a secret or configmap. The namespace is
+// scanned once per refresh.
+func (r *Ref) List(ctx context.Context) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context gvr for %q", r.gvr)
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", r.gvr)
+	}
+
+	ns, _ := client.Namespaced(path)
+	idx, err := dao.NewRefIndex(r.factory, ns)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := idx.Refs(gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, ref := range rr {
+		oo = append(oo, render.RefRes{
+			ID:     ref.ID(),
+			Kind:   ref.Kind,
+			Path:   ref.Path,
+			Type:   ref.Type,
+			Detail: ref.Detail,
+		})
+	}
+
+	return oo, nil
+}
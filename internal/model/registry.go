@@ -12,6 +12,34 @@ var Registry = map[string]ResourceMeta{
 		Model:    &Container{},
 		Renderer: &render.Container{},
 	},
+	"envvars": {
+		Model:    &EnvVar{},
+		Renderer: &render.EnvVar{},
+	},
+	"processes": {
+		Model:    &Process{},
+		Renderer: &render.Process{},
+	},
+	"dscoverage": {
+		Model:    &NodeCoverage{},
+		Renderer: &render.NodeCoverage{},
+	},
+	"jobpods": {
+		Model:    &JobPod{},
+		Renderer: &render.JobPod{},
+	},
+	"audits": {
+		Model:    &Audit{},
+		Renderer: &render.Audit{},
+	},
+	"ingbackends": {
+		Model:    &IngressBackend{},
+		Renderer: &render.IngressBackend{},
+	},
+	"refs": {
+		Model:    &Ref{},
+		Renderer: &render.Ref{},
+	},
 	"contexts": {
 		Model:    &Context{},
 		Renderer: &render.Context{},
@@ -48,12 +76,26 @@ var Registry = map[string]ResourceMeta{
 		Model:    &Alias{},
 		Renderer: &render.Alias{},
 	},
+	"watches": {
+		Model:    &Watch{},
+		Renderer: &render.Watch{},
+	},
+	"snapshotdiffs": {
+		Model:    &SnapshotDiff{},
+		Renderer: &render.SnapshotDiff{},
+	},
+	"foreach": {
+		Model:    &Foreach{},
+		Renderer: &render.Foreach{},
+	},
 
 	// Core...
 	"v1/endpoints": {
+		Model:    &Endpoints{},
 		Renderer: &render.Endpoints{},
 	},
 	"v1/events": {
+		Model:    &Event{},
 		Renderer: &render.Event{},
 	},
 	"v1/pods": {
@@ -76,20 +118,28 @@ var Registry = map[string]ResourceMeta{
 
 	// Apps...
 	"apps/v1/deployments": {
+		Model:    &Drift{},
 		Renderer: &render.Deployment{},
 	},
 	"apps/v1/replicasets": {
+		Model:    &ReplicaSet{},
 		Renderer: &render.ReplicaSet{},
 	},
+	"v1/replicationcontrollers": {
+		Model:    &ReplicationController{},
+		Renderer: &render.ReplicationController{},
+	},
 	"apps/v1/statefulsets": {
 		Renderer: &render.StatefulSet{},
 	},
 	"apps/v1/daemonsets": {
+		Model:    &Drift{},
 		Renderer: &render.DaemonSet{},
 	},
 
 	// Extensions...
 	"extensions/v1beta1/daemonsets": {
+		Model:    &Drift{},
 		Renderer: &render.DaemonSet{},
 	},
 	"extensions/v1beta1/ingresses": {
@@ -139,6 +189,7 @@ var Registry = map[string]ResourceMeta{
 
 	// Policy...
 	"policy/v1beta1/poddisruptionbudgets": {
+		Model:    &PodDisruptionBudget{},
 		Renderer: &render.PodDisruptionBudget{},
 	},
 
@@ -30,8 +30,8 @@ func (r *Resource) Get(ctx context.Context, path string) (runtime.Object, error)
 func (r *Resource) List(ctx context.Context) ([]runtime.Object, error) {
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
 	lsel := labels.Everything()
-	if sel, err := labels.ConvertSelectorToLabelsMap(strLabel); ok && err == nil {
-		lsel = sel.AsSelector()
+	if sel, err := labels.Parse(strLabel); ok && err == nil {
+		lsel = sel
 	}
 
 	return r.factory.List(r.gvr, r.namespace, true, lsel)
@@ -0,0 +1,173 @@
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RestartStorm represents a burst of restarts in a namespace.
+type RestartStorm struct {
+	Namespace string
+	Restarts  int
+	Window    time.Duration
+}
+
+type restart struct {
+	ns string
+	at time.Time
+	n  int
+}
+
+// RestartTracker tallies pods restarts over a sliding window. Restarts are
+// recorded as the pods informer delivers updates and pruned periodically so
+// checks stay cheap.
+type RestartTracker struct {
+	window    time.Duration
+	restarts  []restart
+	dismissed map[string]struct{}
+	informers map[cache.SharedIndexInformer]struct{}
+	mx        sync.Mutex
+}
+
+// NewRestartTracker returns a new tracker.
+func NewRestartTracker(window time.Duration) *RestartTracker {
+	return &RestartTracker{
+		window:    window,
+		dismissed: make(map[string]struct{}),
+		informers: make(map[cache.SharedIndexInformer]struct{}),
+	}
+}
+
+// Attach registers the tracker with a pods informer.
+func (r *RestartTracker) Attach(inf cache.SharedIndexInformer) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if _, ok := r.informers[inf]; ok {
+		return
+	}
+	r.informers[inf] = struct{}{}
+	inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: r.update,
+	})
+}
+
+func (r *RestartTracker) update(o, n interface{}) {
+	ou, ok1 := o.(*unstructured.Unstructured)
+	nu, ok2 := n.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		log.Error().Msgf("Expecting unstructured pods but got %T", n)
+		return
+	}
+	if delta := podRestarts(nu) - podRestarts(ou); delta > 0 {
+		r.Record(nu.GetNamespace(), time.Now(), delta)
+	}
+}
+
+// Record tracks restarts in a namespace.
+func (r *RestartTracker) Record(ns string, at time.Time, n int) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.restarts = append(r.restarts, restart{ns: ns, at: at, n: n})
+}
+
+// Prune drops restarts past the window.
+func (r *RestartTracker) Prune(now time.Time) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.prune(now)
+}
+
+func (r *RestartTracker) prune(now time.Time) {
+	cutoff := now.Add(-r.window)
+	var i int
+	for i < len(r.restarts) && !r.restarts[i].at.After(cutoff) {
+		i++
+	}
+	if i > 0 {
+		r.restarts = append(r.restarts[:0], r.restarts[i:]...)
+	}
+}
+
+// Count returns the number of restarts within the window in a namespace.
+func (r *RestartTracker) Count(ns string, now time.Time) int {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.prune(now)
+	return r.count(ns)
+}
+
+func (r *RestartTracker) count(ns string) int {
+	var total int
+	for _, re := range r.restarts {
+		if ns == render.AllNamespaces || ns == render.NamespaceAll || re.ns == ns {
+			total += re.n
+		}
+	}
+
+	return total
+}
+
+// Check returns the restart storm in a namespace if more than threshold
+// restarts occurred within the window. A dismissed storm stays quiet until
+// restarts fall back under the threshold.
+func (r *RestartTracker) Check(ns string, threshold int, now time.Time) (RestartStorm, bool) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.prune(now)
+	s := RestartStorm{Namespace: ns, Restarts: r.count(ns), Window: r.window}
+	if s.Restarts <= threshold {
+		delete(r.dismissed, ns)
+		return s, false
+	}
+	if _, ok := r.dismissed[ns]; ok {
+		return s, false
+	}
+
+	return s, true
+}
+
+// Dismiss silences the current storm in a namespace.
+func (r *RestartTracker) Dismiss(ns string) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.dismissed[ns] = struct{}{}
+}
+
+// Reset clears all restarts and informers, ie on context switch.
+func (r *RestartTracker) Reset() {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.restarts = nil
+	r.dismissed = make(map[string]struct{})
+	r.informers = make(map[cache.SharedIndexInformer]struct{})
+}
+
+func podRestarts(u *unstructured.Unstructured) int {
+	var total int
+	for _, field := range []string{"initContainerStatuses", "containerStatuses"} {
+		ss, _, _ := unstructured.NestedSlice(u.Object, "status", field)
+		for _, s := range ss {
+			m, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if n, ok, _ := unstructured.NestedInt64(m, "restartCount"); ok {
+				total += int(n)
+			}
+		}
+	}
+
+	return total
+}
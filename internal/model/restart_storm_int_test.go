@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRestartTrackerUpdate(t *testing.T) {
+	r := NewRestartTracker(5 * time.Minute)
+	r.update(makeRestartPod(1, 2), makeRestartPod(1, 5))
+	r.update(makeRestartPod(1, 5), makeRestartPod(1, 5))
+	// Recreated pods reset their counts and must not count as restarts.
+	r.update(makeRestartPod(1, 5), makeRestartPod(0, 0))
+
+	assert.Equal(t, 3, r.count("ns1"))
+}
+
+func TestPodRestarts(t *testing.T) {
+	assert.Equal(t, 7, podRestarts(makeRestartPod(3, 4)))
+	assert.Equal(t, 0, podRestarts(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+}
+
+// Helpers...
+
+func makeRestartPod(initCount, count int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"namespace": "ns1",
+				"name":      "p1",
+			},
+			"status": map[string]interface{}{
+				"initContainerStatuses": []interface{}{
+					map[string]interface{}{"name": "i1", "restartCount": initCount},
+				},
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"name": "c1", "restartCount": count},
+				},
+			},
+		},
+	}
+}
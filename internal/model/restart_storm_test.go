@@ -0,0 +1,66 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartTrackerCount(t *testing.T) {
+	now := time.Now()
+	r := model.NewRestartTracker(5 * time.Minute)
+	r.Record("ns1", now.Add(-10*time.Minute), 4)
+	r.Record("ns1", now.Add(-2*time.Minute), 2)
+	r.Record("ns2", now.Add(-time.Minute), 3)
+	r.Record("ns1", now, 1)
+
+	uu := map[string]struct {
+		ns string
+		e  int
+	}{
+		"ns1":      {ns: "ns1", e: 3},
+		"ns2":      {ns: "ns2", e: 3},
+		"none":     {ns: "ns3", e: 0},
+		"all":      {ns: "all", e: 6},
+		"allBlank": {ns: "", e: 6},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, r.Count(u.ns, now))
+		})
+	}
+}
+
+func TestRestartTrackerCheck(t *testing.T) {
+	now := time.Now()
+	r := model.NewRestartTracker(5 * time.Minute)
+	r.Record("ns1", now.Add(-time.Minute), 6)
+
+	_, ok := r.Check("ns1", 10, now)
+	assert.False(t, ok)
+
+	r.Record("ns1", now, 5)
+	s, ok := r.Check("ns1", 10, now)
+	assert.True(t, ok)
+	assert.Equal(t, model.RestartStorm{Namespace: "ns1", Restarts: 11, Window: 5 * time.Minute}, s)
+
+	r.Dismiss("ns1")
+	r.Record("ns1", now, 2)
+	_, ok = r.Check("ns1", 10, now)
+	assert.False(t, ok)
+
+	// Once the burst falls out of the window, the next one raises again.
+	later := now.Add(6 * time.Minute)
+	_, ok = r.Check("ns1", 10, later)
+	assert.False(t, ok)
+	r.Record("ns1", later, 12)
+	_, ok = r.Check("ns1", 10, later)
+	assert.True(t, ok)
+
+	r.Reset()
+	assert.Equal(t, 0, r.Count("ns1", later))
+}
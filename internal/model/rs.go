@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReplicaSet represents a replicaset model flagging orphaned and stale revisions.
+type ReplicaSet struct {
+	Resource
+}
+
+// List returns a collection of replicasets and their audit state.
+func (r *ReplicaSet) List(ctx context.Context) ([]runtime.Object, error) {
+	oo, err := r.Resource.List(ctx)
+	if err != nil {
+		return oo, err
+	}
+
+	audit, err := dao.NewReplicaSetAudit(r.factory, r.namespace)
+	if err != nil {
+		log.Warn().Err(err).Msgf("ReplicaSet audit unavailable for %s", r.gvr)
+		return oo, nil
+	}
+
+	return withAudit(ctx, oo, audit.State)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// withAudit decorates controllers with their audit state. Only the flagged
+// ones are kept when the orphans filter is on.
+func withAudit(ctx context.Context, oo []runtime.Object, state func(*unstructured.Unstructured) string) ([]runtime.Object, error) {
+	only, _ := ctx.Value(internal.KeyOrphans).(bool)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		s := state(u)
+		if only && s == "" {
+			continue
+		}
+		res = append(res, &render.ControllerWithAudit{Raw: u, State: s})
+	}
+
+	return res, nil
+}
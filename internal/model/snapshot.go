@@ -0,0 +1,170 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SnapshotDiff represents a view bookmark compared against live data.
+type SnapshotDiff struct {
+	Resource
+}
+
+// List returns the rows that changed since the bookmark was taken.
+func (s *SnapshotDiff) List(ctx context.Context) ([]runtime.Object, error) {
+	snap, ok := ctx.Value(internal.KeySnapshot).(Snapshot)
+	if !ok {
+		return nil, errors.New("no snapshot found in context")
+	}
+
+	t := NewTable(snap.GVR)
+	t.SetNamespace(snap.Namespace)
+	ctx = context.WithValue(ctx, internal.KeyGVR, snap.GVR)
+	ctx = context.WithValue(ctx, internal.KeyPath, "")
+	if err := t.Reconcile(ctx); err != nil {
+		return nil, err
+	}
+
+	dd := snap.Diff(t.Peek())
+	oo := make([]runtime.Object, 0, len(dd))
+	for _, d := range dd {
+		oo = append(oo, d)
+	}
+
+	return oo, nil
+}
+
+// Snapshot represents a view table data captured at a point in time.
+type Snapshot struct {
+	GVR       string
+	Namespace string
+	Taken     time.Time
+	Header    render.HeaderRow
+	Rows      map[string]render.Row
+}
+
+// NewSnapshot captures the given table data.
+func NewSnapshot(gvr string, data render.TableData) Snapshot {
+	if data.Mutex != nil {
+		data.Mutex.RLock()
+		defer data.Mutex.RUnlock()
+	}
+
+	s := Snapshot{
+		GVR:       gvr,
+		Namespace: data.Namespace,
+		Taken:     time.Now(),
+		Header:    data.Header.Clone(),
+		Rows:      make(map[string]render.Row, len(data.RowEvents)),
+	}
+	for _, re := range data.RowEvents {
+		s.Rows[re.Row.ID] = re.Row.Clone()
+	}
+
+	return s
+}
+
+// ID returns the snapshot identifier.
+func (s Snapshot) ID() string {
+	return snapshotID(s.GVR, s.Namespace)
+}
+
+// Diff compares the snapshot against the given table data. Age columns are
+// ignored since they always change.
+func (s Snapshot) Diff(data render.TableData) []render.SnapshotDelta {
+	if data.Mutex != nil {
+		data.Mutex.RLock()
+		defer data.Mutex.RUnlock()
+	}
+
+	dd := make([]render.SnapshotDelta, 0, len(data.RowEvents))
+	seen := make(map[string]struct{}, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		seen[re.Row.ID] = struct{}{}
+		old, ok := s.Rows[re.Row.ID]
+		if !ok {
+			dd = append(dd, render.SnapshotDelta{ID: re.Row.ID, State: render.SnapshotAdded})
+			continue
+		}
+		if cc := s.changes(old, data.Header, re.Row); len(cc) > 0 {
+			dd = append(dd, render.SnapshotDelta{ID: re.Row.ID, State: render.SnapshotChanged, Changes: cc})
+		}
+	}
+	for id := range s.Rows {
+		if _, ok := seen[id]; !ok {
+			dd = append(dd, render.SnapshotDelta{ID: id, State: render.SnapshotRemoved})
+		}
+	}
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].ID < dd[j].ID
+	})
+
+	return dd
+}
+
+// changes lists the cells that differ. Columns are matched by name so
+// toggled wide columns are not reported as changes.
+func (s Snapshot) changes(old render.Row, h render.HeaderRow, r render.Row) []render.CellChange {
+	var cc []render.CellChange
+	for i, col := range h {
+		if h.AgeCol(i) || i >= len(r.Fields) {
+			continue
+		}
+		idx := s.Header.IndexOf(col.Name)
+		if idx < 0 || idx >= len(old.Fields) {
+			continue
+		}
+		if old.Fields[idx] != r.Fields[i] {
+			cc = append(cc, render.CellChange{Column: col.Name, Before: old.Fields[idx], After: r.Fields[i]})
+		}
+	}
+
+	return cc
+}
+
+func snapshotID(gvr, ns string) string {
+	return gvr + ":" + ns
+}
+
+// Snapshots tracks view bookmarks for the session, one per view.
+type Snapshots struct {
+	snaps map[string]Snapshot
+	mx    sync.RWMutex
+}
+
+// NewSnapshots returns a new bookmarks store.
+func NewSnapshots() *Snapshots {
+	return &Snapshots{snaps: make(map[string]Snapshot)}
+}
+
+// Set records a snapshot, replacing any prior snapshot of the same view.
+func (s *Snapshots) Set(snap Snapshot) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.snaps[snap.ID()] = snap
+}
+
+// Get returns the snapshot for a given view.
+func (s *Snapshots) Get(gvr, ns string) (Snapshot, bool) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	snap, ok := s.snaps[snapshotID(gvr, ns)]
+	return snap, ok
+}
+
+// Clear removes all snapshots.
+func (s *Snapshots) Clear() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.snaps = make(map[string]Snapshot)
+}
@@ -0,0 +1,71 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotDiff(t *testing.T) {
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE"}}
+	snap := model.NewSnapshot("v1/pods", snapshotData(h,
+		render.Row{ID: "ns1/a", Fields: render.Fields{"a", "Running", "1m"}},
+		render.Row{ID: "ns1/b", Fields: render.Fields{"b", "Running", "1m"}},
+		render.Row{ID: "ns1/c", Fields: render.Fields{"c", "Running", "1m"}},
+	))
+
+	dd := snap.Diff(snapshotData(h,
+		render.Row{ID: "ns1/a", Fields: render.Fields{"a", "Running", "2m"}},
+		render.Row{ID: "ns1/b", Fields: render.Fields{"b", "Failed", "2m"}},
+		render.Row{ID: "ns1/d", Fields: render.Fields{"d", "Pending", "1s"}},
+	))
+
+	assert.Equal(t, []render.SnapshotDelta{
+		{ID: "ns1/b", State: render.SnapshotChanged, Changes: []render.CellChange{{Column: "STATUS", Before: "Running", After: "Failed"}}},
+		{ID: "ns1/c", State: render.SnapshotRemoved},
+		{ID: "ns1/d", State: render.SnapshotAdded},
+	}, dd)
+}
+
+func TestSnapshotDiffColumns(t *testing.T) {
+	snap := model.NewSnapshot("v1/pods", snapshotData(
+		render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE"}},
+		render.Row{ID: "ns1/a", Fields: render.Fields{"a", "Running", "1m"}},
+	))
+
+	dd := snap.Diff(snapshotData(
+		render.HeaderRow{{Name: "NAME"}, {Name: "IP", Wide: true}, {Name: "STATUS"}, {Name: "AGE"}},
+		render.Row{ID: "ns1/a", Fields: render.Fields{"a", "10.0.0.1", "Running", "2m"}},
+	))
+
+	assert.Equal(t, 0, len(dd))
+}
+
+func TestSnapshots(t *testing.T) {
+	s := model.NewSnapshots()
+	s.Set(model.NewSnapshot("v1/pods", render.TableData{Namespace: "ns1"}))
+
+	_, ok := s.Get("v1/pods", "ns1")
+	assert.True(t, ok)
+	_, ok = s.Get("v1/pods", "ns2")
+	assert.False(t, ok)
+
+	s.Clear()
+	_, ok = s.Get("v1/pods", "ns1")
+	assert.False(t, ok)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func snapshotData(h render.HeaderRow, rr ...render.Row) render.TableData {
+	data := render.NewTableData()
+	data.Header, data.Namespace = h, "ns1"
+	for _, r := range rr {
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventAdd, r))
+	}
+
+	return *data
+}
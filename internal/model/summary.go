@@ -0,0 +1,32 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Summarizer extracts the most useful fields of a resource.
+type Summarizer func(*unstructured.Unstructured) (render.Summary, error)
+
+// Summarizers tracks resource summarizers by GVR.
+var Summarizers = map[string]Summarizer{
+	"v1/pods":             render.PodSummary,
+	"apps/v1/deployments": render.DeploymentSummary,
+}
+
+// Summarize returns a resource summary. Resources without a dedicated
+// summarizer show their labels and creation time.
+func Summarize(gvr string, o runtime.Object) (render.Summary, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	if s, ok := Summarizers[gvr]; ok {
+		return s(u)
+	}
+
+	return render.GenericSummary(u)
+}
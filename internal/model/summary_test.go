@@ -0,0 +1,47 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSummarize(t *testing.T) {
+	uu := map[string]struct {
+		gvr   string
+		o     *unstructured.Unstructured
+		names []string
+	}{
+		"pod": {
+			gvr:   "v1/pods",
+			o:     load(t, "p1"),
+			names: []string{"Status", "Node", "IP", "Service Account", "QoS", "Controller", "Started", "Conditions"},
+		},
+		"generic": {
+			gvr:   "v1/configmaps",
+			o:     load(t, "p1"),
+			names: []string{"Labels", "Created"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := model.Summarize(u.gvr, u.o)
+			assert.Nil(t, err)
+			names := make([]string, 0, len(s))
+			for _, f := range s {
+				names = append(names, f.Name)
+			}
+			assert.Equal(t, u.names, names)
+		})
+	}
+}
+
+func TestSummarizeBadObject(t *testing.T) {
+	_, err := model.Summarize("v1/pods", &render.SnapshotDelta{})
+	assert.NotNil(t, err)
+}
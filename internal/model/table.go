@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -18,8 +19,16 @@ type TableListener interface {
 	// TableDataChanged notifies the model data changed.
 	TableDataChanged(render.TableData)
 
-	// TableLoadFailed notifies the load failed.
-	TableLoadFailed(error)
+	// TableLoadFailed notifies the load failed. Stale tracks how long ago the
+	// data was last refreshed or zero if it never loaded.
+	TableLoadFailed(err error, stale time.Duration)
+}
+
+// TablePauseListener represents a table model listener tracking pauses.
+type TablePauseListener interface {
+	// TablePaused notifies the model skipped a refresh while paused. Age
+	// tracks how long ago the data was last refreshed.
+	TablePaused(age time.Duration)
 }
 
 // Table represents a table model.
@@ -30,6 +39,9 @@ type Table struct {
 	listeners   []TableListener
 	inUpdate    int32
 	refreshRate time.Duration
+	lastLoad    time.Time
+	paused      bool
+	mx          sync.RWMutex
 }
 
 // NewTable returns a new table model.
@@ -41,9 +53,12 @@ func NewTable(gvr string) *Table {
 	}
 }
 
-// Watch initiates model updates.
+// Watch initiates model updates. A paused model keeps its data unless it
+// has none yet.
 func (t *Table) Watch(ctx context.Context) {
-	t.Refresh(ctx)
+	if !t.IsPaused() || t.Empty() {
+		t.Refresh(ctx)
+	}
 	go t.updater(ctx)
 }
 
@@ -59,11 +74,47 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 	return meta.Model.Get(ctx, path)
 }
 
-// Refresh update the model now.
+// Refresh update the model now, even when paused.
 func (t *Table) Refresh(ctx context.Context) {
 	t.refresh(ctx)
 }
 
+// Pause holds off periodic updates until resumed.
+func (t *Table) Pause() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.paused = true
+}
+
+// Resume restarts periodic updates.
+func (t *Table) Resume() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.paused = false
+}
+
+// IsPaused checks if periodic updates are on hold.
+func (t *Table) IsPaused() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.paused
+}
+
+// Age returns how long ago the data was last refreshed or zero if it never
+// loaded.
+func (t *Table) Age() time.Duration {
+	return t.staleness()
+}
+
+// Reconcile hydrates the model data now. Unlike Refresh, listeners are not
+// notified and failures are returned to the caller.
+func (t *Table) Reconcile(ctx context.Context) error {
+	return t.reconcile(ctx)
+}
+
 // GetNamespace returns the model namespace.
 func (t *Table) GetNamespace() string {
 	return t.namespace
@@ -73,6 +124,7 @@ func (t *Table) GetNamespace() string {
 func (t *Table) SetNamespace(ns string) {
 	t.namespace = ns
 	t.data.Clear()
+	t.setLastLoad(time.Time{})
 }
 
 // SetRefreshRate sets model refresh duration.
@@ -107,6 +159,10 @@ func (t *Table) updater(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-time.After(t.refreshRate):
+			if t.IsPaused() {
+				t.fireTablePaused(t.staleness())
+				continue
+			}
 			t.refresh(ctx)
 		}
 	}
@@ -122,9 +178,10 @@ func (t *Table) refresh(ctx context.Context) {
 
 	if err := t.reconcile(ctx); err != nil {
 		log.Error().Err(err).Msg("Reconcile failed")
-		t.fireTableLoadFailed(err)
+		t.fireTableLoadFailed(err, t.staleness())
 		return
 	}
+	t.setLastLoad(time.Now())
 	t.fireTableChanged(*t.data)
 }
 
@@ -154,12 +211,38 @@ func (t *Table) fireTableChanged(data render.TableData) {
 	}
 }
 
-func (t *Table) fireTableLoadFailed(err error) {
+func (t *Table) fireTableLoadFailed(err error, stale time.Duration) {
 	for _, l := range t.listeners {
-		l.TableLoadFailed(err)
+		l.TableLoadFailed(err, stale)
 	}
 }
 
+func (t *Table) fireTablePaused(age time.Duration) {
+	for _, l := range t.listeners {
+		if pl, ok := l.(TablePauseListener); ok {
+			pl.TablePaused(age)
+		}
+	}
+}
+
+func (t *Table) setLastLoad(at time.Time) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.lastLoad = at
+}
+
+func (t *Table) staleness() time.Duration {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.lastLoad.IsZero() {
+		return 0
+	}
+
+	return time.Since(t.lastLoad)
+}
+
 func (t *Table) list(ctx context.Context, l Lister) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -199,7 +282,7 @@ func (t *Table) reconcile(ctx context.Context) error {
 	log.Debug().Msgf("LIST returned %d rows", len(oo))
 
 	rows := make(render.Rows, len(oo))
-	if err := meta.Model.Hydrate(oo, rows, meta.Renderer); err != nil {
+	if err := meta.Model.Hydrate(oo, rows, stampRenderer{meta.Renderer}); err != nil {
 		return err
 	}
 
@@ -216,3 +299,15 @@ func (t *Table) reconcile(ctx context.Context) error {
 
 	return nil
 }
+
+// stampRenderer records when rows are rendered so relative times can be
+// shown as absolute timestamps.
+type stampRenderer struct {
+	Renderer
+}
+
+// Render renders a K8s resource to screen.
+func (s stampRenderer) Render(o interface{}, ns string, r *render.Row) error {
+	r.Stamp = time.Now()
+	return s.Renderer.Render(o, ns, r)
+}
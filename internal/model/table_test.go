@@ -0,0 +1,190 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTableRefreshStale(t *testing.T) {
+	const gvr = "test/flaky"
+	l := flakyLister{fail: []bool{false, true, true, false}}
+	model.Registry[gvr] = model.ResourceMeta{Model: &l, Renderer: &render.Alias{}}
+	defer delete(model.Registry, gvr)
+
+	ta := model.NewTable(gvr)
+	var lis tableListener
+	ta.AddListener(&lis)
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, makeFactory())
+
+	ta.Refresh(ctx)
+	assert.Equal(t, 1, lis.changed)
+	assert.Nil(t, lis.err)
+	assert.Equal(t, 1, len(ta.Peek().RowEvents))
+
+	time.Sleep(10 * time.Millisecond)
+	ta.Refresh(ctx)
+	assert.Equal(t, 1, lis.changed)
+	assert.NotNil(t, lis.err)
+	assert.True(t, lis.stale >= 10*time.Millisecond)
+	assert.Equal(t, 1, len(ta.Peek().RowEvents))
+
+	stale := lis.stale
+	ta.Refresh(ctx)
+	assert.Equal(t, 1, lis.changed)
+	assert.True(t, lis.stale > stale)
+
+	ta.Refresh(ctx)
+	assert.Equal(t, 2, lis.changed)
+	assert.Equal(t, 2, lis.failed)
+}
+
+func TestTableRefreshNeverLoaded(t *testing.T) {
+	const gvr = "test/flaky"
+	l := flakyLister{fail: []bool{true}}
+	model.Registry[gvr] = model.ResourceMeta{Model: &l, Renderer: &render.Alias{}}
+	defer delete(model.Registry, gvr)
+
+	ta := model.NewTable(gvr)
+	var lis tableListener
+	ta.AddListener(&lis)
+	ta.Refresh(context.WithValue(context.Background(), internal.KeyFactory, makeFactory()))
+
+	assert.Equal(t, 1, lis.failed)
+	assert.Equal(t, time.Duration(0), lis.stale)
+}
+
+func TestTablePause(t *testing.T) {
+	const gvr = "test/paused"
+	var l flakyLister
+	model.Registry[gvr] = model.ResourceMeta{Model: &l, Renderer: &render.Alias{}}
+	defer delete(model.Registry, gvr)
+
+	ta := model.NewTable(gvr)
+	ta.SetRefreshRate(5 * time.Millisecond)
+	var lis pauseListener
+	ta.AddListener(&lis)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), internal.KeyFactory, makeFactory()))
+	defer cancel()
+
+	ta.Pause()
+	assert.True(t, ta.IsPaused())
+	// Paused models still load their initial data.
+	ta.Watch(ctx)
+	assert.Equal(t, 1, lis.count())
+
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, 1, lis.count())
+	assert.True(t, lis.pausedCount() > 0)
+	assert.True(t, ta.Age() >= 30*time.Millisecond)
+
+	// Forced refreshes go through while paused.
+	ta.Refresh(ctx)
+	assert.Equal(t, 2, lis.count())
+	assert.True(t, ta.Age() < 30*time.Millisecond)
+	assert.True(t, ta.IsPaused())
+
+	ta.Resume()
+	time.Sleep(30 * time.Millisecond)
+	assert.False(t, ta.IsPaused())
+	assert.True(t, lis.count() > 2)
+}
+
+func TestTableWatchPaused(t *testing.T) {
+	const gvr = "test/paused"
+	var l flakyLister
+	model.Registry[gvr] = model.ResourceMeta{Model: &l, Renderer: &render.Alias{}}
+	defer delete(model.Registry, gvr)
+
+	ta := model.NewTable(gvr)
+	ta.SetRefreshRate(time.Hour)
+	var lis pauseListener
+	ta.AddListener(&lis)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), internal.KeyFactory, makeFactory()))
+	defer cancel()
+
+	ta.Watch(ctx)
+	ta.Pause()
+	// Rewatching a paused model keeps the displayed data.
+	ta.Watch(ctx)
+	assert.Equal(t, 1, lis.count())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type tableListener struct {
+	changed, failed int
+	err             error
+	stale           time.Duration
+}
+
+func (l *tableListener) TableDataChanged(render.TableData) {
+	l.changed++
+	l.err, l.stale = nil, 0
+}
+
+func (l *tableListener) TableLoadFailed(err error, stale time.Duration) {
+	l.failed++
+	l.err, l.stale = err, stale
+}
+
+type pauseListener struct {
+	changed, paused int
+	mx              sync.Mutex
+}
+
+func (l *pauseListener) TableDataChanged(render.TableData) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	l.changed++
+}
+
+func (l *pauseListener) TableLoadFailed(error, time.Duration) {}
+
+func (l *pauseListener) TablePaused(time.Duration) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	l.paused++
+}
+
+func (l *pauseListener) count() int {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	return l.changed
+}
+
+func (l *pauseListener) pausedCount() int {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	return l.paused
+}
+
+// flakyLister fails its List calls per the given sequence.
+type flakyLister struct {
+	model.Resource
+
+	fail  []bool
+	calls int
+}
+
+func (f *flakyLister) Init(string, string, dao.Factory) {}
+
+func (f *flakyLister) List(context.Context) ([]runtime.Object, error) {
+	i := f.calls
+	f.calls++
+	if i < len(f.fail) && f.fail[i] {
+		return nil, errors.New("pods is forbidden")
+	}
+
+	return []runtime.Object{render.AliasRes{GVR: "v1/pods", Aliases: []string{"po"}}}, nil
+}
@@ -1,88 +1,47 @@
 package perf
 
 import (
-	"bytes"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"path/filepath"
-	"time"
+	"context"
 
-	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
-	"github.com/rakyll/hey/requester"
 	"github.com/rs/zerolog/log"
 )
 
-const (
-	benchFmat = "%s_%s_%d.txt"
-	k9sUA     = "k9s/"
-)
-
-// K9sBenchDir directory to store K9s Benchmark files.
-var K9sBenchDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-bench-%s", config.MustK9sUser()))
-
 // Benchmark puts a workload under load.
 type Benchmark struct {
 	canceled bool
+	result   Result
 	config   config.BenchConfig
-	worker   *requester.Work
+	base     string
+	ctx      context.Context
+	cancelFn context.CancelFunc
 }
 
 // NewBenchmark returns a new benchmark.
 func NewBenchmark(base, version string, cfg config.BenchConfig) (*Benchmark, error) {
-	b := Benchmark{config: cfg}
-	if err := b.init(base, version); err != nil {
+	ctx := context.WithValue(context.Background(), internal.KeyVersion, version)
+	if _, err := newRequest(ctx, base, cfg, nil); err != nil {
 		return nil, err
 	}
+	b := Benchmark{config: cfg, base: base}
+	b.ctx, b.cancelFn = context.WithCancel(ctx)
+
 	return &b, nil
 }
 
-func (b *Benchmark) init(base, version string) error {
-	req, err := http.NewRequest(b.config.HTTP.Method, base, nil)
-	if err != nil {
-		return err
-	}
-	log.Debug().Msgf("Benchmarking Request %s", req.URL.String())
-
-	if b.config.Auth.User != "" || b.config.Auth.Password != "" {
-		req.SetBasicAuth(b.config.Auth.User, b.config.Auth.Password)
-	}
-
-	req.Header = b.config.HTTP.Headers
-	ua := req.UserAgent()
-	if ua == "" {
-		ua = k9sUA
-	} else {
-		ua += " " + k9sUA
-	}
-	ua += version
-	if req.Header == nil {
-		req.Header = make(http.Header)
-	}
-	req.Header.Set("User-Agent", ua)
-
-	b.worker = &requester.Work{
-		Request:     req,
-		RequestBody: []byte(b.config.HTTP.Body),
-		N:           b.config.N,
-		C:           b.config.C,
-		H2:          b.config.HTTP.HTTP2,
-		Output:      "",
-	}
-
-	return nil
+// Name returns the benchmark name.
+func (b *Benchmark) Name() string {
+	return b.config.Name
 }
 
 // Cancel kills the benchmark in progress.
 func (b *Benchmark) Cancel() {
-	if b == nil {
+	if b == nil || b.canceled {
 		return
 	}
 	b.canceled = true
-	b.worker.Stop()
+	b.cancelFn()
 }
 
 // Canceled checks if the benchmark was canceled.
@@ -90,44 +49,22 @@ func (b *Benchmark) Canceled() bool {
 	return b.canceled
 }
 
-// Run starts a benchmark,
-func (b *Benchmark) Run(cluster string, done func()) {
-	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
-	b.worker.Run()
-	if !b.canceled {
-		if err := b.save(cluster, buff); err != nil {
-			log.Error().Err(err).Msg("Saving Benchmark")
-		}
-	}
-	done()
+// Result returns the outcome of the last run.
+func (b *Benchmark) Result() Result {
+	return b.result
 }
 
-func (b *Benchmark) save(cluster string, r io.Reader) error {
-	dir := filepath.Join(K9sBenchDir, cluster)
-	if err := os.MkdirAll(dir, 0744); err != nil {
-		return err
-	}
-
-	ns, n := client.Namespaced(b.config.Name)
-	file := filepath.Join(dir, fmt.Sprintf(benchFmat, ns, n, time.Now().UnixNano()))
-	f, err := os.Create(file)
+// Run starts a benchmark,
+func (b *Benchmark) Run(cluster string, done func()) {
+	r, err := Run(b.ctx, b.base, b.config, nil)
 	if err != nil {
-		return err
+		log.Error().Err(err).Msg("Running Benchmark")
 	}
-	defer func() {
-		if e := f.Close(); e != nil {
-			log.Fatal().Err(e).Msg("Bench save")
+	b.result = r
+	if err == nil && !r.Canceled {
+		if _, err := Save(cluster, r); err != nil {
+			log.Error().Err(err).Msg("Saving Benchmark")
 		}
-	}()
-
-	bb, err := ioutil.ReadAll(r)
-	if err != nil {
-		return err
 	}
-	if _, err := f.Write(bb); err != nil {
-		return err
-	}
-
-	return nil
+	done()
 }
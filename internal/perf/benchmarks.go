@@ -0,0 +1,145 @@
+package perf
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Benchmarks tracks benchmarks in flight keyed by name.
+type Benchmarks struct {
+	benches map[string]*Benchmark
+	mx      sync.Mutex
+}
+
+// NewBenchmarks returns a new benchmarks tracker.
+func NewBenchmarks() *Benchmarks {
+	return &Benchmarks{benches: make(map[string]*Benchmark)}
+}
+
+// Add registers a benchmark in flight.
+func (bb *Benchmarks) Add(b *Benchmark) {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	bb.benches[b.Name()] = b
+}
+
+// Remove unregisters a completed benchmark. A benchmark since started under
+// the same name is left alone.
+func (bb *Benchmarks) Remove(b *Benchmark) {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	if bb.benches[b.Name()] == b {
+		delete(bb.benches, b.Name())
+	}
+}
+
+// Count returns the number of benchmarks in flight.
+func (bb *Benchmarks) Count() int {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	return len(bb.benches)
+}
+
+// Running checks if a benchmark is in flight under the given name.
+func (bb *Benchmarks) Running(name string) bool {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	_, ok := bb.benches[name]
+	return ok
+}
+
+// Cancel cancels the benchmark in flight under the given name. It returns
+// false when no such benchmark is running.
+func (bb *Benchmarks) Cancel(name string) bool {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	b, ok := bb.benches[name]
+	if !ok {
+		return false
+	}
+	b.Cancel()
+	delete(bb.benches, name)
+
+	return true
+}
+
+// Busy checks if a benchmark is in flight.
+func (bb *Benchmarks) Busy() bool {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	return len(bb.benches) > 0
+}
+
+// Names returns the sorted names of the benchmarks in flight.
+func (bb *Benchmarks) Names() []string {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	nn := make([]string, 0, len(bb.benches))
+	for n := range bb.benches {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Targeting returns the sorted names of the benchmarks in flight against a
+// resource path or any of its containers.
+func (bb *Benchmarks) Targeting(path string) []string {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	nn := make([]string, 0, len(bb.benches))
+	for n := range bb.benches {
+		if targets(n, path) {
+			nn = append(nn, n)
+		}
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Kill cancels benchmarks in flight against a resource path or any of its
+// containers. It returns the number of benchmarks canceled.
+func (bb *Benchmarks) Kill(path string) int {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	var count int
+	for n, b := range bb.benches {
+		if targets(n, path) {
+			b.Cancel()
+			delete(bb.benches, n)
+			count++
+		}
+	}
+
+	return count
+}
+
+// CancelAll cancels all benchmarks in flight.
+func (bb *Benchmarks) CancelAll() {
+	bb.mx.Lock()
+	defer bb.mx.Unlock()
+
+	for n, b := range bb.benches {
+		b.Cancel()
+		delete(bb.benches, n)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func targets(name, path string) bool {
+	return name == path || strings.HasPrefix(name, path+":")
+}
@@ -0,0 +1,94 @@
+package perf_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchmarksCancelAll(t *testing.T) {
+	bb := perf.NewBenchmarks()
+	assert.False(t, bb.Busy())
+	b1, b2 := makeBench(t, "default/svc1"), makeBench(t, "default/svc2")
+	bb.Add(b2)
+	bb.Add(b1)
+	assert.True(t, bb.Busy())
+	assert.Equal(t, []string{"default/svc1", "default/svc2"}, bb.Names())
+
+	bb.Remove(b2)
+	assert.Equal(t, []string{"default/svc1"}, bb.Names())
+
+	bb.Add(b2)
+	bb.CancelAll()
+	assert.True(t, b1.Canceled())
+	assert.True(t, b2.Canceled())
+	assert.Equal(t, 0, len(bb.Names()))
+	assert.False(t, bb.Busy())
+
+	b1.Cancel()
+	assert.True(t, b1.Canceled())
+}
+
+func TestBenchmarksKill(t *testing.T) {
+	bb := perf.NewBenchmarks()
+	b1, b2, b3 := makeBench(t, "default/p1:c1"), makeBench(t, "default/p1:c2"), makeBench(t, "default/p10:c1")
+	bb.Add(b1)
+	bb.Add(b2)
+	bb.Add(b3)
+	assert.Equal(t, []string{"default/p1:c1", "default/p1:c2"}, bb.Targeting("default/p1"))
+	assert.Equal(t, []string{"default/p1:c2"}, bb.Targeting("default/p1:c2"))
+
+	assert.Equal(t, 2, bb.Kill("default/p1"))
+	assert.True(t, b1.Canceled())
+	assert.True(t, b2.Canceled())
+	assert.False(t, b3.Canceled())
+	assert.Equal(t, []string{"default/p10:c1"}, bb.Names())
+}
+
+func TestBenchmarksCancel(t *testing.T) {
+	bb := perf.NewBenchmarks()
+	b1, b2 := makeBench(t, "default/p1:c1"), makeBench(t, "default/p2:c1")
+	bb.Add(b1)
+	bb.Add(b2)
+	assert.Equal(t, 2, bb.Count())
+	assert.True(t, bb.Running("default/p1:c1"))
+	assert.False(t, bb.Running("default/p1"))
+
+	assert.False(t, bb.Cancel("default/p1"))
+	assert.True(t, bb.Cancel("default/p1:c1"))
+	assert.True(t, b1.Canceled())
+	assert.False(t, b2.Canceled())
+	assert.Equal(t, []string{"default/p2:c1"}, bb.Names())
+	assert.Equal(t, 1, bb.Count())
+}
+
+func TestBenchmarksRemoveRerun(t *testing.T) {
+	bb := perf.NewBenchmarks()
+	b1, b2 := makeBench(t, "default/p1:c1"), makeBench(t, "default/p1:c1")
+	bb.Add(b1)
+	assert.True(t, bb.Cancel("default/p1:c1"))
+	bb.Add(b2)
+
+	bb.Remove(b1)
+	assert.True(t, bb.Running("default/p1:c1"))
+	bb.Remove(b2)
+	assert.False(t, bb.Running("default/p1:c1"))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeBench(t *testing.T, n string) *perf.Benchmark {
+	cfg := config.BenchConfig{
+		Name: n,
+		C:    2,
+		N:    10,
+		HTTP: config.HTTP{Method: "GET"},
+	}
+	b, err := perf.NewBenchmark("http://localhost:0", "0.0.0", cfg)
+	assert.Nil(t, err)
+
+	return b
+}
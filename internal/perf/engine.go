@@ -0,0 +1,188 @@
+package perf
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptrace"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	k9sUA          = "k9s/"
+	failuresHeader = "Error classes:"
+)
+
+var (
+	totalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
+	reqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
+	p99Rx   = regexp.MustCompile(`99%\s+in\s+([0-9.]+)\ssecs`)
+	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
+	errRx   = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
+	toastRx = regexp.MustCompile(`Error distribution`)
+	failRx  = regexp.MustCompile(`^\s+([a-z0-9-]+)(?:\s(\d{3}))?\t(\d+)\srequests$`)
+)
+
+// ProgressFunc reports the number of requests sent so far. It is called from
+// the benchmark workers.
+type ProgressFunc func(sent, total int)
+
+// Result represents a benchmark outcome.
+type Result struct {
+	Name     string
+	Report   string
+	Total    time.Duration
+	RPS      float64
+	P99      time.Duration
+	OK       int
+	Errors   int
+	Failed   bool
+	Canceled bool
+	Failures Failures
+}
+
+// Status returns the benchmark status.
+func (r Result) Status() string {
+	if r.Failed {
+		return "fail"
+	}
+	return "pass"
+}
+
+// ParseReport extracts a benchmark result from a report.
+func ParseReport(name, report string) Result {
+	r := Result{
+		Name:   name,
+		Report: report,
+		Failed: toastRx.MatchString(report),
+		OK:     countResponses(okRx.FindAllStringSubmatch(report, -1)),
+		Errors: countResponses(errRx.FindAllStringSubmatch(report, -1)),
+	}
+	r.Failures = parseFailures(report)
+	if m := totalRx.FindStringSubmatch(report); m != nil {
+		if secs, err := strconv.ParseFloat(m[1], 64); err == nil {
+			r.Total = time.Duration(secs * float64(time.Second))
+		}
+	}
+	if m := reqRx.FindStringSubmatch(report); m != nil {
+		if rps, err := strconv.ParseFloat(m[1], 64); err == nil {
+			r.RPS = rps
+		}
+	}
+	if m := p99Rx.FindStringSubmatch(report); m != nil {
+		if secs, err := strconv.ParseFloat(m[1], 64); err == nil {
+			r.P99 = time.Duration(secs * float64(time.Second))
+		}
+	}
+
+	return r
+}
+
+// Run puts a target under load until all requests complete or the context is
+// canceled. The user agent version is read from the context.
+func Run(ctx context.Context, target string, cfg config.BenchConfig, progress ProgressFunc) (Result, error) {
+	req, err := newRequest(ctx, target, cfg, progress)
+	if err != nil {
+		return Result{}, err
+	}
+
+	l := newLoad(req, []byte(cfg.HTTP.Body), cfg.N, cfg.C, cfg.HTTP.HTTP2, cfg.HTTP.Timeout)
+	total := l.run(ctx)
+	if ctx.Err() != nil {
+		return Result{Name: cfg.Name, Canceled: true}, nil
+	}
+
+	return ParseReport(cfg.Name, l.report(total)), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// newRequest builds the benchmark request. The request context is detached
+// from the run context so in flight requests complete on cancel.
+func newRequest(ctx context.Context, target string, cfg config.BenchConfig, progress ProgressFunc) (*http.Request, error) {
+	req, err := http.NewRequest(cfg.HTTP.Method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug().Msgf("Benchmarking Request %s", req.URL.String())
+
+	if cfg.Auth.User != "" || cfg.Auth.Password != "" {
+		req.SetBasicAuth(cfg.Auth.User, cfg.Auth.Password)
+	}
+
+	req.Header = cfg.HTTP.Headers
+	ua := req.UserAgent()
+	if ua == "" {
+		ua = k9sUA
+	} else {
+		ua += " " + k9sUA
+	}
+	if v, ok := ctx.Value(internal.KeyVersion).(string); ok {
+		ua += v
+	}
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	req.Header.Set("User-Agent", ua)
+
+	if progress == nil {
+		return req, nil
+	}
+	var sent int64
+	total := requestCount(cfg)
+	trace := httptrace.ClientTrace{
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			progress(int(atomic.AddInt64(&sent, 1)), total)
+		},
+	}
+
+	return req.WithContext(httptrace.WithClientTrace(context.Background(), &trace)), nil
+}
+
+// requestCount returns the number of requests issued. Each worker sends an
+// equal share of the requests.
+func requestCount(cfg config.BenchConfig) int {
+	if cfg.C <= 0 {
+		return 0
+	}
+	return cfg.N / cfg.C * cfg.C
+}
+
+// parseFailures extracts the failed requests breakdown from a report.
+func parseFailures(report string) Failures {
+	i := strings.Index(report, failuresHeader)
+	if i < 0 {
+		return nil
+	}
+	ff := make(Failures)
+	for _, l := range strings.Split(report[i+len(failuresHeader):], "\n")[1:] {
+		m := failRx.FindStringSubmatch(l)
+		if m == nil {
+			break
+		}
+		f := Failure{Class: ErrorClass(m[1])}
+		f.Code, _ = strconv.Atoi(m[2])
+		ff[f], _ = strconv.Atoi(m[3])
+	}
+
+	return ff
+}
+
+func countResponses(rr [][]string) int {
+	var sum int
+	for _, m := range rr {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			sum += n
+		}
+	}
+
+	return sum
+}
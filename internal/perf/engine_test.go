@@ -0,0 +1,143 @@
+package perf_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReport(t *testing.T) {
+	uu := map[string]struct {
+		file string
+		e    perf.Result
+	}{
+		"errors": {
+			file: "assets/b2.txt",
+			e:    perf.Result{Total: 3354400 * time.Microsecond, RPS: 29.8116, P99: 103100 * time.Microsecond, OK: 100, Errors: 12},
+		},
+		"toast": {
+			file: "assets/b3.txt",
+			e:    perf.Result{Total: 2368800 * time.Microsecond, RPS: 35.4606, Failed: true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			data, err := ioutil.ReadFile(u.file)
+			assert.Nil(t, err)
+			r := perf.ParseReport("default/fred", string(data))
+			assert.Equal(t, "default/fred", r.Name)
+			assert.Equal(t, u.e.Total, r.Total)
+			assert.Equal(t, u.e.RPS, r.RPS)
+			assert.Equal(t, u.e.P99, r.P99)
+			assert.Equal(t, u.e.OK, r.OK)
+			assert.Equal(t, u.e.Errors, r.Errors)
+			assert.Equal(t, u.e.Failed, r.Failed)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	var ua atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua.Store(r.UserAgent())
+	}))
+	defer srv.Close()
+
+	cfg := benchConfig("default/fred", 10, 2)
+	var sent, total int64
+	ctx := context.WithValue(context.Background(), internal.KeyVersion, "1.0")
+	r, err := perf.Run(ctx, srv.URL, cfg, func(s, t int) {
+		atomic.StoreInt64(&total, int64(t))
+		atomic.AddInt64(&sent, 1)
+	})
+
+	assert.Nil(t, err)
+	assert.False(t, r.Canceled)
+	assert.False(t, r.Failed)
+	assert.Equal(t, 10, r.OK)
+	assert.Equal(t, int64(10), atomic.LoadInt64(&sent))
+	assert.Equal(t, int64(10), atomic.LoadInt64(&total))
+	assert.Equal(t, "k9s/1.0", ua.Load())
+}
+
+func TestRunCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err := perf.Run(ctx, srv.URL, benchConfig("default/fred", 1000, 1), func(s, _ int) {
+		if s == 2 {
+			cancel()
+		}
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, r.Canceled)
+	assert.Empty(t, r.Report)
+}
+
+func TestSaveReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = dir
+
+	path, err := perf.Save("c1", perf.Result{Name: "default/fred", Report: "blee"})
+	assert.Nil(t, err)
+	assert.Equal(t, perf.BenchDir("c1"), filepath.Dir(path))
+	assert.Regexp(t, `^default_fred_\d+\.txt$`, filepath.Base(path))
+
+	data, err := perf.ReadReport("c1", filepath.Base(path))
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", data)
+}
+
+func TestLatestResult(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = dir
+
+	f, r, err := perf.LatestResult("c1")
+	assert.Nil(t, err)
+	assert.Empty(t, f)
+	assert.Nil(t, r)
+
+	old, err := perf.Save("c1", perf.Result{Name: "default/fred", Report: "Requests/sec:\t10.0000"})
+	assert.Nil(t, err)
+	assert.Nil(t, os.Chtimes(old, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)))
+	path, err := perf.Save("c1", perf.Result{Name: "default/blee:co", Report: "Requests/sec:\t20.0000"})
+	assert.Nil(t, err)
+
+	f, r, err = perf.LatestResult("c1")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Base(path), f)
+	assert.Equal(t, "default/blee:co", r.Name)
+	assert.Equal(t, 20.0, r.RPS)
+}
+
+func benchConfig(name string, n, c int) config.BenchConfig {
+	return config.BenchConfig{
+		Name: name,
+		C:    c,
+		N:    n,
+		HTTP: config.HTTP{Method: "GET"},
+	}
+}
@@ -0,0 +1,172 @@
+package perf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// ErrorClass represents a kind of benchmark request failure.
+type ErrorClass string
+
+const (
+	// ErrTimeout the request timed out.
+	ErrTimeout ErrorClass = "timeout"
+	// ErrDial the connection could not be established.
+	ErrDial ErrorClass = "dial"
+	// ErrTLS the tls handshake failed.
+	ErrTLS ErrorClass = "tls"
+	// ErrStatus the server replied with a non 2xx status code.
+	ErrStatus ErrorClass = "non-2xx"
+	// ErrBodyRead the response body could not be read.
+	ErrBodyRead ErrorClass = "body-read"
+	// ErrOther the request failed for another reason.
+	ErrOther ErrorClass = "other"
+)
+
+// httpOverTLS the transport error when a tls handshake hits a plain http
+// server.
+const httpOverTLS = "server gave HTTP response to HTTPS client"
+
+var classNames = map[ErrorClass]string{
+	ErrTimeout:  "timeouts",
+	ErrDial:     "dial errors",
+	ErrTLS:      "TLS errors",
+	ErrStatus:   "non-2xx responses",
+	ErrBodyRead: "body read errors",
+	ErrOther:    "other errors",
+}
+
+// Plural returns a human readable name for a bunch of failures.
+func (c ErrorClass) Plural() string {
+	if n, ok := classNames[c]; ok {
+		return n
+	}
+	return string(c) + " errors"
+}
+
+// Failure represents a class of failed requests. Code is only set for
+// non-2xx responses.
+type Failure struct {
+	Class ErrorClass
+	Code  int
+}
+
+// String returns the failure label.
+func (f Failure) String() string {
+	if f.Class == ErrStatus {
+		return fmt.Sprintf("%s %d", f.Class, f.Code)
+	}
+	return string(f.Class)
+}
+
+// Failures tracks failed requests counts by failure.
+type Failures map[Failure]int
+
+// Dominant returns the class accounting for the most failures if any.
+func (ff Failures) Dominant() (ErrorClass, bool) {
+	cc := make(map[ErrorClass]int, len(ff))
+	for f, n := range ff {
+		cc[f.Class] += n
+	}
+	var (
+		best ErrorClass
+		max  int
+	)
+	for c, n := range cc {
+		if n > max || (n == max && c < best) {
+			best, max = c, n
+		}
+	}
+
+	return best, max > 0
+}
+
+// Sorted returns the failures ordered by decreasing count.
+func (ff Failures) Sorted() []Failure {
+	kk := make([]Failure, 0, len(ff))
+	for f := range ff {
+		kk = append(kk, f)
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		if ff[kk[i]] != ff[kk[j]] {
+			return ff[kk[i]] > ff[kk[j]]
+		}
+		return kk[i].String() < kk[j].String()
+	})
+
+	return kk
+}
+
+// bodyReadError signals a response body failed to be read.
+type bodyReadError struct {
+	err error
+}
+
+func (e *bodyReadError) Error() string {
+	return "reading body: " + e.err.Error()
+}
+
+func (e *bodyReadError) Unwrap() error {
+	return e.err
+}
+
+// Classify returns the class of a failed request error. Errors are unwrapped
+// through the url, net and tls layers so the root cause wins over the
+// transport noise.
+func Classify(err error) ErrorClass {
+	if err == nil {
+		return ""
+	}
+
+	var (
+		body *bodyReadError
+		nerr net.Error
+		op   *net.OpError
+		dns  *net.DNSError
+	)
+	switch {
+	case errors.As(err, &body):
+		return ErrBodyRead
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrTimeout
+	case errors.As(err, &nerr) && nerr.Timeout():
+		return ErrTimeout
+	case isTLSError(err):
+		return ErrTLS
+	case errors.As(err, &dns):
+		return ErrDial
+	case errors.As(err, &op) && op.Op == "dial":
+		return ErrDial
+	default:
+		return ErrOther
+	}
+}
+
+func isTLSError(err error) bool {
+	var (
+		rec  tls.RecordHeaderError
+		ca   x509.UnknownAuthorityError
+		host x509.HostnameError
+		cert x509.CertificateInvalidError
+		op   *net.OpError
+	)
+	switch {
+	case errors.As(err, &rec), errors.As(err, &ca), errors.As(err, &host), errors.As(err, &cert):
+		return true
+	case errors.As(err, &op) && op.Op == "remote error":
+		// Alerts sent by the server during the handshake.
+		return true
+	case strings.Contains(err.Error(), httpOverTLS):
+		// The http transport masks record errors caused by plain http servers.
+		return true
+	default:
+		// The tls package reports most handshake failures as plain errors.
+		return strings.Contains(err.Error(), "tls: ")
+	}
+}
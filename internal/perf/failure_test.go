@@ -0,0 +1,250 @@
+package perf_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   perf.ErrorClass
+	}{
+		"none": {},
+		"refused": {
+			err: &url.Error{Op: "Get", URL: "http://fred", Err: &net.OpError{
+				Op:  "dial",
+				Net: "tcp",
+				Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED},
+			}},
+			e: perf.ErrDial,
+		},
+		"dns": {
+			err: &url.Error{Op: "Get", URL: "http://fred", Err: &net.OpError{
+				Op:  "dial",
+				Net: "tcp",
+				Err: &net.DNSError{Err: "no such host", Name: "fred"},
+			}},
+			e: perf.ErrDial,
+		},
+		"dialTimeout": {
+			err: &url.Error{Op: "Get", URL: "http://fred", Err: &net.OpError{
+				Op:  "dial",
+				Net: "tcp",
+				Err: timeoutErr{},
+			}},
+			e: perf.ErrTimeout,
+		},
+		"deadline": {
+			err: &url.Error{Op: "Get", URL: "http://fred", Err: context.DeadlineExceeded},
+			e:   perf.ErrTimeout,
+		},
+		"record": {
+			err: &url.Error{Op: "Get", URL: "https://fred", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}},
+			e:   perf.ErrTLS,
+		},
+		"authority": {
+			err: &url.Error{Op: "Get", URL: "https://fred", Err: x509.UnknownAuthorityError{}},
+			e:   perf.ErrTLS,
+		},
+		"alert": {
+			err: &url.Error{Op: "Get", URL: "https://fred", Err: &net.OpError{
+				Op:  "remote error",
+				Err: errors.New("tls: bad certificate"),
+			}},
+			e: perf.ErrTLS,
+		},
+		"eof": {
+			err: &url.Error{Op: "Get", URL: "http://fred", Err: io.EOF},
+			e:   perf.ErrOther,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, perf.Classify(u.err))
+		})
+	}
+}
+
+func TestRunFailures(t *testing.T) {
+	uu := map[string]struct {
+		url func(t *testing.T) (string, func())
+		e   perf.Failure
+	}{
+		"timeout": {
+			url: serve(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+			}),
+			e: perf.Failure{Class: perf.ErrTimeout},
+		},
+		"dial": {
+			url: func(t *testing.T) (string, func()) {
+				l, err := net.Listen("tcp", "127.0.0.1:0")
+				assert.Nil(t, err)
+				addr := l.Addr().String()
+				l.Close()
+				return "http://" + addr, func() {}
+			},
+			e: perf.Failure{Class: perf.ErrDial},
+		},
+		"tls": {
+			url: func(t *testing.T) (string, func()) {
+				srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+				return "https://" + srv.Listener.Addr().String(), srv.Close
+			},
+			e: perf.Failure{Class: perf.ErrTLS},
+		},
+		"tlsRecord": {
+			url: func(t *testing.T) (string, func()) {
+				l, err := net.Listen("tcp", "127.0.0.1:0")
+				assert.Nil(t, err)
+				go func() {
+					for {
+						conn, err := l.Accept()
+						if err != nil {
+							return
+						}
+						fmt.Fprint(conn, "fred and blee are not a tls handshake")
+						conn.Close()
+					}
+				}()
+				return "https://" + l.Addr().String(), func() { l.Close() }
+			},
+			e: perf.Failure{Class: perf.ErrTLS},
+		},
+		"status": {
+			url: serve(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}),
+			e: perf.Failure{Class: perf.ErrStatus, Code: 503},
+		},
+		"body": {
+			url: serve(func(w http.ResponseWriter, r *http.Request) {
+				conn, buff, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					return
+				}
+				fmt.Fprint(buff, "HTTP/1.1 200 OK\r\nContent-Length: 100\r\n\r\nfred")
+				buff.Flush()
+				conn.Close()
+			}),
+			e: perf.Failure{Class: perf.ErrBodyRead},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			target, done := u.url(t)
+			defer done()
+
+			cfg := benchConfig("default/fred", 4, 2)
+			cfg.HTTP.Timeout = 50 * time.Millisecond
+			r, err := perf.Run(context.Background(), target, cfg, nil)
+
+			assert.Nil(t, err)
+			assert.Equal(t, 0, r.OK)
+			assert.Equal(t, perf.Failures{u.e: 4}, r.Failures)
+			c, ok := r.Failures.Dominant()
+			assert.True(t, ok)
+			assert.Equal(t, u.e.Class, c)
+		})
+	}
+}
+
+func TestFailuresDominant(t *testing.T) {
+	uu := map[string]struct {
+		ff perf.Failures
+		e  perf.ErrorClass
+		ok bool
+	}{
+		"none": {},
+		"single": {
+			ff: perf.Failures{{Class: perf.ErrTimeout}: 2},
+			e:  perf.ErrTimeout,
+			ok: true,
+		},
+		"codes": {
+			ff: perf.Failures{
+				{Class: perf.ErrTimeout}:           3,
+				{Class: perf.ErrStatus, Code: 500}: 2,
+				{Class: perf.ErrStatus, Code: 503}: 2,
+			},
+			e:  perf.ErrStatus,
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := u.ff.Dominant()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestRunRedirect(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/b", http.StatusFound)
+	})
+	mux.HandleFunc("/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "fred")
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	r, err := perf.Run(context.Background(), srv.URL+"/a", benchConfig("default/fred", 4, 2), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, r.OK)
+	assert.Empty(t, r.Failures)
+}
+
+func TestFailuresParse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	r, err := perf.Run(context.Background(), srv.URL, benchConfig("default/fred", 6, 2), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, r.Errors)
+
+	p := perf.ParseReport("default/fred", r.Report)
+	assert.Equal(t, perf.Failures{{Class: perf.ErrStatus, Code: 502}: 6}, p.Failures)
+	assert.Contains(t, r.Report, "non-2xx 502\t6 requests")
+}
+
+// Helpers...
+
+type timeoutErr struct{}
+
+func (timeoutErr) Error() string   { return "i/o timeout" }
+func (timeoutErr) Timeout() bool   { return true }
+func (timeoutErr) Temporary() bool { return true }
+
+func serve(h http.HandlerFunc) func(t *testing.T) (string, func()) {
+	return func(t *testing.T) (string, func()) {
+		srv := httptest.NewServer(h)
+		return srv.URL, srv.Close
+	}
+}
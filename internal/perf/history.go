@@ -0,0 +1,110 @@
+package perf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KeyFunc maps a benchmark name to the target its runs are indexed under.
+type KeyFunc func(name string) string
+
+// History indexes the p99 latencies of the saved benchmark reports by target.
+// Reports never change once saved so their latencies are parsed only once.
+type History struct {
+	mx   sync.Mutex
+	p99s map[string]time.Duration
+}
+
+// NewHistory returns a new benchmark history.
+func NewHistory() *History {
+	return &History{p99s: make(map[string]time.Duration)}
+}
+
+// Trends returns the p99 latencies of the last n runs of each target on a
+// given cluster from oldest to newest. Runs without latencies are skipped.
+func (h *History) Trends(cluster string, n int, key KeyFunc) (map[string][]time.Duration, error) {
+	dir := BenchDir(cluster)
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	p99s := make(map[string]time.Duration, len(h.p99s))
+	trends := make(map[string][]time.Duration)
+	for target, files := range bucketRuns(ff, n, key) {
+		for _, f := range files {
+			p99, ok := h.p99s[f]
+			if !ok {
+				data, err := ReadReport(cluster, f)
+				if err != nil {
+					return nil, err
+				}
+				p99 = ParseReport(reportSubject(f), data).P99
+			}
+			p99s[f] = p99
+			if p99 > 0 {
+				trends[target] = append(trends[target], p99)
+			}
+		}
+	}
+	h.p99s = p99s
+
+	return trends, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// bucketRuns groups report files by target and retains the n most recent
+// runs of each target from oldest to newest.
+func bucketRuns(ff []os.FileInfo, n int, key KeyFunc) map[string][]string {
+	reports := make([]os.FileInfo, 0, len(ff))
+	for _, f := range ff {
+		if !f.IsDir() {
+			reports = append(reports, f)
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reportStamp(reports[i]) < reportStamp(reports[j])
+	})
+
+	runs := make(map[string][]string)
+	for _, f := range reports {
+		target := reportSubject(f.Name())
+		if key != nil {
+			target = key(target)
+		}
+		runs[target] = append(runs[target], f.Name())
+	}
+	for target, files := range runs {
+		if n > 0 && len(files) > n {
+			runs[target] = files[len(files)-n:]
+		}
+	}
+
+	return runs
+}
+
+// reportStamp returns the time a report was saved at. It falls back to the
+// report modification time for files not named by Save.
+func reportStamp(f os.FileInfo) int64 {
+	name := strings.TrimSuffix(f.Name(), filepath.Ext(f.Name()))
+	if i := strings.LastIndex(name, "_"); i != -1 {
+		if ts, err := strconv.ParseInt(name[i+1:], 10, 64); err == nil {
+			return ts
+		}
+	}
+
+	return f.ModTime().UnixNano()
+}
@@ -0,0 +1,90 @@
+package perf_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryTrends(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-history")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = dir
+
+	makeRuns(t, perf.BenchDir("c1"), map[string]string{
+		"default_fred-1:co_1.txt": "  99% in 0.1000 secs",
+		"default_fred-2:co_3.txt": "  99% in 0.3000 secs",
+		"default_fred-2:co_2.txt": "  99% in 0.2000 secs",
+		"default_fred-2:co_4.txt": "Error distribution:",
+		"default_fred-2:co_5.txt": "  99% in 0.5000 secs",
+		"default_blee:co_1.txt":   "  99% in 1.0000 secs",
+	})
+	byPod := func(name string) string {
+		return strings.Split(name, "-")[0]
+	}
+
+	uu := map[string]struct {
+		n   int
+		key perf.KeyFunc
+		e   map[string][]time.Duration
+	}{
+		"all": {
+			key: byPod,
+			e: map[string][]time.Duration{
+				"default/fred":    {100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 500 * time.Millisecond},
+				"default/blee:co": {time.Second},
+			},
+		},
+		"lastRuns": {
+			n:   3,
+			key: byPod,
+			e: map[string][]time.Duration{
+				"default/fred":    {300 * time.Millisecond, 500 * time.Millisecond},
+				"default/blee:co": {time.Second},
+			},
+		},
+		"byName": {
+			n: 2,
+			e: map[string][]time.Duration{
+				"default/fred-1:co": {100 * time.Millisecond},
+				"default/fred-2:co": {500 * time.Millisecond},
+				"default/blee:co":   {time.Second},
+			},
+		},
+	}
+
+	h := perf.NewHistory()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt, err := h.Trends("c1", u.n, u.key)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, tt)
+		})
+	}
+}
+
+func TestHistoryTrendsNoReports(t *testing.T) {
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = filepath.Join(os.TempDir(), "k9s-history-none")
+
+	tt, err := perf.NewHistory().Trends("c1", 5, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, tt)
+}
+
+func makeRuns(t *testing.T, dir string, runs map[string]string) {
+	assert.Nil(t, os.MkdirAll(dir, 0744))
+	for f, report := range runs {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, f), []byte(report), 0644), fmt.Sprintf("write %s", f))
+	}
+}
@@ -0,0 +1,301 @@
+package perf
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+const maxIdleConns = 500
+
+// sample represents the outcome of a single request.
+type sample struct {
+	code     int
+	size     int64
+	duration time.Duration
+	err      error
+}
+
+func (s sample) failure() (Failure, bool) {
+	if s.err != nil {
+		return Failure{Class: Classify(s.err)}, true
+	}
+	if s.code < 200 || s.code > 299 {
+		return Failure{Class: ErrStatus, Code: s.code}, true
+	}
+
+	return Failure{}, false
+}
+
+// load sends requests to a target using concurrent workers.
+type load struct {
+	req     *http.Request
+	body    []byte
+	n, c    int
+	client  *http.Client
+	mx      sync.Mutex
+	samples []sample
+}
+
+func newLoad(req *http.Request, body []byte, n, c int, h2 bool, timeout time.Duration) *load {
+	tr := http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+			ServerName:         req.Host,
+		},
+		MaxIdleConnsPerHost: min(c, maxIdleConns),
+	}
+	if h2 {
+		if err := http2.ConfigureTransport(&tr); err != nil {
+			tr.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+		}
+	} else {
+		tr.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	return &load{
+		req:     req,
+		body:    body,
+		n:       n,
+		c:       c,
+		client:  &http.Client{Transport: &classifier{rt: &tr, timeout: timeout}},
+		samples: make([]sample, 0, n),
+	}
+}
+
+// run blocks until all requests complete or the context is canceled. In
+// flight requests complete on cancel.
+func (l *load) run(ctx context.Context) time.Duration {
+	start := time.Now()
+	var wg sync.WaitGroup
+	wg.Add(l.c)
+	for i := 0; i < l.c; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < l.n/l.c; j++ {
+				if ctx.Err() != nil {
+					return
+				}
+				s := l.send()
+				l.mx.Lock()
+				l.samples = append(l.samples, s)
+				l.mx.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return time.Since(start)
+}
+
+func (l *load) send() sample {
+	var s sample
+	req := cloneRequest(l.req, l.body)
+	req = req.WithContext(context.WithValue(req.Context(), sampleKey{}, &s))
+	start := time.Now()
+	resp, err := l.client.Do(req)
+	if err == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	} else if s.err == nil {
+		s.err = err
+	}
+	s.duration = time.Since(start)
+
+	return s
+}
+
+// sampleKey keys the sample a request outcome is recorded in.
+type sampleKey struct{}
+
+// classifier wraps a transport to record each request outcome in the sample
+// carried by the request context. Redirects overwrite the sample so the final
+// response wins. The timeout bounds each round trip and the response body read.
+type classifier struct {
+	rt      http.RoundTripper
+	timeout time.Duration
+}
+
+// RoundTrip sends a request and records its outcome.
+func (c *classifier) RoundTrip(req *http.Request) (*http.Response, error) {
+	s, ok := req.Context().Value(sampleKey{}).(*sample)
+	if !ok {
+		return c.rt.RoundTrip(req)
+	}
+	cancel := context.CancelFunc(func() {})
+	if c.timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), c.timeout)
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.rt.RoundTrip(req)
+	if err != nil {
+		cancel()
+		s.code, s.size, s.err = 0, 0, err
+		return nil, err
+	}
+	s.code, s.size, s.err = resp.StatusCode, 0, nil
+	resp.Body = &sampledBody{ReadCloser: resp.Body, sample: s, cancel: cancel}
+
+	return resp, nil
+}
+
+// sampledBody records the response size and read failures.
+type sampledBody struct {
+	io.ReadCloser
+
+	sample *sample
+	cancel context.CancelFunc
+}
+
+// Read reads the response body.
+func (b *sampledBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.sample.size += int64(n)
+	if err != nil && err != io.EOF {
+		b.sample.err = &bodyReadError{err: err}
+	}
+
+	return n, err
+}
+
+// Close closes the response body and releases the round trip timeout.
+func (b *sampledBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// failures returns the failed requests breakdown.
+func (l *load) failures() Failures {
+	ff := make(Failures)
+	for _, s := range l.samples {
+		if f, ok := s.failure(); ok {
+			ff[f]++
+		}
+	}
+
+	return ff
+}
+
+// report renders the run summary.
+func (l *load) report(total time.Duration) string {
+	var (
+		b     bytes.Buffer
+		size  int64
+		codes = make(map[int]int)
+		errs  = make(map[string]int)
+		lats  = make([]float64, 0, len(l.samples))
+	)
+	for _, s := range l.samples {
+		lats = append(lats, s.duration.Seconds())
+		if s.err != nil {
+			errs[s.err.Error()]++
+			continue
+		}
+		codes[s.code]++
+		size += s.size
+	}
+	sort.Float64s(lats)
+
+	fmt.Fprintf(&b, "\nSummary:\n")
+	fmt.Fprintf(&b, "  Total:\t%4.4f secs\n", total.Seconds())
+	if len(lats) > 0 {
+		fmt.Fprintf(&b, "  Slowest:\t%4.4f secs\n", lats[len(lats)-1])
+		fmt.Fprintf(&b, "  Fastest:\t%4.4f secs\n", lats[0])
+		fmt.Fprintf(&b, "  Average:\t%4.4f secs\n", average(lats))
+	}
+	fmt.Fprintf(&b, "  Requests/sec:\t%4.4f\n", float64(len(l.samples))/total.Seconds())
+	if n := len(l.samples); n > 0 {
+		fmt.Fprintf(&b, "\n  Total data:\t%d bytes\n", size)
+		fmt.Fprintf(&b, "  Size/request:\t%d bytes\n", size/int64(n))
+	}
+
+	fmt.Fprintf(&b, "\nLatency distribution:\n")
+	for _, p := range []int{10, 25, 50, 75, 90, 95, 99} {
+		if len(lats) == 0 {
+			break
+		}
+		fmt.Fprintf(&b, "  %d%% in %4.4f secs\n", p, lats[(len(lats)-1)*p/100])
+	}
+
+	fmt.Fprintf(&b, "\nStatus code distribution:\n")
+	for _, c := range sortedCodes(codes) {
+		fmt.Fprintf(&b, "  [%d]\t%d responses\n", c, codes[c])
+	}
+
+	if ff := l.failures(); len(ff) > 0 {
+		fmt.Fprintf(&b, "\n%s\n", failuresHeader)
+		for _, f := range ff.Sorted() {
+			fmt.Fprintf(&b, "  %s\t%d requests\n", f, ff[f])
+		}
+	}
+
+	if len(errs) > 0 {
+		fmt.Fprintf(&b, "\nError distribution:\n")
+		ee := make([]string, 0, len(errs))
+		for e := range errs {
+			ee = append(ee, e)
+		}
+		sort.Strings(ee)
+		for _, e := range ee {
+			fmt.Fprintf(&b, "  [%d]\t%s\n", errs[e], e)
+		}
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// cloneRequest returns a copy of the request with its own headers and body.
+func cloneRequest(r *http.Request, body []byte) *http.Request {
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.Header = make(http.Header, len(r.Header))
+	for k, s := range r.Header {
+		r2.Header[k] = append([]string(nil), s...)
+	}
+	if len(body) > 0 {
+		r2.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r2.ContentLength = int64(len(body))
+	}
+
+	return r2
+}
+
+func sortedCodes(codes map[int]int) []int {
+	cc := make([]int, 0, len(codes))
+	for c := range codes {
+		cc = append(cc, c)
+	}
+	sort.Ints(cc)
+
+	return cc
+}
+
+func average(ff []float64) float64 {
+	var sum float64
+	for _, f := range ff {
+		sum += f
+	}
+
+	return sum / float64(len(ff))
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,91 @@
+package perf
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+)
+
+const benchFmat = "%s_%s_%d.txt"
+
+// K9sBenchDir directory to store K9s Benchmark files.
+var K9sBenchDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-bench-%s", config.MustK9sUser()))
+
+// BenchDir returns the benchmark reports directory for a given cluster.
+func BenchDir(cluster string) string {
+	return filepath.Join(K9sBenchDir, cluster)
+}
+
+// Save persists a benchmark report and returns the report path.
+func Save(cluster string, r Result) (string, error) {
+	dir := BenchDir(cluster)
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return "", err
+	}
+
+	ns, n := client.Namespaced(r.Name)
+	file := filepath.Join(dir, fmt.Sprintf(benchFmat, ns, n, time.Now().UnixNano()))
+	if err := ioutil.WriteFile(file, []byte(r.Report), 0644); err != nil {
+		return "", err
+	}
+
+	return file, nil
+}
+
+// ReadReport loads a benchmark report for a given cluster.
+func ReadReport(cluster, file string) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(BenchDir(cluster), file))
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// LatestResult returns the most recent benchmark report file for a given
+// cluster along with its parsed result. The result is nil if no reports exist.
+func LatestResult(cluster string) (string, *Result, error) {
+	ff, err := ioutil.ReadDir(BenchDir(cluster))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+
+	var latest os.FileInfo
+	for _, f := range ff {
+		if f.IsDir() {
+			continue
+		}
+		if latest == nil || f.ModTime().After(latest.ModTime()) {
+			latest = f
+		}
+	}
+	if latest == nil {
+		return "", nil, nil
+	}
+	data, err := ReadReport(cluster, latest.Name())
+	if err != nil {
+		return "", nil, err
+	}
+	r := ParseReport(reportSubject(latest.Name()), data)
+
+	return latest.Name(), &r, nil
+}
+
+// reportSubject returns the benchmark name a report file was saved for.
+func reportSubject(file string) string {
+	tokens := strings.Split(file, "_")
+	if len(tokens) < 3 {
+		return file
+	}
+
+	return tokens[0] + "/" + tokens[1]
+}
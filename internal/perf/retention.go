@@ -0,0 +1,101 @@
+package perf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// PruneReport tallies the benchmark reports removed by a prune.
+type PruneReport struct {
+	Files []string
+	Bytes int64
+}
+
+// Prune deletes the oldest cluster benchmark reports exceeding the retention limits.
+func Prune(cluster string, r config.Retention) (PruneReport, error) {
+	var report PruneReport
+	dir := BenchDir(cluster)
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return report, nil
+		}
+		return report, err
+	}
+
+	for _, f := range expired(ff, r, time.Now()) {
+		path := filepath.Join(dir, f.Name())
+		if err := os.Remove(path); err != nil {
+			return report, err
+		}
+		log.Info().Msgf("Pruned benchmark report %s", path)
+		report.Files = append(report.Files, f.Name())
+		report.Bytes += f.Size()
+	}
+
+	return report, nil
+}
+
+// expired returns the reports to prune, applying the age, per target count and
+// total size limits in that order.
+func expired(ff []os.FileInfo, r config.Retention, now time.Time) []os.FileInfo {
+	reports := make([]os.FileInfo, 0, len(ff))
+	for _, f := range ff {
+		if !f.IsDir() {
+			reports = append(reports, f)
+		}
+	}
+	// Newest reports first.
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ModTime().After(reports[j].ModTime())
+	})
+
+	var (
+		kept, pruned []os.FileInfo
+		counts       = make(map[string]int)
+	)
+	for _, f := range reports {
+		if r.MaxAge > 0 && now.Sub(f.ModTime()) > r.MaxAge {
+			pruned = append(pruned, f)
+			continue
+		}
+		t := reportTarget(f.Name())
+		if r.MaxReports > 0 && counts[t] >= r.MaxReports {
+			pruned = append(pruned, f)
+			continue
+		}
+		counts[t]++
+		kept = append(kept, f)
+	}
+
+	if r.MaxSizeMB <= 0 {
+		return pruned
+	}
+	var size int64
+	for _, f := range kept {
+		size += f.Size()
+	}
+	for i := len(kept) - 1; i >= 0 && size > r.MaxSizeMB<<20; i-- {
+		size -= kept[i].Size()
+		pruned = append(pruned, kept[i])
+	}
+
+	return pruned
+}
+
+// reportTarget returns the benchmark target of a report file ie ns_name.
+func reportTarget(file string) string {
+	i := strings.LastIndex(file, "_")
+	if i == -1 {
+		return file
+	}
+
+	return file[:i]
+}
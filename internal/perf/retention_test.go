@@ -0,0 +1,83 @@
+package perf_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrune(t *testing.T) {
+	uu := map[string]struct {
+		r config.Retention
+		e []string
+	}{
+		"none": {},
+		"count": {
+			r: config.Retention{MaxReports: 2},
+			e: []string{"default_fred_1.txt"},
+		},
+		"age": {
+			r: config.Retention{MaxAge: 150 * time.Minute},
+			e: []string{"default_blee_0.txt", "default_fred_1.txt"},
+		},
+		"size": {
+			r: config.Retention{MaxReports: 50, MaxSizeMB: 1},
+			e: []string{"default_blee_0.txt", "default_fred_1.txt"},
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "k9s-prune")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = dir
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			makeReports(t, filepath.Join(dir, k))
+			r, err := perf.Prune(k, u.r)
+
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, u.e, r.Files)
+			ff, err := ioutil.ReadDir(perf.BenchDir(k))
+			assert.Nil(t, err)
+			assert.Equal(t, 5-len(u.e), len(ff))
+		})
+	}
+}
+
+func TestPruneNoReports(t *testing.T) {
+	defer func(d string) { perf.K9sBenchDir = d }(perf.K9sBenchDir)
+	perf.K9sBenchDir = filepath.Join(os.TempDir(), "k9s-prune-none")
+	r, err := perf.Prune("fred", config.Retention{MaxReports: 1})
+
+	assert.Nil(t, err)
+	assert.Empty(t, r.Files)
+}
+
+// makeReports lays out 5 reports, one per hour starting 4 hours ago. The 2
+// oldest reports weigh 400k each and the rest 300k.
+func makeReports(t *testing.T, dir string) {
+	assert.Nil(t, os.MkdirAll(dir, 0744))
+	ff := []string{"default_blee_0.txt", "default_fred_1.txt", "default_fred_2.txt", "default_fred_3.txt", "default_blee_4.txt"}
+	now := time.Now()
+	for i, f := range ff {
+		size := 300 << 10
+		if i < 2 {
+			size = 400 << 10
+		}
+		path := filepath.Join(dir, f)
+		assert.Nil(t, ioutil.WriteFile(path, []byte(strings.Repeat("x", size)), 0644))
+		at := now.Add(-time.Duration(len(ff)-1-i) * time.Hour)
+		assert.Nil(t, os.Chtimes(path, at, at), fmt.Sprintf("chtimes %s", f))
+	}
+}
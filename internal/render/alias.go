@@ -10,12 +10,18 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// AliasDenied marks resources the user can't list.
+const AliasDenied = "denied"
+
 // Alias renders a aliases to screen.
 type Alias struct{}
 
 // ColorerFunc colors a resource row.
 func (Alias) ColorerFunc() ColorerFunc {
 	return func(ns string, re RowEvent) tcell.Color {
+		if len(re.Row.Fields) > 3 && strings.TrimSpace(re.Row.Fields[3]) == AliasDenied {
+			return ErrColor
+		}
 		return tcell.ColorMediumSpringGreen
 	}
 }
@@ -26,6 +32,7 @@ func (Alias) Header(ns string) HeaderRow {
 		Header{Name: "RESOURCE"},
 		Header{Name: "COMMAND"},
 		Header{Name: "APIGROUP"},
+		Header{Name: "ACCESS"},
 	}
 }
 
@@ -40,10 +47,15 @@ func (Alias) Render(o interface{}, ns string, r *Row) error {
 	r.ID = a.GVR
 	gvr := client.NewGVR(a.GVR)
 	res, grp := gvr.ToRAndG()
+	var access string
+	if a.Denied {
+		access = AliasDenied
+	}
 	r.Fields = append(r.Fields,
 		res,
 		strings.Join(a.Aliases, ","),
 		grp,
+		access,
 	)
 
 	return nil
@@ -56,6 +68,8 @@ func (Alias) Render(o interface{}, ns string, r *Row) error {
 type AliasRes struct {
 	GVR     string
 	Aliases []string
+	// Denied flags resources known to be off limits.
+	Denied bool
 }
 
 // GetObjectKind returns a schema object.
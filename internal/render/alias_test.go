@@ -45,6 +45,7 @@ func TestAliasHeader(t *testing.T) {
 		render.Header{Name: "RESOURCE"},
 		render.Header{Name: "COMMAND"},
 		render.Header{Name: "APIGROUP"},
+		render.Header{Name: "ACCESS"},
 	}
 
 	var a render.Alias
@@ -62,7 +63,22 @@ func TestAliasRender(t *testing.T) {
 
 	var r render.Row
 	assert.Nil(t, a.Render(o, "fred/v1/blee", &r))
-	assert.Equal(t, render.Row{ID: "fred/v1/blee", Fields: render.Fields{"blee", "a,b,c", "fred"}}, r)
+	assert.Equal(t, render.Row{ID: "fred/v1/blee", Fields: render.Fields{"blee", "a,b,c", "fred", ""}}, r)
+}
+
+func TestAliasRenderDenied(t *testing.T) {
+	a := render.Alias{}
+
+	o := render.AliasRes{
+		GVR:     "v1/secrets",
+		Aliases: []string{"sec"},
+		Denied:  true,
+	}
+
+	var r render.Row
+	assert.Nil(t, a.Render(o, "aliases", &r))
+	assert.Equal(t, render.Fields{"secrets", "sec", "", render.AliasDenied}, r.Fields)
+	assert.Equal(t, render.ErrColor, a.ColorerFunc()("", render.RowEvent{Kind: render.EventAdd, Row: r}))
 }
 
 func BenchmarkAlias(b *testing.B) {
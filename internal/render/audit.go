@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const auditOK = "ok"
+
+// Audit renders an action log entry to screen.
+type Audit struct{}
+
+// ColorerFunc colors a resource row.
+func (Audit) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		if r.Row.Fields[5] != auditOK {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Audit) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "TIME"},
+		Header{Name: "CONTEXT"},
+		Header{Name: "USER"},
+		Header{Name: "ACTION"},
+		Header{Name: "TARGET"},
+		Header{Name: "OUTCOME"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Audit) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AuditRes)
+	if !ok {
+		return fmt.Errorf("expecting AuditRes but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(a.Index)
+	r.Fields = Fields{
+		a.Time.Format(time.RFC3339),
+		na(a.Context),
+		na(a.User),
+		a.Action,
+		a.Target,
+		a.Outcome,
+		timeToAge(a.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AuditRes represents an action log entry.
+type AuditRes struct {
+	// Index tracks the entry position in the log.
+	Index   int
+	Time    time.Time
+	Context string
+	User    string
+	Action  string
+	Target  string
+	Outcome string
+}
+
+// GetObjectKind returns a schema object.
+func (AuditRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditRes) DeepCopyObject() runtime.Object {
+	return a
+}
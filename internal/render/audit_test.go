@@ -0,0 +1,36 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRender(t *testing.T) {
+	ts := time.Date(2019, 11, 1, 10, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		o render.AuditRes
+		e render.Fields
+	}{
+		"ok": {
+			o: render.AuditRes{Index: 1, Time: ts, Context: "ctx1", User: "fred", Action: "Delete", Target: "default/p1", Outcome: "ok"},
+			e: render.Fields{"2019-11-01T10:00:00Z", "ctx1", "fred", "Delete", "default/p1", "ok"},
+		},
+		"failed": {
+			o: render.AuditRes{Index: 2, Time: ts, Action: "Scale", Target: "default/d1", Outcome: "boom"},
+			e: render.Fields{"2019-11-01T10:00:00Z", render.NAValue, render.NAValue, "Scale", "default/d1", "boom"},
+		},
+	}
+
+	var a render.Audit
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, a.Render(u.o, "", &r))
+			assert.Equal(t, u.e, r.Fields[:6])
+		})
+	}
+}
@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"regexp"
-	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"golang.org/x/text/language"
@@ -16,14 +15,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-var (
-	totalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
-	reqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
-	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
-	errRx   = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
-	toastRx = regexp.MustCompile(`Error distribution`)
-)
-
 // Benchmark renders a benchmarks to screen.
 type Benchmark struct{}
 
@@ -105,46 +96,12 @@ func (b Benchmark) augmentRow(fields Fields, data string) {
 		return
 	}
 
-	col := 2
-	fields[col] = "pass"
-	mf := toastRx.FindAllStringSubmatch(data, 1)
-	if len(mf) > 0 {
-		fields[col] = "fail"
-	}
-	col++
-
-	mt := totalRx.FindAllStringSubmatch(data, 1)
-	if len(mt) > 0 {
-		fields[col] = mt[0][1]
-	}
-	col++
-
-	mr := reqRx.FindAllStringSubmatch(data, 1)
-	if len(mr) > 0 {
-		fields[col] = mr[0][1]
-	}
-	col++
-
-	ms := okRx.FindAllStringSubmatch(data, -1)
-	fields[col] = b.countReq(ms)
-	col++
-
-	me := errRx.FindAllStringSubmatch(data, -1)
-	fields[col] = b.countReq(me)
-}
-
-func (Benchmark) countReq(rr [][]string) string {
-	if len(rr) == 0 {
-		return "0"
-	}
-
-	var sum int
-	for _, m := range rr {
-		if m, err := strconv.Atoi(string(m[1])); err == nil {
-			sum += m
-		}
-	}
-	return asNum(sum)
+	res := perf.ParseReport("", data)
+	fields[2] = res.Status()
+	fields[3] = fmt.Sprintf("%.4f", res.Total.Seconds())
+	fields[4] = fmt.Sprintf("%.4f", res.RPS)
+	fields[5] = asNum(res.OK)
+	fields[6] = asNum(res.Errors)
 }
 
 // AsNumb prints a number with thousand separator.
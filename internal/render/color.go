@@ -17,6 +17,8 @@ var (
 	KillColor tcell.Color
 	// CompletedColor row completed color.
 	CompletedColor tcell.Color
+	// EvictedColor row evicted color.
+	EvictedColor tcell.Color
 )
 
 // ColorerFunc represents a resource row colorer.
@@ -36,3 +38,26 @@ func DefaultColorer(ns string, evt RowEvent) tcell.Color {
 
 	return col
 }
+
+// A collection of row severities.
+const (
+	SeverityOK        = "ok"
+	SeverityError     = "error"
+	SeverityHighlight = "highlight"
+	SeverityCompleted = "completed"
+)
+
+// Severity classifies a row color. Colors must be distinct for the
+// classification to be meaningful.
+func Severity(c tcell.Color) string {
+	switch c {
+	case ErrColor, EvictedColor:
+		return SeverityError
+	case HighlightColor:
+		return SeverityHighlight
+	case CompletedColor:
+		return SeverityCompleted
+	default:
+		return SeverityOK
+	}
+}
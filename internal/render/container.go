@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -45,6 +46,11 @@ func (Container) ColorerFunc() ColorerFunc {
 			c = ErrColor
 		}
 
+		reasonCol := readyCol + 6
+		if strings.TrimSpace(r.Row.Fields[reasonCol]) == OOMKilled {
+			return ErrColor
+		}
+
 		stateCol := readyCol + 1
 		switch strings.TrimSpace(r.Row.Fields[stateCol]) {
 		case ContainerCreating, PodInitializing:
@@ -66,11 +72,14 @@ func (Container) ColorerFunc() ColorerFunc {
 func (Container) Header(ns string) HeaderRow {
 	return HeaderRow{
 		Header{Name: "NAME"},
-		Header{Name: "IMAGE"},
+		Header{Name: "IMAGE", Long: true},
 		Header{Name: "READY"},
 		Header{Name: "STATE"},
 		Header{Name: "INIT"},
 		Header{Name: "RS", Align: tview.AlignRight},
+		Header{Name: "LAST RESTART", Decorator: TimestampDecorator, Time: TimeStamp},
+		Header{Name: "EXIT CODE", Align: tview.AlignRight},
+		Header{Name: "REASON"},
 		Header{Name: "PROBES(L:R)"},
 		Header{Name: "CPU", Align: tview.AlignRight},
 		Header{Name: "MEM", Align: tview.AlignRight},
@@ -90,25 +99,32 @@ func (c Container) Render(o interface{}, name string, r *Row) error {
 
 	cur, perc := gatherMetrics(co)
 	ready, state, restarts := "false", MissingValue, "0"
+	var last lastTermination
 	if co.Status != nil {
 		ready, state, restarts = boolToStr(co.Status.Ready), toState(co.Status.State), strconv.Itoa(int(co.Status.RestartCount))
+		last = toLastTermination(co.Status.LastTerminationState)
 	}
 
-	r.ID = co.Container.Name
+	pp := ToContainerPorts(co.Container.Ports)
+	r.ID = co.ID()
+	r.Data = pp
 	r.Fields = make(Fields, 0, len(c.Header(AllNamespaces)))
 	r.Fields = append(r.Fields,
 		co.Container.Name,
 		co.Container.Image,
 		ready,
 		state,
-		boolToStr(co.IsInit),
+		boolToStr(co.Type == InitContainer),
 		restarts,
+		last.finishedAt,
+		last.exitCode,
+		last.reason,
 		probe(co.Container.LivenessProbe)+":"+probe(co.Container.ReadinessProbe),
 		cur.cpu,
 		cur.mem,
 		perc.cpu,
 		perc.mem,
-		toStrPorts(co.Container.Ports),
+		toStrPorts(pp),
 		toAge(co.Age),
 	)
 
@@ -119,6 +135,9 @@ func (c Container) Render(o interface{}, name string, r *Row) error {
 // Helpers...
 
 func gatherMetrics(co ContainerRes) (c, p metric) {
+	if !co.Measurable() {
+		return unmeasuredMetric(), unmeasuredMetric()
+	}
 	c, p = noMetric(), noMetric()
 	if co.Metrics == nil {
 		return
@@ -142,16 +161,35 @@ func gatherMetrics(co ContainerRes) (c, p metric) {
 	return
 }
 
-func toStrPorts(pp []v1.ContainerPort) string {
+// lastTermination represents a container previous termination.
+type lastTermination struct {
+	finishedAt, exitCode, reason string
+}
+
+// toLastTermination renders a container last termination. The finish time is
+// kept as a UTC timestamp so it sorts chronologically and does not change
+// across refreshes.
+func toLastTermination(s v1.ContainerState) lastTermination {
+	t := s.Terminated
+	if t == nil {
+		return lastTermination{}
+	}
+	var at string
+	if !t.FinishedAt.IsZero() {
+		at = t.FinishedAt.UTC().Format(time.RFC3339)
+	}
+
+	return lastTermination{
+		finishedAt: at,
+		exitCode:   strconv.Itoa(int(t.ExitCode)),
+		reason:     t.Reason,
+	}
+}
+
+func toStrPorts(pp []ContainerPort) string {
 	ports := make([]string, len(pp))
 	for i, p := range pp {
-		if len(p.Name) > 0 {
-			ports[i] = p.Name + ":"
-		}
-		ports[i] += strconv.Itoa(int(p.ContainerPort))
-		if p.Protocol != "TCP" {
-			ports[i] += "╱" + string(p.Protocol)
-		}
+		ports[i] = p.String()
 	}
 
 	return strings.Join(ports, ",")
@@ -184,13 +222,59 @@ func probe(p *v1.Probe) string {
 	return "on"
 }
 
+// ContainerType represents the kind of a pod container.
+type ContainerType string
+
+const (
+	// RegularContainer represents a pod spec container.
+	RegularContainer ContainerType = "regular"
+
+	// InitContainer represents a pod init container.
+	InitContainer ContainerType = "init"
+
+	// EphemeralContainer represents a pod ephemeral debug container.
+	EphemeralContainer ContainerType = "ephemeral"
+)
+
 // ContainerRes represents a container and its metrics.
 type ContainerRes struct {
 	Container v1.Container
 	Status    *v1.ContainerStatus
 	Metrics   *mv1beta1.ContainerMetrics
-	IsInit    bool
+	Type      ContainerType
 	Age       metav1.Time
+
+	// Shadowed flags an init container named after another pod container.
+	Shadowed bool
+}
+
+// ID returns the container row id. Shadowed init containers are told apart
+// by their type.
+func (c ContainerRes) ID() string {
+	if c.Shadowed {
+		return string(c.Type) + ":" + c.Container.Name
+	}
+
+	return c.Container.Name
+}
+
+// Measurable checks if the container metrics pertain. Only running regular
+// or ephemeral containers are measured.
+func (c ContainerRes) Measurable() bool {
+	if c.Type == InitContainer || c.Status == nil {
+		return false
+	}
+
+	return c.Status.State.Running != nil
+}
+
+// ContainerName returns the container name given a container row id.
+func ContainerName(id string) string {
+	if i := strings.Index(id, ":"); i >= 0 {
+		return id[i+1:]
+	}
+
+	return id
 }
 
 // GetObjectKind returns a schema object.
@@ -202,3 +286,44 @@ func (c ContainerRes) GetObjectKind() schema.ObjectKind {
 func (c ContainerRes) DeepCopyObject() runtime.Object {
 	return c
 }
+
+// ContainerPort represents a container port.
+type ContainerPort struct {
+	Name     string
+	Port     int32
+	Protocol v1.Protocol
+}
+
+// IsTCP checks if the port uses the TCP protocol.
+func (c ContainerPort) IsTCP() bool {
+	return c.Protocol == v1.ProtocolTCP
+}
+
+// Target returns the port as name:number.
+func (c ContainerPort) Target() string {
+	p := strconv.Itoa(int(c.Port))
+	if c.Name == "" {
+		return p
+	}
+
+	return c.Name + ":" + p
+}
+
+// String returns the port as name:number╱protocol.
+func (c ContainerPort) String() string {
+	return c.Target() + "╱" + string(c.Protocol)
+}
+
+// ToContainerPorts converts a container spec ports. Protocol defaults to TCP.
+func ToContainerPorts(pp []v1.ContainerPort) []ContainerPort {
+	cc := make([]ContainerPort, 0, len(pp))
+	for _, p := range pp {
+		proto := p.Protocol
+		if proto == "" {
+			proto = v1.ProtocolTCP
+		}
+		cc = append(cc, ContainerPort{Name: p.Name, Port: p.ContainerPort, Protocol: proto})
+	}
+
+	return cc
+}
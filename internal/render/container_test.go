@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -20,7 +21,7 @@ func TestContainer(t *testing.T) {
 		Container: makeContainer(),
 		Status:    makeContainerStatus(),
 		Metrics:   makeContainerMetrics(),
-		IsInit:    false,
+		Type:      render.RegularContainer,
 		Age:       makeAge(),
 	}
 	var r render.Row
@@ -33,6 +34,9 @@ func TestContainer(t *testing.T) {
 		"Running",
 		"false",
 		"0",
+		"",
+		"",
+		"",
 		"off:off",
 		"10",
 		"20",
@@ -44,6 +48,194 @@ func TestContainer(t *testing.T) {
 	)
 }
 
+func TestContainerMeasurable(t *testing.T) {
+	completed := &v1.ContainerStatus{
+		Name:  "fred",
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}},
+	}
+	uu := map[string]struct {
+		cres render.ContainerRes
+		id   string
+		e    []string
+	}{
+		"running": {
+			cres: render.ContainerRes{Type: render.RegularContainer, Status: makeContainerStatus()},
+			id:   "fred",
+			e:    []string{"10", "20", "50", "20"},
+		},
+		"ephemeral": {
+			cres: render.ContainerRes{Type: render.EphemeralContainer, Status: makeContainerStatus()},
+			id:   "fred",
+			e:    []string{"10", "20", "50", "20"},
+		},
+		"terminated": {
+			cres: render.ContainerRes{Type: render.RegularContainer, Status: completed},
+			id:   "fred",
+			e:    []string{"-", "-", "-", "-"},
+		},
+		"noStatus": {
+			cres: render.ContainerRes{Type: render.RegularContainer},
+			id:   "fred",
+			e:    []string{"-", "-", "-", "-"},
+		},
+		"initCompleted": {
+			cres: render.ContainerRes{Type: render.InitContainer, Status: completed},
+			id:   "fred",
+			e:    []string{"-", "-", "-", "-"},
+		},
+		"initShadowed": {
+			cres: render.ContainerRes{Type: render.InitContainer, Status: completed, Shadowed: true},
+			id:   "init:fred",
+			e:    []string{"-", "-", "-", "-"},
+		},
+	}
+
+	var c render.Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.cres.Container, u.cres.Metrics, u.cres.Age = makeContainer(), makeContainerMetrics(), makeAge()
+			var r render.Row
+			assert.Nil(t, c.Render(u.cres, "blee", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, "fred", render.ContainerName(r.ID))
+			assert.Equal(t, u.e, []string(r.Fields[10:14]))
+			assert.Equal(t, boolStr(u.cres.Type == render.InitContainer), r.Fields[4])
+		})
+	}
+}
+
+func boolStr(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+func TestContainerPorts(t *testing.T) {
+	uu := map[string]struct {
+		pp []v1.ContainerPort
+		e  string
+		d  []render.ContainerPort
+	}{
+		"none": {
+			d: []render.ContainerPort{},
+		},
+		"named": {
+			pp: []v1.ContainerPort{{Name: "http", ContainerPort: 80, Protocol: v1.ProtocolTCP}},
+			e:  "http:80╱TCP",
+			d:  []render.ContainerPort{{Name: "http", Port: 80, Protocol: v1.ProtocolTCP}},
+		},
+		"default-protocol": {
+			pp: []v1.ContainerPort{{ContainerPort: 8080}},
+			e:  "8080╱TCP",
+			d:  []render.ContainerPort{{Port: 8080, Protocol: v1.ProtocolTCP}},
+		},
+		"udp": {
+			pp: []v1.ContainerPort{{Name: "dns", ContainerPort: 53, Protocol: v1.ProtocolUDP}},
+			e:  "dns:53╱UDP",
+			d:  []render.ContainerPort{{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP}},
+		},
+		"sctp": {
+			pp: []v1.ContainerPort{{ContainerPort: 9999, Protocol: v1.ProtocolSCTP}},
+			e:  "9999╱SCTP",
+			d:  []render.ContainerPort{{Port: 9999, Protocol: v1.ProtocolSCTP}},
+		},
+		"multi": {
+			pp: []v1.ContainerPort{
+				{Name: "dns", ContainerPort: 53, Protocol: v1.ProtocolUDP},
+				{Name: "dns-tcp", ContainerPort: 53, Protocol: v1.ProtocolTCP},
+			},
+			e: "dns:53╱UDP,dns-tcp:53╱TCP",
+			d: []render.ContainerPort{
+				{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP},
+				{Name: "dns-tcp", Port: 53, Protocol: v1.ProtocolTCP},
+			},
+		},
+	}
+
+	var c render.Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := makeContainer()
+			co.Ports = u.pp
+			var r render.Row
+			assert.Nil(t, c.Render(render.ContainerRes{Container: co, Age: makeAge()}, "blee", &r))
+			assert.Equal(t, u.e, r.Fields[14])
+			assert.Equal(t, u.d, r.Data)
+		})
+	}
+}
+
+func TestContainerLastTermination(t *testing.T) {
+	uu := map[string]struct {
+		last v1.ContainerState
+		e    render.Fields
+		c    tcell.Color
+	}{
+		"none": {
+			e: render.Fields{"0", "", "", ""},
+			c: render.StdColor,
+		},
+		"oomKilled": {
+			last: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+				ExitCode:   137,
+				Reason:     render.OOMKilled,
+				FinishedAt: makeAge(),
+			}},
+			e: render.Fields{"3", "2018-12-14T17:36:43Z", "137", render.OOMKilled},
+			c: render.ErrColor,
+		},
+		"error": {
+			last: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+				ExitCode:   1,
+				Reason:     "Error",
+				FinishedAt: makeAge(),
+			}},
+			e: render.Fields{"3", "2018-12-14T17:36:43Z", "1", "Error"},
+			c: render.StdColor,
+		},
+		"unfinished": {
+			last: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: render.Completed}},
+			e:    render.Fields{"3", "", "0", render.Completed},
+			c:    render.StdColor,
+		},
+	}
+
+	var c render.Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			st := makeContainerStatus()
+			st.Ready = true
+			st.LastTerminationState = u.last
+			if u.last.Terminated != nil {
+				st.RestartCount = 3
+			}
+			var r render.Row
+			assert.Nil(t, c.Render(render.ContainerRes{Container: makeContainer(), Status: st, Age: makeAge()}, "blee", &r))
+			assert.Equal(t, u.e, r.Fields[5:9])
+			assert.Equal(t, u.c, c.ColorerFunc()("", render.RowEvent{Row: r}))
+		})
+	}
+}
+
+func TestTimestampDecorator(t *testing.T) {
+	ts := time.Now().Add(-90 * time.Minute).UTC().Format(time.RFC3339)
+
+	assert.Equal(t, "90m", render.TimestampDecorator(ts))
+	assert.Equal(t, "90mΔ", render.TimestampDecorator(ts+"Δ"))
+	assert.Equal(t, "", render.TimestampDecorator(""))
+	assert.Equal(t, "blee", render.TimestampDecorator("blee"))
+}
+
+func TestContainerPortIsTCP(t *testing.T) {
+	assert.True(t, render.ContainerPort{Port: 80, Protocol: v1.ProtocolTCP}.IsTCP())
+	assert.False(t, render.ContainerPort{Port: 53, Protocol: v1.ProtocolUDP}.IsTCP())
+	assert.False(t, render.ContainerPort{Port: 99, Protocol: v1.ProtocolSCTP}.IsTCP())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
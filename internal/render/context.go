@@ -11,6 +11,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
+const currentContextMarker = "*"
+
 // Context renders a K8s ConfigMap to screen.
 type Context struct{}
 
@@ -21,7 +23,7 @@ func (Context) ColorerFunc() ColorerFunc {
 		if r.Kind == EventAdd || r.Kind == EventUpdate {
 			return c
 		}
-		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
+		if strings.TrimSpace(r.Row.Fields[1]) == currentContextMarker {
 			c = HighlightColor
 		}
 
@@ -33,9 +35,11 @@ func (Context) ColorerFunc() ColorerFunc {
 func (Context) Header(ns string) HeaderRow {
 	return HeaderRow{
 		Header{Name: "NAME"},
+		Header{Name: "CURRENT"},
 		Header{Name: "CLUSTER"},
 		Header{Name: "AUTHINFO"},
 		Header{Name: "NAMESPACE"},
+		Header{Name: "ORIGIN"},
 	}
 }
 
@@ -46,17 +50,19 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 		return fmt.Errorf("expected *NamedContext, but got %T", o)
 	}
 
-	name := ctx.Name
+	var current string
 	if ctx.IsCurrentContext(ctx.Name) {
-		name += "(*)"
+		current = currentContextMarker
 	}
 
 	r.ID = ctx.Name
 	r.Fields = Fields{
-		name,
+		ctx.Name,
+		current,
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		ctx.Context.LocationOfOrigin,
 	}
 
 	return nil
@@ -11,7 +11,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Equal(t, 4, len(c.Header("")))
+	assert.Equal(t, 6, len(c.Header("")))
 }
 
 func TestContextRender(t *testing.T) {
@@ -32,7 +32,22 @@ func TestContextRender(t *testing.T) {
 			},
 			e: render.Row{
 				ID:     "c1",
-				Fields: render.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: render.Fields{"c1", "", "c1", "u1", "ns1", "fred"},
+			},
+		},
+		"current": {
+			ctx: &render.NamedContext{
+				Name: "fred",
+				Context: &api.Context{
+					LocationOfOrigin: "/tmp/config",
+					Cluster:          "c2",
+					AuthInfo:         "u2",
+				},
+				Config: &config{},
+			},
+			e: render.Row{
+				ID:     "fred",
+				Fields: render.Fields{"fred", "*", "c2", "u2", "", "/tmp/config"},
 			},
 		},
 	}
@@ -41,7 +56,7 @@ func TestContextRender(t *testing.T) {
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			row := render.NewRow(4)
+			row := render.NewRow(6)
 			err := r.Render(uc.ctx, "", &row)
 
 			assert.Nil(t, err)
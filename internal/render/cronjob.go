@@ -3,8 +3,11 @@ package render
 import (
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/derailed/k9s/internal/cron"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -29,7 +32,9 @@ func (CronJob) Header(ns string) HeaderRow {
 		Header{Name: "SCHEDULE"},
 		Header{Name: "SUSPEND"},
 		Header{Name: "ACTIVE"},
-		Header{Name: "LAST_SCHEDULE"},
+		Header{Name: "LAST RUN", Decorator: runDecorator, Time: TimeSince},
+		Header{Name: "LAST SUCCESS", Decorator: runDecorator, Time: TimeSince},
+		Header{Name: "NEXT RUN", Decorator: runDecorator, Time: TimeUntil},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
@@ -46,10 +51,18 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 		return err
 	}
 
-	lastScheduled := "<none>"
+	lastRun := MissingValue
 	if cj.Status.LastScheduleTime != nil {
-		lastScheduled = toAgeHuman(toAge(*cj.Status.LastScheduleTime))
+		lastRun = toAge(*cj.Status.LastScheduleTime)
 	}
+	// Time zones and last success were introduced after batch/v1beta1 so are read raw.
+	lastSuccess := MissingValue
+	if ts, ok, _ := unstructured.NestedString(raw.Object, "status", "lastSuccessfulTime"); ok {
+		if t, err := time.Parse(time.RFC3339, ts); err == nil {
+			lastSuccess = toAge(metav1.NewTime(t))
+		}
+	}
+	tz, _, _ := unstructured.NestedString(raw.Object, "spec", "timeZone")
 
 	r.ID = MetaFQN(cj.ObjectMeta)
 	r.Fields = make(Fields, 0, len(c.Header(ns)))
@@ -61,9 +74,52 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 		cj.Spec.Schedule,
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
-		lastScheduled,
+		lastRun,
+		lastSuccess,
+		nextRun(cj.Spec.Schedule, tz, cj.Spec.Suspend, time.Now()),
 		toAge(cj.ObjectMeta.CreationTimestamp),
 	)
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// suspendedRun indicates a suspended cronjob next run.
+const suspendedRun = "-"
+
+// runDecorator humanizes run durations leaving markers as is.
+func runDecorator(s string) string {
+	if _, err := time.ParseDuration(s); err != nil {
+		return s
+	}
+
+	return toAgeHuman(s)
+}
+
+// nextRun returns the time left until the next schedule. Schedules without an
+// explicit time zone are deemed UTC as the cronjob controller does.
+func nextRun(schedule, tz string, suspend *bool, now time.Time) string {
+	if suspend != nil && *suspend {
+		return suspendedRun
+	}
+	loc := time.UTC
+	if tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return NAValue
+		}
+		loc = l
+	}
+	s, err := cron.Parse(schedule, loc)
+	if err != nil {
+		return NAValue
+	}
+	next := s.Next(now)
+	if next.IsZero() {
+		return NAValue
+	}
+
+	return next.Sub(now).String()
+}
@@ -0,0 +1,67 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextRun(t *testing.T) {
+	yes, no := true, false
+	now := time.Date(2020, 3, 7, 17, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		schedule, tz string
+		suspend      *bool
+		e            string
+	}{
+		"utc": {
+			schedule: "0 18 * * *",
+			e:        "1h0m0s",
+		},
+		"notSuspended": {
+			schedule: "*/15 * * * *",
+			suspend:  &no,
+			e:        "15m0s",
+		},
+		"suspended": {
+			schedule: "0 18 * * *",
+			suspend:  &yes,
+			e:        "-",
+		},
+		// New York springs forward on 2020-03-08 so 9am local is 13:00 UTC not 14:00.
+		"timeZoneDST": {
+			schedule: "0 9 * * *",
+			tz:       "America/New_York",
+			e:        "20h0m0s",
+		},
+		"badTimeZone": {
+			schedule: "0 9 * * *",
+			tz:       "Mars/Olympus",
+			e:        NAValue,
+		},
+		"badSchedule": {
+			schedule: "0 25 * * *",
+			e:        NAValue,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			if u.tz == "America/New_York" {
+				if _, err := time.LoadLocation(u.tz); err != nil {
+					t.Skip("no time zone database available")
+				}
+			}
+			assert.Equal(t, u.e, nextRun(u.schedule, u.tz, u.suspend, now))
+		})
+	}
+}
+
+func TestRunDecorator(t *testing.T) {
+	assert.Equal(t, "-", runDecorator("-"))
+	assert.Equal(t, MissingValue, runDecorator(MissingValue))
+	assert.Equal(t, "90m", runDecorator("1h30m0s"))
+}
@@ -2,6 +2,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -9,9 +10,13 @@ import (
 
 func TestCronJobRender(t *testing.T) {
 	c := render.CronJob{}
-	r := render.NewRow(6)
-	c.Render(load(t, "cj"), "", &r)
+	r := render.NewRow(9)
+	assert.Nil(t, c.Render(load(t, "cj"), "", &r))
 
 	assert.Equal(t, "default/hello", r.ID)
 	assert.Equal(t, render.Fields{"default", "hello", "*/1 * * * *", "false", "0"}, r.Fields[:5])
+	assert.Equal(t, "<none>", r.Fields[6])
+	next, err := time.ParseDuration(r.Fields[7])
+	assert.Nil(t, err)
+	assert.True(t, next > 0 && next <= time.Minute)
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -48,13 +47,15 @@ func (Deployment) Header(ns string) HeaderRow {
 		Header{Name: "READY"},
 		Header{Name: "UP-TO-DATE", Align: tview.AlignRight},
 		Header{Name: "AVAILABLE", Align: tview.AlignRight},
+		Header{Name: "DRIFT", Wide: true},
+		Header{Name: ManagedByCol, Wide: true},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (d Deployment) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
+	raw, drift, ok := unwrapDrift(o)
 	if !ok {
 		return fmt.Errorf("Expected Deployment, but got %T", o)
 	}
@@ -75,6 +76,8 @@ func (d Deployment) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(dp.Status.AvailableReplicas))+"/"+strconv.Itoa(int(*dp.Spec.Replicas)),
 		strconv.Itoa(int(dp.Status.UpdatedReplicas)),
 		strconv.Itoa(int(dp.Status.AvailableReplicas)),
+		drift,
+		ManagedBy(dp.Labels, dp.Annotations),
 		toAge(dp.ObjectMeta.CreationTimestamp),
 	)
 
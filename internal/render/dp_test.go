@@ -27,3 +27,25 @@ func BenchmarkDpRender(b *testing.B) {
 		_ = c.Render(o, "", &r)
 	}
 }
+
+func TestDpRenderDrift(t *testing.T) {
+	uu := map[string]struct {
+		old int
+		e   string
+	}{
+		"synced": {0, "synced"},
+		"one":    {1, "drift (1 pod old)"},
+		"many":   {2, "drift (2 pods old)"},
+	}
+
+	var c render.Deployment
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			o := render.WorkloadWithDrift{Raw: load(t, "dp"), OldPods: u.old}
+			assert.Nil(t, c.Render(&o, "", &r))
+			assert.Equal(t, u.e, r.Fields[5])
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package render
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkloadWithDrift represents a workload and its pods image drift.
+type WorkloadWithDrift struct {
+	Raw     *unstructured.Unstructured
+	OldPods int
+}
+
+// GetObjectKind returns a schema object.
+func (w *WorkloadWithDrift) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w *WorkloadWithDrift) DeepCopyObject() runtime.Object {
+	return w
+}
+
+// unwrapDrift extracts a workload and its drift status. Unknown drift renders
+// as missing.
+func unwrapDrift(o interface{}) (*unstructured.Unstructured, string, bool) {
+	switch w := o.(type) {
+	case *WorkloadWithDrift:
+		return w.Raw, toDrift(w.OldPods), true
+	case *unstructured.Unstructured:
+		return w, MissingValue, true
+	default:
+		return nil, "", false
+	}
+}
+
+func toDrift(old int) string {
+	switch old {
+	case 0:
+		return "synced"
+	case 1:
+		return "drift (1 pod old)"
+	default:
+		return fmt.Sprintf("drift (%d pods old)", old)
+	}
+}
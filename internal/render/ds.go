@@ -8,7 +8,6 @@ import (
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -49,13 +48,15 @@ func (DaemonSet) Header(ns string) HeaderRow {
 		Header{Name: "READY", Align: tview.AlignRight},
 		Header{Name: "UP-TO-DATE", Align: tview.AlignRight},
 		Header{Name: "AVAILABLE", Align: tview.AlignRight},
+		Header{Name: "DRIFT", Wide: true},
+		Header{Name: ManagedByCol, Wide: true},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (d DaemonSet) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
+	raw, drift, ok := unwrapDrift(o)
 	if !ok {
 		return fmt.Errorf("Expected DaemonSet, but got %T", o)
 	}
@@ -77,6 +78,8 @@ func (d DaemonSet) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(ds.Status.NumberReady)),
 		strconv.Itoa(int(ds.Status.UpdatedNumberScheduled)),
 		strconv.Itoa(int(ds.Status.NumberAvailable)),
+		drift,
+		ManagedBy(ds.Labels, ds.Annotations),
 		toAge(ds.ObjectMeta.CreationTimestamp),
 	)
 
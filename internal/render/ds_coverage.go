@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NodeCoverage renders a daemonset node coverage to screen.
+type NodeCoverage struct{}
+
+// ColorerFunc colors a resource row.
+func (NodeCoverage) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		switch r.Row.Fields[5] {
+		case "Missing", "NotReady":
+			return ErrColor
+		case "Outdated":
+			return ModColor
+		case "Excluded":
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (NodeCoverage) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NODE"},
+		Header{Name: "POD"},
+		Header{Name: "SCHEDULED"},
+		Header{Name: "READY"},
+		Header{Name: "UPDATED"},
+		Header{Name: "STATUS"},
+		Header{Name: "REASON"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NodeCoverage) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(NodeCoverageRes)
+	if !ok {
+		return fmt.Errorf("expecting NodeCoverageRes but got %T", o)
+	}
+
+	r.ID = c.Node
+	r.Fields = Fields{
+		c.Node,
+		na(c.Pod),
+		boolToStr(c.Scheduled),
+		boolToStr(c.Ready),
+		boolToStr(c.Updated),
+		c.Status,
+		c.Excluded,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodeCoverageRes represents a daemonset pod status on a node.
+type NodeCoverageRes struct {
+	Node      string
+	Pod       string
+	Scheduled bool
+	Ready     bool
+	Updated   bool
+	Excluded  string
+	Status    string
+}
+
+// GetObjectKind returns a schema object.
+func (NodeCoverageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NodeCoverageRes) DeepCopyObject() runtime.Object {
+	return n
+}
@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeCoverageRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.NodeCoverageRes
+		e render.Fields
+	}{
+		"ready": {
+			o: render.NodeCoverageRes{Node: "n1", Pod: "default/fred-1", Scheduled: true, Ready: true, Updated: true, Status: "Ready"},
+			e: render.Fields{"n1", "default/fred-1", "true", "true", "true", "Ready", ""},
+		},
+		"excluded": {
+			o: render.NodeCoverageRes{Node: "n2", Excluded: "nodeSelector disk=ssd mismatch", Status: "Excluded"},
+			e: render.Fields{"n2", render.NAValue, "false", "false", "false", "Excluded", "nodeSelector disk=ssd mismatch"},
+		},
+	}
+
+	var n render.NodeCoverage
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, n.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Node, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
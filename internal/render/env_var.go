@@ -0,0 +1,117 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EnvSourceSpec indicates a variable defined directly on the container.
+const EnvSourceSpec = "env"
+
+// EnvVar renders a container environment variable to screen.
+type EnvVar struct{}
+
+// ColorerFunc colors a resource row.
+func (EnvVar) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if strings.HasPrefix(strings.TrimSpace(re.Row.Fields[1]), "<") {
+			return HighlightColor
+		}
+		return c
+	}
+}
+
+// Header returns a header row.
+func (EnvVar) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "VALUE"},
+		Header{Name: "SOURCE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (e EnvVar) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(EnvVarRes)
+	if !ok {
+		return fmt.Errorf("expecting EnvVarRes but got %T", o)
+	}
+
+	r.ID = res.Source + ":" + res.Name
+	r.Fields = Fields{
+		res.Name,
+		res.value(),
+		res.Source,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// EnvRef returns a symbolic representation of an env var source.
+func EnvRef(s *v1.EnvVarSource) string {
+	switch {
+	case s == nil:
+		return ""
+	case s.SecretKeyRef != nil:
+		return "<secret:" + s.SecretKeyRef.Name + "/" + s.SecretKeyRef.Key + ">"
+	case s.ConfigMapKeyRef != nil:
+		return "<configmap:" + s.ConfigMapKeyRef.Name + "/" + s.ConfigMapKeyRef.Key + ">"
+	case s.FieldRef != nil:
+		return "<field:" + s.FieldRef.FieldPath + ">"
+	case s.ResourceFieldRef != nil:
+		ref := "<resource:" + s.ResourceFieldRef.Resource
+		if s.ResourceFieldRef.ContainerName != "" {
+			ref += "@" + s.ResourceFieldRef.ContainerName
+		}
+		return ref + ">"
+	default:
+		return UnknownValue
+	}
+}
+
+// EnvFromRef returns a symbolic representation of an envFrom source.
+func EnvFromRef(s v1.EnvFromSource) string {
+	switch {
+	case s.SecretRef != nil:
+		return "envFrom:secret/" + s.SecretRef.Name
+	case s.ConfigMapRef != nil:
+		return "envFrom:configmap/" + s.ConfigMapRef.Name
+	default:
+		return "envFrom:" + UnknownValue
+	}
+}
+
+// EnvVarRes represents a container environment variable.
+type EnvVarRes struct {
+	Name     string
+	Value    string
+	Ref      string
+	Source   string
+	Resolved bool
+}
+
+func (e EnvVarRes) value() string {
+	if e.Ref == "" || e.Resolved {
+		return e.Value
+	}
+	return e.Ref
+}
+
+// GetObjectKind returns a schema object.
+func (EnvVarRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (e EnvVarRes) DeepCopyObject() runtime.Object {
+	return e
+}
@@ -0,0 +1,100 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestEnvVarRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.EnvVarRes
+		e render.Row
+	}{
+		"plain": {
+			o: render.EnvVarRes{Name: "FRED", Value: "blee", Source: render.EnvSourceSpec},
+			e: render.Row{ID: "env:FRED", Fields: render.Fields{"FRED", "blee", "env"}},
+		},
+		"unresolved": {
+			o: render.EnvVarRes{Name: "PWD", Ref: "<secret:s1/pwd>", Source: render.EnvSourceSpec},
+			e: render.Row{ID: "env:PWD", Fields: render.Fields{"PWD", "<secret:s1/pwd>", "env"}},
+		},
+		"resolved": {
+			o: render.EnvVarRes{Name: "PWD", Value: "zorg", Ref: "<secret:s1/pwd>", Source: render.EnvSourceSpec, Resolved: true},
+			e: render.Row{ID: "env:PWD", Fields: render.Fields{"PWD", "zorg", "env"}},
+		},
+	}
+
+	var e render.EnvVar
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, e.Render(u.o, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
+
+func TestEnvRef(t *testing.T) {
+	uu := map[string]struct {
+		s *v1.EnvVarSource
+		e string
+	}{
+		"none": {},
+		"secret": {
+			s: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "s1"},
+				Key:                  "k1",
+			}},
+			e: "<secret:s1/k1>",
+		},
+		"configmap": {
+			s: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "cm1"},
+				Key:                  "k1",
+			}},
+			e: "<configmap:cm1/k1>",
+		},
+		"field": {
+			s: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "status.podIP"}},
+			e: "<field:status.podIP>",
+		},
+		"resource": {
+			s: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{ContainerName: "c1", Resource: "limits.cpu"}},
+			e: "<resource:limits.cpu@c1>",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.EnvRef(u.s))
+		})
+	}
+}
+
+func TestEnvFromRef(t *testing.T) {
+	uu := map[string]struct {
+		s v1.EnvFromSource
+		e string
+	}{
+		"secret": {
+			s: v1.EnvFromSource{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+			e: "envFrom:secret/s1",
+		},
+		"configmap": {
+			s: v1.EnvFromSource{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+			e: "envFrom:configmap/cm1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.EnvFromRef(u.s))
+		})
+	}
+}
@@ -2,20 +2,38 @@ package render
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// maxEPs represents the max number of addresses listed per cell.
+const maxEPs = 3
+
 // Endpoints renders a K8s Endpoints to screen.
 type Endpoints struct{}
 
 // ColorerFunc colors a resource row.
 func (Endpoints) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+
+		notReadyCol := 4
+		if isAllNamespace(ns) {
+			notReadyCol++
+		}
+		if strings.TrimSpace(r.Row.Fields[notReadyCol]) != MissingValue {
+			return ErrColor
+		}
+
+		return c
+	}
 }
 
 // Header returns a header row.
@@ -27,32 +45,33 @@ func (Endpoints) Header(ns string) HeaderRow {
 
 	return append(h,
 		Header{Name: "NAME"},
+		Header{Name: "PORTS"},
+		Header{Name: "READY"},
 		Header{Name: "ENDPOINTS"},
+		Header{Name: "NOT-READY"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (e Endpoints) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
+	ep, ok := o.(EndpointsRes)
 	if !ok {
-		return fmt.Errorf("Expected Endpoints, but got %T", o)
-	}
-	var ep v1.Endpoints
-	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ep)
-	if err != nil {
-		return err
+		return fmt.Errorf("Expected EndpointsRes, but got %T", o)
 	}
 
-	r.ID = MetaFQN(ep.ObjectMeta)
+	r.ID = FQN(ep.Namespace, ep.Name)
 	r.Fields = make(Fields, 0, len(e.Header(ns)))
 	if isAllNamespace(ns) {
 		r.Fields = append(r.Fields, ep.Namespace)
 	}
 	r.Fields = append(r.Fields,
 		ep.Name,
-		missing(toEPs(ep.Subsets)),
-		toAge(ep.ObjectMeta.CreationTimestamp),
+		missing(strings.Join(ep.Ports, ",")),
+		fmt.Sprintf("%d/%d", len(ep.Ready), len(ep.Ready)+len(ep.NotReady)),
+		missing(truncateEPs(ep.Ready, maxEPs)),
+		missing(truncateEPs(ep.NotReady, maxEPs)),
+		toAge(ep.CreationTimestamp),
 	)
 
 	return nil
@@ -61,39 +80,29 @@ func (e Endpoints) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func toEPs(ss []v1.EndpointSubset) string {
-	aa := make([]string, 0, len(ss))
-	for _, s := range ss {
-		pp := make([]string, len(s.Ports))
-		portsToStrs(s.Ports, pp)
-		a := make([]string, len(s.Addresses))
-		proccessIPs(a, pp, s.Addresses)
-		aa = append(aa, strings.Join(a, ","))
-	}
-	return strings.Join(aa, ",")
+// EndpointsRes represents a service endpoints readiness breakdown.
+type EndpointsRes struct {
+	Namespace, Name   string
+	Ports             []string
+	Ready, NotReady   []string
+	CreationTimestamp metav1.Time
 }
 
-func portsToStrs(pp []v1.EndpointPort, ss []string) {
-	for i := 0; i < len(pp); i++ {
-		ss[i] = strconv.Itoa(int(pp[i].Port))
-	}
+// GetObjectKind returns a schema object.
+func (EndpointsRes) GetObjectKind() schema.ObjectKind {
+	return nil
 }
 
-func proccessIPs(aa []string, pp []string, addrs []v1.EndpointAddress) {
-	const maxIPs = 3
-	var i int
-	for _, a := range addrs {
-		if len(a.IP) == 0 {
-			continue
-		}
-		if len(pp) == 0 {
-			aa[i], i = a.IP, i+1
-			continue
-		}
-		if len(pp) > maxIPs {
-			aa[i], i = a.IP+":"+strings.Join(pp[:maxIPs], ",")+"...", i+1
-		} else {
-			aa[i], i = a.IP+":"+strings.Join(pp, ","), i+1
-		}
+// DeepCopyObject returns a container copy.
+func (e EndpointsRes) DeepCopyObject() runtime.Object {
+	return e
+}
+
+// truncateEPs lists the first addresses and tallies the rest.
+func truncateEPs(aa []string, max int) string {
+	if len(aa) <= max {
+		return strings.Join(aa, ",")
 	}
+
+	return fmt.Sprintf("%s +%d more", strings.Join(aa[:max], ","), len(aa)-max)
 }
@@ -8,10 +8,42 @@ import (
 )
 
 func TestEndpointsRender(t *testing.T) {
-	c := render.Endpoints{}
-	r := render.NewRow(4)
-	c.Render(load(t, "ep"), "", &r)
+	uu := map[string]struct {
+		o render.EndpointsRes
+		e render.Fields
+	}{
+		"empty": {
+			o: render.EndpointsRes{Namespace: "default", Name: "dictionary1"},
+			e: render.Fields{"default", "dictionary1", "<none>", "0/0", "<none>", "<none>"},
+		},
+		"mixed": {
+			o: render.EndpointsRes{
+				Namespace: "default",
+				Name:      "fred",
+				Ports:     []string{"http:80", "443"},
+				Ready:     []string{"10.0.0.1(p1)"},
+				NotReady:  []string{"10.0.0.2(p2)"},
+			},
+			e: render.Fields{"default", "fred", "http:80,443", "1/2", "10.0.0.1(p1)", "10.0.0.2(p2)"},
+		},
+		"truncated": {
+			o: render.EndpointsRes{
+				Namespace: "default",
+				Name:      "fred",
+				Ready:     []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"},
+			},
+			e: render.Fields{"default", "fred", "<none>", "5/5", "10.0.0.1,10.0.0.2,10.0.0.3 +2 more", "<none>"},
+		},
+	}
 
-	assert.Equal(t, "default/dictionary1", r.ID)
-	assert.Equal(t, render.Fields{"default", "dictionary1", "<none>"}, r.Fields[:3])
+	var c render.Endpoints
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(7)
+			assert.Nil(t, c.Render(u.o, "", &r))
+			assert.Equal(t, "default/"+u.o.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+		})
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -15,20 +16,26 @@ import (
 // Event renders a K8s Event to screen.
 type Event struct{}
 
+// EventRepeatThreshold flags events firing at least this many times.
+const EventRepeatThreshold = 10
+
 // ColorerFunc colors a resource row.
 func (Event) ColorerFunc() ColorerFunc {
 	return func(ns string, r RowEvent) tcell.Color {
 		c := DefaultColorer(ns, r)
 
-		markCol := 3
-		if ns != AllNamespaces {
-			markCol = 2
+		reasonCol, countCol := 1, 3
+		if isAllNamespace(ns) {
+			reasonCol, countCol = reasonCol+1, countCol+1
 		}
-		switch strings.TrimSpace(r.Row.Fields[markCol]) {
+		switch strings.TrimSpace(r.Row.Fields[reasonCol]) {
 		case "Failed":
-			c = ErrColor
+			return ErrColor
 		case "Killing":
-			c = KillColor
+			return KillColor
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(r.Row.Fields[countCol])); err == nil && n >= EventRepeatThreshold {
+			return HighlightColor
 		}
 
 		return c
@@ -47,7 +54,8 @@ func (Event) Header(ns string) HeaderRow {
 		Header{Name: "REASON"},
 		Header{Name: "SOURCE"},
 		Header{Name: "COUNT", Align: tview.AlignRight},
-		Header{Name: "MESSAGE"},
+		Header{Name: "MESSAGE", Long: true},
+		Header{Name: "LAST SEEN", Decorator: AgeDecorator, Time: TimeSince},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
@@ -74,12 +82,23 @@ func (e Event) Render(o interface{}, ns string, r *Row) error {
 		ev.Reason,
 		ev.Source.Component,
 		strconv.Itoa(int(ev.Count)),
-		Truncate(ev.Message, 80),
-		toAge(ev.LastTimestamp))
+		ev.Message,
+		toAge(ev.LastTimestamp),
+		toAge(eventFirstSeen(ev)))
 
 	return nil
 }
 
+// eventFirstSeen returns when an event first fired. Events recorded by the
+// newer api only carry a creation time.
+func eventFirstSeen(ev v1.Event) metav1.Time {
+	if ev.FirstTimestamp.IsZero() {
+		return ev.CreationTimestamp
+	}
+
+	return ev.FirstTimestamp
+}
+
 func asRef(r v1.ObjectReference) string {
 	return strings.ToLower(r.Kind) + ":" + r.Name
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,4 +15,47 @@ func TestEventRender(t *testing.T) {
 
 	assert.Equal(t, "default/hello-1567197780-mn4mv.15bfce150bd764dd", r.ID)
 	assert.Equal(t, render.Fields{"default", "pod:hello-1567197780-mn4mv", "Pulled", "kubelet", "1", `Successfully pulled image "blang/busybox-bash"`}, r.Fields[:6])
+	assert.Equal(t, 8, len(r.Fields))
+}
+
+func TestEventColorer(t *testing.T) {
+	uu := map[string]struct {
+		ns string
+		r  render.Row
+		e  tcell.Color
+	}{
+		"std": {
+			ns: "default",
+			r:  render.Row{Fields: render.Fields{"pod:p1", "Pulled", "kubelet", "1", "blee", "1m"}},
+			e:  render.AddColor,
+		},
+		"failed": {
+			ns: "default",
+			r:  render.Row{Fields: render.Fields{"pod:p1", "Failed", "kubelet", "1", "blee", "1m"}},
+			e:  render.ErrColor,
+		},
+		"killingAllNS": {
+			ns: render.AllNamespaces,
+			r:  render.Row{Fields: render.Fields{"default", "pod:p1", "Killing", "kubelet", "1", "blee", "1m"}},
+			e:  render.KillColor,
+		},
+		"repeated": {
+			ns: "default",
+			r:  render.Row{Fields: render.Fields{"pod:p1", "BackOff", "kubelet", "42", "blee", "1m"}},
+			e:  render.HighlightColor,
+		},
+		"repeatedAllNS": {
+			ns: render.AllNamespaces,
+			r:  render.Row{Fields: render.Fields{"default", "pod:p1", "BackOff", "kubelet", "10", "blee", "1m"}},
+			e:  render.HighlightColor,
+		},
+	}
+
+	f := render.Event{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f(u.ns, render.RowEvent{Kind: render.EventAdd, Row: u.r}))
+		})
+	}
 }
@@ -0,0 +1,92 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of bulk operation outcomes.
+const (
+	ForeachPending = "Pending"
+	ForeachOK      = "OK"
+	ForeachFailed  = "Failed"
+	ForeachSkipped = "Skipped"
+	ForeachRunning = "Running"
+)
+
+// Foreach renders a bulk operation targets to screen.
+type Foreach struct{}
+
+// ColorerFunc colors a resource row.
+func (Foreach) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[4] {
+		case ForeachOK:
+			return CompletedColor
+		case ForeachFailed:
+			return ErrColor
+		case ForeachSkipped:
+			return KillColor
+		case ForeachRunning:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Foreach) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "ACTION"},
+		Header{Name: "STATUS"},
+		Header{Name: "MESSAGE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Foreach) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ForeachResult)
+	if !ok {
+		return fmt.Errorf("expected ForeachResult, but got %T", o)
+	}
+
+	r.ID = res.Resource + ":" + res.Path
+	ns, n := Namespaced(res.Path)
+	r.Fields = Fields{
+		res.Resource,
+		missing(ns),
+		n,
+		res.Action,
+		res.Status,
+		res.Message,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ForeachResult represents a bulk operation target state.
+type ForeachResult struct {
+	Resource, Path  string
+	Action          string
+	Status, Message string
+}
+
+// GetObjectKind returns a schema object.
+func (ForeachResult) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r ForeachResult) DeepCopyObject() runtime.Object {
+	return r
+}
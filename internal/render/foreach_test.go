@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForeachRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ForeachResult
+		id  string
+		e   render.Fields
+	}{
+		"namespaced": {
+			res: render.ForeachResult{Resource: "pods", Path: "ns1/p1", Action: "delete", Status: render.ForeachFailed, Message: "boom"},
+			id:  "pods:ns1/p1",
+			e:   render.Fields{"pods", "ns1", "p1", "delete", render.ForeachFailed, "boom"},
+		},
+		"clusterScoped": {
+			res: render.ForeachResult{Resource: "nodes", Path: "n1", Action: "label tier=web", Status: render.ForeachOK},
+			id:  "nodes:n1",
+			e:   render.Fields{"nodes", "<none>", "n1", "label tier=web", render.ForeachOK, ""},
+		},
+	}
+
+	var f render.Foreach
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, f.Render(u.res, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
+
+func TestForeachRenderBadObject(t *testing.T) {
+	var r render.Row
+	assert.Error(t, render.Foreach{}.Render("fred", "", &r))
+}
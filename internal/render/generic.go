@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/derailed/tview"
+
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 )
 
@@ -15,12 +17,14 @@ const ageTableCol = "Age"
 type Generic struct {
 	table *metav1beta1.Table
 
-	ageIndex int
+	// cols lists the table columns indexes in display order ie standard
+	// columns, age then wide columns.
+	cols []int
 }
 
 // SetTable sets the tabular resource.
 func (g *Generic) SetTable(t *metav1beta1.Table) {
-	g.table = t
+	g.table, g.cols = t, displayOrder(t)
 }
 
 // ColorerFunc colors a resource row.
@@ -38,15 +42,17 @@ func (g *Generic) Header(ns string) HeaderRow {
 	if ns == "" {
 		h = append(h, Header{Name: "NAMESPACE"})
 	}
-	for i, c := range g.table.ColumnDefinitions {
+	for _, i := range g.cols {
+		c := g.table.ColumnDefinitions[i]
 		if c.Name == ageTableCol {
-			g.ageIndex = i
+			h = append(h, Header{Name: "AGE"})
 			continue
 		}
-		h = append(h, Header{Name: strings.ToUpper(c.Name)})
-	}
-	if g.ageIndex > 0 {
-		h = append(h, Header{Name: "AGE"})
+		h = append(h, Header{
+			Name:  strings.ToUpper(c.Name),
+			Align: columnAlign(c.Type),
+			Wide:  c.Priority > 0,
+		})
 	}
 
 	return h
@@ -73,28 +79,58 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 	}
 
 	r.ID = FQN(nns, n)
-	r.Fields = make(Fields, 0, len(g.Header(ns)))
+	r.Fields = make(Fields, 0, len(g.cols)+1)
 	if isAllNamespace(ns) {
 		r.Fields = append(r.Fields, nns)
 	}
-	var ageCell interface{}
-	for i, c := range row.Cells {
-		if g.ageIndex > 0 && i == g.ageIndex {
-			ageCell = c
-			continue
+	for _, i := range g.cols {
+		if i < len(row.Cells) {
+			r.Fields = append(r.Fields, cellToStr(row.Cells[i]))
 		}
-		r.Fields = append(r.Fields, fmt.Sprintf("%v", c))
-	}
-	if ageCell != nil {
-		r.Fields = append(r.Fields, fmt.Sprintf("%v", ageCell))
 	}
 
 	return nil
 }
 
+// displayOrder returns the table columns indexes in display order. Wide
+// columns trail the header so they can be toggled off without shifting the
+// others.
+func displayOrder(t *metav1beta1.Table) []int {
+	if t == nil {
+		return nil
+	}
+
+	std := make([]int, 0, len(t.ColumnDefinitions))
+	wide := make([]int, 0, len(t.ColumnDefinitions))
+	age := -1
+	for i, c := range t.ColumnDefinitions {
+		switch {
+		case i > 0 && c.Name == ageTableCol:
+			age = i
+		case c.Priority > 0:
+			wide = append(wide, i)
+		default:
+			std = append(std, i)
+		}
+	}
+	if age > 0 {
+		std = append(std, age)
+	}
+
+	return append(std, wide...)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func columnAlign(kind string) int {
+	if isNumericCol(kind) {
+		return tview.AlignRight
+	}
+
+	return tview.AlignLeft
+}
+
 func extractNamespace(raw []byte) (string, error) {
 	var obj map[string]interface{}
 	err := json.Unmarshal(raw, &obj)
@@ -62,6 +62,18 @@ func TestGenericRender(t *testing.T) {
 				render.Header{Name: "AGE"},
 			},
 		},
+		"wide": {
+			ns:      "-",
+			table:   makeWideGeneric(),
+			eID:     "c1",
+			eFields: render.Fields{"c1", "c4", "Age", "c2"},
+			eHeader: render.HeaderRow{
+				render.Header{Name: "A"},
+				render.Header{Name: "D"},
+				render.Header{Name: "AGE"},
+				render.Header{Name: "B", Wide: true},
+			},
+		},
 	}
 
 	var re render.Generic
@@ -190,3 +202,32 @@ func makeAgeGeneric() *metav1beta1.Table {
 		},
 	}
 }
+
+func makeWideGeneric() *metav1beta1.Table {
+	return &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "a"},
+			{Name: "b", Priority: 1},
+			{Name: "Age"},
+			{Name: "d"},
+		},
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+        "kind": "fred",
+        "apiVersion": "v1",
+        "metadata": {
+          "name": "fred"
+        }}`),
+				},
+				Cells: []interface{}{
+					"c1",
+					"c2",
+					"Age",
+					"c4",
+				},
+			},
+		},
+	}
+}
@@ -43,6 +43,10 @@ func noMetric() metric {
 	return metric{cpu: NAValue, mem: NAValue}
 }
 
+func unmeasuredMetric() metric {
+	return metric{cpu: UnmeasuredValue, mem: UnmeasuredValue}
+}
+
 // MetaFQN returns a fully qualified resource name.
 func MetaFQN(m metav1.ObjectMeta) string {
 	if m.Namespace == "" {
@@ -135,6 +139,42 @@ func Namespaced(n string) (string, string) {
 	return strings.Trim(ns, "/"), po
 }
 
+// byteUnits lists humanized byte units, smallest first.
+var byteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB"}
+
+// toBytes humanizes a byte count.
+func toBytes(n uint64) string {
+	if n < 1024 {
+		return strconv.FormatUint(n, 10) + byteUnits[0]
+	}
+	v, u := float64(n), 0
+	for v >= 1024 && u < len(byteUnits)-1 {
+		v /= 1024
+		u++
+	}
+
+	return strconv.FormatFloat(v, 'f', 1, 64) + byteUnits[u]
+}
+
+// fromBytes parses a humanized byte count.
+func fromBytes(s string) (float64, bool) {
+	for i := len(byteUnits) - 1; i >= 0; i-- {
+		if !strings.HasSuffix(s, byteUnits[i]) {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSuffix(s, byteUnits[i]), 64)
+		if err != nil {
+			return 0, false
+		}
+		for j := 0; j < i; j++ {
+			v *= 1024
+		}
+		return v, true
+	}
+
+	return 0, false
+}
+
 func missing(s string) string {
 	return check(s, MissingValue)
 }
@@ -173,6 +213,36 @@ func toAgeHuman(s string) string {
 	return duration.HumanDuration(d)
 }
 
+// MiddleTruncate shortens a string to the given width by eliding its middle
+// so both its prefix and suffix, ie registry and tag, remain visible.
+func MiddleTruncate(str string, width int) string {
+	if runewidth.StringWidth(str) <= width {
+		return str
+	}
+	if width <= 1 {
+		return Truncate(str, width)
+	}
+
+	rr := []rune(str)
+	avail := width - 1
+	head, tail := avail-avail/2, avail/2
+	var l, r []rune
+	for w, i := 0, 0; i < len(rr); i++ {
+		if w += runewidth.RuneWidth(rr[i]); w > head {
+			break
+		}
+		l = append(l, rr[i])
+	}
+	for w, i := 0, len(rr)-1; i >= 0; i-- {
+		if w += runewidth.RuneWidth(rr[i]); w > tail {
+			break
+		}
+		r = append([]rune{rr[i]}, r...)
+	}
+
+	return string(l) + string(tview.SemigraphicsHorizontalEllipsis) + string(r)
+}
+
 // Truncate a string to the given l and suffix ellipsis if needed.
 func Truncate(str string, width int) string {
 	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
@@ -182,6 +182,28 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestMiddleTruncate(t *testing.T) {
+	uu := map[string]struct {
+		s string
+		l int
+		e string
+	}{
+		"fits":   {s: "nginx:1.17", l: 10, e: "nginx:1.17"},
+		"even":   {s: "docker.io/library/nginx:1.17", l: 11, e: "docke…:1.17"},
+		"odd":    {s: "docker.io/library/nginx:1.17", l: 10, e: "docke…1.17"},
+		"tiny":   {s: "fred", l: 1, e: "…"},
+		"wide":   {s: "日本語のテキスト", l: 9, e: "日本…スト"},
+		"single": {s: "fred", l: 3, e: "f…d"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, MiddleTruncate(u.s, u.l))
+		})
+	}
+}
+
 func TestToSelector(t *testing.T) {
 	uu := map[string]struct {
 		m map[string]string
@@ -354,6 +376,33 @@ func TestToMi(t *testing.T) {
 	}
 }
 
+func TestToBytes(t *testing.T) {
+	uu := []struct {
+		v uint64
+		e string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1536, "1.5KiB"},
+		{10 * 1024 * 1024, "10.0MiB"},
+		{3 << 40, "3.0TiB"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, toBytes(u.v))
+		v, ok := fromBytes(u.e)
+		assert.True(t, ok)
+		assert.Equal(t, float64(u.v), v)
+	}
+}
+
+func TestFromBytesToast(t *testing.T) {
+	for _, s := range []string{"", "B", "fred", "10", "1.5KB"} {
+		_, ok := fromBytes(s)
+		assert.False(t, ok, s)
+	}
+}
+
 func TestAsPerc(t *testing.T) {
 	uu := []struct {
 		v float64
@@ -28,7 +28,7 @@ func (Ingress) Header(ns string) HeaderRow {
 	return append(h,
 		Header{Name: "NAME"},
 		Header{Name: "HOSTS"},
-		Header{Name: "ADDRESS"},
+		Header{Name: "ADDRESS", Long: true},
 		Header{Name: "PORT"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
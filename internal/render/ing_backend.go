@@ -0,0 +1,100 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	ingressBackendOK      = "OK"
+	ingressBackendWarning = "Warning"
+	ingressDefaultPath    = "<default>"
+)
+
+// IngressBackend renders an ingress rule backend validation to screen.
+type IngressBackend struct{}
+
+// ColorerFunc colors a resource row.
+func (IngressBackend) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		if r.Row.Fields[5] == ingressBackendWarning {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (IngressBackend) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "HOST"},
+		Header{Name: "PATH"},
+		Header{Name: "SERVICE"},
+		Header{Name: "PORT"},
+		Header{Name: "ENDPOINTS", Align: tview.AlignRight},
+		Header{Name: "STATUS"},
+		Header{Name: "ISSUES"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (IngressBackend) Render(o interface{}, ns string, r *Row) error {
+	b, ok := o.(IngressBackendRes)
+	if !ok {
+		return fmt.Errorf("expecting IngressBackendRes but got %T", o)
+	}
+
+	path := b.Path
+	if b.Default {
+		path = ingressDefaultPath
+	}
+	status := ingressBackendOK
+	if len(b.Issues) > 0 {
+		status = ingressBackendWarning
+	}
+
+	r.ID = b.Host + path
+	r.Fields = Fields{
+		b.Host,
+		path,
+		b.Service,
+		b.Port,
+		strconv.Itoa(b.Ready),
+		status,
+		missing(strings.Join(b.Issues, ",")),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// IngressBackendRes represents an ingress rule backend validation.
+type IngressBackendRes struct {
+	Namespace string
+	Host      string
+	Path      string
+	Service   string
+	Port      string
+	Ready     int
+	Default   bool
+	Issues    []string
+}
+
+// GetObjectKind returns a schema object.
+func (IngressBackendRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i IngressBackendRes) DeepCopyObject() runtime.Object {
+	return i
+}
@@ -0,0 +1,49 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressBackendRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.IngressBackendRes
+		id string
+		e  render.Fields
+	}{
+		"ok": {
+			o:  render.IngressBackendRes{Host: "fred.com", Path: "/a", Service: "web", Port: "http", Ready: 2},
+			id: "fred.com/a",
+			e:  render.Fields{"fred.com", "/a", "web", "http", "2", "OK", render.MissingValue},
+		},
+		"default": {
+			o:  render.IngressBackendRes{Host: "*", Service: "web", Port: "80", Ready: 1, Default: true},
+			id: "*<default>",
+			e:  render.Fields{"*", "<default>", "web", "80", "1", "OK", render.MissingValue},
+		},
+		"warning": {
+			o: render.IngressBackendRes{
+				Host:    "*",
+				Path:    "/",
+				Service: "web",
+				Port:    "443",
+				Issues:  []string{"no service port 443", "no ready endpoints"},
+			},
+			id: "*/",
+			e:  render.Fields{"*", "/", "web", "443", "0", "Warning", "no service port 443,no ready endpoints"},
+		},
+	}
+
+	var i render.IngressBackend
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, i.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
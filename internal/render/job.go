@@ -34,7 +34,7 @@ func (Job) Header(ns string) HeaderRow {
 		Header{Name: "COMPLETIONS"},
 		Header{Name: "DURATION"},
 		Header{Name: "CONTAINERS"},
-		Header{Name: "IMAGES"},
+		Header{Name: "IMAGES", Long: true},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
@@ -0,0 +1,136 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// JobPod renders a job pod termination status to screen.
+type JobPod struct{}
+
+// ColorerFunc colors a resource row.
+func (JobPod) ColorerFunc() ColorerFunc {
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+		switch r.Row.Fields[1] {
+		case "Failed":
+			return ErrColor
+		case "Succeeded":
+			return CompletedColor
+		}
+		if code := r.Row.Fields[2]; code != NAValue && code != "0" {
+			return ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (JobPod) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "STATUS"},
+		Header{Name: "EXIT"},
+		Header{Name: "REASON"},
+		Header{Name: "RESTARTS", Align: tview.AlignRight},
+		Header{Name: "NODE"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (JobPod) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(JobPodRes)
+	if !ok {
+		return fmt.Errorf("expecting JobPodRes but got %T", o)
+	}
+
+	r.ID = FQN(p.Namespace, p.Name)
+	r.Fields = Fields{
+		p.Name,
+		p.Phase,
+		exitCodes(p.Exits),
+		exitReasons(p.Exits),
+		strconv.Itoa(int(p.Restarts)),
+		na(p.Node),
+		toAge(p.Age),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// JobPodRes represents a job pod termination status.
+type JobPodRes struct {
+	Namespace string
+	Name      string
+	Phase     string
+	Node      string
+	Restarts  int32
+	Age       metav1.Time
+	Exits     []ExitRes
+}
+
+// ExitRes represents a container termination.
+type ExitRes struct {
+	Container string
+	Code      int32
+	Reason    string
+}
+
+// GetObjectKind returns a schema object.
+func (JobPodRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (j JobPodRes) DeepCopyObject() runtime.Object {
+	return j
+}
+
+// exitCodes lists containers exit codes. Container names are only shown when
+// more than one container terminated.
+func exitCodes(ee []ExitRes) string {
+	switch len(ee) {
+	case 0:
+		return NAValue
+	case 1:
+		return strconv.Itoa(int(ee[0].Code))
+	}
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, e.Container+"="+strconv.Itoa(int(e.Code)))
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func exitReasons(ee []ExitRes) string {
+	ss := make([]string, 0, len(ee))
+	seen := make(map[string]struct{}, len(ee))
+	for _, e := range ee {
+		if e.Reason == "" {
+			continue
+		}
+		if _, ok := seen[e.Reason]; ok {
+			continue
+		}
+		seen[e.Reason] = struct{}{}
+		ss = append(ss, e.Reason)
+	}
+
+	return missing(strings.Join(ss, ","))
+}
@@ -0,0 +1,55 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobPodRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.JobPodRes
+		e render.Fields
+	}{
+		"running": {
+			o: render.JobPodRes{Namespace: "default", Name: "p1", Phase: "Running", Node: "n1"},
+			e: render.Fields{"p1", "Running", render.NAValue, render.MissingValue, "0", "n1"},
+		},
+		"failed": {
+			o: render.JobPodRes{
+				Namespace: "default",
+				Name:      "p2",
+				Phase:     "Failed",
+				Restarts:  1,
+				Exits:     []render.ExitRes{{Container: "c1", Code: 137, Reason: "OOMKilled"}},
+			},
+			e: render.Fields{"p2", "Failed", "137", "OOMKilled", "1", render.NAValue},
+		},
+		"multi": {
+			o: render.JobPodRes{
+				Namespace: "default",
+				Name:      "p3",
+				Phase:     "Failed",
+				Node:      "n1",
+				Exits: []render.ExitRes{
+					{Container: "init", Code: 0, Reason: "Completed"},
+					{Container: "c1", Code: 1, Reason: "Error"},
+					{Container: "c2", Code: 2, Reason: "Error"},
+				},
+			},
+			e: render.Fields{"p3", "Failed", "init=0,c1=1,c2=2", "Completed,Error", "0", "n1"},
+		},
+	}
+
+	var j render.JobPod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, j.Render(u.o, "", &r))
+			assert.Equal(t, "default/"+u.o.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+		})
+	}
+}
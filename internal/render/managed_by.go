@@ -0,0 +1,75 @@
+package render
+
+import "strings"
+
+const (
+	managedByLabel       = "app.kubernetes.io/managed-by"
+	helmReleaseAnnot     = "meta.helm.sh/release-name"
+	helmChartLabel       = "helm.sh/chart"
+	argoInstanceLabel    = "argocd.argoproj.io/instance"
+	fluxKustomizeLabel   = "kustomize.toolkit.fluxcd.io/name"
+	kustomizeOriginAnnot = "config.kubernetes.io/origin"
+
+	// ManagedByCol names the column listing the managing tool.
+	ManagedByCol = "MANAGED-BY"
+
+	// Unmanaged indicates no known tool manages a resource.
+	Unmanaged = "-"
+)
+
+// ManagedBy infers the tool managing a resource from well known labels and
+// annotations ie helm:fred for the fred helm release. Gitops controllers win
+// over the tools they drive.
+func ManagedBy(labels, annotations map[string]string) string {
+	if i, ok := labels[argoInstanceLabel]; ok {
+		return "argocd:" + i
+	}
+	if n, ok := labels[fluxKustomizeLabel]; ok {
+		return "flux:" + n
+	}
+	if r, ok := annotations[helmReleaseAnnot]; ok {
+		return "helm:" + r
+	}
+	if _, ok := labels[helmChartLabel]; ok {
+		return "helm"
+	}
+	if m, ok := labels[managedByLabel]; ok && m != "" {
+		if isHelm(m) {
+			return "helm"
+		}
+		return strings.ToLower(m)
+	}
+	if _, ok := annotations[kustomizeOriginAnnot]; ok {
+		return "kustomize"
+	}
+
+	return Unmanaged
+}
+
+// ManagedByMatch checks if a tool query matches a managing tool inferred by
+// ManagedBy ie helm matches any helm release and helm:fred only the fred one.
+func ManagedByMatch(tool, managedBy string) bool {
+	t, m := strings.ToLower(strings.TrimSpace(tool)), strings.ToLower(managedBy)
+	switch {
+	case t == "":
+		return false
+	case isHelm(t):
+		t = "helm"
+	case t == "argo":
+		t = "argocd"
+	}
+	if strings.Contains(t, ":") {
+		return m == t
+	}
+
+	return m == t || strings.HasPrefix(m, t+":")
+}
+
+func isHelm(s string) bool {
+	switch strings.ToLower(s) {
+	case "helm", "tiller":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,94 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedBy(t *testing.T) {
+	uu := map[string]struct {
+		labels, annotations map[string]string
+		e                   string
+	}{
+		"none": {
+			e: "-",
+		},
+		"unrelated": {
+			labels: map[string]string{"app": "fred"},
+			e:      "-",
+		},
+		"helmRelease": {
+			labels:      map[string]string{"app.kubernetes.io/managed-by": "Helm"},
+			annotations: map[string]string{"meta.helm.sh/release-name": "fred"},
+			e:           "helm:fred",
+		},
+		"helmLabel": {
+			labels: map[string]string{"app.kubernetes.io/managed-by": "Helm"},
+			e:      "helm",
+		},
+		"tiller": {
+			labels: map[string]string{"app.kubernetes.io/managed-by": "Tiller"},
+			e:      "helm",
+		},
+		"helmChart": {
+			labels: map[string]string{"helm.sh/chart": "fred-1.0.0"},
+			e:      "helm",
+		},
+		"argocd": {
+			labels: map[string]string{
+				"argocd.argoproj.io/instance":  "blee",
+				"app.kubernetes.io/managed-by": "Helm",
+			},
+			annotations: map[string]string{"meta.helm.sh/release-name": "fred"},
+			e:           "argocd:blee",
+		},
+		"flux": {
+			labels: map[string]string{"kustomize.toolkit.fluxcd.io/name": "apps"},
+			e:      "flux:apps",
+		},
+		"kustomize": {
+			annotations: map[string]string{"config.kubernetes.io/origin": "path: base/dp.yml"},
+			e:           "kustomize",
+		},
+		"other": {
+			labels: map[string]string{"app.kubernetes.io/managed-by": "Pulumi"},
+			e:      "pulumi",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ManagedBy(u.labels, u.annotations))
+		})
+	}
+}
+
+func TestManagedByMatch(t *testing.T) {
+	uu := map[string]struct {
+		tool, managedBy string
+		e               bool
+	}{
+		"helmRelease":  {"helm", "helm:fred", true},
+		"helmChart":    {"Helm", "helm", true},
+		"tiller":       {"tiller", "helm:fred", true},
+		"release":      {"helm:fred", "helm:fred", true},
+		"otherRelease": {"helm:fred", "helm:blee", false},
+		"argo":         {"argo", "argocd:fred", true},
+		"flux":         {"flux", "flux:fred", true},
+		"kustomize":    {"kustomize", "kustomize", true},
+		"other":        {" Pulumi ", "pulumi", true},
+		"prefix":       {"hel", "helm:fred", false},
+		"unmanaged":    {"helm", render.Unmanaged, false},
+		"blank":        {"", render.Unmanaged, false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ManagedByMatch(u.tool, u.managedBy))
+		})
+	}
+}
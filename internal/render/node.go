@@ -2,9 +2,11 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -15,6 +17,9 @@ import (
 const (
 	labelNodeRolePrefix = "node-role.kubernetes.io/"
 	nodeLabelRole       = "kubernetes.io/role"
+
+	// nodeAllocThreshold represents the requested percentage above which a node is flagged.
+	nodeAllocThreshold = 90
 )
 
 // Node renders a K8s Node to screen.
@@ -22,7 +27,19 @@ type Node struct{}
 
 // ColorerFunc colors a resource row.
 func (Node) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, r RowEvent) tcell.Color {
+		c := DefaultColorer(ns, r)
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+		for _, f := range r.Row.Fields[13:15] {
+			if p, err := strconv.Atoi(f); err == nil && p > nodeAllocThreshold {
+				return ErrColor
+			}
+		}
+
+		return c
+	}
 }
 
 // Header returns a header row.
@@ -41,6 +58,8 @@ func (Node) Header(_ string) HeaderRow {
 		Header{Name: "%MEM", Align: tview.AlignRight},
 		Header{Name: "ACPU", Align: tview.AlignRight},
 		Header{Name: "AMEM", Align: tview.AlignRight},
+		Header{Name: "CPU-ALLOC%", Align: tview.AlignRight},
+		Header{Name: "MEM-ALLOC%", Align: tview.AlignRight},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	}
 }
@@ -67,6 +86,7 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 	iIP, eIP = missing(iIP), missing(eIP)
 
 	c, a, p := gatherNodeMX(&no, oo.MX)
+	rq := gatherNodeAlloc(&no, oo.Requested)
 
 	sta := make([]string, 10)
 	status(no.Status, no.Spec.Unschedulable, sta)
@@ -89,6 +109,8 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		p.mem,
 		a.cpu,
 		a.mem,
+		rq.cpu,
+		rq.mem,
 		toAge(no.ObjectMeta.CreationTimestamp),
 	)
 
@@ -102,6 +124,8 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 type NodeWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.NodeMetrics
+	// Requested tracks the node pods resource requests or nil if unknown.
+	Requested v1.ResourceList
 }
 
 // GetObjectKind returns a schema object.
@@ -142,6 +166,18 @@ func gatherNodeMX(no *v1.Node, mx *mv1beta1.NodeMetrics) (c metric, a metric, p
 	return
 }
 
+// gatherNodeAlloc computes the node pods requests as a percentage of allocatable.
+func gatherNodeAlloc(no *v1.Node, rl v1.ResourceList) metric {
+	if rl == nil {
+		return noMetric()
+	}
+
+	return metric{
+		cpu: AsPerc(toPerc(float64(rl.Cpu().MilliValue()), float64(no.Status.Allocatable.Cpu().MilliValue()))),
+		mem: AsPerc(toPerc(float64(rl.Memory().Value()), float64(no.Status.Allocatable.Memory().Value()))),
+	}
+}
+
 func nodeRoles(node *v1.Node, res []string) {
 	index := 0
 	for k, v := range node.Labels {
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -25,6 +27,43 @@ func TestNodeRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:13])
 }
 
+func TestNodeRenderAlloc(t *testing.T) {
+	defer withRowColors()()
+
+	uu := map[string]struct {
+		requested v1.ResourceList
+		e         render.Fields
+		color     tcell.Color
+	}{
+		"unknown": {
+			e:     render.Fields{render.NAValue, render.NAValue},
+			color: render.StdColor,
+		},
+		"noRequests": {
+			requested: v1.ResourceList{},
+			e:         render.Fields{"0", "0"},
+			color:     render.StdColor,
+		},
+		"overCommitted": {
+			requested: makeRes("3800m", "1Gi"),
+			e:         render.Fields{"95", "13"},
+			color:     render.ErrColor,
+		},
+	}
+
+	var no render.Node
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pom := render.NodeWithMetrics{Raw: load(t, "no"), Requested: u.requested}
+			r := render.NewRow(16)
+			assert.Nil(t, no.Render(&pom, "", &r))
+			assert.Equal(t, u.e, r.Fields[13:15])
+			assert.Equal(t, u.color, no.ColorerFunc()("", render.RowEvent{Kind: render.EventUnchanged, Row: r}))
+		})
+	}
+}
+
 func BenchmarkNodeRender(b *testing.B) {
 	pom := render.NodeWithMetrics{
 		Raw: load(b, "no"),
@@ -17,7 +17,7 @@ type Namespace struct{}
 func (Namespace) ColorerFunc() ColorerFunc {
 	return func(ns string, r RowEvent) tcell.Color {
 		c := DefaultColorer(ns, r)
-		if r.Kind == EventAdd {
+		if r.Kind == EventAdd && strings.TrimSpace(r.Row.Fields[1]) != Terminating {
 			return c
 		}
 
@@ -25,8 +25,10 @@ func (Namespace) ColorerFunc() ColorerFunc {
 			c = StdColor
 		}
 		switch strings.TrimSpace(r.Row.Fields[1]) {
-		case "Inactive", Terminating:
+		case "Inactive":
 			c = ErrColor
+		case Terminating:
+			c = KillColor
 		}
 		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
 			c = HighlightColor
@@ -21,8 +21,10 @@ func TestNSColorer(t *testing.T) {
 		{"", render.RowEvent{Kind: render.EventUpdate, Row: ns}, render.ModColor},
 		// MoChange AllNS
 		{"", render.RowEvent{Kind: render.EventUnchanged, Row: ns}, render.StdColor},
-		// Bust NS
-		{"", render.RowEvent{Kind: render.EventUnchanged, Row: term}, render.ErrColor},
+		// Terminating NS
+		{"", render.RowEvent{Kind: render.EventUnchanged, Row: term}, render.KillColor},
+		// Add Terminating NS
+		{"", render.RowEvent{Kind: render.EventAdd, Row: term}, render.KillColor},
 		// Bust NS
 		{"", render.RowEvent{Kind: render.EventUnchanged, Row: dead}, render.ErrColor},
 	}
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"regexp"
+
+	"github.com/gdamore/tcell"
+)
+
+// NoColorEnv turns monochrome on when set. See https://no-color.org.
+const NoColorEnv = "NO_COLOR"
+
+var (
+	monochrome bool
+	colorTagRX = regexp.MustCompile(`\[([a-zA-Z0-9#]*|-):([a-zA-Z0-9#]*|-)(:[a-zA-Z-]*)?\]`)
+)
+
+// SetMonochrome toggles monochrome rendering. Colors collapse to the
+// terminal defaults and severities are conveyed via attributes.
+func SetMonochrome(b bool) {
+	monochrome = b
+}
+
+// IsMonochrome returns true if colors are turned off.
+func IsMonochrome() bool {
+	return monochrome
+}
+
+// Paint returns the color and attributes to render a row color with.
+func Paint(c tcell.Color) (tcell.Color, tcell.AttrMask) {
+	if !monochrome {
+		return c, tcell.AttrNone
+	}
+
+	switch c {
+	case ErrColor, EvictedColor:
+		return tcell.ColorDefault, tcell.AttrBold | tcell.AttrReverse
+	case HighlightColor, AddColor, ModColor:
+		return tcell.ColorDefault, tcell.AttrBold
+	case CompletedColor, KillColor:
+		return tcell.ColorDefault, tcell.AttrDim
+	default:
+		return tcell.ColorDefault, tcell.AttrNone
+	}
+}
+
+// PaintMarked returns the color and attributes to render a marked row with.
+func PaintMarked(c tcell.Color) (tcell.Color, tcell.AttrMask) {
+	if !monochrome {
+		return c, tcell.AttrNone
+	}
+
+	return tcell.ColorDefault, tcell.AttrUnderline
+}
+
+// Accent returns the color to decorate borders, labels and the like with.
+func Accent(c tcell.Color) tcell.Color {
+	if monochrome {
+		return tcell.ColorDefault
+	}
+
+	return c
+}
+
+// Selection returns the style to render a cursor with.
+func Selection(fg, bg tcell.Color, attrs tcell.AttrMask) (tcell.Color, tcell.Color, tcell.AttrMask) {
+	if monochrome {
+		return tcell.ColorDefault, tcell.ColorDefault, tcell.AttrReverse
+	}
+
+	return fg, bg, attrs
+}
+
+// Tags strips the colors off text tags in monochrome, keeping attributes.
+func Tags(s string) string {
+	if !monochrome {
+		return s
+	}
+
+	return colorTagRX.ReplaceAllString(s, "[-:-$3]")
+}
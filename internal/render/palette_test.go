@@ -0,0 +1,103 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaint(t *testing.T) {
+	defer setPalette()()
+
+	uu := map[string]struct {
+		mono bool
+		c, e tcell.Color
+		a    tcell.AttrMask
+	}{
+		"color": {
+			c: tcell.ColorRed,
+			e: tcell.ColorRed,
+		},
+		"monoErr": {
+			mono: true,
+			c:    tcell.ColorRed,
+			e:    tcell.ColorDefault,
+			a:    tcell.AttrBold | tcell.AttrReverse,
+		},
+		"monoCompleted": {
+			mono: true,
+			c:    tcell.ColorGray,
+			e:    tcell.ColorDefault,
+			a:    tcell.AttrDim,
+		},
+		"monoStd": {
+			mono: true,
+			c:    tcell.ColorSkyblue,
+			e:    tcell.ColorDefault,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			render.SetMonochrome(u.mono)
+			c, a := render.Paint(u.c)
+			assert.Equal(t, u.e, c)
+			assert.Equal(t, u.a, a)
+		})
+	}
+}
+
+func TestPaletteMonochrome(t *testing.T) {
+	defer setPalette()()
+	render.SetMonochrome(true)
+
+	assert.Equal(t, tcell.ColorDefault, render.Accent(tcell.ColorDodgerBlue))
+	fg, bg, a := render.Selection(tcell.ColorWhite, tcell.ColorDodgerBlue, tcell.AttrNone)
+	assert.Equal(t, tcell.ColorDefault, fg)
+	assert.Equal(t, tcell.ColorDefault, bg)
+	assert.Equal(t, tcell.AttrReverse, a)
+	_, a = render.PaintMarked(tcell.ColorPaleGreen)
+	assert.Equal(t, tcell.AttrUnderline, a)
+	assert.Equal(t, "_#", render.Sparkline([]int64{0, 10}))
+}
+
+func TestTags(t *testing.T) {
+	defer setPalette()()
+
+	uu := map[string]struct {
+		mono bool
+		s, e string
+	}{
+		"color": {
+			s: "[orange::b]K9s [aqua::]v1",
+			e: "[orange::b]K9s [aqua::]v1",
+		},
+		"mono": {
+			mono: true,
+			s:    "[orange::b]K9s [aqua::]v1 [white::-]fred[-:-] [blee]",
+			e:    "[-:-:b]K9s [-:-:]v1 [-:-:-]fred[-:-] [blee]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			render.SetMonochrome(u.mono)
+			assert.Equal(t, u.e, render.Tags(u.s))
+		})
+	}
+}
+
+// Helpers...
+
+func setPalette() func() {
+	render.ErrColor, render.CompletedColor = tcell.ColorRed, tcell.ColorGray
+
+	return func() {
+		render.SetMonochrome(false)
+		render.ErrColor, render.CompletedColor = tcell.ColorDefault, tcell.ColorDefault
+	}
+}
@@ -10,6 +10,7 @@ import (
 	v1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -24,17 +25,20 @@ func (PodDisruptionBudget) ColorerFunc() ColorerFunc {
 			return c
 		}
 
-		markCol := 5
+		allowedCol := 4
 		if ns != AllNamespaces {
-			markCol = 4
+			allowedCol = 3
 		}
+		if strings.TrimSpace(r.Row.Fields[allowedCol]) == "0" {
+			return ErrColor
+		}
+		markCol := allowedCol + 1
 		if strings.TrimSpace(r.Row.Fields[markCol]) != strings.TrimSpace(r.Row.Fields[markCol+1]) {
 			return ErrColor
 		}
 
 		return StdColor
 	}
-
 }
 
 // Header returns a header row.
@@ -48,17 +52,22 @@ func (PodDisruptionBudget) Header(ns string) HeaderRow {
 		Header{Name: "NAME"},
 		Header{Name: "MIN AVAILABLE", Align: tview.AlignRight},
 		Header{Name: "MAX_ UNAVAILABLE", Align: tview.AlignRight},
-		Header{Name: "ALLOWED DISRUPTIONS", Align: tview.AlignRight},
+		Header{Name: "ALLOWED", Align: tview.AlignRight},
 		Header{Name: "CURRENT", Align: tview.AlignRight},
 		Header{Name: "DESIRED", Align: tview.AlignRight},
 		Header{Name: "EXPECTED", Align: tview.AlignRight},
+		Header{Name: "COVERED", Align: tview.AlignRight},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (p PodDisruptionBudget) Render(o interface{}, ns string, r *Row) error {
+	covered := NAValue
 	raw, ok := o.(*unstructured.Unstructured)
+	if c, isCov := o.(*PDBWithCoverage); isCov {
+		raw, ok, covered = c.Raw, true, strconv.Itoa(c.Covered)
+	}
 	if !ok {
 		return fmt.Errorf("Expected PodDisruptionBudget, but got %T", o)
 	}
@@ -81,6 +90,7 @@ func (p PodDisruptionBudget) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(pdb.Status.CurrentHealthy)),
 		strconv.Itoa(int(pdb.Status.DesiredHealthy)),
 		strconv.Itoa(int(pdb.Status.ExpectedPods)),
+		covered,
 		toAge(pdb.ObjectMeta.CreationTimestamp),
 	)
 
@@ -89,6 +99,22 @@ func (p PodDisruptionBudget) Render(o interface{}, ns string, r *Row) error {
 
 // Helpers...
 
+// PDBWithCoverage represents a disruption budget along with the number of pods it covers.
+type PDBWithCoverage struct {
+	Raw     *unstructured.Unstructured
+	Covered int
+}
+
+// GetObjectKind returns a schema object.
+func (p *PDBWithCoverage) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PDBWithCoverage) DeepCopyObject() runtime.Object {
+	return p
+}
+
 func numbToStr(n *intstr.IntOrString) string {
 	if n == nil {
 		return NAValue
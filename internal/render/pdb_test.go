@@ -4,14 +4,54 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestPodDisruptionBudgetRender(t *testing.T) {
 	c := render.PodDisruptionBudget{}
-	r := render.NewRow(9)
+	r := render.NewRow(10)
 	c.Render(load(t, "pdb"), "", &r)
 
 	assert.Equal(t, "default/fred", r.ID)
-	assert.Equal(t, render.Fields{"default", "fred", "2", "n/a", "0", "0", "2", "0"}, r.Fields[:8])
+	assert.Equal(t, render.Fields{"default", "fred", "2", "n/a", "0", "0", "2", "0", "n/a"}, r.Fields[:9])
+}
+
+func TestPodDisruptionBudgetRenderCoverage(t *testing.T) {
+	c := render.PodDisruptionBudget{}
+	r := render.NewRow(10)
+	c.Render(&render.PDBWithCoverage{Raw: load(t, "pdb"), Covered: 3}, "", &r)
+
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred", "2", "n/a", "0", "0", "2", "0", "3"}, r.Fields[:9])
+}
+
+func TestPodDisruptionBudgetColorer(t *testing.T) {
+	std, errc, add := render.StdColor, render.ErrColor, render.AddColor
+	defer func() {
+		render.StdColor, render.ErrColor, render.AddColor = std, errc, add
+	}()
+	render.StdColor, render.ErrColor, render.AddColor = tcell.ColorWhite, tcell.ColorRed, tcell.ColorBlue
+	blocked := render.Row{Fields: render.Fields{"default", "fred", "2", "n/a", "0", "2", "2", "2", "2"}}
+	healthy := render.Row{Fields: render.Fields{"default", "fred", "2", "n/a", "1", "3", "3", "3", "3"}}
+	degraded := render.Row{Fields: render.Fields{"fred", "1", "n/a", "1", "2", "3", "3", "3"}}
+
+	uu := map[string]struct {
+		ns string
+		r  render.RowEvent
+		e  tcell.Color
+	}{
+		"blocked":  {"", render.RowEvent{Kind: render.EventUnchanged, Row: blocked}, render.ErrColor},
+		"healthy":  {"", render.RowEvent{Kind: render.EventUnchanged, Row: healthy}, render.StdColor},
+		"degraded": {"default", render.RowEvent{Kind: render.EventUnchanged, Row: degraded}, render.ErrColor},
+		"added":    {"", render.RowEvent{Kind: render.EventAdd, Row: blocked}, render.AddColor},
+	}
+
+	f := render.PodDisruptionBudget{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f(u.ns, u.r))
+		})
+	}
 }
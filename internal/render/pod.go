@@ -2,6 +2,7 @@ package render
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -16,6 +17,10 @@ import (
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
+// evictionRX extracts the node condition or starved resource from a kubelet
+// eviction message.
+var evictionRX = regexp.MustCompile(`condition: \[(\w+)\]|low on resource: ([\w-]+)`)
+
 // Pod renders a K8s Pod to screen.
 type Pod struct{}
 
@@ -31,6 +36,9 @@ func (p Pod) ColorerFunc() ColorerFunc {
 		statusCol := readyCol + 1
 
 		ready, status := strings.TrimSpace(re.Row.Fields[readyCol]), strings.TrimSpace(re.Row.Fields[statusCol])
+		if strings.HasPrefix(status, Evicted) {
+			return EvictedColor
+		}
 		c = p.checkReadyCol(ready, status, c)
 
 		switch status {
@@ -83,6 +91,11 @@ func (Pod) Header(ns string) HeaderRow {
 		Header{Name: "NODE"},
 		Header{Name: "QOS"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
+		Header{Name: "CPU-HIST", Wide: true},
+		Header{Name: "MEM-HIST", Wide: true},
+		Header{Name: "SERVICEACCOUNT", Wide: true},
+		Header{Name: "HOST-NET", Wide: true},
+		Header{Name: "DNS", Wide: true},
 	)
 }
 
@@ -117,10 +130,15 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		c.mem,
 		perc.cpu,
 		perc.mem,
-		na(po.Status.PodIP),
+		na(PodIPs(po.Status)),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
 		toAge(po.ObjectMeta.CreationTimestamp),
+		Sparkline(oo.CPUHist),
+		Sparkline(oo.MEMHist),
+		PodServiceAccount(po.Spec),
+		boolToStr(po.Spec.HostNetwork),
+		na(string(po.Spec.DNSPolicy)),
 	)
 
 	return nil
@@ -129,10 +147,38 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// PodServiceAccount returns the pod service account name. Pods without one
+// run as their namespace default service account.
+func PodServiceAccount(spec v1.PodSpec) string {
+	switch {
+	case spec.ServiceAccountName != "":
+		return spec.ServiceAccountName
+	case spec.DeprecatedServiceAccount != "":
+		return spec.DeprecatedServiceAccount
+	default:
+		return "default"
+	}
+}
+
+// PodIPs returns the pod addresses for all its ip families, ie both v4 and v6
+// on dual-stack clusters.
+func PodIPs(st v1.PodStatus) string {
+	if len(st.PodIPs) == 0 {
+		return st.PodIP
+	}
+	ss := make([]string, 0, len(st.PodIPs))
+	for _, ip := range st.PodIPs {
+		ss = append(ss, ip.IP)
+	}
+
+	return strings.Join(ss, ",")
+}
+
 // PodWithMetrics represents a pod and its metrics.
 type PodWithMetrics struct {
-	Raw *unstructured.Unstructured
-	MX  *mv1beta1.PodMetrics
+	Raw              *unstructured.Unstructured
+	MX               *mv1beta1.PodMetrics
+	CPUHist, MEMHist []int64
 }
 
 // GetObjectKind returns a schema object.
@@ -212,6 +258,24 @@ func (*Pod) mapQOS(class v1.PodQOSClass) string {
 	}
 }
 
+// PodStatus returns a pod status as displayed in the pod view.
+func PodStatus(po *v1.Pod) string {
+	var p Pod
+	return p.phase(po)
+}
+
+// IsEvicted checks if a pod was evicted by its node.
+func IsEvicted(po *v1.Pod) bool {
+	return po.Status.Phase == v1.PodFailed && po.Status.Reason == Evicted
+}
+
+// PodRestarts returns the total number of container restarts on a pod.
+func PodRestarts(po *v1.Pod) int {
+	var p Pod
+	_, _, rc := p.statuses(po.Status.ContainerStatuses)
+	return rc
+}
+
 func (*Pod) statuses(ss []v1.ContainerStatus) (cr, ct, rc int) {
 	for _, c := range ss {
 		if c.State.Terminated != nil {
@@ -227,6 +291,9 @@ func (*Pod) statuses(ss []v1.ContainerStatus) (cr, ct, rc int) {
 }
 
 func (p *Pod) phase(po *v1.Pod) string {
+	if IsEvicted(po) {
+		return evictedStatus(po.Status.Message)
+	}
 	status := string(po.Status.Phase)
 	if po.Status.Reason != "" {
 		if po.DeletionTimestamp != nil && po.Status.Reason == node.NodeUnreachablePodReason {
@@ -258,6 +325,9 @@ func (*Pod) containerPhase(st v1.PodStatus, status string) (string, bool) {
 		switch {
 		case cs.State.Waiting != nil && cs.State.Waiting.Reason != "":
 			status = cs.State.Waiting.Reason
+			if t := cs.LastTerminationState.Terminated; t != nil && t.Reason == OOMKilled {
+				status += "(" + OOMKilled + ")"
+			}
 		case cs.State.Terminated != nil && cs.State.Terminated.Reason != "":
 			status = cs.State.Terminated.Reason
 		case cs.State.Terminated != nil:
@@ -289,6 +359,36 @@ func (*Pod) initContainerPhase(st v1.PodStatus, initCount int, status string) (s
 // ----------------------------------------------------------------------------
 // Helpers..
 
+// evictedStatus qualifies an eviction with the node pressure that caused it
+// ie Evicted(DiskPressure) when the kubelet message tells.
+func evictedStatus(msg string) string {
+	if cause := evictionCause(msg); cause != "" {
+		return Evicted + "(" + cause + ")"
+	}
+
+	return Evicted
+}
+
+func evictionCause(msg string) string {
+	mm := evictionRX.FindStringSubmatch(msg)
+	if len(mm) != 3 {
+		return ""
+	}
+	if mm[1] != "" {
+		return mm[1]
+	}
+	switch mm[2] {
+	case "memory":
+		return string(v1.NodeMemoryPressure)
+	case "ephemeral-storage", "nodefs", "imagefs":
+		return string(v1.NodeDiskPressure)
+	case "pids":
+		return string(v1.NodePIDPressure)
+	default:
+		return ""
+	}
+}
+
 func checkContainerStatus(cs v1.ContainerStatus, i, initCount int) string {
 	switch {
 	case cs.State.Terminated != nil:
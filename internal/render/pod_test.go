@@ -9,6 +9,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	res "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -30,6 +31,7 @@ func TestPodColorer(t *testing.T) {
 		row        = render.Row{Fields: render.Fields{"fred", "1/1", "Running"}}
 		toast      = render.Row{Fields: render.Fields{"fred", "1/1", "Boom"}}
 		notReady   = render.Row{Fields: render.Fields{"fred", "0/1", "Boom"}}
+		evicted    = render.Row{Fields: render.Fields{"fred", "0/1", "Evicted(DiskPressure)"}}
 	)
 
 	uu := colorerUCs{
@@ -49,6 +51,8 @@ func TestPodColorer(t *testing.T) {
 		{"", render.RowEvent{Kind: render.EventUpdate, Row: notReadyNS}, render.ErrColor},
 		// NotReady Namespaced
 		{"blee", render.RowEvent{Kind: render.EventUpdate, Row: notReady}, render.ErrColor},
+		// Evicted Namespaced
+		{"blee", render.RowEvent{Kind: render.EventUpdate, Row: evicted}, render.EvictedColor},
 	}
 
 	var p render.Pod
@@ -72,6 +76,62 @@ func TestPodRender(t *testing.T) {
 	assert.Equal(t, "default/nginx", r.ID)
 	e := render.Fields{"default", "nginx", "1/1", "Running", "0", "10", "10", "10", "14", "172.17.0.6", "minikube", "BE"}
 	assert.Equal(t, e, r.Fields[:12])
+	assert.Equal(t, render.Fields{"default", "false", "ClusterFirst"}, r.Fields[len(r.Fields)-3:])
+}
+
+func TestPodRenderNetwork(t *testing.T) {
+	uu := map[string]struct {
+		ips     []string
+		hostNet bool
+		dns     string
+		e       render.Fields
+	}{
+		"singleStack": {
+			ips: []string{"10.0.0.4"},
+			dns: "ClusterFirst",
+			e:   render.Fields{"10.0.0.4", "false", "ClusterFirst"},
+		},
+		"dualStack": {
+			ips: []string{"10.0.0.4", "fd00::4"},
+			dns: "ClusterFirst",
+			e:   render.Fields{"10.0.0.4,fd00::4", "false", "ClusterFirst"},
+		},
+		"hostNetwork": {
+			ips:     []string{"192.168.64.104"},
+			hostNet: true,
+			dns:     "ClusterFirstWithHostNet",
+			e:       render.Fields{"192.168.64.104", "true", "ClusterFirstWithHostNet"},
+		},
+		"pending": {
+			e: render.Fields{render.NAValue, "false", render.NAValue},
+		},
+	}
+
+	var po render.Pod
+	h := po.Header("blee")
+	ipCol, hostCol, dnsCol := h.IndexOf("IP"), h.IndexOf("HOST-NET"), h.IndexOf("DNS")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw := load(t, "po")
+			var pp []interface{}
+			for _, ip := range u.ips {
+				pp = append(pp, map[string]interface{}{"ip": ip})
+			}
+			if len(u.ips) > 0 {
+				assert.Nil(t, unstructured.SetNestedField(raw.Object, u.ips[0], "status", "podIP"))
+			} else {
+				unstructured.RemoveNestedField(raw.Object, "status", "podIP")
+			}
+			assert.Nil(t, unstructured.SetNestedSlice(raw.Object, pp, "status", "podIPs"))
+			assert.Nil(t, unstructured.SetNestedField(raw.Object, u.hostNet, "spec", "hostNetwork"))
+			assert.Nil(t, unstructured.SetNestedField(raw.Object, u.dns, "spec", "dnsPolicy"))
+
+			var r render.Row
+			assert.Nil(t, po.Render(&render.PodWithMetrics{Raw: raw}, "blee", &r))
+			assert.Equal(t, u.e, render.Fields{r.Fields[ipCol], r.Fields[hostCol], r.Fields[dnsCol]})
+		})
+	}
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -105,6 +165,111 @@ func TestPodInitRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:12])
 }
 
+func TestPodStatus(t *testing.T) {
+	evicted := func(msg string) v1.PodStatus {
+		return v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted", Message: msg}
+	}
+	uu := map[string]struct {
+		status v1.PodStatus
+		e      string
+	}{
+		"running": {
+			status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Ready: true, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+			e: "Running",
+		},
+		"evictedCondition": {
+			status: evicted("The node had condition: [DiskPressure]. "),
+			e:      "Evicted(DiskPressure)",
+		},
+		"evictedStorage": {
+			status: evicted("The node was low on resource: ephemeral-storage. Container nginx was using 10Gi, which exceeds its request of 0. "),
+			e:      "Evicted(DiskPressure)",
+		},
+		"evictedMemory": {
+			status: evicted("The node was low on resource: memory. Container nginx was using 1Gi, which exceeds its request of 0. "),
+			e:      "Evicted(MemoryPressure)",
+		},
+		"evictedPids": {
+			status: evicted("The node was low on resource: pids. "),
+			e:      "Evicted(PIDPressure)",
+		},
+		"evictedUnknown": {
+			status: evicted("Pod ephemeral local storage usage exceeds the total limit of containers 1Gi. "),
+			e:      "Evicted",
+		},
+		"oomKilled": {
+			status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}}},
+				},
+			},
+			e: "OOMKilled",
+		},
+		"crashLoopOOM": {
+			status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+					},
+				},
+			},
+			e: "CrashLoopBackOff(OOMKilled)",
+		},
+		"crashLoop": {
+			status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+					},
+				},
+			},
+			e: "CrashLoopBackOff",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{Status: u.status}
+			assert.Equal(t, u.e, render.PodStatus(&po))
+		})
+	}
+}
+
+func TestIsEvicted(t *testing.T) {
+	assert.True(t, render.IsEvicted(&v1.Pod{Status: v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"}}))
+	assert.False(t, render.IsEvicted(&v1.Pod{Status: v1.PodStatus{Phase: v1.PodFailed, Reason: "DeadlineExceeded"}}))
+	assert.False(t, render.IsEvicted(&v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}}))
+}
+
+func TestPodServiceAccount(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    string
+	}{
+		"named":      {spec: v1.PodSpec{ServiceAccountName: "fred"}, e: "fred"},
+		"deprecated": {spec: v1.PodSpec{DeprecatedServiceAccount: "blee"}, e: "blee"},
+		"default":    {e: "default"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.PodServiceAccount(u.spec))
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -2,6 +2,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -30,12 +31,87 @@ func TestPortForwardRender(t *testing.T) {
 		"http://0.0.0.0:p1/",
 		"1",
 		"1",
+		"512B",
+		"1.5KiB",
+		"Active",
+		"59m",
+		"",
 		"2m",
 	}, r.Fields)
 }
 
+func TestLatencyTrend(t *testing.T) {
+	uu := map[string]struct {
+		ll []time.Duration
+		e  string
+	}{
+		"none": {},
+		"one": {
+			ll: []time.Duration{100 * time.Millisecond},
+			e:  "█ 100ms",
+		},
+		"many": {
+			ll: []time.Duration{100 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, 200*time.Millisecond + 300*time.Microsecond},
+			e:  "▁▄█▂ 200ms",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.LatencyTrend(u.ll))
+		})
+	}
+}
+
+func TestPortForwardRenderManaged(t *testing.T) {
+	uu := map[string]struct {
+		o      render.ForwardRes
+		id, po string
+		state  string
+	}{
+		"managed": {
+			o:     render.ForwardRes{Forwarder: fwd{}, Managed: true},
+			id:    "blee/fred",
+			po:    "fred",
+			state: "Managed",
+		},
+		"failed": {
+			o:     render.ForwardRes{Forwarder: failedFwd{}, Managed: true, Err: "no ready pods matching deploy/fred"},
+			id:    "blee/deploy/fred:co",
+			po:    "deploy/fred",
+			state: "Failed: no ready pods matching deploy/fred",
+		},
+	}
+
+	var p render.PortForward
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, p.Render(u.o, "fred", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, "blee", r.Fields[0])
+			assert.Equal(t, u.po, r.Fields[1])
+			assert.Equal(t, u.state, r.Fields[9])
+		})
+	}
+}
+
 // Helpers...
 
+type failedFwd struct {
+	fwd
+}
+
+func (f failedFwd) Path() string {
+	return "blee/deploy/fred:co"
+}
+
+func (f failedFwd) Active() bool {
+	return false
+}
+
 type fwd struct{}
 
 func (f fwd) Path() string {
@@ -57,3 +133,11 @@ func (f fwd) Active() bool {
 func (f fwd) Age() string {
 	return "2m"
 }
+
+func (f fwd) TTL() string {
+	return "59m"
+}
+
+func (f fwd) Traffic() (uint64, uint64) {
+	return 512, 1536
+}
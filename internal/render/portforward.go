@@ -3,7 +3,9 @@ package render
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,6 +27,12 @@ type Forwarder interface {
 
 	// Age returns forwarder age.
 	Age() string
+
+	// TTL returns forwarder remaining time to live.
+	TTL() string
+
+	// Traffic returns forwarder bytes received and sent.
+	Traffic() (uint64, uint64)
 }
 
 // PortForward renders a portforwards to screen.
@@ -33,6 +41,10 @@ type PortForward struct{}
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() ColorerFunc {
 	return func(ns string, re RowEvent) tcell.Color {
+		if strings.HasPrefix(re.Row.Fields[9], failedState) {
+			return ErrColor
+		}
+
 		return tcell.ColorSkyblue
 	}
 }
@@ -44,9 +56,14 @@ func (PortForward) Header(ns string) HeaderRow {
 		Header{Name: "POD"},
 		Header{Name: "CONTAINER"},
 		Header{Name: "PORTS"},
-		Header{Name: "URL"},
+		Header{Name: "URL", Long: true},
 		Header{Name: "C"},
 		Header{Name: "N"},
+		Header{Name: "RX", Align: tview.AlignRight},
+		Header{Name: "TX", Align: tview.AlignRight},
+		Header{Name: "STATE"},
+		Header{Name: "TTL"},
+		Header{Name: "P99"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	}
 }
@@ -58,8 +75,12 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 		return fmt.Errorf("expecting a ForwardRes but got %T", o)
 	}
 
-	ports := strings.Split(pf.Ports()[0], ":")
-	ns, n := Namespaced(pf.Path())
+	var lport string
+	if pp := pf.Ports(); len(pp) > 0 {
+		lport = strings.Split(pp[0], ":")[0]
+	}
+	rx, tx := pf.Traffic()
+	ns, n := splitForward(pf.Path())
 
 	r.ID = pf.Path()
 	r.Fields = Fields{
@@ -67,9 +88,14 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 		trimContainer(n),
 		pf.Container(),
 		strings.Join(pf.Ports(), ","),
-		UrlFor(pf.Config.Host, pf.Config.Path, ports[0]),
+		UrlFor(pf.Config.Host, pf.Config.Path, lport),
 		asNum(pf.Config.C),
 		asNum(pf.Config.N),
+		toBytes(rx),
+		toBytes(tx),
+		ForwardState(pf),
+		missing(pf.TTL()),
+		LatencyTrend(pf.Latencies),
 		pf.Age(),
 	}
 
@@ -78,6 +104,47 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 
 // Helpers...
 
+const failedState = "Failed"
+
+// splitForward splits a forward path into its namespace and target. Managed
+// forwards targets may specify a workload ie ns/deploy/fred:co.
+func splitForward(path string) (string, string) {
+	tokens := strings.SplitN(path, "/", 2)
+	if len(tokens) < 2 {
+		return "", path
+	}
+
+	return tokens[0], tokens[1]
+}
+
+// ForwardState returns a port-forward state.
+func ForwardState(pf ForwardRes) string {
+	switch {
+	case pf.Err != "":
+		return failedState + ": " + pf.Err
+	case !pf.Active():
+		return "Inactive"
+	case pf.Managed:
+		return "Managed"
+	default:
+		return "Active"
+	}
+}
+
+// LatencyTrend renders latencies as a sparkline followed by the latest one.
+// No latencies renders blank.
+func LatencyTrend(ll []time.Duration) string {
+	if len(ll) == 0 {
+		return ""
+	}
+	vv := make([]int64, 0, len(ll))
+	for _, l := range ll {
+		vv = append(vv, int64(l))
+	}
+
+	return Sparkline(vv) + " " + ll[len(ll)-1].Round(time.Millisecond).String()
+}
+
 func trimContainer(n string) string {
 	tokens := strings.Split(n, ":")
 	if len(tokens) == 0 {
@@ -107,7 +174,11 @@ type BenchCfg struct {
 // ForwardRes represents a benchmark resource.
 type ForwardRes struct {
 	Forwarder
-	Config BenchCfg
+	Config  BenchCfg
+	Managed bool
+	Err     string
+	// Latencies tracks the p99 latencies of the last benchmarks runs.
+	Latencies []time.Duration
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,187 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// PrinterColumn represents a custom resource additional printer column.
+type PrinterColumn struct {
+	Name        string
+	Type        string
+	Format      string
+	Description string
+	Priority    int32
+	JSONPath    string
+}
+
+// PrinterColumns represents a collection of printer columns.
+type PrinterColumns []PrinterColumn
+
+// withoutAge drops declared age columns as ages are always tacked on last.
+func (cc PrinterColumns) withoutAge() PrinterColumns {
+	pp := make(PrinterColumns, 0, len(cc))
+	for _, c := range cc {
+		if strings.EqualFold(c.Name, ageTableCol) {
+			continue
+		}
+		pp = append(pp, c)
+	}
+
+	return pp
+}
+
+// NewPrinterTable evaluates printer columns against custom resources client
+// side and returns a table akin to the server side table transform. Name and
+// Age columns are always present.
+func NewPrinterTable(cc PrinterColumns, oo []runtime.Object) (*metav1beta1.Table, error) {
+	var t metav1beta1.Table
+	t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{
+		Name:   "Name",
+		Type:   "string",
+		Format: "name",
+	})
+	cc = cc.withoutAge()
+	pp := make([]*jsonpath.JSONPath, 0, len(cc))
+	for _, c := range cc {
+		jp, err := parseJSONPath(c.Name, c.JSONPath)
+		if err != nil {
+			return nil, err
+		}
+		pp = append(pp, jp)
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{
+			Name:        c.Name,
+			Type:        c.Type,
+			Format:      c.Format,
+			Description: c.Description,
+			Priority:    c.Priority,
+		})
+	}
+	t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{
+		Name: ageTableCol,
+		Type: "date",
+	})
+
+	t.Rows = make([]metav1beta1.TableRow, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		raw, err := json.Marshal(u.Object)
+		if err != nil {
+			return nil, err
+		}
+		row := metav1beta1.TableRow{Object: runtime.RawExtension{Raw: raw}}
+		row.Cells = append(row.Cells, u.GetName())
+		for i, jp := range pp {
+			row.Cells = append(row.Cells, evalJSONPath(jp, cc[i].Type, u.Object))
+		}
+		row.Cells = append(row.Cells, toAgeHuman(toAge(u.GetCreationTimestamp())))
+		t.Rows = append(t.Rows, row)
+	}
+
+	return &t, nil
+}
+
+// evalJSONPath evaluates a printer column path against a resource and
+// formats the result per the column type. Missing values yield a blank cell.
+func evalJSONPath(jp *jsonpath.JSONPath, kind string, o map[string]interface{}) interface{} {
+	rr, err := jp.FindResults(o)
+	if err != nil || len(rr) == 0 || len(rr[0]) == 0 {
+		return nil
+	}
+	v := rr[0][0]
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	return cellFor(kind, v.Interface())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseJSONPath(name, path string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{%s}", path)); err != nil {
+		return nil, fmt.Errorf("invalid printer column %q path %q: %w", name, path, err)
+	}
+
+	return jp, nil
+}
+
+// cellFor coerces a raw value to its printer column type.
+func cellFor(kind string, v interface{}) interface{} {
+	switch kind {
+	case "integer":
+		switch n := v.(type) {
+		case int64:
+			return n
+		case float64:
+			return int64(n)
+		}
+	case "number":
+		switch n := v.(type) {
+		case int64:
+			return float64(n)
+		case float64:
+			return n
+		}
+	case "boolean":
+		if b, ok := v.(bool); ok {
+			return b
+		}
+	case "date":
+		if s, ok := v.(string); ok {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return s
+			}
+			return toAgeHuman(toAge(metav1.NewTime(t)))
+		}
+	}
+
+	switch v.(type) {
+	case string, int64, float64, bool:
+		return v
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(raw)
+	}
+}
+
+// cellToStr renders a table cell. Numbers are rendered without exponents so
+// they sort naturally.
+func cellToStr(c interface{}) string {
+	switch v := c.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func isNumericCol(kind string) bool {
+	return kind == "integer" || kind == "number"
+}
@@ -0,0 +1,111 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalJSONPath(t *testing.T) {
+	o := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"ratio":    0.25,
+			"paused":   true,
+			"hosts":    []interface{}{"a.com", "b.com"},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Issuing", "status": "False"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+			"notAfter": time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+			"size":     float64(1e7),
+		},
+	}
+
+	uu := map[string]struct {
+		path, kind string
+		e          interface{}
+	}{
+		"integer": {
+			path: ".spec.replicas",
+			kind: "integer",
+			e:    int64(3),
+		},
+		"intFromFloat": {
+			path: ".status.size",
+			kind: "integer",
+			e:    int64(10000000),
+		},
+		"number": {
+			path: ".spec.ratio",
+			kind: "number",
+			e:    0.25,
+		},
+		"boolean": {
+			path: ".spec.paused",
+			kind: "boolean",
+			e:    true,
+		},
+		"filter": {
+			path: `.status.conditions[?(@.type=="Ready")].status`,
+			kind: "string",
+			e:    "True",
+		},
+		"index": {
+			path: ".spec.hosts[1]",
+			kind: "string",
+			e:    "b.com",
+		},
+		"date": {
+			path: ".status.notAfter",
+			kind: "date",
+			e:    "120m",
+		},
+		"object": {
+			path: ".spec.hosts",
+			kind: "string",
+			e:    `["a.com","b.com"]`,
+		},
+		"missing": {
+			path: ".spec.blee",
+			kind: "string",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			jp, err := parseJSONPath(k, u.path)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, evalJSONPath(jp, u.kind, o))
+		})
+	}
+}
+
+func TestParseJSONPathInvalid(t *testing.T) {
+	_, err := parseJSONPath("blee", ".spec[")
+	assert.NotNil(t, err)
+}
+
+func TestCellToStr(t *testing.T) {
+	uu := map[string]struct {
+		c interface{}
+		e string
+	}{
+		"nil":    {e: ""},
+		"float":  {c: float64(1e7), e: "10000000"},
+		"frac":   {c: 0.25, e: "0.25"},
+		"int":    {c: int64(3), e: "3"},
+		"string": {c: "fred", e: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, cellToStr(u.c))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewPrinterTable(t *testing.T) {
+	uu := map[string]struct {
+		cols    render.PrinterColumns
+		eHeader render.HeaderRow
+		eFields render.Fields
+	}{
+		"columns": {
+			cols: render.PrinterColumns{
+				{Name: "Ready", Type: "string", JSONPath: `.status.conditions[?(@.type=="Ready")].status`},
+				{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+				{Name: "Issuer", Type: "string", Priority: 1, JSONPath: ".spec.issuerRef.name"},
+				{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+			},
+			eHeader: render.HeaderRow{
+				render.Header{Name: "NAME"},
+				render.Header{Name: "READY"},
+				render.Header{Name: "REPLICAS", Align: tview.AlignRight},
+				render.Header{Name: "AGE"},
+				render.Header{Name: "ISSUER", Wide: true},
+			},
+			eFields: render.Fields{"c1", "True", "3", "", "letsencrypt"},
+		},
+		"fallback": {
+			eHeader: render.HeaderRow{
+				render.Header{Name: "NAME"},
+				render.Header{Name: "AGE"},
+			},
+			eFields: render.Fields{"c1", ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			table, err := render.NewPrinterTable(u.cols, []runtime.Object{makeCR()})
+			assert.Nil(t, err)
+
+			var (
+				re render.Generic
+				r  render.Row
+			)
+			re.SetTable(table)
+			hh := re.Header("ns1")
+			assert.Equal(t, u.eHeader, hh)
+			assert.Nil(t, re.Render(&table.Rows[0], "ns1", &r))
+			assert.Equal(t, "ns1/c1", r.ID)
+			for i, h := range hh {
+				if h.Name == "AGE" {
+					r.Fields[i] = ""
+				}
+			}
+			assert.Equal(t, u.eFields, r.Fields)
+		})
+	}
+}
+
+func TestNewPrinterTableInvalidPath(t *testing.T) {
+	_, err := render.NewPrinterTable(render.PrinterColumns{{Name: "A", JSONPath: ".spec["}}, nil)
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+func makeCR() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1alpha2",
+		"kind":       "Certificate",
+		"metadata": map[string]interface{}{
+			"name":              "c1",
+			"namespace":         "ns1",
+			"creationTimestamp": "2019-11-01T10:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"replicas":  int64(3),
+			"issuerRef": map[string]interface{}{"name": "letsencrypt"},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+}
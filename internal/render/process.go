@@ -0,0 +1,73 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Process renders a container process to screen.
+type Process struct{}
+
+// ColorerFunc colors a resource row.
+func (Process) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Process) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "PID", Align: tview.AlignRight},
+		Header{Name: "USER"},
+		Header{Name: "%CPU", Align: tview.AlignRight},
+		Header{Name: "%MEM", Align: tview.AlignRight},
+		Header{Name: "RSS(KiB)", Align: tview.AlignRight},
+		Header{Name: "COMMAND"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Process) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(ProcessRes)
+	if !ok {
+		return fmt.Errorf("expecting ProcessRes but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(p.PID)
+	r.Fields = Fields{
+		r.ID,
+		p.User,
+		strconv.FormatFloat(p.CPU, 'f', 1, 64),
+		strconv.FormatFloat(p.MEM, 'f', 1, 64),
+		strconv.FormatInt(p.RSS, 10),
+		p.Command,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ProcessRes represents a container process.
+type ProcessRes struct {
+	PID     int
+	User    string
+	CPU     float64
+	MEM     float64
+	RSS     int64
+	Command string
+}
+
+// GetObjectKind returns a schema object.
+func (ProcessRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p ProcessRes) DeepCopyObject() runtime.Object {
+	return p
+}
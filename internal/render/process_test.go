@@ -0,0 +1,19 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessRender(t *testing.T) {
+	var p render.Process
+
+	var r render.Row
+	o := render.ProcessRes{PID: 12, User: "root", CPU: 12.54, MEM: 0.1, RSS: 800, Command: "ps aux"}
+
+	assert.Nil(t, p.Render(o, "", &r))
+	assert.Equal(t, "12", r.ID)
+	assert.Equal(t, render.Fields{"12", "root", "12.5", "0.1", "800", "ps aux"}, r.Fields)
+}
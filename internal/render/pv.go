@@ -28,6 +28,8 @@ func (PersistentVolume) ColorerFunc() ColorerFunc {
 			c = StdColor
 		case "Available":
 			c = tcell.ColorYellow
+		case "Released":
+			c = HighlightColor
 		default:
 			c = ErrColor
 		}
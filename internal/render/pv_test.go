@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +16,38 @@ func TestPersistentVolumeRender(t *testing.T) {
 	assert.Equal(t, "pvc-07aa4e2c-8726-11e9-a8e8-42010a80015b", r.ID)
 	assert.Equal(t, render.Fields{"pvc-07aa4e2c-8726-11e9-a8e8-42010a80015b", "1Gi", "RWO", "Delete", "Bound", "default/www-nginx-sts-1", "standard"}, r.Fields[:7])
 }
+
+func TestPersistentVolumeColorer(t *testing.T) {
+	defer withRowColors()()
+
+	uu := map[string]struct {
+		status string
+		e      tcell.Color
+	}{
+		"bound":    {status: "Bound", e: render.StdColor},
+		"released": {status: "Released", e: render.HighlightColor},
+		"failed":   {status: "Failed", e: render.ErrColor},
+	}
+
+	f := render.PersistentVolume{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.Row{Fields: render.Fields{"pv1", "1Gi", "RWO", "Retain", u.status}}
+			assert.Equal(t, u.e, f("", render.RowEvent{Kind: render.EventUnchanged, Row: r}))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// withRowColors sets distinct row colors and returns a restore function.
+func withRowColors() func() {
+	std, err, hi := render.StdColor, render.ErrColor, render.HighlightColor
+	render.StdColor, render.ErrColor, render.HighlightColor = tcell.ColorWhite, tcell.ColorRed, tcell.ColorAqua
+
+	return func() {
+		render.StdColor, render.ErrColor, render.HighlightColor = std, err, hi
+	}
+}
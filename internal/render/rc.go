@@ -0,0 +1,55 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReplicationController renders a K8s ReplicationController to screen.
+type ReplicationController struct{}
+
+// ColorerFunc colors a resource row.
+func (ReplicationController) ColorerFunc() ColorerFunc {
+	return ReplicaSet{}.ColorerFunc()
+}
+
+// Header returns a header row.
+func (ReplicationController) Header(ns string) HeaderRow {
+	return ReplicaSet{}.Header(ns)
+}
+
+// Render renders a K8s resource to screen.
+func (s ReplicationController) Render(o interface{}, ns string, r *Row) error {
+	raw, state, ok := unwrapAudit(o)
+	if !ok {
+		return fmt.Errorf("Expected ReplicationController, but got %T", o)
+	}
+	var rc v1.ReplicationController
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rc)
+	if err != nil {
+		return err
+	}
+
+	desired := 1
+	if rc.Spec.Replicas != nil {
+		desired = int(*rc.Spec.Replicas)
+	}
+	r.ID = MetaFQN(rc.ObjectMeta)
+	r.Fields = make(Fields, 0, len(s.Header(ns)))
+	if isAllNamespace(ns) {
+		r.Fields = append(r.Fields, rc.Namespace)
+	}
+	r.Fields = append(r.Fields,
+		rc.Name,
+		strconv.Itoa(desired),
+		strconv.Itoa(int(rc.Status.Replicas)),
+		strconv.Itoa(int(rc.Status.ReadyReplicas)),
+		state,
+		toAge(rc.ObjectMeta.CreationTimestamp),
+	)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationControllerRender(t *testing.T) {
+	c := render.ReplicationController{}
+	r := render.NewRow(7)
+	c.Render(load(t, "rc"), render.AllNamespaces, &r)
+
+	assert.Equal(t, "icx/icx-web-2", r.ID)
+	assert.Equal(t, render.Fields{"icx", "icx-web-2", "2", "2", "1", ""}, r.Fields[:6])
+}
+
+func TestReplicationControllerRenderAudit(t *testing.T) {
+	c := render.ReplicationController{}
+	r := render.NewRow(6)
+	c.Render(&render.ControllerWithAudit{Raw: load(t, "rc"), State: render.RSStale}, "icx", &r)
+
+	assert.Equal(t, "icx/icx-web-2", r.ID)
+	assert.Equal(t, render.Fields{"icx-web-2", "2", "2", "1", render.RSStale}, r.Fields[:5])
+}
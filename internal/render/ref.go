@@ -0,0 +1,66 @@
+package render
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Ref renders a resource consumer to screen.
+type Ref struct{}
+
+// ColorerFunc colors a resource row.
+func (Ref) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Ref) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "KIND"},
+		Header{Name: "NAME"},
+		Header{Name: "REFERENCE"},
+		Header{Name: "DETAIL"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Ref) Render(o interface{}, ns string, r *Row) error {
+	ref, ok := o.(RefRes)
+	if !ok {
+		return fmt.Errorf("expecting RefRes but got %T", o)
+	}
+
+	r.ID = ref.ID
+	r.Fields = Fields{
+		ref.Kind,
+		ref.Path,
+		ref.Type,
+		na(ref.Detail),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RefRes represents a resource consumer.
+type RefRes struct {
+	ID     string
+	Kind   string
+	Path   string
+	Type   string
+	Detail string
+}
+
+// GetObjectKind returns a schema object.
+func (RefRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RefRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.RefRes
+		e render.Fields
+	}{
+		"env": {
+			o: render.RefRes{ID: "1", Kind: "Pod", Path: "default/p1", Type: "Env", Detail: "c1/PWD"},
+			e: render.Fields{"Pod", "default/p1", "Env", "c1/PWD"},
+		},
+		"imagePull": {
+			o: render.RefRes{ID: "2", Kind: "ServiceAccount", Path: "default/sa1", Type: "ImagePullSecret"},
+			e: render.Fields{"ServiceAccount", "default/sa1", "ImagePullSecret", render.NAValue},
+		},
+	}
+
+	var ref render.Ref
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, ref.Render(u.o, "", &r))
+			assert.Equal(t, u.o.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package render
 
 import (
+	"bytes"
+	"net"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"vbom.ml/util/sortorder"
@@ -25,6 +29,11 @@ func (f Fields) Clone() Fields {
 type Row struct {
 	ID     string
 	Fields Fields
+	// Data carries structured row data views may need beyond display text.
+	Data interface{}
+	// Stamp tracks when the row was rendered. Relative time cells are
+	// computed against it.
+	Stamp time.Time
 }
 
 // NewRow returns a new row with initialized fields.
@@ -37,6 +46,8 @@ func (r Row) Clone() Row {
 	return Row{
 		ID:     r.ID,
 		Fields: r.Fields.Clone(),
+		Data:   r.Data,
+		Stamp:  r.Stamp,
 	}
 }
 
@@ -116,9 +127,24 @@ func (s RowSorter) Less(i, j int) bool {
 
 // Less return true if c1 < c2.
 func Less(asc bool, c1, c2 string) bool {
+	if o, ok := isUnmeasuredSort(c1, c2); ok {
+		return o
+	}
+	if o, ok := isIntegerSort(asc, c1, c2); ok {
+		return o
+	}
+	if o, ok := isFloatSort(asc, c1, c2); ok {
+		return o
+	}
 	if o, ok := isDurationSort(asc, c1, c2); ok {
 		return o
 	}
+	if o, ok := isBytesSort(asc, c1, c2); ok {
+		return o
+	}
+	if o, ok := isIPSort(asc, c1, c2); ok {
+		return o
+	}
 
 	b := sortorder.NaturalLess(c1, c2)
 	if asc {
@@ -127,6 +153,51 @@ func Less(asc bool, c1, c2 string) bool {
 	return !b
 }
 
+// isUnmeasuredSort sorts unmeasured values after measures either way.
+func isUnmeasuredSort(s1, s2 string) (bool, bool) {
+	switch {
+	case s1 == UnmeasuredValue && s2 == UnmeasuredValue:
+		return false, true
+	case s1 == UnmeasuredValue:
+		return false, isMeasure(s2)
+	case s2 == UnmeasuredValue:
+		return true, isMeasure(s1)
+	default:
+		return false, false
+	}
+}
+
+func isMeasure(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+func isFloatSort(asc bool, s1, s2 string) (bool, bool) {
+	n1, err1 := strconv.ParseFloat(s1, 64)
+	n2, err2 := strconv.ParseFloat(s2, 64)
+	if err1 != nil || err2 != nil {
+		return false, false
+	}
+
+	if asc {
+		return n1 < n2, true
+	}
+	return n1 > n2, true
+}
+
+func isIntegerSort(asc bool, s1, s2 string) (bool, bool) {
+	n1, err1 := strconv.ParseInt(s1, 10, 64)
+	n2, err2 := strconv.ParseInt(s2, 10, 64)
+	if err1 != nil || err2 != nil {
+		return false, false
+	}
+
+	if asc {
+		return n1 < n2, true
+	}
+	return n1 > n2, true
+}
+
 func isDurationSort(asc bool, s1, s2 string) (bool, bool) {
 	d1, ok1 := isDuration(s1)
 	d2, ok2 := isDuration(s2)
@@ -140,6 +211,42 @@ func isDurationSort(asc bool, s1, s2 string) (bool, bool) {
 	return d1 >= d2, true
 }
 
+func isBytesSort(asc bool, s1, s2 string) (bool, bool) {
+	b1, ok1 := fromBytes(s1)
+	b2, ok2 := fromBytes(s2)
+	if !ok1 || !ok2 {
+		return false, false
+	}
+
+	if asc {
+		return b1 < b2, true
+	}
+	return b1 > b2, true
+}
+
+// isIPSort compares addresses numerically. Multi addresses cells, ie dual-stack
+// pods, sort on their first address.
+func isIPSort(asc bool, s1, s2 string) (bool, bool) {
+	ip1, ip2 := firstIP(s1), firstIP(s2)
+	if ip1 == nil || ip2 == nil {
+		return false, false
+	}
+
+	c := bytes.Compare(ip1, ip2)
+	if asc {
+		return c < 0, true
+	}
+	return c > 0, true
+}
+
+func firstIP(s string) net.IP {
+	if i := strings.Index(s, ","); i >= 0 {
+		s = s[:i]
+	}
+
+	return net.ParseIP(s).To16()
+}
+
 func isDuration(s string) (time.Duration, bool) {
 	d, err := time.ParseDuration(s)
 	if err != nil {
@@ -145,15 +145,14 @@ func (rr RowEvents) Sort(ns string, col int, asc bool) {
 	t := RowEventSorter{NS: ns, Events: rr, Index: col, Asc: asc}
 	sort.Sort(t)
 
-	gg, kk := map[string][]string{}, make(StringSet, 0, len(rr))
+	gg, kk := map[string][]string{}, make([]string, 0, len(rr))
 	for _, e := range rr {
 		g := e.Row.Fields[col]
-		kk = kk.Add(g)
-		if ss, ok := gg[g]; ok {
-			gg[g] = append(ss, e.Row.ID)
-		} else {
-			gg[g] = []string{e.Row.ID}
+		ss, ok := gg[g]
+		if !ok {
+			kk = append(kk, g)
 		}
+		gg[g] = append(ss, e.Row.ID)
 	}
 
 	ids := make([]string, 0, len(rr))
@@ -161,8 +160,7 @@ func (rr RowEvents) Sort(ns string, col int, asc bool) {
 		sort.StringSlice(gg[k]).Sort()
 		ids = append(ids, gg[k]...)
 	}
-	s := IdSorter{Ids: ids, Events: rr}
-	sort.Sort(s)
+	sort.Sort(NewIdSorter(ids, rr))
 }
 
 // ----------------------------------------------------------------------------
@@ -194,6 +192,18 @@ func (r RowEventSorter) Less(i, j int) bool {
 type IdSorter struct {
 	Ids    []string
 	Events RowEvents
+
+	index map[string]int
+}
+
+// NewIdSorter returns a sorter indexing the ids so large lists sort fast.
+func NewIdSorter(ids []string, rr RowEvents) IdSorter {
+	index := make(map[string]int, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		index[ids[i]] = i
+	}
+
+	return IdSorter{Ids: ids, Events: rr, index: index}
 }
 
 func (s IdSorter) Len() int {
@@ -206,8 +216,18 @@ func (s IdSorter) Swap(i, j int) {
 
 func (s IdSorter) Less(i, j int) bool {
 	id1, id2 := s.Events[i].Row.ID, s.Events[j].Row.ID
-	i1, i2 := findIndex(s.Ids, id1), findIndex(s.Ids, id2)
-	return i1 < i2
+	return s.indexOf(id1) < s.indexOf(id2)
+}
+
+func (s IdSorter) indexOf(id string) int {
+	if s.index == nil {
+		return findIndex(s.Ids, id)
+	}
+	if i, ok := s.index[id]; ok {
+		return i
+	}
+
+	return findIndex(s.Ids, id)
 }
 
 func findIndex(ss []string, s string) int {
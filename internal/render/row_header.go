@@ -9,6 +9,11 @@ type Header struct {
 	Name      string
 	Align     int
 	Decorator DecoratorFunc
+	Wide      bool
+	// Long columns hold lengthy values truncated in the middle to fit.
+	Long bool
+	// Time tracks how a time column relates to its row render time.
+	Time TimeKind
 }
 
 // Clone copies a header.
@@ -16,6 +21,15 @@ func (h Header) Clone() Header {
 	return h
 }
 
+// TimeKind returns the column time kind. Age columns are deemed times.
+func (h Header) TimeKind() TimeKind {
+	if h.Time == NoTime && h.Name == ageCol {
+		return TimeSince
+	}
+
+	return h.Time
+}
+
 // ----------------------------------------------------------------------------
 
 // HeaderRow represents a table header.
@@ -70,5 +84,27 @@ func (hh HeaderRow) AgeCol(col int) bool {
 	if !hh.HasAge() {
 		return false
 	}
-	return col == len(hh)-1
+	return col == hh.AgeIndex()
+}
+
+// AgeIndex returns the age column index or the last column if none.
+func (hh HeaderRow) AgeIndex() int {
+	for i, h := range hh {
+		if h.Name == ageCol {
+			return i
+		}
+	}
+
+	return len(hh) - 1
+}
+
+// IndexOf returns the index of the named column or -1 if not found.
+func (hh HeaderRow) IndexOf(name string) int {
+	for i, h := range hh {
+		if h.Name == name {
+			return i
+		}
+	}
+
+	return -1
 }
@@ -196,6 +196,100 @@ func TestRowsSortDuration(t *testing.T) {
 	}
 }
 
+func TestRowsSortNumber(t *testing.T) {
+	uu := map[string]struct {
+		rows render.Rows
+		col  int
+		asc  bool
+		e    render.Rows
+	}{
+		"numberAsc": {
+			rows: render.Rows{
+				{Fields: []string{"10.5", "duh"}},
+				{Fields: []string{"9.75", "blee"}},
+			},
+			col: 0,
+			asc: true,
+			e: render.Rows{
+				{Fields: []string{"9.75", "blee"}},
+				{Fields: []string{"10.5", "duh"}},
+			},
+		},
+		"numberDesc": {
+			rows: render.Rows{
+				{Fields: []string{"0.5", "duh"}},
+				{Fields: []string{"2", "blee"}},
+			},
+			col: 0,
+			e: render.Rows{
+				{Fields: []string{"2", "blee"}},
+				{Fields: []string{"0.5", "duh"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		uc := uu[k]
+		t.Run(k, func(t *testing.T) {
+			uc.rows.Sort(uc.col, uc.asc)
+			assert.Equal(t, uc.e, uc.rows)
+		})
+	}
+}
+
+func TestRowsSortIP(t *testing.T) {
+	uu := map[string]struct {
+		rows render.Rows
+		col  int
+		asc  bool
+		e    render.Rows
+	}{
+		"v6Asc": {
+			rows: render.Rows{
+				{Fields: []string{"fd00::10", "duh"}},
+				{Fields: []string{"fd00::f", "blee"}},
+			},
+			col: 0,
+			asc: true,
+			e: render.Rows{
+				{Fields: []string{"fd00::f", "blee"}},
+				{Fields: []string{"fd00::10", "duh"}},
+			},
+		},
+		"familiesAsc": {
+			rows: render.Rows{
+				{Fields: []string{"fd00::4", "duh"}},
+				{Fields: []string{"10.0.0.4", "blee"}},
+			},
+			col: 0,
+			asc: true,
+			e: render.Rows{
+				{Fields: []string{"10.0.0.4", "blee"}},
+				{Fields: []string{"fd00::4", "duh"}},
+			},
+		},
+		"dualStackDesc": {
+			rows: render.Rows{
+				{Fields: []string{"10.0.0.9,fd00::9", "duh"}},
+				{Fields: []string{"10.0.0.10,fd00::a", "blee"}},
+			},
+			col: 0,
+			e: render.Rows{
+				{Fields: []string{"10.0.0.10,fd00::a", "blee"}},
+				{Fields: []string{"10.0.0.9,fd00::9", "duh"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		uc := uu[k]
+		t.Run(k, func(t *testing.T) {
+			uc.rows.Sort(uc.col, uc.asc)
+			assert.Equal(t, uc.e, uc.rows)
+		})
+	}
+}
+
 func TestRowsSortMetrics(t *testing.T) {
 	uu := map[string]struct {
 		rows render.Rows
@@ -237,3 +331,123 @@ func TestRowsSortMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestRowsSortUnmeasured(t *testing.T) {
+	uu := map[string]struct {
+		rows render.Rows
+		asc  bool
+		e    render.Rows
+	}{
+		"asc": {
+			rows: render.Rows{
+				{Fields: []string{"-", "init"}},
+				{Fields: []string{"20", "fred"}},
+				{Fields: []string{"5", "blee"}},
+			},
+			asc: true,
+			e: render.Rows{
+				{Fields: []string{"5", "blee"}},
+				{Fields: []string{"20", "fred"}},
+				{Fields: []string{"-", "init"}},
+			},
+		},
+		"desc": {
+			rows: render.Rows{
+				{Fields: []string{"5", "blee"}},
+				{Fields: []string{"-", "init"}},
+				{Fields: []string{"20", "fred"}},
+			},
+			asc: false,
+			e: render.Rows{
+				{Fields: []string{"20", "fred"}},
+				{Fields: []string{"5", "blee"}},
+				{Fields: []string{"-", "init"}},
+			},
+		},
+		"na": {
+			rows: render.Rows{
+				{Fields: []string{"-", "init"}},
+				{Fields: []string{"n/a", "fred"}},
+			},
+			asc: true,
+			e: render.Rows{
+				{Fields: []string{"-", "init"}},
+				{Fields: []string{"n/a", "fred"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.rows.Sort(0, u.asc)
+			assert.Equal(t, u.e, u.rows)
+		})
+	}
+}
+
+func TestRowsSortInteger(t *testing.T) {
+	uu := map[string]struct {
+		rows render.Rows
+		col  int
+		asc  bool
+		e    render.Rows
+	}{
+		"intAsc": {
+			rows: render.Rows{
+				{Fields: []string{"p1", "10"}},
+				{Fields: []string{"p2", "0"}},
+				{Fields: []string{"p3", "9"}},
+				{Fields: []string{"p4", "101"}},
+			},
+			col: 1,
+			asc: true,
+			e: render.Rows{
+				{Fields: []string{"p2", "0"}},
+				{Fields: []string{"p3", "9"}},
+				{Fields: []string{"p1", "10"}},
+				{Fields: []string{"p4", "101"}},
+			},
+		},
+		"intDesc": {
+			rows: render.Rows{
+				{Fields: []string{"p1", "10"}},
+				{Fields: []string{"p2", "0"}},
+				{Fields: []string{"p3", "9"}},
+				{Fields: []string{"p4", "101"}},
+			},
+			col: 1,
+			e: render.Rows{
+				{Fields: []string{"p4", "101"}},
+				{Fields: []string{"p1", "10"}},
+				{Fields: []string{"p3", "9"}},
+				{Fields: []string{"p2", "0"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		uc := uu[k]
+		t.Run(k, func(t *testing.T) {
+			uc.rows.Sort(uc.col, uc.asc)
+			assert.Equal(t, uc.e, uc.rows)
+		})
+	}
+}
+
+func TestRowsSortBytes(t *testing.T) {
+	rows := render.Rows{
+		{Fields: []string{"p1", "1.5KiB"}},
+		{Fields: []string{"p2", "900B"}},
+		{Fields: []string{"p3", "2.0MiB"}},
+		{Fields: []string{"p4", "10.0KiB"}},
+	}
+	rows.Sort(1, false)
+
+	assert.Equal(t, render.Rows{
+		{Fields: []string{"p3", "2.0MiB"}},
+		{Fields: []string{"p4", "10.0KiB"}},
+		{Fields: []string{"p1", "1.5KiB"}},
+		{Fields: []string{"p2", "900B"}},
+	}, rows)
+}
@@ -10,6 +10,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of replicaset audit states.
+const (
+	RSOrphan = "Orphan"
+	RSStale  = "Stale"
 )
 
 // ReplicaSet renders a K8s ReplicaSet to screen.
@@ -19,14 +26,20 @@ type ReplicaSet struct{}
 func (ReplicaSet) ColorerFunc() ColorerFunc {
 	return func(ns string, r RowEvent) tcell.Color {
 		c := DefaultColorer(ns, r)
-		if r.Kind == EventAdd || r.Kind == EventUpdate {
-			return c
-		}
-
 		markCol := 2
 		if ns != AllNamespaces {
 			markCol = 1
 		}
+		switch r.Row.Fields[markCol+3] {
+		case RSOrphan:
+			return ErrColor
+		case RSStale:
+			return CompletedColor
+		}
+		if r.Kind == EventAdd || r.Kind == EventUpdate {
+			return c
+		}
+
 		if strings.TrimSpace(r.Row.Fields[markCol]) != strings.TrimSpace(r.Row.Fields[markCol+1]) {
 			return ErrColor
 		}
@@ -48,13 +61,14 @@ func (ReplicaSet) Header(ns string) HeaderRow {
 		Header{Name: "DESIRED", Align: tview.AlignRight},
 		Header{Name: "CURRENT", Align: tview.AlignRight},
 		Header{Name: "READY", Align: tview.AlignRight},
+		Header{Name: "STATUS"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (s ReplicaSet) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
+	raw, state, ok := unwrapAudit(o)
 	if !ok {
 		return fmt.Errorf("Expected ReplicaSet, but got %T", o)
 	}
@@ -74,8 +88,40 @@ func (s ReplicaSet) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(*rs.Spec.Replicas)),
 		strconv.Itoa(int(rs.Status.Replicas)),
 		strconv.Itoa(int(rs.Status.ReadyReplicas)),
+		state,
 		toAge(rs.ObjectMeta.CreationTimestamp),
 	)
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ControllerWithAudit represents a replicaset or a replication controller and
+// its audit state.
+type ControllerWithAudit struct {
+	Raw   *unstructured.Unstructured
+	State string
+}
+
+// GetObjectKind returns a schema object.
+func (r *ControllerWithAudit) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r *ControllerWithAudit) DeepCopyObject() runtime.Object {
+	return r
+}
+
+func unwrapAudit(o interface{}) (*unstructured.Unstructured, string, bool) {
+	switch r := o.(type) {
+	case *ControllerWithAudit:
+		return r.Raw, r.State, true
+	case *unstructured.Unstructured:
+		return r, "", true
+	default:
+		return nil, "", false
+	}
+}
@@ -15,3 +15,12 @@ func TestReplicaSetRender(t *testing.T) {
 	assert.Equal(t, "icx/icx-db-7d4b578979", r.ID)
 	assert.Equal(t, render.Fields{"icx", "icx-db-7d4b578979", "1", "1", "1"}, r.Fields[:5])
 }
+
+func TestReplicaSetRenderAudit(t *testing.T) {
+	c := render.ReplicaSet{}
+	r := render.NewRow(6)
+	c.Render(&render.ControllerWithAudit{Raw: load(t, "rs"), State: render.RSOrphan}, "", &r)
+
+	assert.Equal(t, "icx/icx-db-7d4b578979", r.ID)
+	assert.Equal(t, render.Fields{"icx", "icx-db-7d4b578979", "1", "1", "1", render.RSOrphan}, r.Fields[:6])
+}
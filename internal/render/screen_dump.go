@@ -21,6 +21,9 @@ func (ScreenDump) ColorerFunc() ColorerFunc {
 	}
 }
 
+// utcTimestampLen represents the length of a UTC RFC3339 timestamp.
+const utcTimestampLen = len("2006-01-02T15:04:05Z")
+
 // DecoratorFunc decorates a string.
 type DecoratorFunc func(string) string
 
@@ -29,6 +32,20 @@ var AgeDecorator = func(a string) string {
 	return toAgeHuman(a)
 }
 
+// TimestampDecorator renders a UTC RFC3339 timestamp column as a human age.
+// Trailing delta markers are preserved.
+var TimestampDecorator = func(s string) string {
+	if len(s) < utcTimestampLen {
+		return s
+	}
+	t, err := time.Parse(time.RFC3339, s[:utcTimestampLen])
+	if err != nil {
+		return s
+	}
+
+	return toAgeHuman(time.Since(t).String()) + s[utcTimestampLen:]
+}
+
 // Header returns a header row.
 func (ScreenDump) Header(ns string) HeaderRow {
 	return HeaderRow{
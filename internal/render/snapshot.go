@@ -0,0 +1,96 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of bookmark comparison states.
+const (
+	SnapshotAdded   = "ADDED"
+	SnapshotRemoved = "REMOVED"
+	SnapshotChanged = "CHANGED"
+)
+
+// SnapshotDiff renders a view bookmark comparison to screen.
+type SnapshotDiff struct{}
+
+// ColorerFunc colors a resource row.
+func (SnapshotDiff) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[0] {
+		case SnapshotAdded:
+			return AddColor
+		case SnapshotRemoved:
+			return KillColor
+		default:
+			return ModColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (SnapshotDiff) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "STATE"},
+		Header{Name: "NAME"},
+		Header{Name: "COLUMNS"},
+		Header{Name: "CHANGES"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (SnapshotDiff) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(SnapshotDelta)
+	if !ok {
+		return fmt.Errorf("expected SnapshotDelta, but got %T", o)
+	}
+
+	cols := make([]string, 0, len(d.Changes))
+	cc := make([]string, 0, len(d.Changes))
+	for _, c := range d.Changes {
+		cols = append(cols, c.Column)
+		cc = append(cc, c.String())
+	}
+	r.ID, r.Data = d.ID, d.Changes
+	r.Fields = Fields{
+		d.State,
+		d.ID,
+		join(cols, ","),
+		join(cc, " "),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// CellChange represents a table cell that changed since a bookmark.
+type CellChange struct {
+	Column, Before, After string
+}
+
+func (c CellChange) String() string {
+	return fmt.Sprintf("%s:%s->%s", c.Column, missing(c.Before), missing(c.After))
+}
+
+// SnapshotDelta represents a row that differs from a bookmark.
+type SnapshotDelta struct {
+	ID      string
+	State   string
+	Changes []CellChange
+}
+
+// GetObjectKind returns a schema object.
+func (SnapshotDelta) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d SnapshotDelta) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,57 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotDiffRender(t *testing.T) {
+	uu := map[string]struct {
+		d render.SnapshotDelta
+		e render.Fields
+	}{
+		"added": {
+			d: render.SnapshotDelta{ID: "ns1/a", State: render.SnapshotAdded},
+			e: render.Fields{render.SnapshotAdded, "ns1/a", "", ""},
+		},
+		"changed": {
+			d: render.SnapshotDelta{
+				ID:    "ns1/b",
+				State: render.SnapshotChanged,
+				Changes: []render.CellChange{
+					{Column: "STATUS", Before: "Running", After: "Failed"},
+					{Column: "IP", After: "10.0.0.1"},
+				},
+			},
+			e: render.Fields{render.SnapshotChanged, "ns1/b", "STATUS,IP", "STATUS:Running->Failed IP:<none>->10.0.0.1"},
+		},
+	}
+
+	var s render.SnapshotDiff
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, s.Render(u.d, "", &r))
+			assert.Equal(t, u.d.ID, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+			assert.Equal(t, u.d.Changes, r.Data)
+		})
+	}
+}
+
+func TestSnapshotDiffColorer(t *testing.T) {
+	add, kill, mod := render.AddColor, render.KillColor, render.ModColor
+	defer func() {
+		render.AddColor, render.KillColor, render.ModColor = add, kill, mod
+	}()
+	render.AddColor, render.KillColor, render.ModColor = tcell.ColorBlue, tcell.ColorGray, tcell.ColorOrange
+	f := render.SnapshotDiff{}.ColorerFunc()
+
+	assert.Equal(t, render.AddColor, f("", render.RowEvent{Row: render.Row{Fields: render.Fields{render.SnapshotAdded}}}))
+	assert.Equal(t, render.KillColor, f("", render.RowEvent{Row: render.Row{Fields: render.Fields{render.SnapshotRemoved}}}))
+	assert.Equal(t, render.ModColor, f("", render.RowEvent{Row: render.Row{Fields: render.Fields{render.SnapshotChanged}}}))
+}
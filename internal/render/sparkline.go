@@ -0,0 +1,88 @@
+package render
+
+import (
+	"strings"
+)
+
+var (
+	sparks     = []rune("▁▂▃▄▅▆▇█")
+	monoSparks = []rune("_.-~=+*#")
+)
+
+// Sparkline renders samples as a line of unicode blocks scaled to the
+// largest sample.
+func Sparkline(vv []int64) string {
+	if len(vv) == 0 {
+		return NAValue
+	}
+
+	rr, max := sparkRunes(), maxSample(vv)
+	var b strings.Builder
+	for _, v := range vv {
+		b.WriteRune(rr[scale(v, max, len(rr)-1)])
+	}
+
+	return b.String()
+}
+
+// SparkChart renders samples as vertical bars spanning several lines.
+func SparkChart(vv []int64, height int) []string {
+	if len(vv) == 0 || height <= 0 {
+		return nil
+	}
+
+	rr, max := sparkRunes(), maxSample(vv)
+	levels := make([]int, len(vv))
+	for i, v := range vv {
+		levels[i] = scale(v, max, height*len(rr))
+	}
+
+	ll := make([]string, 0, height)
+	for row := height - 1; row >= 0; row-- {
+		var b strings.Builder
+		for _, l := range levels {
+			switch fill := l - row*len(rr); {
+			case fill >= len(rr):
+				b.WriteRune(rr[len(rr)-1])
+			case fill > 0:
+				b.WriteRune(rr[fill-1])
+			default:
+				b.WriteRune(' ')
+			}
+		}
+		ll = append(ll, b.String())
+	}
+
+	return ll
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// sparkRunes returns plain ascii blocks in monochrome.
+func sparkRunes() []rune {
+	if monochrome {
+		return monoSparks
+	}
+
+	return sparks
+}
+
+func maxSample(vv []int64) int64 {
+	var max int64
+	for _, v := range vv {
+		if v > max {
+			max = v
+		}
+	}
+
+	return max
+}
+
+func scale(v, max int64, levels int) int {
+	if max <= 0 || v <= 0 {
+		return 0
+	}
+
+	return int(v * int64(levels) / max)
+}
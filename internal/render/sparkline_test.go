@@ -0,0 +1,80 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		e  string
+	}{
+		"none": {
+			e: render.NAValue,
+		},
+		"zeros": {
+			vv: []int64{0, 0, 0},
+			e:  "▁▁▁",
+		},
+		"flat": {
+			vv: []int64{5, 5},
+			e:  "██",
+		},
+		"ramp": {
+			vv: []int64{0, 1, 2, 3, 4, 5, 6, 7},
+			e:  "▁▂▃▄▅▆▇█",
+		},
+		"scaled": {
+			vv: []int64{100, 400, 200, 800},
+			e:  "▁▄▂█",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.vv))
+		})
+	}
+}
+
+func TestSparkChart(t *testing.T) {
+	uu := map[string]struct {
+		vv     []int64
+		height int
+		e      []string
+	}{
+		"none": {
+			height: 2,
+		},
+		"noHeight": {
+			vv: []int64{1},
+		},
+		"twoRows": {
+			vv:     []int64{0, 2, 4, 8, 16},
+			height: 2,
+			e: []string{
+				"    █",
+				" ▂▄██",
+			},
+		},
+		"partial": {
+			vv:     []int64{12, 16},
+			height: 2,
+			e: []string{
+				"▄█",
+				"██",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.SparkChart(u.vv, u.height))
+		})
+	}
+}
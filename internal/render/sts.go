@@ -48,6 +48,7 @@ func (StatefulSet) Header(ns string) HeaderRow {
 		Header{Name: "READY"},
 		Header{Name: "SELECTOR"},
 		Header{Name: "SERVICE"},
+		Header{Name: ManagedByCol, Wide: true},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
@@ -74,6 +75,7 @@ func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(sts.Status.Replicas))+"/"+strconv.Itoa(int(*sts.Spec.Replicas)),
 		asSelector(sts.Spec.Selector),
 		na(sts.Spec.ServiceName),
+		ManagedBy(sts.Labels, sts.Annotations),
 		toAge(sts.ObjectMeta.CreationTimestamp),
 	)
 
@@ -0,0 +1,105 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SummaryField represents a resource summary entry.
+type SummaryField struct {
+	Name, Value string
+}
+
+// Summary represents the most useful fields of a resource.
+type Summary []SummaryField
+
+// GenericSummary summarizes a resource labels and creation time.
+func GenericSummary(u *unstructured.Unstructured) (Summary, error) {
+	return Summary{
+		{Name: "Labels", Value: mapToStr(u.GetLabels())},
+		{Name: "Created", Value: toCreated(u.GetCreationTimestamp())},
+	}, nil
+}
+
+// PodSummary summarizes a pod placement, identity and conditions.
+func PodSummary(u *unstructured.Unstructured) (Summary, error) {
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return nil, err
+	}
+
+	start := MissingValue
+	if po.Status.StartTime != nil {
+		start = toCreated(*po.Status.StartTime)
+	}
+	cc := make([]string, 0, len(po.Status.Conditions))
+	for _, c := range po.Status.Conditions {
+		cc = append(cc, fmt.Sprintf("%s=%s", c.Type, c.Status))
+	}
+
+	return Summary{
+		{Name: "Status", Value: PodStatus(&po)},
+		{Name: "Node", Value: missing(po.Spec.NodeName)},
+		{Name: "IP", Value: missing(PodIPs(po.Status))},
+		{Name: "Service Account", Value: PodServiceAccount(po.Spec)},
+		{Name: "QoS", Value: missing(string(po.Status.QOSClass))},
+		{Name: "Controller", Value: controllerRef(po.ObjectMeta)},
+		{Name: "Started", Value: start},
+		{Name: "Conditions", Value: missing(strings.Join(cc, ", "))},
+	}, nil
+}
+
+// DeploymentSummary summarizes a deployment rollout strategy and conditions.
+func DeploymentSummary(u *unstructured.Unstructured) (Summary, error) {
+	var dp appsv1.Deployment
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dp); err != nil {
+		return nil, err
+	}
+
+	strategy := string(dp.Spec.Strategy.Type)
+	if r := dp.Spec.Strategy.RollingUpdate; r != nil && r.MaxSurge != nil && r.MaxUnavailable != nil {
+		strategy += fmt.Sprintf(" (max surge %s, max unavailable %s)", r.MaxSurge, r.MaxUnavailable)
+	}
+	cc := make([]string, 0, len(dp.Status.Conditions))
+	for _, c := range dp.Status.Conditions {
+		cond := fmt.Sprintf("%s=%s", c.Type, c.Status)
+		if c.Reason != "" {
+			cond += " (" + c.Reason + ")"
+		}
+		cc = append(cc, cond)
+	}
+
+	return Summary{
+		{Name: "Strategy", Value: missing(strategy)},
+		{Name: "Selector", Value: missing(metav1.FormatLabelSelector(dp.Spec.Selector))},
+		{Name: "Replicas", Value: fmt.Sprintf("%d/%d ready", dp.Status.ReadyReplicas, dp.Status.Replicas)},
+		{Name: "Conditions", Value: missing(strings.Join(cc, ", "))},
+		{Name: "Created", Value: toCreated(dp.CreationTimestamp)},
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toCreated(t metav1.Time) string {
+	if t.IsZero() {
+		return MissingValue
+	}
+
+	return toTimestamp(t.Time) + " (" + toAgeHuman(toAge(t)) + " ago)"
+}
+
+func controllerRef(m metav1.ObjectMeta) string {
+	ref := metav1.GetControllerOf(&m)
+	if ref == nil {
+		return MissingValue
+	}
+
+	return ref.Kind + "/" + ref.Name
+}
@@ -0,0 +1,57 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodSummary(t *testing.T) {
+	s, err := render.PodSummary(load(t, "po"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(s))
+	assert.Equal(t, render.SummaryField{Name: "Node", Value: "minikube"}, s[1])
+	assert.Equal(t, render.SummaryField{Name: "IP", Value: "172.17.0.6"}, s[2])
+	assert.Equal(t, render.SummaryField{Name: "Service Account", Value: "default"}, s[3])
+	assert.Equal(t, render.SummaryField{Name: "QoS", Value: "BestEffort"}, s[4])
+	assert.Equal(t, render.SummaryField{Name: "Controller", Value: render.MissingValue}, s[5])
+	assert.Equal(t, "Started", s[6].Name)
+	assert.True(t, strings.HasSuffix(s[6].Value, " ago)"))
+	assert.Equal(t, render.SummaryField{Name: "Conditions", Value: "Initialized=True, Ready=True, ContainersReady=True, PodScheduled=True"}, s[7])
+}
+
+func TestDeploymentSummary(t *testing.T) {
+	s, err := render.DeploymentSummary(load(t, "dp"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(s))
+	assert.Equal(t, render.SummaryField{Name: "Strategy", Value: "RollingUpdate (max surge 25%, max unavailable 25%)"}, s[0])
+	assert.Equal(t, render.SummaryField{Name: "Selector", Value: "app=icx-db"}, s[1])
+	assert.Equal(t, render.SummaryField{Name: "Replicas", Value: "1/1 ready"}, s[2])
+	assert.Equal(t, render.SummaryField{Name: "Conditions", Value: "Available=True (MinimumReplicasAvailable), Progressing=True (NewReplicaSetAvailable)"}, s[3])
+}
+
+func TestGenericSummary(t *testing.T) {
+	o := load(t, "dp")
+	o.SetLabels(map[string]string{"b": "2", "a": "1"})
+	s, err := render.GenericSummary(o)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(s))
+	assert.Equal(t, render.SummaryField{Name: "Labels", Value: "a=1,b=2"}, s[0])
+	assert.Equal(t, "Created", s[1].Name)
+	assert.True(t, strings.HasSuffix(s[1].Value, " ago)"))
+
+	o.SetLabels(nil)
+	o.SetCreationTimestamp(metav1.Time{})
+	s, err = render.GenericSummary(o)
+	assert.Nil(t, err)
+	assert.Equal(t, render.Summary{
+		{Name: "Labels", Value: render.MissingValue},
+		{Name: "Created", Value: render.MissingValue},
+	}, s)
+}
@@ -0,0 +1,53 @@
+package render
+
+import "time"
+
+// TimeKind represents a time column kind.
+type TimeKind int
+
+const (
+	// NoTime represents a non time column.
+	NoTime TimeKind = iota
+	// TimeSince represents cells holding the duration since an event.
+	TimeSince
+	// TimeUntil represents cells holding the duration until an event.
+	TimeUntil
+	// TimeStamp represents cells holding a UTC RFC3339 timestamp.
+	TimeStamp
+)
+
+// AbsTime renders a time cell as a local RFC3339 timestamp given the time the
+// row was rendered. Cells that aren't times ie markers are left as is.
+// Trailing delta markers are preserved on timestamps.
+func AbsTime(kind TimeKind, field string, stamp time.Time) string {
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	switch kind {
+	case TimeStamp:
+		if len(field) < utcTimestampLen {
+			return field
+		}
+		t, err := time.Parse(time.RFC3339, field[:utcTimestampLen])
+		if err != nil {
+			return field
+		}
+		return toTimestamp(t) + field[utcTimestampLen:]
+	case TimeSince, TimeUntil:
+		d, err := time.ParseDuration(field)
+		if err != nil {
+			return field
+		}
+		if kind == TimeSince {
+			d = -d
+		}
+		return toTimestamp(stamp.Add(d))
+	default:
+		return field
+	}
+}
+
+func toTimestamp(t time.Time) string {
+	return t.Round(time.Second).Local().Format(time.RFC3339)
+}
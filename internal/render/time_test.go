@@ -0,0 +1,60 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsTime(t *testing.T) {
+	stamp := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	local := func(t time.Time) string {
+		return t.Local().Format(time.RFC3339)
+	}
+
+	uu := map[string]struct {
+		kind  render.TimeKind
+		field string
+		e     string
+	}{
+		"since": {
+			kind:  render.TimeSince,
+			field: "1h30m0.4s",
+			e:     local(stamp.Add(-90 * time.Minute)),
+		},
+		"until": {
+			kind:  render.TimeUntil,
+			field: "10m0s",
+			e:     local(stamp.Add(10 * time.Minute)),
+		},
+		"stamp": {
+			kind:  render.TimeStamp,
+			field: "2020-03-01T10:00:00ZΔ",
+			e:     local(stamp.Add(-2*time.Hour)) + "Δ",
+		},
+		"marker": {
+			kind:  render.TimeSince,
+			field: render.MissingValue,
+			e:     render.MissingValue,
+		},
+		"noTime": {
+			field: "1h",
+			e:     "1h",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.AbsTime(u.kind, u.field, stamp))
+		})
+	}
+}
+
+func TestHeaderTimeKind(t *testing.T) {
+	assert.Equal(t, render.TimeSince, render.Header{Name: "AGE"}.TimeKind())
+	assert.Equal(t, render.TimeUntil, render.Header{Name: "NEXT RUN", Time: render.TimeUntil}.TimeKind())
+	assert.Equal(t, render.NoTime, render.Header{Name: "NAME"}.TimeKind())
+}
@@ -32,6 +32,12 @@ const (
 
 	// PodInitializing represents a pod initializing status.
 	PodInitializing = "PodInitializing"
+
+	// OOMKilled represents a container killed for exceeding its memory limit.
+	OOMKilled = "OOMKilled"
+
+	// Evicted represents a pod evicted by its node.
+	Evicted = "Evicted"
 )
 
 const (
@@ -43,4 +49,8 @@ const (
 
 	// UnknownValue represents an unknown.
 	UnknownValue = "<unknown>"
+
+	// UnmeasuredValue indicates a metric that can not be measured ie a
+	// container that is not running.
+	UnmeasuredValue = "-"
 )
@@ -0,0 +1,73 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Watch renders watched resources to screen.
+type Watch struct{}
+
+// ColorerFunc colors a resource row.
+func (Watch) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[2] == MissingValue {
+			return tcell.ColorSkyblue
+		}
+		return tcell.ColorOrange
+	}
+}
+
+// Header returns a header row.
+func (Watch) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAME"},
+		Header{Name: "LAST CHANGE"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Watch) Render(o interface{}, ns string, r *Row) error {
+	w, ok := o.(WatchRes)
+	if !ok {
+		return fmt.Errorf("expected WatchRes, but got %T", o)
+	}
+
+	r.ID = w.ID
+	r.Fields = Fields{
+		client.NewGVR(w.GVR).ToR(),
+		w.Path,
+		missing(w.LastChange),
+		toAge(metav1.NewTime(w.Since)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WatchRes represents a watched resource.
+type WatchRes struct {
+	ID, GVR, Path string
+	LastChange    string
+	Since         time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (WatchRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w WatchRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -0,0 +1,34 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchRender(t *testing.T) {
+	var (
+		w render.Watch
+		r render.Row
+	)
+	o := render.WatchRes{
+		ID:         "apps/v1/deployments:ns1/fred",
+		GVR:        "apps/v1/deployments",
+		Path:       "ns1/fred",
+		LastChange: "replicas 3→5",
+		Since:      time.Now().Add(-time.Minute),
+	}
+
+	assert.Nil(t, w.Render(o, "", &r))
+	assert.Equal(t, "apps/v1/deployments:ns1/fred", r.ID)
+	assert.Equal(t, render.Fields{"deployments", "ns1/fred", "replicas 3→5"}, r.Fields[:3])
+	assert.Equal(t, tcell.ColorOrange, w.ColorerFunc()("", render.RowEvent{Row: r}))
+
+	o.LastChange = ""
+	assert.Nil(t, w.Render(o, "", &r))
+	assert.Equal(t, render.MissingValue, r.Fields[2])
+	assert.Equal(t, tcell.ColorSkyblue, w.ColorerFunc()("", render.RowEvent{Row: r}))
+}
@@ -0,0 +1,227 @@
+// Package status serves the port-forwards and benchmarks state to local
+// clients.
+package status
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// TokenHeader carries the client token.
+	TokenHeader = "X-K9s-Token"
+
+	localhost       = "127.0.0.1"
+	shutdownTimeout = 2 * time.Second
+)
+
+// Source provides the state served by the endpoint.
+type Source interface {
+	// Forwards returns the session port-forwards.
+	Forwards() []render.ForwardRes
+
+	// Benchmarks returns the names of the benchmarks in flight.
+	Benchmarks() []string
+
+	// LastBenchmark returns the most recent benchmark report and result if any.
+	LastBenchmark() (string, *perf.Result, error)
+}
+
+type (
+	// Status represents the endpoint payload.
+	Status struct {
+		Forwards   []Forward  `json:"forwards"`
+		Benchmarks Benchmarks `json:"benchmarks"`
+	}
+
+	// Forward represents a port-forward state.
+	Forward struct {
+		Path      string   `json:"path"`
+		Container string   `json:"container"`
+		Ports     []string `json:"ports"`
+		State     string   `json:"state"`
+		Age       string   `json:"age"`
+		TTL       string   `json:"ttl,omitempty"`
+		RxBytes   *uint64  `json:"rxBytes,omitempty"`
+		TxBytes   *uint64  `json:"txBytes,omitempty"`
+	}
+
+	// Benchmarks represents the benchmarks state.
+	Benchmarks struct {
+		Running []string `json:"running"`
+		Last    *Bench   `json:"last,omitempty"`
+	}
+
+	// Bench represents a benchmark summary.
+	Bench struct {
+		Name     string         `json:"name"`
+		Report   string         `json:"report"`
+		Status   string         `json:"status"`
+		Total    float64        `json:"totalSecs"`
+		RPS      float64        `json:"rps"`
+		OK       int            `json:"ok"`
+		Errors   int            `json:"errors"`
+		Failures map[string]int `json:"failures,omitempty"`
+	}
+)
+
+// Server serves the status endpoint on localhost.
+type Server struct {
+	port  int
+	token string
+	src   Source
+	mux   *http.ServeMux
+	srv   *http.Server
+}
+
+// NewServer returns a new status server.
+func NewServer(port int, token string, src Source) *Server {
+	s := Server{
+		port:  port,
+		token: token,
+		src:   src,
+		mux:   http.NewServeMux(),
+	}
+	s.mux.HandleFunc("/status", s.statusHandler)
+	s.mux.HandleFunc("/forwards", s.forwardsHandler)
+	s.mux.HandleFunc("/benchmarks", s.benchmarksHandler)
+
+	return &s
+}
+
+// Start listens on localhost and serves requests in the background.
+func (s *Server) Start() error {
+	if s.token == "" {
+		return fmt.Errorf("status server requires a token")
+	}
+	l, err := net.Listen("tcp", net.JoinHostPort(localhost, strconv.Itoa(s.port)))
+	if err != nil {
+		return err
+	}
+	s.srv = &http.Server{Handler: s}
+	go func() {
+		if err := s.srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Status server failed")
+		}
+	}()
+	log.Info().Msgf("Status server listening on %s", l.Addr())
+
+	return nil
+}
+
+// Stop shuts the server down.
+func (s *Server) Stop() {
+	if s.srv == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		log.Error().Err(err).Msg("Status server shutdown failed")
+	}
+	s.srv = nil
+}
+
+// ServeHTTP authorizes requests and dispatches them.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get(TokenHeader)), []byte(s.token)) != 1 {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) statusHandler(w http.ResponseWriter, r *http.Request) {
+	bb, err := s.benchmarks()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, Status{Forwards: s.forwards(), Benchmarks: bb})
+}
+
+func (s *Server) forwardsHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.forwards())
+}
+
+func (s *Server) benchmarksHandler(w http.ResponseWriter, r *http.Request) {
+	bb, err := s.benchmarks()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, bb)
+}
+
+func (s *Server) forwards() []Forward {
+	ff := make([]Forward, 0)
+	for _, f := range s.src.Forwards() {
+		fw := Forward{
+			Path:      f.Path(),
+			Container: f.Container(),
+			Ports:     f.Ports(),
+			State:     render.ForwardState(f),
+			Age:       f.Age(),
+			TTL:       f.TTL(),
+		}
+		if f.Err == "" {
+			rx, tx := f.Traffic()
+			fw.RxBytes, fw.TxBytes = &rx, &tx
+		}
+		ff = append(ff, fw)
+	}
+
+	return ff
+}
+
+func (s *Server) benchmarks() (Benchmarks, error) {
+	bb := Benchmarks{Running: s.src.Benchmarks()}
+	if bb.Running == nil {
+		bb.Running = []string{}
+	}
+	file, r, err := s.src.LastBenchmark()
+	if err != nil || r == nil {
+		return bb, err
+	}
+	bb.Last = &Bench{
+		Name:   r.Name,
+		Report: file,
+		Status: r.Status(),
+		Total:  r.Total.Seconds(),
+		RPS:    r.RPS,
+		OK:     r.OK,
+		Errors: r.Errors,
+	}
+	if len(r.Failures) > 0 {
+		bb.Last.Failures = make(map[string]int, len(r.Failures))
+		for f, n := range r.Failures {
+			bb.Last.Failures[f.String()] = n
+		}
+	}
+
+	return bb, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msg("Status encoding failed")
+	}
+}
@@ -0,0 +1,194 @@
+package status_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerAuth(t *testing.T) {
+	uu := map[string]struct {
+		token, header string
+		method        string
+		e             int
+	}{
+		"ok": {
+			token:  "fred",
+			header: "fred",
+			method: http.MethodGet,
+			e:      http.StatusOK,
+		},
+		"missing": {
+			token:  "fred",
+			method: http.MethodGet,
+			e:      http.StatusUnauthorized,
+		},
+		"wrong": {
+			token:  "fred",
+			header: "blee",
+			method: http.MethodGet,
+			e:      http.StatusUnauthorized,
+		},
+		"noToken": {
+			method: http.MethodGet,
+			e:      http.StatusUnauthorized,
+		},
+		"post": {
+			token:  "fred",
+			header: "fred",
+			method: http.MethodPost,
+			e:      http.StatusMethodNotAllowed,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := status.NewServer(0, u.token, &testSource{})
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(u.method, "/status", nil)
+			if u.header != "" {
+				req.Header.Set(status.TokenHeader, u.header)
+			}
+			s.ServeHTTP(rec, req)
+
+			assert.Equal(t, u.e, rec.Code)
+		})
+	}
+}
+
+func TestServerStatus(t *testing.T) {
+	s := status.NewServer(0, "fred", &testSource{
+		ff: []render.ForwardRes{
+			{Forwarder: testForwarder{path: "ns1/p1:c1", active: true, rx: 10, tx: 20}, Managed: true},
+			{Forwarder: testForwarder{path: "ns1/p2:c1"}, Managed: true, Err: "boom"},
+		},
+		running: []string{"ns1/p1:c1"},
+		file:    "ns1_p1:c1_1.txt",
+		last: &perf.Result{
+			Name:     "ns1/p1:c1",
+			Total:    2 * time.Second,
+			RPS:      50,
+			OK:       90,
+			Errors:   10,
+			Failures: perf.Failures{{Class: perf.ErrStatus, Code: 503}: 10},
+		},
+	})
+
+	var st status.Status
+	assert.Equal(t, http.StatusOK, get(t, s, "/status", &st))
+
+	rx, tx := uint64(10), uint64(20)
+	assert.Equal(t, []status.Forward{
+		{Path: "ns1/p1:c1", Container: "c1", Ports: []string{"8080:80"}, State: "Managed", Age: "1m0s", RxBytes: &rx, TxBytes: &tx},
+		{Path: "ns1/p2:c1", Container: "c1", Ports: []string{"8080:80"}, State: "Failed: boom", Age: "1m0s"},
+	}, st.Forwards)
+	assert.Equal(t, status.Benchmarks{
+		Running: []string{"ns1/p1:c1"},
+		Last: &status.Bench{
+			Name:     "ns1/p1:c1",
+			Report:   "ns1_p1:c1_1.txt",
+			Status:   "pass",
+			Total:    2,
+			RPS:      50,
+			OK:       90,
+			Errors:   10,
+			Failures: map[string]int{"non-2xx 503": 10},
+		},
+	}, st.Benchmarks)
+}
+
+func TestServerEmpty(t *testing.T) {
+	s := status.NewServer(0, "fred", &testSource{})
+
+	var ff []status.Forward
+	assert.Equal(t, http.StatusOK, get(t, s, "/forwards", &ff))
+	assert.Equal(t, []status.Forward{}, ff)
+
+	var bb status.Benchmarks
+	assert.Equal(t, http.StatusOK, get(t, s, "/benchmarks", &bb))
+	assert.Equal(t, status.Benchmarks{Running: []string{}}, bb)
+}
+
+func TestServerBenchFailed(t *testing.T) {
+	s := status.NewServer(0, "fred", &testSource{err: errors.New("boom")})
+
+	assert.Equal(t, http.StatusInternalServerError, get(t, s, "/benchmarks", nil))
+}
+
+func TestServerStartStop(t *testing.T) {
+	assert.EqualError(t, status.NewServer(0, "", &testSource{}).Start(), "status server requires a token")
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	s := status.NewServer(port, "fred", &testSource{})
+	assert.Nil(t, s.Start())
+	defer s.Stop()
+
+	req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:"+strconv.Itoa(port)+"/status", nil)
+	assert.Nil(t, err)
+	req.Header.Set(status.TokenHeader, "fred")
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	s.Stop()
+	_, err = http.DefaultClient.Do(req)
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+func get(t *testing.T, s *status.Server, path string, v interface{}) int {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(status.TokenHeader, "fred")
+	s.ServeHTTP(rec, req)
+	if rec.Code == http.StatusOK && v != nil {
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), v))
+	}
+
+	return rec.Code
+}
+
+type testSource struct {
+	ff      []render.ForwardRes
+	running []string
+	file    string
+	last    *perf.Result
+	err     error
+}
+
+func (s *testSource) Forwards() []render.ForwardRes { return s.ff }
+func (s *testSource) Benchmarks() []string          { return s.running }
+func (s *testSource) LastBenchmark() (string, *perf.Result, error) {
+	return s.file, s.last, s.err
+}
+
+type testForwarder struct {
+	path   string
+	active bool
+	rx, tx uint64
+}
+
+func (f testForwarder) Path() string              { return f.path }
+func (f testForwarder) Container() string         { return "c1" }
+func (f testForwarder) Ports() []string           { return []string{"8080:80"} }
+func (f testForwarder) Active() bool              { return f.active }
+func (f testForwarder) Age() string               { return "1m0s" }
+func (f testForwarder) TTL() string               { return "" }
+func (f testForwarder) Traffic() (uint64, uint64) { return f.rx, f.tx }
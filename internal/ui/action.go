@@ -18,6 +18,17 @@ type (
 		Action      ActionHandler
 		Visible     bool
 		Shared      bool
+		Dangerous   bool
+		Destructive bool
+		Permission  Permission
+	}
+
+	// Permission represents the RBAC permission an action requires. A blank
+	// resource denotes the viewed resource.
+	Permission struct {
+		Resource    string
+		SubResource string
+		Verb        string
 	}
 
 	// KeyActions tracks mappings between keystrokes and actions.
@@ -34,6 +45,43 @@ func NewSharedKeyAction(d string, a ActionHandler, display bool) KeyAction {
 	return KeyAction{Description: d, Action: a, Visible: display, Shared: true}
 }
 
+// NewDangerousKeyAction returns a new keyboard action mutating resources.
+// Dangerous actions are vetted by the table guard before they run.
+func NewDangerousKeyAction(d string, a ActionHandler, display bool) KeyAction {
+	return KeyAction{Description: d, Action: a, Visible: display, Dangerous: true}
+}
+
+// NewDestructiveKeyAction returns a new keyboard action tearing down local
+// state ie port-forwards or contexts.
+func NewDestructiveKeyAction(d string, a ActionHandler, display bool) KeyAction {
+	return KeyAction{Description: d, Action: a, Visible: display, Destructive: true}
+}
+
+// Requires sets the permission the action needs to be offered.
+func (a KeyAction) Requires(p Permission) KeyAction {
+	a.Permission = p
+	return a
+}
+
+// Mutates checks if the action changes cluster resources, either flagged
+// dangerous or requiring a mutating verb.
+func (a KeyAction) Mutates() bool {
+	if a.Dangerous {
+		return true
+	}
+	switch a.Permission.Verb {
+	case "create", "update", "patch", "delete":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDestructive checks if the action requires a key sequence in safe keymap.
+func (a KeyAction) IsDestructive() bool {
+	return a.Dangerous || a.Destructive
+}
+
 // Add sets up keyboard action listener.
 func (a KeyActions) Add(aa KeyActions) {
 	for k, v := range aa {
@@ -64,6 +112,16 @@ func (a KeyActions) Delete(kk ...tcell.Key) {
 
 // Hints returns a collection of hints.
 func (a KeyActions) Hints() model.MenuHints {
+	return a.hints(false)
+}
+
+// SequenceHints returns a collection of hints where destructive actions show
+// their key sequence.
+func (a KeyActions) SequenceHints() model.MenuHints {
+	return a.hints(true)
+}
+
+func (a KeyActions) hints(seq bool) model.MenuHints {
 	kk := make([]int, 0, len(a))
 	for k := range a {
 		if !a[k].Shared {
@@ -75,6 +133,9 @@ func (a KeyActions) Hints() model.MenuHints {
 	hh := make(model.MenuHints, 0, len(kk))
 	for _, k := range kk {
 		if name, ok := tcell.KeyNames[tcell.Key(k)]; ok {
+			if seq && a[tcell.Key(k)].IsDestructive() {
+				name = sequenceMnemonic(tcell.Key(k))
+			}
 			hh = append(hh,
 				model.MenuHint{
 					Mnemonic:    name,
@@ -83,7 +144,7 @@ func (a KeyActions) Hints() model.MenuHints {
 				},
 			)
 		} else {
-			log.Error().Msgf("Unable to locate KeyName for %#v", string(k))
+			log.Error().Msgf("Unable to locate KeyName for %#v", string(rune(k)))
 		}
 	}
 	return hh
@@ -20,3 +20,40 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestKeyActionRequires(t *testing.T) {
+	a := ui.NewDangerousKeyAction("Scale", nil, true)
+	p := ui.Permission{SubResource: "scale", Verb: "update"}
+
+	assert.Equal(t, p, a.Requires(p).Permission)
+	assert.Equal(t, ui.Permission{}, a.Permission)
+}
+
+func TestKeyActionMutates(t *testing.T) {
+	uu := map[string]struct {
+		a ui.KeyAction
+		e bool
+	}{
+		"plain": {
+			a: ui.NewKeyAction("Fred", nil, true),
+		},
+		"dangerous": {
+			a: ui.NewDangerousKeyAction("Fred", nil, true),
+			e: true,
+		},
+		"read": {
+			a: ui.NewKeyAction("Fred", nil, true).Requires(ui.Permission{Verb: "get"}),
+		},
+		"patch": {
+			a: ui.NewKeyAction("Fred", nil, true).Requires(ui.Permission{Verb: "patch"}),
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.a.Mutates())
+		})
+	}
+}
@@ -13,10 +13,12 @@ type App struct {
 	*tview.Application
 	Configurator
 
-	Main    *Pages
-	actions KeyActions
-	views   map[string]tview.Primitive
-	cmdBuff *CmdBuff
+	Main      *Pages
+	actions   KeyActions
+	views     map[string]tview.Primitive
+	cmdBuff   *CmdBuff
+	clipboard Clipboard
+	mouse     *MouseScreen
 }
 
 // NewApp returns a new app.
@@ -36,6 +38,8 @@ func NewApp(cluster string) *App {
 		"flash":  NewFlash(&a, "Initializing..."),
 		"crumbs": NewCrumbs(a.Styles),
 	}
+	a.views["errors"] = NewErrBadge(a.Flash(), a.Styles)
+	a.views["banner"] = NewBanner(a.Styles)
 
 	return &a
 }
@@ -51,6 +55,52 @@ func (a *App) Init() {
 	a.SetRoot(a.Main, true)
 }
 
+// InitMouse sets up the application screen to dispatch mouse events.
+// This must be called prior to running the application.
+func (a *App) InitMouse(h MouseHandler, on bool) error {
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	a.mouse = NewMouseScreen(screen, h, on)
+	if err := a.mouse.Init(); err != nil {
+		return err
+	}
+	a.SetScreen(a.mouse)
+
+	return nil
+}
+
+// Suspend exits terminal UI mode while f runs. The replacement screen keeps
+// dispatching mouse events.
+func (a *App) Suspend(f func()) bool {
+	if a.mouse == nil {
+		return a.Application.Suspend(f)
+	}
+	old := a.mouse
+	old.Fini()
+	f()
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		panic(err)
+	}
+	a.mouse = NewMouseScreen(screen, old.handler, old.IsMouseEnabled())
+	a.SetScreen(a.mouse)
+
+	return true
+}
+
+// ToggleMouse turns mouse support on or off and returns the new state.
+func (a *App) ToggleMouse() bool {
+	if a.mouse == nil {
+		return false
+	}
+	on := !a.mouse.IsMouseEnabled()
+	a.mouse.SetMouse(on)
+
+	return on
+}
+
 // BufferChanged indicates the buffer was changed.
 func (a *App) BufferChanged(s string) {}
 
@@ -89,6 +139,20 @@ func (a *App) ReloadStyles(cluster string) {
 	a.RefreshStyles(cluster)
 }
 
+// Clipboard returns the clipboard backend selected by the configuration.
+func (a *App) Clipboard() Clipboard {
+	if a.clipboard == nil {
+		mode := ClipboardAuto
+		if a.Config != nil && a.Config.K9s != nil {
+			mode = a.Config.K9s.Clipboard
+		}
+		a.clipboard = NewClipboard(mode)
+		log.Debug().Msgf("Using %s clipboard", a.clipboard.Name())
+	}
+
+	return a.clipboard
+}
+
 // Conn returns an api server connection.
 func (a *App) Conn() client.Connection {
 	return a.Config.GetConnection()
@@ -244,6 +308,16 @@ func (a *App) Flash() *Flash {
 	return a.views["flash"].(*Flash)
 }
 
+// Banner returns the app header banner.
+func (a *App) Banner() *Banner {
+	return a.views["banner"].(*Banner)
+}
+
+// ErrBadge returns the app recent errors badge.
+func (a *App) ErrBadge() *ErrBadge {
+	return a.views["errors"].(*ErrBadge)
+}
+
 // Cmd returns app cmd.
 func (a *App) Cmd() *Command {
 	return a.views["cmd"].(*Command)
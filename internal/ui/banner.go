@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// Banner displays a header alert until cleared.
+type Banner struct {
+	*tview.TextView
+
+	width int
+}
+
+// NewBanner returns a new header banner.
+func NewBanner(styles *config.Styles) *Banner {
+	b := Banner{TextView: tview.NewTextView()}
+	b.SetDynamicColors(true)
+	b.SetTextAlign(tview.AlignCenter)
+	b.SetBackgroundColor(styles.BgColor())
+	styles.AddListener(&b)
+
+	return &b
+}
+
+// StylesChanged notifies the skin changed.
+func (b *Banner) StylesChanged(s *config.Styles) {
+	b.SetBackgroundColor(s.BgColor())
+}
+
+// Show displays an alert followed by plain hint lines. An empty alert clears
+// the banner.
+func (b *Banner) Show(alert string, hints ...string) {
+	if alert == "" {
+		b.width = 0
+		b.SetText("")
+		return
+	}
+
+	ll := append([]string{alert}, hints...)
+	b.width = 0
+	for i, l := range ll {
+		if w := runewidth.StringWidth(l); w > b.width {
+			b.width = w
+		}
+		ll[i] = tview.Escape(l)
+	}
+	b.width += 2
+	ll[0] = "[orangered::b]" + ll[0] + "[-::-]"
+	b.SetText(strings.Join(ll, "\n"))
+}
+
+// Width returns the banner width or zero when cleared.
+func (b *Banner) Width() int {
+	return b.width
+}
@@ -0,0 +1,216 @@
+package ui
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ClipboardAuto picks a backend based on the current session.
+	ClipboardAuto = "auto"
+
+	// ClipboardNative copies via the platform clipboard tool.
+	ClipboardNative = "native"
+
+	// ClipboardOSC52 copies via terminal escape sequences.
+	ClipboardOSC52 = "osc52"
+
+	// ClipboardNone disables copy.
+	ClipboardNone = "none"
+
+	// OSC52Limit represents the max encoded payload most terminals accept.
+	OSC52Limit = 74994
+
+	osc52Chunk  = 4096
+	screenChunk = 76
+)
+
+// Clipboard represents a clipboard backend.
+type Clipboard interface {
+	// Copy writes text to the clipboard.
+	Copy(text string) error
+
+	// Name returns the backend name.
+	Name() string
+}
+
+// ClipboardEnv represents the session environment used to pick a backend.
+type ClipboardEnv struct {
+	GOOS     string
+	Getenv   func(string) string
+	LookPath func(string) (string, error)
+}
+
+// NewClipboard returns a clipboard backend for the given mode.
+func NewClipboard(mode string) Clipboard {
+	return SelectClipboard(mode, ClipboardEnv{
+		GOOS:     runtime.GOOS,
+		Getenv:   os.Getenv,
+		LookPath: exec.LookPath,
+	})
+}
+
+// SelectClipboard picks a clipboard backend given a mode and an environment.
+func SelectClipboard(mode string, env ClipboardEnv) Clipboard {
+	switch mode {
+	case ClipboardNone:
+		return noClipboard{}
+	case ClipboardOSC52:
+		return newOSC52Clipboard(env)
+	case ClipboardNative:
+		if cmd, ok := nativeCommand(env); ok {
+			return nativeClipboard{cmd: cmd}
+		}
+		log.Warn().Msg("No native clipboard tool found. Copy disabled")
+		return noClipboard{}
+	case ClipboardAuto, "":
+	default:
+		log.Warn().Msgf("Unknown clipboard mode %q. Using auto", mode)
+	}
+
+	if env.Getenv("SSH_TTY") != "" {
+		return newOSC52Clipboard(env)
+	}
+	if cmd, ok := nativeCommand(env); ok {
+		return nativeClipboard{cmd: cmd}
+	}
+
+	return noClipboard{}
+}
+
+func nativeCommand(env ClipboardEnv) ([]string, bool) {
+	var cc [][]string
+	switch env.GOOS {
+	case "darwin":
+		cc = append(cc, []string{"pbcopy"})
+	case "windows":
+		cc = append(cc, []string{"clip"})
+	default:
+		if env.Getenv("WAYLAND_DISPLAY") != "" {
+			cc = append(cc, []string{"wl-copy"})
+		}
+		cc = append(cc,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"},
+		)
+	}
+	for _, c := range cc {
+		if _, err := env.LookPath(c[0]); err == nil {
+			return c, true
+		}
+	}
+
+	return nil, false
+}
+
+// ----------------------------------------------------------------------------
+
+type noClipboard struct{}
+
+func (noClipboard) Name() string { return ClipboardNone }
+
+func (noClipboard) Copy(string) error {
+	return errors.New("Clipboard is disabled")
+}
+
+// ----------------------------------------------------------------------------
+
+type nativeClipboard struct {
+	cmd []string
+}
+
+func (n nativeClipboard) Name() string { return ClipboardNative }
+
+func (n nativeClipboard) Copy(text string) error {
+	cmd := exec.Command(n.cmd[0], n.cmd[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %v %s", n.cmd[0], err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+type osc52Clipboard struct {
+	term, tmux string
+	openFn     func() (io.WriteCloser, error)
+}
+
+func newOSC52Clipboard(env ClipboardEnv) osc52Clipboard {
+	return osc52Clipboard{
+		term:   env.Getenv("TERM"),
+		tmux:   env.Getenv("TMUX"),
+		openFn: openTTY,
+	}
+}
+
+func openTTY() (io.WriteCloser, error) {
+	return os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+}
+
+func (o osc52Clipboard) Name() string { return ClipboardOSC52 }
+
+func (o osc52Clipboard) Copy(text string) error {
+	seq, err := OSC52Sequence(text, o.tmux != "", strings.HasPrefix(o.term, "screen"))
+	if err != nil {
+		return err
+	}
+	w, err := o.openFn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing tty")
+		}
+	}()
+	for _, c := range chunk(seq, osc52Chunk) {
+		if _, err := io.WriteString(w, c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OSC52Sequence returns the escape sequence copying text to the terminal clipboard.
+func OSC52Sequence(text string, tmux, screen bool) (string, error) {
+	b64 := base64.StdEncoding.EncodeToString([]byte(text))
+	if len(b64) > OSC52Limit {
+		return "", fmt.Errorf("Selection too large for OSC52 (%d > %d bytes)", len(b64), OSC52Limit)
+	}
+
+	seq := "\x1b]52;c;" + b64 + "\x07"
+	switch {
+	case tmux:
+		return "\x1bPtmux;" + strings.Replace(seq, "\x1b", "\x1b\x1b", -1) + "\x1b\\", nil
+	case screen:
+		var b strings.Builder
+		for _, c := range chunk(seq, screenChunk) {
+			b.WriteString("\x1bP" + c + "\x1b\\")
+		}
+		return b.String(), nil
+	default:
+		return seq, nil
+	}
+}
+
+func chunk(s string, size int) []string {
+	cc := make([]string, 0, len(s)/size+1)
+	for len(s) > size {
+		cc = append(cc, s[:size])
+		s = s[size:]
+	}
+
+	return append(cc, s)
+}
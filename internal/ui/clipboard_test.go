@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOSC52Sequence(t *testing.T) {
+	b64 := base64.StdEncoding.EncodeToString([]byte("fred"))
+	uu := map[string]struct {
+		tmux, screen bool
+		e            string
+	}{
+		"plain":  {e: "\x1b]52;c;" + b64 + "\x07"},
+		"tmux":   {tmux: true, e: "\x1bPtmux;\x1b\x1b]52;c;" + b64 + "\x07\x1b\\"},
+		"screen": {screen: true, e: "\x1bP\x1b]52;c;" + b64 + "\x07\x1b\\"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			seq, err := OSC52Sequence("fred", u.tmux, u.screen)
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, seq)
+		})
+	}
+}
+
+func TestOSC52SequenceScreenChunks(t *testing.T) {
+	seq, err := OSC52Sequence(strings.Repeat("a", 100), false, true)
+
+	assert.Nil(t, err)
+	cc := strings.Split(strings.TrimSuffix(seq, "\x1b\\"), "\x1b\\")
+	assert.Equal(t, 2, len(cc))
+	for _, c := range cc {
+		assert.True(t, strings.HasPrefix(c, "\x1bP"))
+		assert.True(t, len(c) <= screenChunk+2)
+	}
+}
+
+func TestOSC52SequenceTooLarge(t *testing.T) {
+	_, err := OSC52Sequence(strings.Repeat("a", OSC52Limit), false, false)
+
+	assert.Error(t, err)
+}
+
+func TestOSC52Copy(t *testing.T) {
+	var buff closeBuffer
+	o := osc52Clipboard{openFn: func() (io.WriteCloser, error) { return &buff, nil }}
+
+	assert.Nil(t, o.Copy("fred"))
+	assert.Equal(t, "\x1b]52;c;ZnJlZA==\x07", buff.String())
+	assert.True(t, buff.closed)
+}
+
+func TestSelectClipboard(t *testing.T) {
+	uu := map[string]struct {
+		mode, goos string
+		env        map[string]string
+		bins       []string
+		e          string
+		cmd        []string
+	}{
+		"none": {
+			mode: ClipboardNone, bins: []string{"xclip"},
+			e: ClipboardNone,
+		},
+		"osc52": {
+			mode: ClipboardOSC52, bins: []string{"xclip"},
+			e: ClipboardOSC52,
+		},
+		"nativeMissing": {
+			mode: ClipboardNative,
+			e:    ClipboardNone,
+		},
+		"nativeXclip": {
+			mode: ClipboardNative, goos: "linux", bins: []string{"xsel", "xclip"},
+			e: ClipboardNative, cmd: []string{"xclip", "-selection", "clipboard"},
+		},
+		"nativeWayland": {
+			mode: ClipboardNative, goos: "linux", bins: []string{"xclip", "wl-copy"},
+			env: map[string]string{"WAYLAND_DISPLAY": "wayland-0"},
+			e:   ClipboardNative, cmd: []string{"wl-copy"},
+		},
+		"nativeMac": {
+			mode: ClipboardNative, goos: "darwin", bins: []string{"pbcopy"},
+			e: ClipboardNative, cmd: []string{"pbcopy"},
+		},
+		"autoSSH": {
+			mode: ClipboardAuto, goos: "linux", bins: []string{"xclip"},
+			env: map[string]string{"SSH_TTY": "/dev/pts/0"},
+			e:   ClipboardOSC52,
+		},
+		"autoLocal": {
+			goos: "linux", bins: []string{"xclip"},
+			e: ClipboardNative, cmd: []string{"xclip", "-selection", "clipboard"},
+		},
+		"autoNothing": {
+			goos: "linux",
+			e:    ClipboardNone,
+		},
+		"unknown": {
+			mode: "blee", goos: "darwin", bins: []string{"pbcopy"},
+			e: ClipboardNative, cmd: []string{"pbcopy"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := SelectClipboard(u.mode, makeClipboardEnv(u.goos, u.env, u.bins))
+
+			assert.Equal(t, u.e, c.Name())
+			if n, ok := c.(nativeClipboard); ok {
+				assert.Equal(t, u.cmd, n.cmd)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type closeBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeBuffer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func makeClipboardEnv(goos string, env map[string]string, bins []string) ClipboardEnv {
+	return ClipboardEnv{
+		GOOS:   goos,
+		Getenv: func(k string) string { return env[k] },
+		LookPath: func(bin string) (string, error) {
+			for _, b := range bins {
+				if b == bin {
+					return "/usr/bin/" + b, nil
+				}
+			}
+			return "", errors.New("not found")
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
@@ -91,13 +92,19 @@ func (c *Command) BufferActive(f bool, k BufferKind) {
 func colorFor(k BufferKind) tcell.Color {
 	switch k {
 	case CommandBuff:
-		return tcell.ColorAqua
+		return render.Accent(tcell.ColorAqua)
 	default:
-		return tcell.ColorSeaGreen
+		return render.Accent(tcell.ColorSeaGreen)
 	}
 }
 
 func iconFor(k BufferKind) rune {
+	if render.IsMonochrome() {
+		if k == CommandBuff {
+			return ':'
+		}
+		return '/'
+	}
 	switch k {
 	case CommandBuff:
 		return '🐶'
@@ -67,6 +67,41 @@ func (c *Configurator) StylesUpdater(ctx context.Context, s synchronizer) error
 	return w.Add(c.skinFile)
 }
 
+// BenchUpdater watches for benchmark configuration changes on a given cluster.
+func (c *Configurator) BenchUpdater(ctx context.Context, s synchronizer, cluster string) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	path := BenchConfig(cluster)
+	go func() {
+		for {
+			select {
+			case evt := <-w.Events:
+				if filepath.Clean(evt.Name) != path {
+					continue
+				}
+				s.QueueUpdate(func() {
+					c.InitBench(cluster)
+				})
+			case err := <-w.Errors:
+				log.Info().Err(err).Msg("Bench watcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msgf("BenchWatcher Done `%s!!", path)
+				if err := w.Close(); err != nil {
+					log.Error().Err(err).Msg("Closing watcher")
+				}
+				return
+			}
+		}
+	}()
+
+	log.Debug().Msgf("BenchWatcher watching `%s", path)
+	return w.Add(filepath.Dir(path))
+}
+
 // InitBench load benchmark configuration if any.
 func (c *Configurator) InitBench(cluster string) {
 	var err error
@@ -86,6 +121,11 @@ func (c *Configurator) RefreshStyles(cluster string) {
 	if c.Styles == nil {
 		c.Styles = config.NewStyles()
 	}
+	if render.IsMonochrome() {
+		c.Styles.Monochrome()
+		c.updateStyles("")
+		return
+	}
 	if err := c.Styles.Load(clusterSkins); err != nil {
 		log.Info().Msgf("No cluster specific skin file found -- %s", clusterSkins)
 	} else {
@@ -106,10 +146,16 @@ func (c *Configurator) updateStyles(f string) {
 	c.skinFile = f
 	c.Styles.Update()
 
-	render.StdColor = config.AsColor(c.Styles.Frame().Status.NewColor)
-	render.AddColor = config.AsColor(c.Styles.Frame().Status.AddColor)
-	render.ModColor = config.AsColor(c.Styles.Frame().Status.ModifyColor)
-	render.ErrColor = config.AsColor(c.Styles.Frame().Status.ErrorColor)
-	render.HighlightColor = config.AsColor(c.Styles.Frame().Status.HighlightColor)
-	render.CompletedColor = config.AsColor(c.Styles.Frame().Status.CompletedColor)
+	st := c.Styles.Frame().Status
+	if render.IsMonochrome() {
+		// Keeps row colors distinct so the palette can tell severities apart.
+		st = config.NewStyles().Frame().Status
+	}
+	render.StdColor = config.AsColor(st.NewColor)
+	render.AddColor = config.AsColor(st.AddColor)
+	render.ModColor = config.AsColor(st.ModifyColor)
+	render.ErrColor = config.AsColor(st.ErrorColor)
+	render.HighlightColor = config.AsColor(st.HighlightColor)
+	render.CompletedColor = config.AsColor(st.CompletedColor)
+	render.EvictedColor = config.AsColor(st.EvictedColor)
 }
@@ -1,12 +1,19 @@
 package ui_test
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
@@ -38,3 +45,73 @@ func TestInitBench(t *testing.T) {
 	assert.Equal(t, 1000, cfg.Bench.Benchmarks.Defaults.N)
 	assert.Equal(t, 2, len(cfg.Bench.Benchmarks.Services))
 }
+
+func TestBenchUpdater(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	config.K9sHome = dir
+	writeBench(t, ui.BenchConfig("fred"), 10)
+
+	cfg := ui.Configurator{}
+	cfg.InitBench("fred")
+	ctx, cancel := context.WithCancel(context.Background())
+	s := newSync()
+	assert.Nil(t, cfg.BenchUpdater(ctx, s, "fred"))
+
+	writeBench(t, ui.BenchConfig("blee"), 20)
+	writeBench(t, ui.BenchConfig("fred"), 30)
+	assert.Equal(t, 30, s.waitForN(&cfg, 30))
+
+	cancel()
+	<-time.After(100 * time.Millisecond)
+	writeBench(t, ui.BenchConfig("fred"), 40)
+	<-time.After(100 * time.Millisecond)
+	assert.Equal(t, 30, s.n(&cfg))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type testSync struct {
+	mx sync.Mutex
+}
+
+func newSync() *testSync {
+	return &testSync{}
+}
+
+func (s *testSync) QueueUpdateDraw(f func()) *tview.Application {
+	return s.QueueUpdate(f)
+}
+
+func (s *testSync) QueueUpdate(f func()) *tview.Application {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	f()
+
+	return nil
+}
+
+func (s *testSync) n(cfg *ui.Configurator) int {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return cfg.Bench.Benchmarks.Defaults.N
+}
+
+func (s *testSync) waitForN(cfg *ui.Configurator, n int) int {
+	for i := 0; i < 50; i++ {
+		if v := s.n(cfg); v == n {
+			return v
+		}
+		<-time.After(20 * time.Millisecond)
+	}
+
+	return s.n(cfg)
+}
+
+func writeBench(t *testing.T, path string, n int) {
+	raw := fmt.Sprintf("benchmarks:\n  defaults:\n    concurrency: 1\n    requests: %d\n", n)
+	assert.Nil(t, ioutil.WriteFile(path, []byte(raw), 0600))
+}
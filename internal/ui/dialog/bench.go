@@ -0,0 +1,91 @@
+package dialog
+
+import (
+	"strconv"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const benchKey = "bench"
+
+type benchFunc func(cfg config.BenchConfig) error
+
+// ShowBench pops a benchmark configuration dialog. The edits only apply to
+// this run unless saved via saveFn when given. The dialog stays up if the
+// benchmark can't be started.
+func ShowBench(p *ui.Pages, url string, cfg config.BenchConfig, okFn, saveFn benchFunc) {
+	modal := ui.NewModalForm("<Benchmark>", benchForm(p, cfg, okFn, saveFn))
+	modal.SetText(url)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissBench(p)
+	})
+	p.AddPage(benchKey, modal, false, false)
+	p.ShowPage(benchKey)
+}
+
+// DismissBench dismiss the benchmark dialog.
+func DismissBench(p *ui.Pages) {
+	p.RemovePage(benchKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// benchForm builds the benchmark form. Focus starts on OK so Enter runs the
+// benchmark as configured.
+func benchForm(p *ui.Pages, cfg config.BenchConfig, okFn, saveFn benchFunc) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
+
+	c, n := strconv.Itoa(cfg.C), strconv.Itoa(cfg.N)
+	f.AddInputField("Concurrency:", c, 20, integerOnly, func(v string) {
+		c = v
+	})
+	f.AddInputField("Requests:", n, 20, integerOnly, func(v string) {
+		n = v
+	})
+	f.AddInputField("Method:", cfg.HTTP.Method, 20, nil, func(v string) {
+		cfg.HTTP.Method = v
+	})
+	f.AddInputField("Path:", cfg.HTTP.Path, 40, nil, func(v string) {
+		cfg.HTTP.Path = v
+	})
+
+	submit := func(fn benchFunc) func() {
+		return func() {
+			cfg.C, _ = strconv.Atoi(c)
+			cfg.N, _ = strconv.Atoi(n)
+			if err := fn(cfg); err == nil {
+				DismissBench(p)
+			}
+		}
+	}
+	f.AddButton("OK", submit(okFn))
+	if saveFn != nil {
+		f.AddButton("Save & Run", submit(saveFn))
+	}
+	f.AddButton("Cancel", func() {
+		DismissBench(p)
+	})
+	f.SetFocus(f.GetFormItemCount())
+
+	return f
+}
+
+func integerOnly(text string, _ rune) bool {
+	if text == "" {
+		return true
+	}
+	_, err := strconv.Atoi(text)
+
+	return err == nil
+}
@@ -0,0 +1,76 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	okFunc := func(config.BenchConfig) error {
+		return nil
+	}
+	ShowBench(p, "http://localhost:8080", config.BenchConfig{C: 1, N: 200}, okFunc, nil)
+
+	d := p.GetPrimitive(benchKey).(*ui.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissBench(p)
+	assert.Nil(t, p.GetPrimitive(benchKey))
+}
+
+func TestBenchFormSave(t *testing.T) {
+	p := ui.NewPages()
+
+	var ran, saved config.BenchConfig
+	okFunc := func(cfg config.BenchConfig) error {
+		ran = cfg
+		return nil
+	}
+	saveFunc := func(cfg config.BenchConfig) error {
+		saved = cfg
+		return nil
+	}
+	cfg := config.BenchConfig{C: 2, N: 100, HTTP: config.HTTP{Method: "GET", Path: "/"}}
+	f := benchForm(p, cfg, okFunc, saveFunc)
+
+	assert.Equal(t, 3, f.GetButtonCount())
+	assert.Equal(t, 1, f.GetButtonIndex("Save & Run"))
+
+	f.GetFormItemByLabel("Concurrency:").(*tview.InputField).SetText("5")
+	f.GetButton(1).InputHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), nil)
+	assert.Equal(t, config.BenchConfig{}, ran)
+	assert.Equal(t, 5, saved.C)
+	assert.Equal(t, 100, saved.N)
+}
+
+func TestBenchFormNoSave(t *testing.T) {
+	f := benchForm(ui.NewPages(), config.BenchConfig{C: 1, N: 200}, func(config.BenchConfig) error { return nil }, nil)
+
+	assert.Equal(t, 2, f.GetButtonCount())
+	assert.Equal(t, -1, f.GetButtonIndex("Save & Run"))
+}
+
+func TestIntegerOnly(t *testing.T) {
+	uu := map[string]struct {
+		text string
+		e    bool
+	}{
+		"blank":  {"", true},
+		"number": {"20", true},
+		"alpha":  {"2a", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, integerOnly(u.text, 0))
+		})
+	}
+}
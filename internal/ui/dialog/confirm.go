@@ -1,6 +1,7 @@
 package dialog
 
 import (
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -19,8 +20,8 @@ func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel can
 	f.SetButtonsAlign(tview.AlignCenter).
 		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
 	f.AddButton("Cancel", func() {
 		dismissConfirm(pages)
 		cancel()
@@ -31,7 +32,7 @@ func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel can
 		cancel()
 	})
 
-	modal := tview.NewModalForm(" <"+title+"> ", f)
+	modal := ui.NewModalForm(" <"+title+"> ", f)
 	modal.SetText(msg)
 	modal.SetDoneFunc(func(int, string) {
 		dismissConfirm(pages)
@@ -21,7 +21,7 @@ func TestConfirmDialog(t *testing.T) {
 	}
 	ShowConfirm(p, "Blee", "Yo", ackFunc, caFunc)
 
-	d := p.GetPrimitive(confirmKey).(*tview.ModalForm)
+	d := p.GetPrimitive(confirmKey).(*ui.ModalForm)
 	assert.NotNil(t, d)
 
 	dismissConfirm(p)
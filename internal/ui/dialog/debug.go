@@ -0,0 +1,86 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	debugKey = "debug"
+
+	// DefaultDebugCommand keeps a debug copy running.
+	DefaultDebugCommand = "sleep infinity"
+)
+
+type debugFunc func(co, image string, cmd []string, noProbes bool)
+
+// ShowDebug pops a pod debug copy dialog. The image of the selected container
+// can be overridden along with its command. A blank command keeps the original
+// entrypoint.
+func ShowDebug(p *ui.Pages, path string, cc []string, images map[string]string, okFn debugFunc) {
+	modal := ui.NewModalForm("<Debug Copy>", debugForm(p, cc, images, okFn))
+	modal.SetText(path)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissDebug(p)
+	})
+	p.AddPage(debugKey, modal, false, false)
+	p.ShowPage(debugKey)
+}
+
+// DismissDebug dismiss the debug copy dialog.
+func DismissDebug(p *ui.Pages) {
+	p.RemovePage(debugKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// debugForm builds the debug copy form. Picking another container resets the
+// image to the container's.
+func debugForm(p *ui.Pages, cc []string, images map[string]string, okFn debugFunc) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
+
+	var co string
+	if len(cc) > 0 {
+		co = cc[0]
+	}
+	image, cmd, noProbes := images[co], DefaultDebugCommand, true
+	f.AddDropDown("Container:", cc, 0, func(option string, _ int) {
+		if option == co {
+			return
+		}
+		co, image = option, images[option]
+		if i, ok := f.GetFormItemByLabel("Image:").(*tview.InputField); ok {
+			i.SetText(image)
+		}
+	})
+	f.AddInputField("Image:", image, 40, nil, func(v string) {
+		image = v
+	})
+	f.AddInputField("Command:", cmd, 40, nil, func(v string) {
+		cmd = v
+	})
+	f.AddCheckbox("Disable Probes:", noProbes, func(v bool) {
+		noProbes = v
+	})
+
+	f.AddButton("OK", func() {
+		DismissDebug(p)
+		okFn(co, strings.TrimSpace(image), strings.Fields(cmd), noProbes)
+	})
+	f.AddButton("Cancel", func() {
+		DismissDebug(p)
+	})
+
+	return f
+}
@@ -0,0 +1,45 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowDebug(p, "ns1/p1", []string{"c1"}, map[string]string{"c1": "fred:1.0"}, nil)
+	d := p.GetPrimitive(debugKey).(*ui.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissDebug(p)
+	assert.Nil(t, p.GetPrimitive(debugKey))
+}
+
+func TestDebugForm(t *testing.T) {
+	p := ui.NewPages()
+
+	var (
+		co, image string
+		cmd       []string
+		noProbes  bool
+	)
+	okFunc := func(c, i string, cc []string, np bool) {
+		co, image, cmd, noProbes = c, i, cc, np
+	}
+	f := debugForm(p, []string{"c1", "c2"}, map[string]string{"c1": "fred:1.0", "c2": "blee:2.0"}, okFunc)
+
+	assert.Equal(t, "fred:1.0", f.GetFormItemByLabel("Image:").(*tview.InputField).GetText())
+	f.GetFormItemByLabel("Container:").(*tview.DropDown).SetCurrentOption(1)
+	assert.Equal(t, "blee:2.0", f.GetFormItemByLabel("Image:").(*tview.InputField).GetText())
+	f.GetButton(0).InputHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), nil)
+
+	assert.Equal(t, "c2", co)
+	assert.Equal(t, "blee:2.0", image)
+	assert.Equal(t, []string{"sleep", "infinity"}, cmd)
+	assert.True(t, noProbes)
+}
@@ -1,6 +1,9 @@
 package dialog
 
 import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -9,37 +12,44 @@ import (
 const deleteKey = "delete"
 
 type (
-	okFunc     func(cascade, force bool)
+	okFunc     func(cascade, force, cleanup bool)
 	cancelFunc func()
 )
 
-// ShowDelete pops a resource deletion dialog.
-func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
-	cascade, force := true, false
+// ShowDelete pops a resource deletion dialog. Dependents such as port-forwards
+// are listed and cleaned up along with the resource unless unchecked.
+func ShowDelete(pages *ui.Pages, msg string, deps []string, ok okFunc, cancel cancelFunc) {
+	cascade, force, cleanup := true, false, len(deps) > 0
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
 		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
 	f.AddCheckbox("Cascade:", cascade, func(checked bool) {
 		cascade = checked
 	})
 	f.AddCheckbox("Force:", force, func(checked bool) {
 		force = checked
 	})
+	if len(deps) > 0 {
+		f.AddCheckbox("Stop Dependents:", cleanup, func(checked bool) {
+			cleanup = checked
+		})
+		msg += "\n\nActive dependents:\n  " + strings.Join(deps, "\n  ")
+	}
 	f.AddButton("Cancel", func() {
 		dismissDelete(pages)
 		cancel()
 	})
 	f.AddButton("OK", func() {
-		ok(cascade, force)
+		ok(cascade, force, cleanup)
 		dismissDelete(pages)
 		cancel()
 	})
 
-	confirm := tview.NewModalForm("<Delete>", f)
+	confirm := ui.NewModalForm("<Delete>", f)
 	confirm.SetText(msg)
 	confirm.SetDoneFunc(func(int, string) {
 		dismissDelete(pages)
@@ -4,23 +4,34 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestDeleteDialog(t *testing.T) {
 	p := ui.NewPages()
 
-	okFunc := func(c, f bool) {
+	okFunc := func(c, f, cl bool) {
 		assert.True(t, c)
 		assert.True(t, f)
 	}
 	caFunc := func() {
 		assert.True(t, true)
 	}
-	ShowDelete(p, "Yo", okFunc, caFunc)
+	ShowDelete(p, "Yo", nil, okFunc, caFunc)
 
-	d := p.GetPrimitive(deleteKey).(*tview.ModalForm)
+	d := p.GetPrimitive(deleteKey).(*ui.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissDelete(p)
+	assert.Nil(t, p.GetPrimitive(deleteKey))
+}
+
+func TestDeleteDialogDependents(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowDelete(p, "Yo", []string{"port-forward default/p1:c1"}, func(bool, bool, bool) {}, func() {})
+
+	d := p.GetPrimitive(deleteKey).(*ui.ModalForm)
 	assert.NotNil(t, d)
 
 	dismissDelete(p)
@@ -0,0 +1,183 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	paletteKey       = "palette"
+	paletteMaxWidth  = 80
+	paletteMaxHeight = 20
+)
+
+// PaletteEntry represents an action listed in the command palette.
+type PaletteEntry struct {
+	Key, Description, Scope string
+	Run                     func()
+}
+
+func (e PaletteEntry) label() string {
+	return e.Description + " " + e.Key + " " + e.Scope
+}
+
+// ShowPalette pops a fuzzy searchable list of actions. Enter dismisses the
+// palette and runs the selected entry, Escape dismisses it. Done is called
+// on dismiss before the entry runs.
+func ShowPalette(pages *ui.Pages, ee []PaletteEntry, done func()) {
+	v := newPaletteView(ee)
+	v.input.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyEscape:
+			dismissPalette(pages)
+			done()
+			return nil
+		case tcell.KeyEnter:
+			e, ok := v.selected()
+			if !ok {
+				return nil
+			}
+			dismissPalette(pages)
+			done()
+			e.Run()
+			return nil
+		case tcell.KeyUp, tcell.KeyCtrlP:
+			v.move(-1)
+			return nil
+		case tcell.KeyDown, tcell.KeyCtrlN:
+			v.move(1)
+			return nil
+		}
+		return evt
+	})
+	pages.AddPage(paletteKey, v, false, false)
+	pages.ShowPage(paletteKey)
+}
+
+func dismissPalette(pages *ui.Pages) {
+	pages.RemovePage(paletteKey)
+}
+
+// paletteView displays a filter input atop the matching entries.
+type paletteView struct {
+	*tview.Flex
+
+	input   *tview.InputField
+	list    *tview.Table
+	entries []PaletteEntry
+	matches []PaletteEntry
+}
+
+func newPaletteView(ee []PaletteEntry) *paletteView {
+	v := paletteView{
+		Flex:    tview.NewFlex(),
+		input:   tview.NewInputField(),
+		list:    tview.NewTable(),
+		entries: ee,
+	}
+	v.SetDirection(tview.FlexRow)
+	v.SetBackgroundColor(tview.Styles.ContrastBackgroundColor)
+	v.SetBorder(true).SetBorderPadding(0, 0, 1, 1)
+	v.SetTitle(" <Command Palette> ").SetTitleColor(render.Accent(tcell.ColorAqua))
+
+	v.input.SetLabel("> ")
+	v.input.SetLabelColor(render.Accent(tcell.ColorAqua))
+	v.input.SetFieldBackgroundColor(tview.Styles.ContrastBackgroundColor)
+	v.input.SetFieldTextColor(render.Accent(tcell.ColorOrange))
+	v.input.SetChangedFunc(v.filter)
+
+	v.list.SetBackgroundColor(tview.Styles.ContrastBackgroundColor)
+	v.list.SetSelectable(true, false)
+	v.list.SetSelectedStyle(tcell.ColorBlack, render.Accent(tcell.ColorAqua), tcell.AttrBold)
+
+	v.AddItem(v.input, 1, 0, true)
+	v.AddItem(v.list, 0, 1, false)
+	v.filter("")
+
+	return &v
+}
+
+// Draw draws the palette in the middle of the screen.
+func (v *paletteView) Draw(screen tcell.Screen) {
+	sw, sh := screen.Size()
+	w, h := paletteMaxWidth, len(v.entries)+3
+	if h > paletteMaxHeight {
+		h = paletteMaxHeight
+	}
+	if w > sw {
+		w = sw
+	}
+	if h > sh {
+		h = sh
+	}
+	v.SetRect((sw-w)/2, (sh-h)/2, w, h)
+	v.Flex.Draw(screen)
+}
+
+func (v *paletteView) filter(q string) {
+	v.matches = fuzzyEntries(v.entries, q)
+	v.list.Clear()
+	var keyWidth int
+	for _, e := range v.matches {
+		if len(e.Key) > keyWidth {
+			keyWidth = len(e.Key)
+		}
+	}
+	for i, e := range v.matches {
+		v.list.SetCell(i, 0, tview.NewTableCell(tview.Escape(e.Description)).
+			SetTextColor(render.Accent(tcell.ColorWhite)).
+			SetExpansion(1))
+		v.list.SetCell(i, 1, tview.NewTableCell(tview.Escape(e.Scope)).
+			SetTextColor(render.Accent(tcell.ColorGray)))
+		v.list.SetCell(i, 2, tview.NewTableCell(render.Pad(tview.Escape(e.Key), keyWidth)).
+			SetTextColor(render.Accent(tcell.ColorDodgerBlue)).
+			SetAttributes(tcell.AttrBold))
+	}
+	v.list.Select(0, 0)
+	v.list.ScrollToBeginning()
+}
+
+func (v *paletteView) move(delta int) {
+	if len(v.matches) == 0 {
+		return
+	}
+	r, _ := v.list.GetSelection()
+	r += delta
+	if r < 0 {
+		r = 0
+	}
+	if r >= len(v.matches) {
+		r = len(v.matches) - 1
+	}
+	v.list.Select(r, 0)
+}
+
+func (v *paletteView) selected() (PaletteEntry, bool) {
+	r, _ := v.list.GetSelection()
+	if r < 0 || r >= len(v.matches) {
+		return PaletteEntry{}, false
+	}
+
+	return v.matches[r], true
+}
+
+// fuzzyEntries returns the entries matching a query, best matches first.
+func fuzzyEntries(ee []PaletteEntry, q string) []PaletteEntry {
+	if q == "" {
+		return ee
+	}
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, e.label())
+	}
+	mm := fuzzy.Find(q, ss)
+	res := make([]PaletteEntry, 0, len(mm))
+	for _, m := range mm {
+		res = append(res, ee[m.Index])
+	}
+
+	return res
+}
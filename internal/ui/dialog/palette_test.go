@@ -0,0 +1,104 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var (
+		done int
+		ran  string
+	)
+	ee := makePaletteEntries(&ran)
+	ShowPalette(p, ee, func() { done++ })
+
+	v := p.GetPrimitive(paletteKey).(*paletteView)
+	assert.NotNil(t, v)
+	assert.Equal(t, 3, len(v.matches))
+
+	v.input.SetText("log")
+	assert.Equal(t, 2, len(v.matches))
+	capture := v.input.GetInputCapture()
+	capture(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	capture(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	capture(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	assert.Equal(t, 1, done)
+	assert.Equal(t, v.matches[1].Description, ran)
+	assert.Nil(t, p.GetPrimitive(paletteKey))
+}
+
+func TestPaletteDialogCancel(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var (
+		done int
+		ran  string
+	)
+	ShowPalette(p, makePaletteEntries(&ran), func() { done++ })
+
+	v := p.GetPrimitive(paletteKey).(*paletteView)
+	v.input.SetText("zorg")
+	assert.Equal(t, 0, len(v.matches))
+	v.input.GetInputCapture()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	assert.Equal(t, 0, done)
+	assert.NotNil(t, p.GetPrimitive(paletteKey))
+
+	v.input.GetInputCapture()(tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone))
+	assert.Equal(t, 1, done)
+	assert.Equal(t, "", ran)
+	assert.Nil(t, p.GetPrimitive(paletteKey))
+}
+
+func TestFuzzyEntries(t *testing.T) {
+	var ran string
+	ee := makePaletteEntries(&ran)
+
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"all":   {e: []string{"Logs", "Logs Previous", "Shell"}},
+		"desc":  {q: "shell", e: []string{"Shell"}},
+		"key":   {q: "Shift-l", e: []string{"Logs Previous"}},
+		"scope": {q: "pod", e: []string{"Logs", "Shell", "Logs Previous"}},
+		"none":  {q: "zorg", e: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dd := []string{}
+			for _, e := range fuzzyEntries(ee, u.q) {
+				dd = append(dd, e.Description)
+			}
+			assert.Equal(t, u.e, dd)
+		})
+	}
+}
+
+// Helpers...
+
+func makePaletteEntries(ran *string) []PaletteEntry {
+	ee := []PaletteEntry{
+		{Key: "l", Description: "Logs", Scope: "Pod"},
+		{Key: "Shift-L", Description: "Logs Previous", Scope: "Pod"},
+		{Key: "s", Description: "Shell", Scope: "Pod"},
+	}
+	for i := range ee {
+		d := ee[i].Description
+		ee[i].Run = func() { *ran = d }
+	}
+
+	return ee
+}
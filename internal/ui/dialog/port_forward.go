@@ -3,6 +3,7 @@ package dialog
 import (
 	"strings"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -10,35 +11,57 @@ import (
 
 const portForwardKey = "portforward"
 
-// ShowPortForward pops a port forwarding configuration dialog.
-func ShowPortForward(p *ui.Pages, port string, okFn func(address, lport, cport string)) {
+// ShowPortForward pops a port forwarding configuration dialog. The first port
+// is preselected and others are offered as choices. A blank local port
+// defaults to the selected port. Blank TTL or idle timeout disables the
+// corresponding expiry.
+func ShowPortForward(p *ui.Pages, ports []string, lport, ttl, idle string, okFn func(address, lport, cport, ttl, idle string)) {
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
 		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
 
+	var port string
+	if len(ports) > 0 {
+		port = ports[0]
+	}
 	p1, p2, address := port, port, "localhost"
+	if lport != "" {
+		p2 = lport
+	}
 	f.AddInputField("Pod Port:", p1, 20, nil, func(p string) {
 		p1 = p
 	})
 	f.AddInputField("Local Port:", p2, 20, nil, func(p string) {
 		p2 = p
 	})
+	if len(ports) > 1 {
+		f.AddDropDown("Container Ports:", ports, 0, func(option string, _ int) {
+			f.GetFormItem(0).(*tview.InputField).SetText(option)
+			f.GetFormItem(1).(*tview.InputField).SetText(option)
+		})
+	}
 	f.AddInputField("Address:", address, 20, nil, func(h string) {
 		address = h
 	})
+	f.AddInputField("TTL:", ttl, 20, nil, func(v string) {
+		ttl = v
+	})
+	f.AddInputField("Idle Timeout:", idle, 20, nil, func(v string) {
+		idle = v
+	})
 
 	f.AddButton("OK", func() {
-		okFn(address, stripPort(p2), stripPort(p1))
+		okFn(address, stripPort(p2), stripPort(p1), ttl, idle)
 	})
 	f.AddButton("Cancel", func() {
 		DismissPortForward(p)
 	})
 
-	modal := tview.NewModalForm("<PortForward>", f)
+	modal := ui.NewModalForm("<PortForward>", f)
 	modal.SetDoneFunc(func(_ int, b string) {
 		DismissPortForward(p)
 	})
@@ -54,11 +77,14 @@ func DismissPortForward(p *ui.Pages) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-// StripPort removes the named port id if present.
+// StripPort removes the named port id and protocol if present.
 func stripPort(p string) string {
+	if i := strings.Index(p, "╱"); i >= 0 {
+		p = p[:i]
+	}
 	tokens := strings.Split(p, ":")
 	if len(tokens) == 2 {
-		return strings.Replace(tokens[1], "╱UDP", "", 1)
+		return tokens[1]
 	}
 
 	return p
@@ -4,18 +4,22 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestPortForwardDialog(t *testing.T) {
 	p := ui.NewPages()
 
-	okFunc := func(address, lport, cport string) {
+	okFunc := func(address, lport, cport, ttl, idle string) {
 	}
-	ShowPortForward(p, "8080", okFunc)
+	ShowPortForward(p, []string{"8080"}, "", "1h", "", okFunc)
 
-	d := p.GetPrimitive(portForwardKey).(*tview.ModalForm)
+	d := p.GetPrimitive(portForwardKey).(*ui.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissPortForward(p)
+	ShowPortForward(p, []string{"http:8080", "admin:9090"}, "9999", "", "", okFunc)
+	d = p.GetPrimitive(portForwardKey).(*ui.ModalForm)
 	assert.NotNil(t, d)
 
 	DismissPortForward(p)
@@ -35,6 +39,9 @@ func TestStripPort(t *testing.T) {
 		"protocol": {
 			"dns:53╱UDP", "53",
 		},
+		"unnamed-protocol": {
+			"8000╱TCP", "8000",
+		},
 	}
 
 	for k := range uu {
@@ -0,0 +1,48 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const promptKey = "prompt"
+
+type promptFunc func(value string)
+
+// ShowPrompt pops a dialog requesting a single value.
+func ShowPrompt(pages *ui.Pages, title, msg, label, value string, ack promptFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
+	f.AddInputField(label, value, 30, nil, func(v string) {
+		value = v
+	})
+	f.AddButton("Cancel", func() {
+		dismissPrompt(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissPrompt(pages)
+		ack(value)
+		cancel()
+	})
+
+	modal := ui.NewModalForm(" <"+title+"> ", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		dismissPrompt(pages)
+		cancel()
+	})
+	pages.AddPage(promptKey, modal, false, false)
+	pages.ShowPage(promptKey)
+}
+
+func dismissPrompt(pages *ui.Pages) {
+	pages.RemovePage(promptKey)
+}
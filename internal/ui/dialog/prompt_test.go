@@ -0,0 +1,29 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromptDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ackFunc := func(string) {
+		assert.True(t, true)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowPrompt(p, "Blee", "Yo", "Name:", "fred", ackFunc, caFunc)
+
+	d := p.GetPrimitive(promptKey).(*ui.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissPrompt(p)
+	assert.Nil(t, p.GetPrimitive(promptKey))
+}
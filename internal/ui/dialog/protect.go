@@ -0,0 +1,61 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const protectKey = "protect"
+
+// ShowProtect pops a confirmation dialog requiring the resource name to be
+// typed in. The action only proceeds when the name matches.
+func ShowProtect(pages *ui.Pages, title, msg, name string, ack confirmFunc, cancel cancelFunc) {
+	modal := ui.NewModalForm(" <"+title+"> ", protectForm(pages, name, ack, cancel))
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		dismissProtect(pages)
+		cancel()
+	})
+	pages.AddPage(protectKey, modal, false, false)
+	pages.ShowPage(protectKey)
+}
+
+func dismissProtect(pages *ui.Pages) {
+	pages.RemovePage(protectKey)
+}
+
+// protectForm builds the name confirmation form. The confirmation is run once
+// the dialog is gone so it may pop its own dialog.
+func protectForm(pages *ui.Pages, name string, ack confirmFunc, cancel cancelFunc) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
+
+	var typed string
+	f.AddInputField("Name:", "", 30, nil, func(v string) {
+		typed = v
+	})
+	f.AddButton("Cancel", func() {
+		dismissProtect(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		if typed != name {
+			f.GetFormItem(0).(*tview.InputField).SetLabel(render.Tags(fmt.Sprintf("[red::b]Type %s:", name)))
+			return
+		}
+		dismissProtect(pages)
+		cancel()
+		ack()
+	})
+
+	return f
+}
@@ -0,0 +1,47 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtectDialog(t *testing.T) {
+	p := ui.NewPages()
+	ShowProtect(p, "Blee", "Yo", "fred", func() {}, func() {})
+
+	assert.NotNil(t, p.GetPrimitive(protectKey).(*ui.ModalForm))
+	dismissProtect(p)
+	assert.Nil(t, p.GetPrimitive(protectKey))
+}
+
+func TestProtectForm(t *testing.T) {
+	uu := map[string]struct {
+		typed string
+		ack   bool
+	}{
+		"match":    {typed: "fred", ack: true},
+		"mismatch": {typed: "fre"},
+		"blank":    {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := ui.NewPages()
+			var acked, canceled bool
+			f := protectForm(p, "fred", func() { acked = true }, func() { canceled = true })
+			p.AddPage(protectKey, f, false, true)
+
+			f.GetFormItem(0).(*tview.InputField).SetText(u.typed)
+			f.GetButton(f.GetButtonIndex("OK")).InputHandler()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), nil)
+
+			assert.Equal(t, u.ack, acked)
+			assert.Equal(t, u.ack, canceled)
+			assert.Equal(t, !u.ack, p.HasPage(protectKey))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	summaryKey      = "summary"
+	summaryMaxWidth = 100
+)
+
+// ShowSummary pops a read only resource summary. Escape or Enter closes it.
+func ShowSummary(pages *ui.Pages, title string, s render.Summary, done func()) {
+	v := newSummaryView(" <"+title+"> ", s)
+	v.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyEscape, tcell.KeyEnter:
+			dismissSummary(pages)
+			done()
+			return nil
+		}
+		return evt
+	})
+	pages.AddPage(summaryKey, v, false, false)
+	pages.ShowPage(summaryKey)
+}
+
+// ShowValues pops a read only list of full cell values. Pressing c hands the
+// values to the copy function. Escape or Enter closes it.
+func ShowValues(pages *ui.Pages, title string, s render.Summary, copyFn func(string), done func()) {
+	v := newSummaryView(" <"+title+"> ", s)
+	v.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyEscape, tcell.KeyEnter:
+			dismissSummary(pages)
+			done()
+			return nil
+		case tcell.KeyRune:
+			if evt.Rune() == 'c' {
+				copyFn(summaryValues(s))
+				return nil
+			}
+		}
+		return evt
+	})
+	pages.AddPage(summaryKey, v, false, false)
+	pages.ShowPage(summaryKey)
+}
+
+func dismissSummary(pages *ui.Pages) {
+	pages.RemovePage(summaryKey)
+}
+
+// summaryView displays a summary centered and sized to its content.
+type summaryView struct {
+	*tview.TextView
+
+	titleWidth int
+	widths     []int
+}
+
+func newSummaryView(title string, s render.Summary) *summaryView {
+	v := summaryView{TextView: tview.NewTextView()}
+	v.SetDynamicColors(true).SetWrap(true)
+	v.SetBackgroundColor(tview.Styles.ContrastBackgroundColor)
+	v.SetBorder(true).SetBorderPadding(0, 0, 1, 1)
+	v.SetTitle(title).SetTitleColor(render.Accent(tcell.ColorAqua))
+
+	text, widths := summaryText(s)
+	v.SetText(text)
+	v.titleWidth, v.widths = len(title), widths
+
+	return &v
+}
+
+// Draw draws the summary in the middle of the screen.
+func (v *summaryView) Draw(screen tcell.Screen) {
+	sw, sh := screen.Size()
+	w := v.titleWidth
+	for _, l := range v.widths {
+		if l > w {
+			w = l
+		}
+	}
+	w += 4
+	if w > summaryMaxWidth {
+		w = summaryMaxWidth
+	}
+	if w > sw {
+		w = sw
+	}
+	h := 2
+	for _, l := range v.widths {
+		h += wrappedLines(l, w-4)
+	}
+	if h > sh {
+		h = sh
+	}
+	v.SetRect((sw-w)/2, (sh-h)/2, w, h)
+	v.TextView.Draw(screen)
+}
+
+// summaryText formats the summary fields with aligned names and returns each
+// line width.
+func summaryText(s render.Summary) (string, []int) {
+	var pad int
+	for _, f := range s {
+		if len(f.Name) > pad {
+			pad = len(f.Name)
+		}
+	}
+
+	var b strings.Builder
+	ww := make([]int, 0, len(s))
+	for i, f := range s {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[aqua::b]%-*s[white::-] %s", pad+1, f.Name+":", tview.Escape(f.Value))
+		ww = append(ww, pad+2+len(f.Value))
+	}
+
+	return render.Tags(b.String()), ww
+}
+
+// summaryValues returns the raw summary values, one per line.
+func summaryValues(s render.Summary) string {
+	vv := make([]string, 0, len(s))
+	for _, f := range s {
+		vv = append(vv, f.Value)
+	}
+
+	return strings.Join(vv, "\n")
+}
+
+func wrappedLines(l, width int) int {
+	if width <= 0 || l <= width {
+		return 1
+	}
+
+	return (l + width - 1) / width
+}
@@ -0,0 +1,84 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var done bool
+	ShowSummary(p, "Blee", render.Summary{{Name: "Node", Value: "n1"}}, func() { done = true })
+
+	v := p.GetPrimitive(summaryKey).(*summaryView)
+	assert.NotNil(t, v)
+	v.GetInputCapture()(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone))
+	assert.False(t, done)
+	assert.NotNil(t, p.GetPrimitive(summaryKey))
+
+	v.GetInputCapture()(tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone))
+	assert.True(t, done)
+	assert.Nil(t, p.GetPrimitive(summaryKey))
+}
+
+func TestValuesDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var (
+		done   bool
+		copied string
+	)
+	s := render.Summary{
+		{Name: "IMAGE", Value: "registry.example.com/team/app:v1.2.3"},
+		{Name: "MESSAGE", Value: "Back-off restarting failed container"},
+	}
+	ShowValues(p, "Blee", s, func(v string) { copied = v }, func() { done = true })
+
+	v := p.GetPrimitive(summaryKey).(*summaryView)
+	assert.NotNil(t, v)
+	v.GetInputCapture()(tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone))
+	assert.Equal(t, "registry.example.com/team/app:v1.2.3\nBack-off restarting failed container", copied)
+	assert.False(t, done)
+
+	v.GetInputCapture()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	assert.True(t, done)
+	assert.Nil(t, p.GetPrimitive(summaryKey))
+}
+
+func TestSummaryText(t *testing.T) {
+	text, ww := summaryText(render.Summary{
+		{Name: "IP", Value: "10.0.0.1"},
+		{Name: "Service Account", Value: "[fred]"},
+	})
+
+	assert.Equal(t, "[aqua::b]IP:             [white::-] 10.0.0.1\n[aqua::b]Service Account:[white::-] [fred[]", text)
+	assert.Equal(t, []int{25, 23}, ww)
+}
+
+func TestWrappedLines(t *testing.T) {
+	uu := map[string]struct {
+		l, width, e int
+	}{
+		"fits":    {l: 10, width: 20, e: 1},
+		"exact":   {l: 20, width: 20, e: 1},
+		"wraps":   {l: 41, width: 20, e: 3},
+		"noWidth": {l: 10, e: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, wrappedLines(u.l, u.width))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const errBadgeFmt = "[orangered::b]⚠ %d"
+
+// ErrBadge counts the errors flashed since they were last acknowledged.
+type ErrBadge struct {
+	*tview.TextView
+
+	flash *Flash
+}
+
+// NewErrBadge returns a new recent errors badge.
+func NewErrBadge(f *Flash, styles *config.Styles) *ErrBadge {
+	b := ErrBadge{TextView: tview.NewTextView(), flash: f}
+	b.SetDynamicColors(true)
+	b.SetTextAlign(tview.AlignCenter)
+	b.SetBackgroundColor(styles.BgColor())
+	styles.AddListener(&b)
+
+	return &b
+}
+
+// StylesChanged notifies the skin changed.
+func (b *ErrBadge) StylesChanged(s *config.Styles) {
+	b.SetBackgroundColor(s.BgColor())
+}
+
+// Draw refreshes the error count so errors flashed while the screen was
+// suspended or covered show up on the next draw.
+func (b *ErrBadge) Draw(screen tcell.Screen) {
+	b.SetText(errBadgeText(b.flash.ErrCount()))
+	b.TextView.Draw(screen)
+}
+
+// Contains checks if a screen position falls on the badge.
+func (b *ErrBadge) Contains(x, y int) bool {
+	if b.flash.ErrCount() == 0 {
+		return false
+	}
+	bx, by, w, h := b.GetRect()
+
+	return x >= bx && x < bx+w && y >= by && y < by+h
+}
+
+func errBadgeText(count int) string {
+	if count == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(errBadgeFmt, count)
+}
@@ -0,0 +1,40 @@
+package ui_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrBadge(t *testing.T) {
+	a := ui.NewApp("")
+	f, b := a.Flash(), a.ErrBadge()
+	s := tcell.NewSimulationScreen("UTF-8")
+	assert.Nil(t, s.Init())
+	s.SetSize(80, 5)
+	b.SetRect(10, 0, 8, 1)
+
+	b.Draw(s)
+	assert.Equal(t, "", b.GetText(true))
+	assert.False(t, b.Contains(12, 0))
+
+	f.Info("Blee")
+	f.Warn("Duh")
+	f.Err(errors.New("Fred"))
+	f.Errf("Zorg %s", "crapola")
+	assert.Equal(t, 2, f.ErrCount())
+	b.Draw(s)
+	assert.Equal(t, "⚠ 2", b.GetText(true))
+	assert.True(t, b.Contains(12, 0))
+	assert.False(t, b.Contains(12, 1))
+	assert.False(t, b.Contains(18, 0))
+
+	f.AckErrs()
+	assert.Equal(t, 0, f.ErrCount())
+	b.Draw(s)
+	assert.Equal(t, "", b.GetText(true))
+	assert.Equal(t, 4, len(f.History()))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/config"
@@ -23,31 +24,47 @@ const (
 	// FlashFatal represents an fatal message.
 	FlashFatal
 
-	flashDelay = 3
+	flashDelay       = 3
+	flashHistorySize = 100
 
 	emoDoh   = "😗"
 	emoRed   = "😡"
 	emoDead  = "💀"
 	emoHappy = "😎"
+
+	// Severity prefixes used in lieu of colors in monochrome.
+	monoWarn  = "!"
+	monoErr   = "!!"
+	monoFatal = "!!!"
 )
 
 type (
 	// FlashLevel represents flash message severity.
 	FlashLevel int
 
+	// FlashMessage represents a past flash message.
+	FlashMessage struct {
+		Level FlashLevel
+		Text  string
+		Time  time.Time
+	}
+
 	// Flash represents a flash message indicator.
 	Flash struct {
 		*tview.TextView
 
-		cancel context.CancelFunc
-		app    *App
+		cancel  context.CancelFunc
+		app     *App
+		history []FlashMessage
+		errs    int
+		mx      sync.RWMutex
 	}
 )
 
 // NewFlash returns a new flash view.
 func NewFlash(app *App, m string) *Flash {
 	f := Flash{app: app, TextView: tview.NewTextView()}
-	f.SetTextColor(tcell.ColorAqua)
+	f.SetTextColor(render.Accent(tcell.ColorAqua))
 	f.SetTextAlign(tview.AlignLeft)
 	f.SetBorderPadding(0, 0, 1, 1)
 	f.SetText("")
@@ -101,6 +118,47 @@ func (f *Flash) Errf(fmat string, args ...interface{}) {
 	f.SetMessage(FlashErr, fmt.Sprintf(fmat, args...))
 }
 
+// History returns past flash messages, oldest first.
+func (f *Flash) History() []FlashMessage {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	hh := make([]FlashMessage, len(f.history))
+	copy(hh, f.history)
+
+	return hh
+}
+
+// ErrCount returns the number of errors flashed since last acknowledged.
+func (f *Flash) ErrCount() int {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.errs
+}
+
+// AckErrs acknowledges past errors and resets the error count.
+func (f *Flash) AckErrs() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.errs = 0
+}
+
+func (f *Flash) record(level FlashLevel, msg string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if level >= FlashErr {
+		f.errs++
+	}
+	f.history = append(f.history, FlashMessage{Level: level, Text: msg, Time: time.Now()})
+	if len(f.history) > flashHistorySize {
+		f.history = f.history[len(f.history)-flashHistorySize:]
+	}
+}
+
+// SetMessage displays a flash message.
 func (f *Flash) SetMessage(level FlashLevel, msg ...string) {
 	if f.cancel != nil {
 		f.cancel()
@@ -117,8 +175,9 @@ func (f *Flash) SetMessage(level FlashLevel, msg ...string) {
 		width = 100
 	}
 	m := strings.Join(msg, " ")
+	f.record(level, m)
 	f.SetTextColor(flashColor(level))
-	f.SetText(render.Truncate(flashEmoji(level)+" "+m, width-3))
+	f.SetText(render.Truncate(flashPrefix(level)+" "+m, width-3))
 }
 
 func (f *Flash) refresh(ctx1, ctx2 context.Context, cancel context.CancelFunc) {
@@ -138,7 +197,10 @@ func (f *Flash) refresh(ctx1, ctx2 context.Context, cancel context.CancelFunc) {
 	}
 }
 
-func flashEmoji(l FlashLevel) string {
+func flashPrefix(l FlashLevel) string {
+	if render.IsMonochrome() {
+		return monoPrefix(l)
+	}
 	switch l {
 	case FlashWarn:
 		return emoDoh
@@ -151,15 +213,29 @@ func flashEmoji(l FlashLevel) string {
 	}
 }
 
+// monoPrefix conveys a severity without colors nor emojis.
+func monoPrefix(l FlashLevel) string {
+	switch l {
+	case FlashWarn:
+		return monoWarn
+	case FlashErr:
+		return monoErr
+	case FlashFatal:
+		return monoFatal
+	default:
+		return ""
+	}
+}
+
 func flashColor(l FlashLevel) tcell.Color {
 	switch l {
 	case FlashWarn:
-		return tcell.ColorOrange
+		return render.Accent(tcell.ColorOrange)
 	case FlashErr:
-		return tcell.ColorOrangeRed
+		return render.Accent(tcell.ColorOrangeRed)
 	case FlashFatal:
-		return tcell.ColorFuchsia
+		return render.Accent(tcell.ColorFuchsia)
 	default:
-		return tcell.ColorNavajoWhite
+		return render.Accent(tcell.ColorNavajoWhite)
 	}
 }
@@ -38,3 +38,19 @@ func TestFlashErr(t *testing.T) {
 	assert.Equal(t, "😡 Blee duh\n", f.GetText(false))
 
 }
+
+func TestFlashHistory(t *testing.T) {
+	f := ui.NewFlash(ui.NewApp(""), "YO!")
+
+	f.Info("Blee")
+	f.Err(errors.New("Duh"))
+	for i := 0; i < 200; i++ {
+		f.Warnf("Fred %d", i)
+	}
+
+	hh := f.History()
+	assert.Equal(t, 100, len(hh))
+	assert.Equal(t, "Fred 100", hh[0].Text)
+	assert.Equal(t, ui.FlashWarn, hh[99].Level)
+	assert.Equal(t, "Fred 199", hh[99].Text)
+}
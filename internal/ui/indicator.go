@@ -3,9 +3,11 @@ package ui
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
@@ -28,7 +30,7 @@ func NewStatusIndicator(app *App, styles *config.Styles) *StatusIndicator {
 		styles:   styles,
 	}
 	s.SetTextAlign(tview.AlignCenter)
-	s.SetTextColor(tcell.ColorWhite)
+	s.SetTextColor(render.Accent(tcell.ColorWhite))
 	s.SetBackgroundColor(styles.BgColor())
 	s.SetDynamicColors(true)
 	styles.AddListener(&s)
@@ -45,8 +47,8 @@ func (s *StatusIndicator) StylesChanged(styles *config.Styles) {
 
 // SetPermanent sets permanent title to be reset to after updates
 func (s *StatusIndicator) SetPermanent(info string) {
-	s.permanent = info
-	s.SetText(info)
+	s.permanent = render.Tags(info)
+	s.SetText(s.permanent)
 }
 
 // Reset clears out the logo view and resets colors.
@@ -57,21 +59,24 @@ func (s *StatusIndicator) Reset() {
 
 // Err displays a log error state.
 func (s *StatusIndicator) Err(msg string) {
-	s.update(msg, "orangered")
+	s.update(FlashErr, msg, "orangered")
 }
 
 // Warn displays a log warning state.
 func (s *StatusIndicator) Warn(msg string) {
-	s.update(msg, "mediumvioletred")
+	s.update(FlashWarn, msg, "mediumvioletred")
 }
 
 // Info displays a log info state.
 func (s *StatusIndicator) Info(msg string) {
-	s.update(msg, "lawngreen")
+	s.update(FlashInfo, msg, "lawngreen")
 }
 
-func (s *StatusIndicator) update(msg, c string) {
-	s.setText(fmt.Sprintf("[%s::b] <%s> ", c, msg))
+func (s *StatusIndicator) update(l FlashLevel, msg, c string) {
+	if render.IsMonochrome() {
+		msg = strings.TrimSpace(monoPrefix(l) + " " + msg)
+	}
+	s.setText(render.Tags(fmt.Sprintf("[%s::b] <%s> ", c, msg)))
 }
 
 func (s *StatusIndicator) setText(msg string) {
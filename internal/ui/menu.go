@@ -85,6 +85,8 @@ func (m *Menu) HydrateMenu(hh model.MenuHints) {
 			c := tview.NewTableCell(t[row][col])
 			if len(t[row][col]) == 0 {
 				c = tview.NewTableCell("")
+			} else {
+				c.SetReference(table[row][col])
 			}
 			c.SetBackgroundColor(m.styles.BgColor())
 			m.SetCell(row, col, c)
@@ -92,6 +94,26 @@ func (m *Menu) HydrateMenu(hh model.MenuHints) {
 	}
 }
 
+// HintAt returns the menu hint displayed at the given screen coordinates.
+func (m *Menu) HintAt(x, y int) (model.MenuHint, bool) {
+	for row := 0; row < m.GetRowCount(); row++ {
+		for col := 0; col < m.GetColumnCount(); col++ {
+			c := m.GetCell(row, col)
+			if c == nil {
+				continue
+			}
+			cx, cy, w := c.GetLastPosition()
+			if cy != y || x < cx || x >= cx+w {
+				continue
+			}
+			h, ok := c.GetReference().(model.MenuHint)
+			return h, ok
+		}
+	}
+
+	return model.MenuHint{}, false
+}
+
 func (m *Menu) hasDigits(hh model.MenuHints) bool {
 	for _, h := range hh {
 		if !h.Visible {
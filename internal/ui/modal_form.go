@@ -0,0 +1,216 @@
+package ui
+
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+// modalChrome accounts for the modal borders and padding on each axis.
+const modalChrome = 4
+
+// ModalForm represents a modal dialog holding a form. Unlike tview modals its
+// layout is worked out on each draw from the form content and the terminal
+// size so it adapts to terminal resizes and keeps the form buttons on screen.
+type ModalForm struct {
+	*tview.Box
+
+	frame     *tview.Frame
+	form      *tview.Form
+	text      string
+	textColor tcell.Color
+	done      func(int, string)
+}
+
+// NewModalForm returns a new modal form.
+func NewModalForm(title string, f *tview.Form) *ModalForm {
+	m := ModalForm{
+		Box:       tview.NewBox(),
+		form:      f,
+		textColor: tview.Styles.PrimaryTextColor,
+	}
+	f.SetItemPadding(0)
+	f.SetBackgroundColor(tview.Styles.ContrastBackgroundColor).SetBorderPadding(0, 0, 0, 0)
+	f.SetCancelFunc(func() {
+		if m.done != nil {
+			m.done(-1, "")
+		}
+	})
+	m.frame = tview.NewFrame(f).SetBorders(0, 0, 1, 0, 0, 0)
+	m.frame.SetBorder(true).
+		SetBackgroundColor(tview.Styles.ContrastBackgroundColor).
+		SetBorderPadding(1, 1, 1, 1)
+	m.frame.SetTitle(title)
+	m.frame.SetTitleColor(tcell.ColorAqua)
+
+	return &m
+}
+
+// NewModal returns a new modal offering a choice of buttons. The done function
+// receives the picked button.
+func NewModal(title string, buttons []string) *ModalForm {
+	f := tview.NewForm()
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor)
+	m := NewModalForm(title, f)
+	m.frame.SetTitleColor(tview.Styles.PrimaryTextColor)
+	for i, l := range buttons {
+		i, l := i, l
+		f.AddButton(l, func() {
+			if m.done != nil {
+				m.done(i, l)
+			}
+		})
+		f.GetButton(i).SetInputCapture(buttonNav)
+	}
+
+	return m
+}
+
+// SetText sets the modal message.
+func (m *ModalForm) SetText(text string) *ModalForm {
+	m.text = text
+	return m
+}
+
+// SetTextColor sets the modal message color.
+func (m *ModalForm) SetTextColor(c tcell.Color) *ModalForm {
+	m.textColor = c
+	return m
+}
+
+// SetDoneFunc sets a function to be called when the modal is dismissed.
+func (m *ModalForm) SetDoneFunc(f func(int, string)) *ModalForm {
+	m.done = f
+	return m
+}
+
+// GetForm returns the modal form.
+func (m *ModalForm) GetForm() *tview.Form {
+	return m.form
+}
+
+// Focus delegates focus to the form.
+func (m *ModalForm) Focus(delegate func(p tview.Primitive)) {
+	delegate(m.form)
+}
+
+// HasFocus checks if the form has focus.
+func (m *ModalForm) HasFocus() bool {
+	return m.form.HasFocus()
+}
+
+// Draw lays out the modal in the middle of the screen.
+func (m *ModalForm) Draw(screen tcell.Screen) {
+	sw, sh := screen.Size()
+	x, y, w, h, lines := modalLayout(sw, sh, m.text, m.form)
+
+	m.frame.Clear()
+	for _, l := range lines {
+		m.frame.AddText(l, true, tview.AlignCenter, m.textColor)
+	}
+	m.SetRect(x, y, w, h)
+	m.frame.SetRect(x, y, w, h)
+	m.frame.Draw(screen)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// modalLayout positions a modal within the screen. The modal spans a third
+// of the screen or as much as the form needs, and shrinks to fit smaller
+// screens. The message lines that do not fit are dropped so the form remains
+// usable.
+func modalLayout(sw, sh int, text string, f *tview.Form) (int, int, int, int, []string) {
+	w := sw / 3
+	if fw := formWidth(f); w < fw {
+		w = fw
+	}
+	if w > sw-modalChrome {
+		w = sw - modalChrome
+	}
+	if w < 1 {
+		w = 1
+	}
+
+	lines := tview.WordWrap(text, w)
+	rows := formHeight(f)
+	if room := sh - modalChrome - rows - 1; len(lines) > room {
+		if room < 0 {
+			room = 0
+		}
+		lines = lines[:room]
+	}
+	h := modalChrome + rows
+	if len(lines) > 0 {
+		h += len(lines) + 1
+	}
+	if h > sh {
+		h = sh
+	}
+	w += modalChrome
+
+	return clampOrigin((sw - w) / 2), clampOrigin((sh - h) / 2), w, h, lines
+}
+
+// buttonNav moves between the modal buttons using the arrow keys.
+func buttonNav(evt *tcell.EventKey) *tcell.EventKey {
+	switch evt.Key() {
+	case tcell.KeyDown, tcell.KeyRight:
+		return tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+	case tcell.KeyUp, tcell.KeyLeft:
+		return tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+	default:
+		return evt
+	}
+}
+
+func clampOrigin(v int) int {
+	if v < 0 {
+		return 0
+	}
+
+	return v
+}
+
+// formWidth returns the width needed to display the form fields and buttons.
+func formWidth(f *tview.Form) int {
+	var label, field int
+	for i := 0; i < f.GetFormItemCount(); i++ {
+		item := f.GetFormItem(i)
+		if l := tview.TaggedStringWidth(item.GetLabel()) + 1; l > label {
+			label = l
+		}
+		fw := item.GetFieldWidth()
+		if fw == 0 {
+			fw = tview.DefaultFormFieldWidth
+		}
+		if fw > field {
+			field = fw
+		}
+	}
+
+	var buttons int
+	for i := 0; i < f.GetButtonCount(); i++ {
+		buttons += tview.TaggedStringWidth(f.GetButton(i).GetLabel()) + 4 + 1
+	}
+	if buttons > 0 {
+		buttons--
+	}
+	if label+field > buttons {
+		return label + field
+	}
+
+	return buttons
+}
+
+// formHeight returns the number of rows the form spans. Buttons sit on a line
+// of their own following an empty line.
+func formHeight(f *tview.Form) int {
+	rows := f.GetFormItemCount()
+	if f.GetButtonCount() > 0 {
+		rows += 2
+	}
+
+	return rows
+}
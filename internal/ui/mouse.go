@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gdamore/tcell"
+)
+
+// MouseHandler handles a mouse event.
+type MouseHandler func(*tcell.EventMouse)
+
+// MouseScreen intercepts mouse events since tview drops them on the floor.
+// Mouse reporting is only requested from the terminal when enabled so native
+// text selection keeps working otherwise.
+type MouseScreen struct {
+	tcell.Screen
+
+	handler  MouseHandler
+	enabled  int32
+	finiOnce sync.Once
+}
+
+// NewMouseScreen returns a new screen dispatching mouse events to a handler.
+// Mouse reporting is requested once the screen initializes.
+func NewMouseScreen(s tcell.Screen, h MouseHandler, on bool) *MouseScreen {
+	m := MouseScreen{Screen: s, handler: h}
+	if on {
+		m.enabled = 1
+	}
+
+	return &m
+}
+
+// Init initializes the screen and restores the mouse reporting mode.
+func (m *MouseScreen) Init() error {
+	if err := m.Screen.Init(); err != nil {
+		return err
+	}
+	m.SetMouse(m.IsMouseEnabled())
+
+	return nil
+}
+
+// Fini finalizes the screen. Subsequent calls are no-ops.
+func (m *MouseScreen) Fini() {
+	m.finiOnce.Do(m.Screen.Fini)
+}
+
+// PollEvent returns the next non mouse event. Mouse events are handed off to
+// the handler.
+func (m *MouseScreen) PollEvent() tcell.Event {
+	for {
+		evt := m.Screen.PollEvent()
+		me, ok := evt.(*tcell.EventMouse)
+		if !ok {
+			return evt
+		}
+		if m.IsMouseEnabled() && m.handler != nil {
+			m.handler(me)
+		}
+	}
+}
+
+// SetMouse turns mouse reporting on or off.
+func (m *MouseScreen) SetMouse(on bool) {
+	if on {
+		atomic.StoreInt32(&m.enabled, 1)
+		m.EnableMouse()
+		return
+	}
+	atomic.StoreInt32(&m.enabled, 0)
+	m.DisableMouse()
+}
+
+// IsMouseEnabled checks if mouse reporting is on.
+func (m *MouseScreen) IsMouseEnabled() bool {
+	return atomic.LoadInt32(&m.enabled) == 1
+}
+
+// KeyEvent synthesizes the key event bound to an action key.
+func KeyEvent(k tcell.Key) *tcell.EventKey {
+	if k >= ' ' && k < tcell.KeyDEL {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
+
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
+
+// KeyFor returns the action key matching a menu hint mnemonic.
+func KeyFor(mnemonic string) (tcell.Key, bool) {
+	// Sequences are keyed in one key at a time.
+	if i := strings.Index(mnemonic, sequenceSep); i > 0 {
+		mnemonic = mnemonic[:i]
+	}
+	for k, n := range tcell.KeyNames {
+		if n == mnemonic {
+			return k, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,151 @@
+package ui_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMouseScreenPollEvent(t *testing.T) {
+	uu := map[string]struct {
+		on    bool
+		count int
+	}{
+		"on":  {on: true, count: 2},
+		"off": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sim := tcell.NewSimulationScreen("")
+			var count int
+			s := ui.NewMouseScreen(sim, func(*tcell.EventMouse) { count++ }, u.on)
+			assert.Nil(t, s.Init())
+			defer s.Fini()
+
+			sim.InjectMouse(1, 1, tcell.Button1, tcell.ModNone)
+			sim.InjectMouse(1, 1, tcell.ButtonNone, tcell.ModNone)
+			sim.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+
+			evt, ok := s.PollEvent().(*tcell.EventKey)
+			assert.True(t, ok)
+			assert.Equal(t, tcell.KeyEnter, evt.Key())
+			assert.Equal(t, u.count, count)
+			assert.Equal(t, u.on, s.IsMouseEnabled())
+		})
+	}
+}
+
+func TestMouseScreenToggle(t *testing.T) {
+	s := ui.NewMouseScreen(tcell.NewSimulationScreen(""), nil, false)
+	assert.Nil(t, s.Init())
+	defer s.Fini()
+
+	s.SetMouse(true)
+	assert.True(t, s.IsMouseEnabled())
+	s.SetMouse(false)
+	assert.False(t, s.IsMouseEnabled())
+}
+
+func TestKeyEvent(t *testing.T) {
+	uu := map[string]struct {
+		k    tcell.Key
+		key  tcell.Key
+		rune rune
+	}{
+		"rune":  {k: ui.KeyD, key: tcell.KeyRune, rune: 'd'},
+		"shift": {k: ui.KeyShiftD, key: tcell.KeyRune, rune: 'D'},
+		"ctrl":  {k: tcell.KeyCtrlD, key: tcell.KeyCtrlD},
+		"enter": {k: tcell.KeyEnter, key: tcell.KeyEnter},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			evt := ui.KeyEvent(u.k)
+			assert.Equal(t, u.key, evt.Key())
+			assert.Equal(t, u.rune, evt.Rune())
+		})
+	}
+}
+
+func TestKeyFor(t *testing.T) {
+	k, ok := ui.KeyFor("Ctrl-D")
+	assert.True(t, ok)
+	assert.Equal(t, tcell.KeyCtrlD, k)
+
+	k, ok = ui.KeyFor("Shift-S")
+	assert.True(t, ok)
+	assert.Equal(t, ui.KeyShiftS, k)
+
+	_, ok = ui.KeyFor("blee")
+	assert.False(t, ok)
+}
+
+func TestMenuHintAt(t *testing.T) {
+	m := ui.NewMenu(config.NewStyles())
+	m.HydrateMenu(model.MenuHints{
+		{Mnemonic: "a", Description: "bleeA", Visible: true},
+		{Mnemonic: "b", Description: "bleeB", Visible: true},
+	})
+	m.SetRect(0, 0, 80, 7)
+	draw(m.Draw)
+
+	h, ok := m.HintAt(3, 1)
+	assert.True(t, ok)
+	assert.Equal(t, "b", h.Mnemonic)
+	_, ok = m.HintAt(3, 5)
+	assert.False(t, ok)
+}
+
+func TestTableRowAt(t *testing.T) {
+	v := ui.NewTable("fred")
+	v.Init(context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles()))
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	v.SetRect(0, 0, 80, 10)
+	draw(v.Draw)
+	x, y, _, _ := v.GetInnerRect()
+
+	uu := map[string]struct {
+		x, y int
+		row  int
+		ok   bool
+	}{
+		"header":  {x: x, y: y},
+		"first":   {x: x, y: y + 1, row: 1, ok: true},
+		"second":  {x: x + 5, y: y + 2, row: 2, ok: true},
+		"pastEnd": {x: x, y: y + 5},
+		"outside": {x: 100, y: y + 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			row, ok := v.RowAt(u.x, u.y)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.row, row)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func draw(f func(tcell.Screen)) {
+	sim := tcell.NewSimulationScreen("")
+	if err := sim.Init(); err != nil {
+		panic(err)
+	}
+	defer sim.Fini()
+	sim.SetSize(80, 25)
+	f(sim)
+}
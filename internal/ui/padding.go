@@ -9,6 +9,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
+// minLongPad caps how narrow long columns get when squeezed.
+const minLongPad = 20
+
 // MaxyPad tracks uniform column padding.
 type MaxyPad []int
 
@@ -38,6 +41,61 @@ func ComputeMaxColumns(pads MaxyPad, sortCol int, header render.HeaderRow, ee re
 	}
 }
 
+// FitColumns shrinks long columns, widest first, so a table fits within the
+// given width. It returns the widest visible column which absorbs any spare
+// room or -1 when the width is not known yet.
+func FitColumns(pads MaxyPad, header render.HeaderRow, wide bool, width int) int {
+	if width <= 0 {
+		return -1
+	}
+	visible := func(i int) bool {
+		return i < len(header) && (wide || !header[i].Wide)
+	}
+
+	// Columns are separated by a space and the table starts one cell in.
+	total := 1
+	for i, p := range pads {
+		if visible(i) {
+			total += p
+		}
+		total++
+	}
+	for total >= width {
+		col := -1
+		for i, p := range pads {
+			if !visible(i) || !header[i].Long || p <= longFloor(header[i]) {
+				continue
+			}
+			if col < 0 || p > pads[col] {
+				col = i
+			}
+		}
+		if col < 0 {
+			break
+		}
+		pads[col]--
+		total--
+	}
+
+	widest := -1
+	for i, p := range pads {
+		if visible(i) && (widest < 0 || p > pads[widest]) {
+			widest = i
+		}
+	}
+
+	return widest
+}
+
+// longFloor returns the narrowest a long column may get.
+func longFloor(h render.Header) int {
+	if n := len(h.Name) + 1; n > minLongPad {
+		return n
+	}
+
+	return minLongPad
+}
+
 // IsASCII checks if table cell has all ascii characters.
 func IsASCII(s string) bool {
 	for i := 0; i < len(s); i++ {
@@ -79,6 +79,76 @@ func TestMaxColumn(t *testing.T) {
 	}
 }
 
+func TestFitColumns(t *testing.T) {
+	h := render.HeaderRow{
+		render.Header{Name: "NAME"},
+		render.Header{Name: "IMAGE", Long: true},
+		render.Header{Name: "MESSAGE", Long: true},
+	}
+	uu := map[string]struct {
+		header render.HeaderRow
+		wide   bool
+		width  int
+		pads   MaxyPad
+		e      MaxyPad
+		col    int
+	}{
+		"unknown": {
+			header: h,
+			pads:   MaxyPad{5, 40, 60},
+			e:      MaxyPad{5, 40, 60},
+			col:    -1,
+		},
+		"roomy": {
+			header: h,
+			width:  200,
+			pads:   MaxyPad{5, 40, 60},
+			e:      MaxyPad{5, 40, 60},
+			col:    2,
+		},
+		"widestFirst": {
+			header: h,
+			width:  80,
+			pads:   MaxyPad{5, 40, 60},
+			e:      MaxyPad{5, 35, 35},
+			col:    1,
+		},
+		"floor": {
+			header: h,
+			width:  30,
+			pads:   MaxyPad{5, 40, 60},
+			e:      MaxyPad{5, 20, 20},
+			col:    1,
+		},
+		"shortOnly": {
+			header: h,
+			width:  80,
+			pads:   MaxyPad{70, 10, 10},
+			e:      MaxyPad{70, 10, 10},
+			col:    0,
+		},
+		"hiddenWide": {
+			header: render.HeaderRow{
+				render.Header{Name: "NAME"},
+				render.Header{Name: "IMAGE", Long: true},
+				render.Header{Name: "MESSAGE", Long: true, Wide: true},
+			},
+			width: 40,
+			pads:  MaxyPad{5, 40, 60},
+			e:     MaxyPad{5, 30, 60},
+			col:   1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.col, FitColumns(u.pads, u.header, u.wide, u.width))
+			assert.Equal(t, u.e, u.pads)
+		})
+	}
+}
+
 func TestIsASCII(t *testing.T) {
 	uu := []struct {
 		s string
@@ -0,0 +1,242 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModalLayout(t *testing.T) {
+	uu := map[string]struct {
+		sw, sh     int
+		text       string
+		x, y, w, h int
+		lines      int
+	}{
+		"wide": {
+			sw: 300, sh: 60, text: "Yo",
+			x: 98, y: 23, w: 104, h: 14, lines: 1,
+		},
+		"narrow": {
+			sw: 60, sh: 24, text: "Yo",
+			x: 9, y: 5, w: 41, h: 14, lines: 1,
+		},
+		"tooNarrow": {
+			sw: 40, sh: 24, text: "Yo",
+			x: 0, y: 5, w: 40, h: 14, lines: 1,
+		},
+		"noText": {
+			sw: 60, sh: 24,
+			x: 9, y: 6, w: 41, h: 12,
+		},
+		"longText": {
+			sw: 60, sh: 16, text: strings.Repeat("blee ", 100),
+			x: 9, y: 0, w: 41, h: 16, lines: 3,
+		},
+		"tooShort": {
+			sw: 60, sh: 8, text: "Yo",
+			x: 9, y: 0, w: 41, h: 8,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			x, y, w, h, lines := modalLayout(u.sw, u.sh, u.text, makeResizeForm())
+			assert.Equal(t, u.x, x)
+			assert.Equal(t, u.y, y)
+			assert.Equal(t, u.w, w)
+			assert.Equal(t, u.h, h)
+			assert.Equal(t, u.lines, len(lines))
+		})
+	}
+}
+
+func TestModalFormResize(t *testing.T) {
+	m := NewModalForm("<PortForward>", makeResizeForm())
+	m.SetText("Forward fred:8080")
+	h := newResizeHarness(t, m, false)
+	defer h.Fini()
+
+	for _, size := range [][2]int{{200, 50}, {80, 24}, {40, 14}, {120, 30}, {200, 50}} {
+		lines := h.resize(size[0], size[1])
+		h.assertWithin(m)
+		screen := strings.Join(lines, "\n")
+		assert.Contains(t, screen, "OK", "size %v", size)
+		assert.Contains(t, screen, "Cancel", "size %v", size)
+		assert.Contains(t, screen, "Idle Timeout:", "size %v", size)
+	}
+}
+
+func TestModalResize(t *testing.T) {
+	m := NewModal("<Delete Benchmark>", []string{"Cancel", "OK"})
+	m.SetText(strings.Repeat("Delete benchmark fred? ", 20))
+	h := newResizeHarness(t, m, false)
+	defer h.Fini()
+
+	for _, size := range [][2]int{{120, 40}, {60, 10}, {30, 9}, {120, 40}} {
+		lines := h.resize(size[0], size[1])
+		h.assertWithin(m)
+		screen := strings.Join(lines, "\n")
+		assert.Contains(t, screen, "OK", "size %v", size)
+		assert.Contains(t, screen, "Cancel", "size %v", size)
+	}
+}
+
+func TestTableResize(t *testing.T) {
+	v := NewTable("fred")
+	v.Init(context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles()))
+	h := newResizeHarness(t, v, true)
+	defer h.Fini()
+	h.resize(200, 30)
+	v.Update(makeResizeData(100))
+	v.Select(41, 0)
+
+	long := strings.Repeat("x", 100)
+	for _, size := range [][2]int{{200, 30}, {80, 30}, {80, 10}, {60, 6}, {200, 30}} {
+		lines := h.resize(size[0], size[1])
+		screen := strings.Join(lines, "\n")
+		assert.Contains(t, screen, "p040", "size %v", size)
+		sel := lineOf(lines, "p040")
+		assert.True(t, strings.HasSuffix(strings.Trim(lines[sel], "│ "), "Running"), "size %v: %q", size, lines[sel])
+		if size[0] > 150 {
+			assert.Contains(t, lines[sel], long, "size %v", size)
+		} else {
+			assert.NotContains(t, lines[sel], long, "size %v", size)
+		}
+	}
+	assert.Equal(t, 41, v.GetSelectedRowIndex())
+}
+
+func TestTableResizeGroups(t *testing.T) {
+	v := NewTable("fred")
+	v.Init(context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles()))
+	v.SetGroupFn(func(re render.RowEvent) string {
+		return re.Row.Fields[2]
+	})
+	h := newResizeHarness(t, v, true)
+	defer h.Fini()
+	h.resize(200, 30)
+	v.Update(makeResizeData(10))
+
+	for _, size := range [][2]int{{200, 30}, {80, 30}, {200, 30}} {
+		lines := h.resize(size[0], size[1])
+		screen := strings.Join(lines, "\n")
+		assert.Contains(t, screen, "p009", "size %v", size)
+		for _, l := range lines[1:12] {
+			assert.NotEmpty(t, strings.TrimSpace(l), "size %v", size)
+		}
+	}
+}
+
+// Helpers...
+
+// resizeHarness draws a primitive onto a simulated terminal which gets
+// resized between draws.
+type resizeHarness struct {
+	tcell.SimulationScreen
+
+	t          *testing.T
+	p          tview.Primitive
+	fullScreen bool
+}
+
+func newResizeHarness(t *testing.T, p tview.Primitive, fullScreen bool) *resizeHarness {
+	s := tcell.NewSimulationScreen("UTF-8")
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	return &resizeHarness{SimulationScreen: s, t: t, p: p, fullScreen: fullScreen}
+}
+
+// resize resizes the terminal and redraws the primitive like the application
+// does on resize events. It returns the screen lines.
+func (h *resizeHarness) resize(w, ht int) []string {
+	h.SetSize(w, ht)
+	h.Clear()
+	if h.fullScreen {
+		h.p.SetRect(0, 0, w, ht)
+	}
+	h.p.Draw(h)
+	h.Show()
+
+	cc, sw, sh := h.GetContents()
+	lines := make([]string, 0, sh)
+	for y := 0; y < sh; y++ {
+		var b strings.Builder
+		for x := 0; x < sw; x++ {
+			rr := cc[y*sw+x].Runes
+			if len(rr) == 0 {
+				b.WriteRune(' ')
+				continue
+			}
+			b.WriteString(string(rr))
+		}
+		lines = append(lines, b.String())
+	}
+
+	return lines
+}
+
+// assertWithin checks the primitive lays within the terminal.
+func (h *resizeHarness) assertWithin(p tview.Primitive) {
+	x, y, w, ht := p.GetRect()
+	sw, sh := h.Size()
+	assert.True(h.t, x >= 0 && y >= 0, "origin %d:%d", x, y)
+	assert.True(h.t, x+w <= sw && y+ht <= sh, "rect %d:%d:%d:%d exceeds %dx%d", x, y, w, ht, sw, sh)
+}
+
+func lineOf(lines []string, s string) int {
+	for i, l := range lines {
+		if strings.Contains(l, s) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func makeResizeForm() *tview.Form {
+	f := tview.NewForm()
+	f.AddInputField("Pod Port:", "8080", 20, nil, nil)
+	f.AddInputField("Local Port:", "8080", 20, nil, nil)
+	f.AddDropDown("Container Ports:", []string{"http:8080", "admin:9090"}, 0, nil)
+	f.AddInputField("Address:", "localhost", 20, nil, nil)
+	f.AddInputField("TTL:", "", 20, nil, nil)
+	f.AddInputField("Idle Timeout:", "", 20, nil, nil)
+	f.AddButton("OK", nil)
+	f.AddButton("Cancel", nil)
+
+	return f
+}
+
+func makeResizeData(n int) render.TableData {
+	data := render.NewTableData()
+	data.Namespace = "default"
+	data.Header = render.HeaderRow{
+		render.Header{Name: "NAME"},
+		render.Header{Name: "IMAGES", Long: true},
+		render.Header{Name: "STATUS"},
+	}
+	data.RowEvents = make(render.RowEvents, 0, n)
+	for i := 0; i < n; i++ {
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Kind: render.EventAdd,
+			Row: render.Row{
+				ID:     fmt.Sprintf("default/p%03d", i),
+				Fields: render.Fields{fmt.Sprintf("p%03d", i), strings.Repeat("x", 100), "Running"},
+			},
+		})
+	}
+
+	return *data
+}
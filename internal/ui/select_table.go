@@ -1,18 +1,36 @@
 package ui
 
 import (
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
 
+// SelectionListener represents a table selection listener.
+type SelectionListener interface {
+	// SelectionChanged notifies the selected item changed.
+	SelectionChanged(item string)
+}
+
 // SelectTable represents a table with selections.
 type SelectTable struct {
 	*tview.Table
 
-	model       Tabular
-	selectedRow int
-	selectedFn  func(string) string
-	marks       map[string]struct{}
+	model        Tabular
+	selectedRow  int
+	selectedFn   func(string) string
+	marks        map[string]struct{}
+	selListeners []SelectionListener
+	buildFn      func(row int)
+}
+
+// GetCell returns a table cell, building its row first if not done yet.
+func (s *SelectTable) GetCell(row, col int) *tview.TableCell {
+	if s.buildFn != nil {
+		s.buildFn(row)
+	}
+
+	return s.Table.GetCell(row, col)
 }
 
 // SetModel sets the table model.
@@ -25,6 +43,26 @@ func (s *SelectTable) GetModel() Tabular {
 	return s.model
 }
 
+// AddSelectionListener registers a selection listener.
+func (s *SelectTable) AddSelectionListener(l SelectionListener) {
+	s.selListeners = append(s.selListeners, l)
+}
+
+// RemoveSelectionListener unregisters a selection listener.
+func (s *SelectTable) RemoveSelectionListener(l SelectionListener) {
+	victim := -1
+	for i, lis := range s.selListeners {
+		if lis == l {
+			victim = i
+			break
+		}
+	}
+	if victim == -1 {
+		return
+	}
+	s.selListeners = append(s.selListeners[:victim], s.selListeners[victim+1:]...)
+}
+
 // ClearSelection reset selected row.
 func (s *SelectTable) ClearSelection() {
 	s.Select(0, 0)
@@ -33,9 +71,16 @@ func (s *SelectTable) ClearSelection() {
 
 // SelectFirstRow select first data row if any.
 func (s *SelectTable) SelectFirstRow() {
-	if s.GetRowCount() > 0 {
-		s.Select(1, 0)
+	if s.GetRowCount() == 0 {
+		return
 	}
+	r := 1
+	for ; r < s.GetRowCount()-1; r++ {
+		if c := s.GetCell(r, 0); c == nil || !c.NotSelectable {
+			break
+		}
+	}
+	s.Select(r, 0)
 }
 
 // GetSelectedItems return currently marked or selected items names.
@@ -102,7 +147,18 @@ func (s *SelectTable) selectionChanged(r, c int) {
 	}
 	s.selectedRow = r
 	cell := s.GetCell(r, c)
-	s.SetSelectedStyle(tcell.ColorBlack, cell.Color, tcell.AttrBold)
+	s.SetSelectedStyle(render.Selection(tcell.ColorBlack, cell.Color, tcell.AttrBold))
+	s.fireSelectionChanged()
+}
+
+func (s *SelectTable) fireSelectionChanged() {
+	if len(s.selListeners) == 0 || s.model == nil {
+		return
+	}
+	sel := s.GetSelectedItem()
+	for _, l := range s.selListeners {
+		l.SelectionChanged(sel)
+	}
 }
 
 // ClearMarks delete all marked items.
@@ -130,11 +186,11 @@ func (s *SelectTable) ToggleMark() {
 	}
 
 	cell := s.GetCell(s.GetSelectedRowIndex(), 0)
-	s.SetSelectedStyle(
+	s.SetSelectedStyle(render.Selection(
 		tcell.ColorBlack,
 		cell.Color,
 		tcell.AttrBold,
-	)
+	))
 }
 
 // IsMarked returns true if this item was marked.
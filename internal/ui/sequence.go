@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+// DefaultSequenceTimeout the time given to key in the second key of a sequence.
+const DefaultSequenceTimeout = 2 * time.Second
+
+// sequenceSep separates the keys of a sequence mnemonic.
+const sequenceSep = " "
+
+// SequenceFunc notifies a key sequence got armed or cancelled.
+type SequenceFunc func(msg string)
+
+// KeySequence requires destructive actions to be keyed in twice in a row
+// within a timeout.
+type KeySequence struct {
+	timeout time.Duration
+	key     tcell.Key
+	action  string
+	armedAt time.Time
+	armed   bool
+}
+
+// NewKeySequence returns a new key sequence.
+func NewKeySequence(timeout time.Duration) *KeySequence {
+	return &KeySequence{timeout: timeout}
+}
+
+// Next records a destructive action key press. It returns true when the key
+// completes the sequence. Otherwise the sequence is armed for this key.
+func (s *KeySequence) Next(k tcell.Key, action string) bool {
+	now := time.Now()
+	if s.armed && s.key == k && now.Sub(s.armedAt) <= s.timeout {
+		s.armed = false
+		return true
+	}
+	s.key, s.action, s.armedAt, s.armed = k, action, now, true
+
+	return false
+}
+
+// Cancel disarms the sequence. It returns the pending action if any.
+func (s *KeySequence) Cancel() (string, bool) {
+	if !s.armed {
+		return "", false
+	}
+	s.armed = false
+
+	return s.action, time.Since(s.armedAt) <= s.timeout
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func sequenceMnemonic(k tcell.Key) string {
+	n := tcell.KeyNames[k]
+	return n + sequenceSep + n
+}
+
+func armedMsg(k tcell.Key, action string) string {
+	return fmt.Sprintf("Press <%s> again to %s", strings.ToLower(tcell.KeyNames[k]), action)
+}
@@ -0,0 +1,75 @@
+package ui_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeySequenceNext(t *testing.T) {
+	s := ui.NewKeySequence(time.Second)
+
+	assert.False(t, s.Next(tcell.KeyCtrlD, "Delete"))
+	assert.True(t, s.Next(tcell.KeyCtrlD, "Delete"))
+	assert.False(t, s.Next(tcell.KeyCtrlD, "Delete"))
+}
+
+func TestKeySequenceOtherKey(t *testing.T) {
+	s := ui.NewKeySequence(time.Second)
+
+	assert.False(t, s.Next(tcell.KeyCtrlD, "Delete"))
+	assert.False(t, s.Next(tcell.KeyCtrlK, "Kill"))
+	assert.True(t, s.Next(tcell.KeyCtrlK, "Kill"))
+}
+
+func TestKeySequenceTimeout(t *testing.T) {
+	s := ui.NewKeySequence(10 * time.Millisecond)
+
+	assert.False(t, s.Next(tcell.KeyCtrlD, "Delete"))
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, s.Next(tcell.KeyCtrlD, "Delete"))
+	assert.True(t, s.Next(tcell.KeyCtrlD, "Delete"))
+}
+
+func TestKeySequenceCancel(t *testing.T) {
+	s := ui.NewKeySequence(time.Second)
+
+	_, ok := s.Cancel()
+	assert.False(t, ok)
+
+	s.Next(tcell.KeyCtrlD, "Delete")
+	action, ok := s.Cancel()
+	assert.True(t, ok)
+	assert.Equal(t, "Delete", action)
+
+	_, ok = s.Cancel()
+	assert.False(t, ok)
+	assert.False(t, s.Next(tcell.KeyCtrlD, "Delete"))
+}
+
+func TestKeySequenceCancelExpired(t *testing.T) {
+	s := ui.NewKeySequence(10 * time.Millisecond)
+
+	s.Next(tcell.KeyCtrlD, "Delete")
+	time.Sleep(20 * time.Millisecond)
+	_, ok := s.Cancel()
+	assert.False(t, ok)
+}
+
+func TestKeyActionsSequenceHints(t *testing.T) {
+	kk := ui.KeyActions{
+		tcell.KeyCtrlD: ui.NewDestructiveKeyAction("Delete", nil, true),
+		ui.KeyB:        ui.NewKeyAction("blee", nil, true),
+	}
+
+	hh := kk.SequenceHints()
+	assert.Equal(t, 2, len(hh))
+	assert.Equal(t, "Ctrl-D Ctrl-D", hh[0].Mnemonic)
+	assert.Equal(t, "b", hh[1].Mnemonic)
+
+	hh = kk.Hints()
+	assert.Equal(t, "Ctrl-D", hh[0].Mnemonic)
+}
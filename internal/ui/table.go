@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
@@ -23,6 +26,20 @@ type (
 
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
+
+	// GroupFunc returns the section a row belongs to.
+	GroupFunc func(render.RowEvent) string
+
+	// RankFunc returns a row rank. Lower ranks sort first, ties keep the
+	// column sort order.
+	RankFunc func(render.RowEvent) int
+
+	// GuardFunc vets a dangerous action. It returns true when it takes over
+	// running the action.
+	GuardFunc func(action string, run func()) bool
+
+	// SortedFunc gets notified when the sort column or order changes.
+	SortedFunc func()
 )
 
 // Table represents tabular data.
@@ -32,16 +49,36 @@ type Table struct {
 	actions    KeyActions
 	BaseTitle  string
 	Path       string
+	resource   string
 	cmdBuff    *CmdBuff
 	styles     *config.Styles
 	sortCol    SortColumn
 	colorerFn  render.ColorerFunc
 	decorateFn DecorateFunc
+	groupFn    GroupFunc
+	rankFn     RankFunc
+	guardFn    GuardFunc
+	sortedFn   SortedFunc
+	seq        *KeySequence
+	seqFn      SequenceFunc
+	sections   int
+	total      int
+	pendingSel string
+	wide       bool
+	absTime    bool
+	deprecated bool
+	notice     string
+	stale      string
+	paused     string
+	loadErr    error
+	expandCol  int
+	width      int
+	window     *rowWindow
 }
 
 // NewTable returns a new table view.
 func NewTable(gvr string) *Table {
-	return &Table{
+	t := Table{
 		SelectTable: &SelectTable{
 			Table: tview.NewTable(),
 			model: model.NewTable(gvr),
@@ -50,8 +87,13 @@ func NewTable(gvr string) *Table {
 		actions:   make(KeyActions),
 		cmdBuff:   NewCmdBuff('/', FilterBuff),
 		BaseTitle: gvr,
+		resource:  path.Base(gvr),
 		sortCol:   SortColumn{index: -1, colCount: 0, asc: true},
+		expandCol: -1,
 	}
+	t.buildFn = func(r int) { t.buildRows(r, r+1) }
+
+	return &t
 }
 
 // Init initializes the component.
@@ -73,11 +115,11 @@ func (t *Table) StylesChanged(s *config.Styles) {
 	t.SetBackgroundColor(config.AsColor(s.GetTable().BgColor))
 	t.SetBorderColor(config.AsColor(s.GetTable().FgColor))
 	t.SetBorderFocusColor(config.AsColor(s.Frame().Border.FocusColor))
-	t.SetSelectedStyle(
+	t.SetSelectedStyle(render.Selection(
 		tcell.ColorBlack,
 		config.AsColor(t.styles.GetTable().CursorColor),
 		tcell.AttrBold,
-	)
+	))
 	t.Refresh()
 }
 
@@ -97,6 +139,10 @@ func (t *Table) SendKey(evt *tcell.EventKey) {
 }
 
 func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if t.sequence(evt) {
+		return nil
+	}
+
 	key := evt.Key()
 	if key == tcell.KeyUp || key == tcell.KeyDown {
 		return evt
@@ -116,14 +162,69 @@ func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	if a, ok := t.actions[key]; ok {
+		if a.Dangerous {
+			t.resume()
+		}
+		if a.Mutates() && t.guardFn != nil && t.guardFn(a.Description, func() { a.Action(evt) }) {
+			return nil
+		}
 		return a.Action(evt)
 	}
 
 	return evt
 }
 
+// resume restarts paused updates once the resource is about to change.
+func (t *Table) resume() {
+	if !t.GetModel().IsPaused() {
+		return
+	}
+	t.GetModel().Resume()
+	t.SetPaused(false, 0)
+	t.UpdateTitle()
+}
+
+// sequence holds off destructive actions until their key is pressed twice
+// in safe keymap. Any other key cancels a pending action. It returns true
+// when it consumes the event.
+func (t *Table) sequence(evt *tcell.EventKey) bool {
+	if t.seq == nil {
+		return false
+	}
+
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = asKey(evt)
+		if t.SearchBuff().IsActive() {
+			key = tcell.KeyRune
+		}
+	}
+	if a, ok := t.actions[key]; ok && a.IsDestructive() {
+		if t.seq.Next(key, a.Description) {
+			return false
+		}
+		t.notifySeq(armedMsg(key, a.Description))
+		return true
+	}
+	if action, ok := t.seq.Cancel(); ok {
+		t.notifySeq(action + " cancelled")
+		return key == tcell.KeyEscape
+	}
+
+	return false
+}
+
+func (t *Table) notifySeq(msg string) {
+	if t.seqFn != nil {
+		t.seqFn(msg)
+	}
+}
+
 // Hints returns the view hints.
 func (t *Table) Hints() model.MenuHints {
+	if t.seq != nil {
+		return t.actions.SequenceHints()
+	}
 	return t.actions.Hints()
 }
 
@@ -142,6 +243,97 @@ func (t *Table) SetColorerFn(f render.ColorerFunc) {
 	t.colorerFn = f
 }
 
+// SetGroupFn groups rows into sections or disables grouping if nil.
+func (t *Table) SetGroupFn(f GroupFunc) {
+	t.groupFn = f
+}
+
+// SetRankFn ranks rows ahead of the column sort or disables ranking if nil.
+func (t *Table) SetRankFn(f RankFunc) {
+	t.rankFn = f
+}
+
+// SetSortedFn sets a function notified of sort changes.
+func (t *Table) SetSortedFn(f SortedFunc) {
+	t.sortedFn = f
+}
+
+// SetGuardFn sets a function vetting dangerous actions.
+func (t *Table) SetGuardFn(f GuardFunc) {
+	t.guardFn = f
+}
+
+// SetKeySequence requires destructive actions to be keyed in twice. The
+// function gets notified when an action is armed or cancelled.
+func (t *Table) SetKeySequence(s *KeySequence, f SequenceFunc) {
+	t.seq, t.seqFn = s, f
+}
+
+// ToggleWide shows or hides wide columns. Wide columns must trail the header.
+func (t *Table) ToggleWide() bool {
+	t.wide = !t.wide
+	t.Refresh()
+
+	return t.wide
+}
+
+// SetAbsTime shows time columns as absolute timestamps or relative durations.
+func (t *Table) SetAbsTime(b bool) {
+	if t.absTime == b {
+		return
+	}
+	t.absTime = b
+	t.Refresh()
+}
+
+// SetDeprecated flags the table resource as using a deprecated api.
+func (t *Table) SetDeprecated(b bool) {
+	t.deprecated = b
+}
+
+// SetNotice sets a message displayed in the table title. Use blank to clear.
+func (t *Table) SetNotice(s string) {
+	t.notice = s
+}
+
+// SetStale flags the table data as stale given the last load failure and how
+// long ago the data was refreshed. Use a nil error to clear. It returns true
+// if the table was not already stale.
+func (t *Table) SetStale(err error, d time.Duration) bool {
+	was := t.stale != ""
+	t.stale, t.loadErr = "", err
+	if err != nil {
+		t.stale = staleMarker(err, d)
+	}
+
+	return !was
+}
+
+// SetPaused flags the table data as paused given how long ago it was
+// refreshed.
+func (t *Table) SetPaused(paused bool, age time.Duration) {
+	t.paused = ""
+	if paused {
+		t.paused = pausedMarker(age)
+	}
+}
+
+// IsStale checks if the table data failed to refresh.
+func (t *Table) IsStale() bool {
+	return t.stale != ""
+}
+
+// SelectItem selects a given item once it is listed.
+func (t *Table) SelectItem(path string) {
+	t.pendingSel = path
+}
+
+// SortCol returns the sort column index, the column count it applies to and
+// the order.
+func (t *Table) SortCol() (int, int, bool) {
+	return t.sortCol.index, t.sortCol.colCount, t.sortCol.asc
+}
+
 // SetSortCol sets in sort column index and order.
 func (t *Table) SetSortCol(index, count int, asc bool) {
 	t.sortCol.index, t.sortCol.colCount, t.sortCol.asc = index, count, asc
@@ -160,6 +352,7 @@ func (t *Table) Update(data render.TableData) {
 	if t.decorateFn != nil {
 		data = t.decorateFn(data)
 	}
+	t.total = len(data.RowEvents)
 	if !t.cmdBuff.Empty() {
 		data = t.filtered(data)
 	}
@@ -174,30 +367,184 @@ func (t *Table) doUpdate(data render.TableData, firstRow bool) {
 		t.actions.Delete(KeyShiftP)
 	}
 
+	sel, selRow := t.selectedID(), t.selectedRow
 	t.Clear()
 	t.adjustSorter(data)
 	fg := config.AsColor(t.styles.GetTable().Header.FgColor)
 	bg := config.AsColor(t.styles.GetTable().Header.BgColor)
 	for col, h := range data.Header {
+		if h.Wide && !t.wide {
+			continue
+		}
 		t.AddHeaderCell(col, h)
 		c := t.GetCell(0, col)
 		c.SetBackgroundColor(bg)
 		c.SetTextColor(fg)
 	}
 	data.RowEvents.Sort(data.Namespace, t.sortCol.index, t.sortCol.asc)
+	if t.rankFn != nil {
+		sort.SliceStable(data.RowEvents, func(i, j int) bool {
+			return t.rankFn(data.RowEvents[i]) < t.rankFn(data.RowEvents[j])
+		})
+	}
 
 	pads := make(MaxyPad, len(data.Header))
-	ComputeMaxColumns(pads, t.sortCol.index, data.Header, data.RowEvents)
-	for i, r := range data.RowEvents {
-		t.buildRow(data.Namespace, i+1, r, data.Header, pads)
+	_, _, width, _ := t.GetInnerRect()
+	t.fitColumns(pads, data.Header, data.RowEvents, width)
+	t.window = newRowWindow(data.Namespace, data.Header, pads, len(data.RowEvents)+1)
+	if t.groupFn == nil {
+		t.sections = 0
+		for i, r := range data.RowEvents {
+			t.window.add(i+1, r)
+		}
+	} else {
+		t.buildGroups(data)
 	}
+	// Building the last row sizes the table, the others are built on display.
+	t.buildRows(t.window.size()-1, t.window.size())
 
 	if firstRow {
 		t.SelectFirstRow()
+	} else {
+		t.reselect(sel, selRow)
+	}
+	t.selectPending()
+	if t.IsSection(t.selectedRow) {
+		t.selectedRow++
 	}
 	t.updateSelection(true)
 }
 
+// padAbsTimes widens time columns to fit absolute timestamps.
+func padAbsTimes(pads MaxyPad, header render.HeaderRow, ee render.RowEvents) {
+	for col, h := range header {
+		k := h.TimeKind()
+		if k == render.NoTime {
+			continue
+		}
+		for _, re := range ee {
+			if col >= len(re.Row.Fields) {
+				continue
+			}
+			if w := len(render.AbsTime(k, re.Row.Fields[col], re.Row.Stamp)) + 1; w > pads[col] {
+				pads[col] = w
+			}
+		}
+	}
+}
+
+// buildGroups lays out rows sorted by section, each section led by a header
+// row.
+func (t *Table) buildGroups(data render.TableData) {
+	counts := make(map[string]int)
+	for _, re := range data.RowEvents {
+		counts[t.groupFn(re)]++
+	}
+	sort.SliceStable(data.RowEvents, func(i, j int) bool {
+		return t.groupFn(data.RowEvents[i]) < t.groupFn(data.RowEvents[j])
+	})
+
+	t.sections = 0
+	row, group := 1, ""
+	for i, re := range data.RowEvents {
+		if g := t.groupFn(re); i == 0 || g != group {
+			group = g
+			t.buildSection(row, fmt.Sprintf(sectionFmt, g, counts[g]), data.Header)
+			row++
+			t.sections++
+		}
+		t.window.add(row, re)
+		row++
+	}
+}
+
+func (t *Table) buildSection(r int, title string, header render.HeaderRow) {
+	fg := config.AsColor(t.styles.GetTable().Header.FgColor)
+	for col, h := range header {
+		if h.Wide && !t.wide {
+			continue
+		}
+		c := tview.NewTableCell("")
+		if col == 0 {
+			c.SetText(title)
+		}
+		c.SetExpansion(t.expansion(col))
+		c.SetTextColor(fg)
+		c.SetAttributes(tcell.AttrBold)
+		c.SetSelectable(false)
+		t.SetCell(r, col, c)
+	}
+}
+
+// IsSection checks if a row is a section header.
+func (t *Table) IsSection(r int) bool {
+	if r <= 0 || r >= t.GetRowCount() {
+		return false
+	}
+	c := t.GetCell(r, 0)
+
+	return c != nil && c.NotSelectable
+}
+
+// RowAt returns the data row displayed at the given screen coordinates.
+func (t *Table) RowAt(x, y int) (int, bool) {
+	rx, ry, w, h := t.GetInnerRect()
+	if x < rx || x >= rx+w || y < ry || y >= ry+h {
+		return 0, false
+	}
+	// Skip the fixed header row.
+	if y == ry {
+		return 0, false
+	}
+	offset, _ := t.GetOffset()
+	r := offset + y - ry
+	if r >= t.GetRowCount() || t.IsSection(r) {
+		return 0, false
+	}
+
+	return r, true
+}
+
+func (t *Table) selectPending() {
+	if t.pendingSel == "" {
+		return
+	}
+	if r, ok := t.rowIndex(t.pendingSel); ok {
+		t.selectedRow, t.pendingSel = r, ""
+	}
+}
+
+// selectedID returns the id of the selected row or blank if none.
+func (t *Table) selectedID() string {
+	if t.selectedRow <= 0 || t.selectedRow >= t.GetRowCount() {
+		return ""
+	}
+	id, _ := t.GetCell(t.selectedRow, 0).GetReference().(string)
+
+	return id
+}
+
+// reselect tracks the selection by row id so it follows its item across
+// refreshes. Falls back to the nearest row when the item is no longer listed.
+func (t *Table) reselect(id string, row int) {
+	if r, ok := t.rowIndex(id); ok {
+		t.selectedRow = r
+		return
+	}
+	if last := t.GetRowCount() - 1; last > 0 && row > last {
+		t.selectedRow = last
+	}
+}
+
+func (t *Table) rowIndex(id string) (int, bool) {
+	if id == "" || t.window == nil {
+		return 0, false
+	}
+	r, ok := t.window.ids[id]
+
+	return r, ok
+}
+
 // SortColCmd designates a sorted column.
 func (t *Table) SortColCmd(col int, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
@@ -206,7 +553,7 @@ func (t *Table) SortColCmd(col int, asc bool) func(evt *tcell.EventKey) *tcell.E
 		case -2:
 			index = 0
 		case -1:
-			index = t.GetColumnCount() - 1
+			index = t.GetModel().Peek().Header.AgeIndex()
 		default:
 			index = t.NameColIndex() + col
 		}
@@ -216,6 +563,7 @@ func (t *Table) SortColCmd(col int, asc bool) func(evt *tcell.EventKey) *tcell.E
 		}
 		t.sortCol.index = index
 		t.Refresh()
+		t.sorted()
 		return nil
 	}
 }
@@ -224,10 +572,17 @@ func (t *Table) SortColCmd(col int, asc bool) func(evt *tcell.EventKey) *tcell.E
 func (t *Table) SortInvertCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.sortCol.asc = !t.sortCol.asc
 	t.Refresh()
+	t.sorted()
 
 	return nil
 }
 
+func (t *Table) sorted() {
+	if t.sortedFn != nil {
+		t.sortedFn()
+	}
+}
+
 func (t *Table) adjustSorter(data render.TableData) {
 	// Going from namespace to non namespace or vice-versa?
 	switch {
@@ -250,24 +605,36 @@ func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.Hea
 	}
 	marked := t.IsMarked(re.Row.ID)
 	for col, field := range re.Row.Fields {
-		if !re.Deltas.IsBlank() && !header.AgeCol(col) {
+		if header[col].Wide && !t.wide {
+			continue
+		}
+		k := header[col].TimeKind()
+		// Durations change on every refresh so deltas are noise.
+		if !re.Deltas.IsBlank() && k != render.TimeSince && k != render.TimeUntil {
 			field += Deltas(re.Deltas[col], field)
 		}
 
-		if header[col].Decorator != nil {
+		if t.absTime && k != render.NoTime {
+			field = render.AbsTime(k, field, re.Row.Stamp)
+		} else if header[col].Decorator != nil {
 			field = header[col].Decorator(field)
 		}
 
+		if header[col].Long && pads[col] > 0 {
+			field = render.MiddleTruncate(field, pads[col]-1)
+		}
 		if header[col].Align == tview.AlignLeft {
 			field = formatCell(field, pads[col])
 		}
 		c := tview.NewTableCell(field)
-		c.SetExpansion(1)
+		c.SetExpansion(t.expansion(col))
 		c.SetAlign(header[col].Align)
-		c.SetTextColor(color(ns, re))
+		fg, attrs := render.Paint(color(ns, re))
 		if marked {
-			c.SetTextColor(config.AsColor(t.styles.GetTable().MarkColor))
+			fg, attrs = render.PaintMarked(config.AsColor(t.styles.GetTable().MarkColor))
 		}
+		c.SetTextColor(fg)
+		c.SetAttributes(attrs)
 		if col == 0 {
 			c.SetReference(re.Row.ID)
 		}
@@ -275,6 +642,16 @@ func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.Hea
 	}
 }
 
+// expansion returns a column share of the spare room. It all goes to the widest
+// column once the table width is known.
+func (t *Table) expansion(col int) int {
+	if t.expandCol < 0 || col == t.expandCol {
+		return 1
+	}
+
+	return 0
+}
+
 // ClearMarks clear out marked items.
 func (t *Table) ClearMarks() {
 	t.SelectTable.ClearMarks()
@@ -292,6 +669,35 @@ func (t *Table) GetSelectedRow() render.Row {
 	return t.model.Peek().RowEvents[t.GetSelectedRowIndex()].Row
 }
 
+// GetRow returns the row matching a given id.
+func (t *Table) GetRow(id string) (render.Row, bool) {
+	data := t.model.Peek()
+	idx, ok := data.RowEvents.FindIndex(id)
+	if !ok {
+		return render.Row{}, false
+	}
+
+	return data.RowEvents[idx].Row, true
+}
+
+// LongFields returns the full values of a row long columns.
+func (t *Table) LongFields(id string) render.Summary {
+	r, ok := t.GetRow(id)
+	if !ok {
+		return nil
+	}
+	header := t.model.Peek().Header
+	ss := make(render.Summary, 0, len(header))
+	for i, h := range header {
+		if !h.Long || i >= len(r.Fields) || (h.Wide && !t.wide) {
+			continue
+		}
+		ss = append(ss, render.SummaryField{Name: h.Name, Value: r.Fields[i]})
+	}
+
+	return ss
+}
+
 // NameColIndex returns the index of the resource name column.
 func (t *Table) NameColIndex() int {
 	col := 0
@@ -310,10 +716,13 @@ func (t *Table) AddHeaderCell(col int, h render.Header) {
 }
 
 func (t *Table) filtered(data render.TableData) render.TableData {
-	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.String()) {
+	if t.cmdBuff.Empty() || IsServerSelector(t.cmdBuff.String()) {
 		return data
 	}
 	q := t.cmdBuff.String()
+	if IsManagedBySelector(q) {
+		return managedByFilter(q, data)
+	}
 	if isFuzzySelector(q) {
 		return fuzzyFilter(q[2:], t.NameColIndex(), data)
 	}
@@ -354,7 +763,7 @@ func (t *Table) styleTitle() string {
 	}
 	rc := t.GetRowCount()
 	if rc > 0 {
-		rc--
+		rc -= 1 + t.sections
 	}
 
 	base, path := strings.Title(t.BaseTitle), t.Path
@@ -370,19 +779,19 @@ func (t *Table) styleTitle() string {
 		}
 	}
 
-	buff := t.SearchBuff().String()
-	var title string
-	if info == "" || info == render.ClusterScope {
-		title = SkinTitle(fmt.Sprintf(titleFmt, base, rc), t.styles.Frame())
-	} else {
-		title = SkinTitle(fmt.Sprintf(nsTitleFmt, base, info, rc), t.styles.Frame())
+	title := SkinTitle(fmtTitle(base, info, t.SearchBuff().String(), rc, t.total), t.styles.Frame())
+	if t.deprecated {
+		title += SkinTitle(deprecatedTitle, t.styles.Frame())
 	}
-	if buff == "" {
-		return title
+	if t.notice != "" {
+		title += SkinTitle(fmt.Sprintf(noticeTitleFmt, tview.Escape(t.notice)), t.styles.Frame())
 	}
-
-	if IsLabelSelector(buff) {
-		buff = TrimLabelSelector(buff)
+	if t.stale != "" {
+		title += SkinTitle(fmt.Sprintf(noticeTitleFmt, tview.Escape(t.stale)), t.styles.Frame())
 	}
-	return title + SkinTitle(fmt.Sprintf(SearchFmt, buff), t.styles.Frame())
+	if t.paused != "" {
+		title += SkinTitle(fmt.Sprintf(pausedTitleFmt, t.paused), t.styles.Frame())
+	}
+
+	return title
 }
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const emptyErrWidth = 80
+
+// EmptyMessage explains why the table lists no rows. It returns blank when
+// rows are listed or the data has yet to load.
+func (t *Table) EmptyMessage() string {
+	if t.GetRowCount() > 1+t.sections {
+		return ""
+	}
+	if t.loadErr == nil && t.GetModel().Age() == 0 {
+		return ""
+	}
+
+	return emptyReason(t.resource, t.GetModel().GetNamespace(), t.Path, t.cmdBuff.String(), t.total, t.loadErr)
+}
+
+// drawEmpty centers the empty table message in the table body.
+func (t *Table) drawEmpty(screen tcell.Screen) {
+	msg := t.EmptyMessage()
+	if msg == "" {
+		return
+	}
+	x, y, w, h := t.GetInnerRect()
+	if h < 2 {
+		return
+	}
+	fg := tcell.ColorWhite
+	if t.styles != nil {
+		fg = config.AsColor(t.styles.GetTable().FgColor)
+	}
+	tview.Print(screen, tview.Escape(msg), x, y+1+(h-1)/2, w, tview.AlignCenter, fg)
+}
+
+// emptyReason picks the cause of an empty listing. Rows hidden by a filter
+// take precedence over load failures as the filter is what the user can act
+// on.
+func emptyReason(res, ns, path, filter string, total int, err error) string {
+	scope := emptyScope(ns, path)
+	switch {
+	case filter != "" && total > 0:
+		return fmt.Sprintf("Filter '/%s' matches 0 of %d rows (Esc to clear)", filter, total)
+	case err != nil && isAccessDenied(err):
+		return fmt.Sprintf("Access denied listing %s%s", res, scope)
+	case err != nil:
+		return fmt.Sprintf("Unable to list %s%s -- %s", res, scope, render.Truncate(strings.TrimSpace(err.Error()), emptyErrWidth))
+	case filter != "":
+		return fmt.Sprintf("No %s%s matching '/%s' (Esc to clear)", res, scope, filter)
+	default:
+		return fmt.Sprintf("No %s%s", res, scope)
+	}
+}
+
+func emptyScope(ns, path string) string {
+	switch {
+	case path != "":
+		return " for " + path
+	case ns == render.ClusterScope:
+		return ""
+	case ns == render.AllNamespaces || ns == render.NamespaceAll:
+		return " in any namespace"
+	default:
+		return " in namespace " + ns
+	}
+}
+
+// isAccessDenied checks if a load failed on RBAC grounds.
+func isAccessDenied(err error) bool {
+	return kerrors.IsForbidden(err) || strings.Contains(err.Error(), "access denied")
+}
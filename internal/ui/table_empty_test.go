@@ -0,0 +1,117 @@
+package ui_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestTableEmptyMessage(t *testing.T) {
+	forbidden := kerrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", errors.New("nope"))
+	uu := map[string]struct {
+		ns, path, filter string
+		rows             bool
+		age              time.Duration
+		err              error
+		e                string
+	}{
+		"loading": {
+			ns: "blee",
+		},
+		"rows": {
+			ns:   "blee",
+			rows: true,
+			age:  time.Second,
+		},
+		"none": {
+			ns:  "blee",
+			age: time.Second,
+			e:   "No pods in namespace blee",
+		},
+		"noneAllNS": {
+			ns:  render.AllNamespaces,
+			age: time.Second,
+			e:   "No pods in any namespace",
+		},
+		"noneCluster": {
+			ns:  render.ClusterScope,
+			age: time.Second,
+			e:   "No pods",
+		},
+		"nonePath": {
+			ns:   "blee",
+			path: "blee/fred",
+			age:  time.Second,
+			e:    "No pods for blee/fred",
+		},
+		"filtered": {
+			ns:     "blee",
+			filter: "nada",
+			rows:   true,
+			age:    time.Second,
+			e:      "Filter '/nada' matches 0 of 2 rows (Esc to clear)",
+		},
+		"labels": {
+			ns:     "blee",
+			filter: "-l app=fred",
+			age:    time.Second,
+			e:      "No pods in namespace blee matching '/-l app=fred' (Esc to clear)",
+		},
+		"forbidden": {
+			ns:  "blee",
+			err: forbidden,
+			e:   "Access denied listing pods in namespace blee",
+		},
+		"denied": {
+			ns:  "blee",
+			err: errors.New(`[list watch] access denied on resource "blee":"v1/pods"`),
+			e:   "Access denied listing pods in namespace blee",
+		},
+		"failed": {
+			ns:  "blee",
+			err: errors.New("server went south"),
+			e:   "Unable to list pods in namespace blee -- server went south",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable("v1/pods")
+			v.Init(context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles()))
+			m := emptyModel{ns: u.ns, age: u.age, data: *render.NewTableData()}
+			if u.rows {
+				m.data = makeTableData()
+			}
+			v.SetModel(&m)
+			v.Path = u.path
+			v.SearchBuff().Set(u.filter)
+			v.SetStale(u.err, 0)
+			v.Update(m.Peek())
+
+			assert.Equal(t, u.e, v.EmptyMessage())
+		})
+	}
+}
+
+type emptyModel struct {
+	testModel
+
+	ns   string
+	age  time.Duration
+	data render.TableData
+}
+
+func (e *emptyModel) Empty() bool            { return len(e.data.RowEvents) == 0 }
+func (e *emptyModel) GetNamespace() string   { return e.ns }
+func (e *emptyModel) Age() time.Duration     { return e.age }
+func (e *emptyModel) Peek() render.TableData { return e.data }
@@ -4,23 +4,30 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
 )
 
 const (
-	// SearchFmt represents a filter view title.
-	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
-
-	nsTitleFmt    = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
-	titleFmt      = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
-	descIndicator = "↓"
-	ascIndicator  = "↑"
+	deprecatedTitle  = "<[orange::b]deprecated[fg:bg:-]> "
+	noticeTitleFmt   = "<[red::b]%s[fg:bg:-]> "
+	pausedTitleFmt   = "<[orange::b]%s[fg:bg:-]> "
+	nsTitleFmt       = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
+	titleFmt         = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
+	nsFilterTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[filter:bg:r]/%s[fg:bg:-] [count:bg:b]%s[fg:bg:-]][fg:bg:-] "
+	filterTitleFmt   = "[fg:bg:b] %s[fg:bg:-][[filter:bg:r]/%s[fg:bg:-] [count:bg:b]%s[fg:bg:-]][fg:bg:-] "
+	sectionFmt       = "» %s (%d)"
+	staleErrWidth    = 60
+	descIndicator    = "↓"
+	ascIndicator     = "↑"
 
 	// FullFmat specifies a namespaced dump file name.
 	FullFmat = "%s-%s-%d.csv"
@@ -33,6 +40,12 @@ var (
 	// LabelCmd identifies a label query
 	LabelCmd = regexp.MustCompile(`\A\-l`)
 
+	// ManagedByCmd identifies a managing tool query ie managed-by:helm.
+	ManagedByCmd = regexp.MustCompile(`\Amanaged-by:`)
+
+	// NodeCmd identifies a node query.
+	NodeCmd = regexp.MustCompile(`\A@`)
+
 	fuzzyCmd = regexp.MustCompile(`\A\-f`)
 )
 
@@ -62,6 +75,27 @@ func IsLabelSelector(s string) bool {
 	return LabelCmd.MatchString(s)
 }
 
+// IsManagedBySelector checks if query is a managing tool query.
+func IsManagedBySelector(s string) bool {
+	if s == "" {
+		return false
+	}
+	return ManagedByCmd.MatchString(s)
+}
+
+// IsNodeSelector checks if query is a node query.
+func IsNodeSelector(s string) bool {
+	if s == "" {
+		return false
+	}
+	return NodeCmd.MatchString(s)
+}
+
+// IsServerSelector checks if query must be resolved while listing resources.
+func IsServerSelector(s string) bool {
+	return IsLabelSelector(s) || IsNodeSelector(s)
+}
+
 // IsFuzztySelector checks if query is fuzzy.
 func isFuzzySelector(s string) bool {
 	if s == "" {
@@ -70,11 +104,42 @@ func isFuzzySelector(s string) bool {
 	return fuzzyCmd.MatchString(s)
 }
 
+// fmtTitle formats a table title given its namespace or path info. An active
+// filter is listed along with the matched rows out of the total.
+func fmtTitle(base, info, filter string, count, total int) string {
+	scoped := info != "" && info != render.ClusterScope
+	if filter == "" {
+		if scoped {
+			return fmt.Sprintf(nsTitleFmt, base, info, count)
+		}
+		return fmt.Sprintf(titleFmt, base, count)
+	}
+
+	if IsLabelSelector(filter) {
+		filter = TrimLabelSelector(filter)
+	}
+	filter = tview.Escape(filter)
+	counts := strconv.Itoa(count)
+	if total != count {
+		counts += "/" + strconv.Itoa(total)
+	}
+	if scoped {
+		return fmt.Sprintf(nsFilterTitleFmt, base, info, filter, counts)
+	}
+
+	return fmt.Sprintf(filterTitleFmt, base, filter, counts)
+}
+
 // TrimLabelSelector extracts label query.
 func TrimLabelSelector(s string) string {
 	return strings.TrimSpace(s[2:])
 }
 
+// TrimNodeSelector extracts node query.
+func TrimNodeSelector(s string) string {
+	return strings.TrimSpace(s[1:])
+}
+
 // SkinTitle decorates a title.
 func SkinTitle(fmat string, style config.Frame) string {
 	fmat = strings.Replace(fmat, "[fg:bg", "["+style.Title.FgColor+":"+style.Title.BgColor, -1)
@@ -84,7 +149,7 @@ func SkinTitle(fmat string, style config.Frame) string {
 	fmat = strings.Replace(fmat, "[count", "["+style.Title.CounterColor, 1)
 	fmat = strings.Replace(fmat, ":bg:", ":"+style.Title.BgColor+":", -1)
 
-	return fmat
+	return render.Tags(fmat)
 }
 
 func sortIndicator(col SortColumn, style config.Table, index int, name string) string {
@@ -128,6 +193,28 @@ func rxFilter(q string, data render.TableData) (render.TableData, error) {
 	return filtered, nil
 }
 
+// managedByFilter keeps the rows whose MANAGED-BY column matches a tool
+// query. Resources without the column are never matched.
+func managedByFilter(q string, data render.TableData) render.TableData {
+	filtered := render.TableData{
+		Header:    data.Header,
+		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	col := data.Header.IndexOf(render.ManagedByCol)
+	if col < 0 {
+		return filtered
+	}
+	tool := ManagedByCmd.ReplaceAllString(q, "")
+	for _, re := range data.RowEvents {
+		if col < len(re.Row.Fields) && render.ManagedByMatch(tool, re.Row.Fields[col]) {
+			filtered.RowEvents = append(filtered.RowEvents, re)
+		}
+	}
+
+	return filtered
+}
+
 func fuzzyFilter(q string, index int, data render.TableData) render.TableData {
 	var ss []string
 	for _, re := range data.RowEvents {
@@ -146,3 +233,18 @@ func fuzzyFilter(q string, index int, data render.TableData) render.TableData {
 
 	return filtered
 }
+
+// pausedMarker returns a title marker for data held still by the user.
+func pausedMarker(d time.Duration) string {
+	return fmt.Sprintf("PAUSED %s", d.Truncate(time.Second))
+}
+
+// staleMarker returns a title marker for data that failed to refresh.
+func staleMarker(err error, d time.Duration) string {
+	msg := render.Truncate(strings.TrimSpace(err.Error()), staleErrWidth)
+	if d <= 0 {
+		return fmt.Sprintf("DATA UNAVAILABLE (%s)", msg)
+	}
+
+	return fmt.Sprintf("DATA STALE %s (%s)", d.Truncate(time.Second), msg)
+}
@@ -1,8 +1,11 @@
 package ui
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,6 +18,7 @@ func TestIsLabelSelector(t *testing.T) {
 		"noMode":     {"app=fred,env=blee", false},
 		"noSpace":    {"-lapp=fred,env=blee", true},
 		"wrongLabel": {"-f app=fred,env=blee", false},
+		"managedBy":  {"managed-by:helm", false},
 	}
 
 	for k := range uu {
@@ -25,6 +29,95 @@ func TestIsLabelSelector(t *testing.T) {
 	}
 }
 
+func TestIsManagedBySelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   bool
+	}{
+		"cool":      {"managed-by:helm", true},
+		"empty":     {"", false},
+		"label":     {"-l app=fred", false},
+		"notPrefix": {"fred managed-by:helm", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsManagedBySelector(u.sel))
+		})
+	}
+}
+
+func TestManagedByFilter(t *testing.T) {
+	data := render.TableData{
+		Header: render.HeaderRow{
+			render.Header{Name: "NAME"},
+			render.Header{Name: render.ManagedByCol, Wide: true},
+		},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"a", "helm:fred"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"b", "helm"}}},
+			{Row: render.Row{ID: "c", Fields: render.Fields{"c", "kustomize"}}},
+			{Row: render.Row{ID: "d", Fields: render.Fields{"d", render.Unmanaged}}},
+		},
+	}
+
+	uu := map[string]struct {
+		q    string
+		data render.TableData
+		e    []string
+	}{
+		"helm":      {q: "managed-by:helm", data: data, e: []string{"a", "b"}},
+		"release":   {q: "managed-by:helm:fred", data: data, e: []string{"a"}},
+		"kustomize": {q: "managed-by:kustomize", data: data, e: []string{"c"}},
+		"none":      {q: "managed-by:argocd", data: data, e: []string{}},
+		"noColumn": {
+			q: "managed-by:helm",
+			data: render.TableData{
+				Header:    render.HeaderRow{render.Header{Name: "NAME"}},
+				RowEvents: render.RowEvents{{Row: render.Row{ID: "helm", Fields: render.Fields{"helm"}}}},
+			},
+			e: []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ids := []string{}
+			for _, re := range managedByFilter(u.q, u.data).RowEvents {
+				ids = append(ids, re.Row.ID)
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestIsNodeSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   bool
+	}{
+		"cool":    {"@node-1", true},
+		"empty":   {"", false},
+		"noMode":  {"node-1", false},
+		"label":   {"-l app=fred", false},
+		"trailer": {"node@1", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsNodeSelector(u.sel))
+		})
+	}
+}
+
+func TestTrimNodeSelector(t *testing.T) {
+	assert.Equal(t, "node-1", TrimNodeSelector("@node-1"))
+	assert.Equal(t, "node-1", TrimNodeSelector("@ node-1 "))
+}
+
 func TestTrimLabelSelector(t *testing.T) {
 	uu := map[string]struct {
 		sel, e string
@@ -40,3 +133,117 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestFmtTitle(t *testing.T) {
+	uu := map[string]struct {
+		base, info, filter string
+		count, total       int
+		e                  string
+	}{
+		"plain": {
+			base: "Nodes", count: 3, total: 3,
+			e: "[fg:bg:b] Nodes[fg:bg:-][[count:bg:b]3[fg:bg:-]][fg:bg:-] ",
+		},
+		"cluster": {
+			base: "Nodes", info: "-", count: 3, total: 3,
+			e: "[fg:bg:b] Nodes[fg:bg:-][[count:bg:b]3[fg:bg:-]][fg:bg:-] ",
+		},
+		"namespace": {
+			base: "Pods", info: "kube-system", count: 41, total: 41,
+			e: "[fg:bg:b] Pods([hilite:bg:b]kube-system[fg:bg:-])[fg:bg:-][[count:bg:b]41[fg:bg:-]][fg:bg:-] ",
+		},
+		"filter": {
+			base: "Pods", info: "kube-system", filter: "coredns", count: 3, total: 41,
+			e: "[fg:bg:b] Pods([hilite:bg:b]kube-system[fg:bg:-])[fg:bg:-][[filter:bg:r]/coredns[fg:bg:-] [count:bg:b]3/41[fg:bg:-]][fg:bg:-] ",
+		},
+		"filter-no-ns": {
+			base: "Nodes", filter: "n1", count: 1, total: 3,
+			e: "[fg:bg:b] Nodes[fg:bg:-][[filter:bg:r]/n1[fg:bg:-] [count:bg:b]1/3[fg:bg:-]][fg:bg:-] ",
+		},
+		"selector": {
+			base: "Pods", info: "default", filter: "-l app=fred", count: 2, total: 2,
+			e: "[fg:bg:b] Pods([hilite:bg:b]default[fg:bg:-])[fg:bg:-][[filter:bg:r]/app=fred[fg:bg:-] [count:bg:b]2[fg:bg:-]][fg:bg:-] ",
+		},
+		"managed-by": {
+			base: "Deployments", info: "default", filter: "managed-by:helm", count: 2, total: 2,
+			e: "[fg:bg:b] Deployments([hilite:bg:b]default[fg:bg:-])[fg:bg:-][[filter:bg:r]/managed-by:helm[fg:bg:-] [count:bg:b]2[fg:bg:-]][fg:bg:-] ",
+		},
+		"escaped": {
+			base: "Pods", filter: "fred[0-9]", count: 0, total: 5,
+			e: "[fg:bg:b] Pods[fg:bg:-][[filter:bg:r]/fred[0-9[][fg:bg:-] [count:bg:b]0/5[fg:bg:-]][fg:bg:-] ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, fmtTitle(u.base, u.info, u.filter, u.count, u.total))
+		})
+	}
+}
+
+func TestPausedMarker(t *testing.T) {
+	assert.Equal(t, "PAUSED 0s", pausedMarker(0))
+	assert.Equal(t, "PAUSED 1m12s", pausedMarker(72*time.Second+500*time.Millisecond))
+}
+
+func TestStaleMarker(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		d   time.Duration
+		e   string
+	}{
+		"unavailable": {
+			err: errors.New("pods is forbidden"),
+			e:   "DATA UNAVAILABLE (pods is forbidden)",
+		},
+		"stale": {
+			err: errors.New("pods is forbidden"),
+			d:   45*time.Second + 300*time.Millisecond,
+			e:   "DATA STALE 45s (pods is forbidden)",
+		},
+		"truncated": {
+			err: errors.New(`pods is forbidden: User "fred" cannot list resource "pods" in API group "" in the namespace "default"`),
+			d:   2 * time.Minute,
+			e:   `DATA STALE 2m0s (pods is forbidden: User "fred" cannot list resource "pods" …)`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, staleMarker(u.err, u.d))
+		})
+	}
+}
+
+func TestRxFilterDualStack(t *testing.T) {
+	data := render.TableData{
+		Header: render.HeaderRow{render.Header{Name: "NAME"}, render.Header{Name: "IP"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"a", "10.0.0.4,fd00::4"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"b", "10.0.0.5"}}},
+		},
+	}
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"v4":   {q: `10\.0\.0\.4`, e: []string{"a"}},
+		"v6":   {q: "fd00::4", e: []string{"a"}},
+		"both": {q: `10\.0\.0`, e: []string{"a", "b"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, err := rxFilter(u.q, data)
+			assert.Nil(t, err)
+			ids := make([]string, 0, len(f.RowEvents))
+			for _, re := range f.RowEvents {
+				ids = append(ids, re.Row.ID)
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ui_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -53,9 +54,88 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableSelectItem(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.SelectItem("r2")
+	v.Update(m.Peek())
+
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	v.SelectRow(1, true)
+	v.Update(m.Peek())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+}
+
+func TestTableSelectionTracking(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &trackModel{ids: []string{"r1", "r2", "r3"}}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	v.SelectRow(1, true)
+	assert.Equal(t, "r1", v.GetSelectedItem())
+
+	// Sort reorder.
+	v.SetSortCol(0, 3, false)
+	v.Update(m.Peek())
+	assert.Equal(t, 3, v.GetSelectedRowIndex())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+
+	// Row removal.
+	m.ids = []string{"r2", "r3"}
+	v.Update(m.Peek())
+	assert.Equal(t, 2, v.GetSelectedRowIndex())
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	// Filtered out selection.
+	m.ids = []string{"r1", "r2", "r3"}
+	v.SetSortCol(0, 3, true)
+	v.Update(m.Peek())
+	v.SelectRow(3, true)
+	assert.Equal(t, "r3", v.GetSelectedItem())
+	v.SearchBuff().Set("v1")
+	v.Update(m.Peek())
+	assert.Equal(t, 1, v.GetSelectedRowIndex())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+}
+
+func TestTableSelectionListener(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	var l selListener
+	v.AddSelectionListener(&l)
+	v.SelectRow(2, true)
+	assert.Equal(t, []string{"r2"}, l.items)
+
+	v.SelectRow(1, false)
+	assert.Equal(t, []string{"r2"}, l.items)
+
+	v.RemoveSelectionListener(&l)
+	v.SelectRow(2, true)
+	assert.Equal(t, []string{"r2"}, l.items)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+type selListener struct {
+	items []string
+}
+
+func (s *selListener) SelectionChanged(item string) {
+	s.items = append(s.items, item)
+}
+
 type testModel struct{}
 
 var _ ui.Tabular = &testModel{}
@@ -72,6 +152,11 @@ func (t *testModel) Get(ctx context.Context, path string) (runtime.Object, error
 }
 func (t *testModel) InNamespace(string) bool      { return true }
 func (t *testModel) SetRefreshRate(time.Duration) {}
+func (t *testModel) Refresh(context.Context)      {}
+func (t *testModel) Pause()                       {}
+func (t *testModel) Resume()                      {}
+func (t *testModel) IsPaused() bool               { return false }
+func (t *testModel) Age() time.Duration           { return 0 }
 
 func makeTableData() render.TableData {
 	t := render.NewTableData()
@@ -98,3 +183,206 @@ func makeTableData() render.TableData {
 
 	return *t
 }
+
+func TestTableWide(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &wideModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	assert.Equal(t, 3, v.GetColumnCount())
+	assert.True(t, v.ToggleWide())
+	assert.Equal(t, 4, v.GetColumnCount())
+	assert.Equal(t, "▁█", v.GetCell(1, 3).Text)
+	assert.False(t, v.ToggleWide())
+}
+
+func TestTableSortIndicator(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.SetSortCol(1, 0, true)
+	v.Update(m.Peek())
+	assert.Contains(t, v.GetCell(0, 1).Text, "↑")
+	assert.NotContains(t, v.GetCell(0, 0).Text, "↑")
+
+	v.SortColCmd(1, true)(nil)
+	assert.Contains(t, v.GetCell(0, 1).Text, "↓")
+
+	v.SortColCmd(2, true)(nil)
+	assert.Contains(t, v.GetCell(0, 2).Text, "↑")
+	assert.NotContains(t, v.GetCell(0, 1).Text, "↓")
+
+	v.SortInvertCmd(nil)
+	assert.Contains(t, v.GetCell(0, 2).Text, "↓")
+}
+
+func TestTableSortedFn(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	var count int
+	v.SetSortedFn(func() { count++ })
+
+	v.SetSortCol(1, 0, true)
+	assert.Equal(t, 0, count)
+	v.SortColCmd(2, true)(nil)
+	v.SortInvertCmd(nil)
+	assert.Equal(t, 2, count)
+}
+
+type ageModel struct {
+	testModel
+	stamp time.Time
+}
+
+func (a *ageModel) Peek() render.TableData {
+	data := makeTableData()
+	data.Header = append(data.Header, render.Header{Name: "AGE", Decorator: render.AgeDecorator})
+	for i := range data.RowEvents {
+		data.RowEvents[i].Row.Fields = append(data.RowEvents[i].Row.Fields, "1h30m0s")
+		data.RowEvents[i].Row.Stamp = a.stamp
+	}
+
+	return data
+}
+
+func TestTableAbsTime(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	stamp := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	m := &ageModel{stamp: stamp}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	assert.Equal(t, "90m", strings.TrimSpace(v.GetCell(1, 3).Text))
+
+	v.SetAbsTime(true)
+	e := stamp.Add(-90 * time.Minute).Local().Format(time.RFC3339)
+	assert.Equal(t, e, strings.TrimSpace(v.GetCell(1, 3).Text))
+	assert.Equal(t, "blee", strings.TrimSpace(v.GetCell(1, 0).Text))
+
+	v.SetAbsTime(false)
+	assert.Equal(t, "90m", strings.TrimSpace(v.GetCell(1, 3).Text))
+}
+
+type wideModel struct {
+	testModel
+}
+
+func (w *wideModel) Peek() render.TableData {
+	data := makeTableData()
+	data.Header = append(data.Header, render.Header{Name: "HIST", Wide: true})
+	for i := range data.RowEvents {
+		data.RowEvents[i].Row.Fields = append(data.RowEvents[i].Row.Fields, "▁█")
+	}
+
+	return data
+}
+
+func TestTableGroups(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &groupModel{}
+	v.SetModel(m)
+	v.SetGroupFn(func(re render.RowEvent) string {
+		return re.Row.Fields[0]
+	})
+	v.Update(m.Peek())
+
+	assert.Equal(t, 6, v.GetRowCount())
+	assert.True(t, v.IsSection(1))
+	assert.Equal(t, "» blee (1)", v.GetCell(1, 0).Text)
+	assert.Equal(t, "r2", v.GetCell(2, 0).GetReference())
+	assert.True(t, v.IsSection(3))
+	assert.Equal(t, "» zorg (2)", v.GetCell(3, 0).Text)
+	assert.Equal(t, "r1", v.GetCell(4, 0).GetReference())
+	assert.Equal(t, "r3", v.GetCell(5, 0).GetReference())
+	assert.False(t, v.IsSection(0))
+	assert.False(t, v.IsSection(2))
+
+	v.ClearSelection()
+	v.SelectFirstRow()
+	assert.Equal(t, 2, v.GetSelectedRowIndex())
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	v.SelectRow(3, true)
+	v.Update(m.Peek())
+	assert.Equal(t, 4, v.GetSelectedRowIndex())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+
+	v.SetGroupFn(nil)
+	v.Update(m.Peek())
+	assert.Equal(t, 4, v.GetRowCount())
+	assert.False(t, v.IsSection(1))
+}
+
+func TestTableRank(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &trackModel{ids: []string{"r1", "r2", "r3", "r4"}}
+	v.SetModel(m)
+	rank := map[string]int{"r3": 0, "r2": 1}
+	v.SetRankFn(func(re render.RowEvent) int {
+		if r, ok := rank[re.Row.ID]; ok {
+			return r
+		}
+		return len(rank)
+	})
+	v.Update(m.Peek())
+
+	ids := make([]string, 0, 4)
+	for r := 1; r < v.GetRowCount(); r++ {
+		ids = append(ids, v.GetCell(r, 0).GetReference().(string))
+	}
+	assert.Equal(t, []string{"r3", "r2", "r1", "r4"}, ids)
+
+	v.SetRankFn(nil)
+	v.Update(m.Peek())
+	assert.Equal(t, "r1", v.GetCell(1, 0).GetReference())
+}
+
+type trackModel struct {
+	testModel
+	ids []string
+}
+
+func (m *trackModel) Peek() render.TableData {
+	data := makeTableData()
+	data.RowEvents = data.RowEvents[:0]
+	for _, id := range m.ids {
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Row: render.Row{
+				ID:     id,
+				Fields: render.Fields{"v" + id[1:], "duh", "zorg"},
+			},
+		})
+	}
+
+	return data
+}
+
+type groupModel struct {
+	testModel
+}
+
+func (g *groupModel) Peek() render.TableData {
+	data := makeTableData()
+	data.RowEvents[0].Row.Fields[0] = "zorg"
+	data.RowEvents = append(data.RowEvents, render.RowEvent{
+		Row: render.Row{
+			ID:     "r3",
+			Fields: render.Fields{"zorg", "duh", "zorg"},
+		},
+	})
+
+	return data
+}
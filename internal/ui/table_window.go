@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+)
+
+// rowWindow tracks the table rows yet to be built into cells. Large lists
+// only build the rows coming into view, the others get built once scrolled
+// to or looked up.
+type rowWindow struct {
+	ns      string
+	header  render.HeaderRow
+	pads    MaxyPad
+	rows    []render.RowEvent
+	pending []bool
+	ids     map[string]int
+}
+
+func newRowWindow(ns string, header render.HeaderRow, pads MaxyPad, size int) *rowWindow {
+	return &rowWindow{
+		ns:      ns,
+		header:  header,
+		pads:    pads,
+		rows:    make([]render.RowEvent, 0, size),
+		pending: make([]bool, 0, size),
+		ids:     make(map[string]int, size),
+	}
+}
+
+// add registers a row to be built at a given table row.
+func (w *rowWindow) add(r int, re render.RowEvent) {
+	for len(w.rows) <= r {
+		w.rows, w.pending = append(w.rows, render.RowEvent{}), append(w.pending, false)
+	}
+	w.rows[r], w.pending[r] = re, true
+	w.ids[re.Row.ID] = r
+}
+
+// size returns the number of table rows tracked.
+func (w *rowWindow) size() int {
+	return len(w.rows)
+}
+
+// Draw builds the rows coming into view prior to drawing the table. The
+// columns are refit when the table width changed ie the terminal was resized.
+// An empty table explains why it lists no rows.
+func (t *Table) Draw(screen tcell.Screen) {
+	if _, _, w, _ := t.GetInnerRect(); w != t.width {
+		t.relayout(w)
+	}
+	t.buildVisible()
+	t.SelectTable.Table.Draw(screen)
+	t.drawEmpty(screen)
+}
+
+// buildVisible builds the displayed rows. Drawing scrolls the table to keep
+// the selection in view so the window spans from the offset or the selection
+// and extends a page either way.
+func (t *Table) buildVisible() {
+	_, _, _, h := t.GetInnerRect()
+	off, _ := t.GetOffset()
+	sel, _ := t.GetSelection()
+	from, to := off, off+h
+	if sel < from {
+		from = sel
+	}
+	if sel+h > to {
+		to = sel + h
+	}
+	t.buildRows(from-h, to+h)
+}
+
+// buildRows builds the pending rows in the given range.
+func (t *Table) buildRows(from, to int) {
+	w := t.window
+	if w == nil {
+		return
+	}
+	if from < 0 {
+		from = 0
+	}
+	if to > w.size() {
+		to = w.size()
+	}
+	for r := from; r < to; r++ {
+		if !w.pending[r] {
+			continue
+		}
+		w.pending[r] = false
+		t.buildRow(w.ns, r, w.rows[r], w.header, w.pads)
+	}
+}
+
+// fitColumns sizes the columns after the rows content and the table width.
+func (t *Table) fitColumns(pads MaxyPad, header render.HeaderRow, rr render.RowEvents, width int) {
+	ComputeMaxColumns(pads, t.sortCol.index, header, rr)
+	if t.absTime {
+		padAbsTimes(pads, header, rr)
+	}
+	t.width = width
+	t.expandCol = FitColumns(pads, header, t.wide, width)
+	for col := range header {
+		t.GetCell(0, col).SetExpansion(t.expansion(col))
+	}
+}
+
+// relayout refits the columns to a new table width. The rows are rebuilt once
+// displayed to pick up the new column widths.
+func (t *Table) relayout(width int) {
+	w := t.window
+	if w == nil {
+		t.width = width
+		return
+	}
+	t.fitColumns(w.pads, w.header, w.rows, width)
+	for r := 1; t.sections > 0 && r < w.size(); r++ {
+		if !t.IsSection(r) {
+			continue
+		}
+		for col := range w.header {
+			t.GetCell(r, col).SetExpansion(t.expansion(col))
+		}
+	}
+	for _, r := range w.ids {
+		w.pending[r] = true
+	}
+}
@@ -0,0 +1,277 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableWindowBuild(t *testing.T) {
+	v, s := makeWindowTable(t, 1000, 20)
+	defer s.Fini()
+
+	assert.Equal(t, 1001, v.GetRowCount())
+	pending := countPending(v.window)
+	assert.True(t, pending > 900, "expected most rows to be pending, got %d", pending)
+
+	v.Draw(s)
+	assert.True(t, countPending(v.window) > pending-3*20)
+	for r := 1; r <= 20; r++ {
+		assert.False(t, v.window.pending[r])
+	}
+	assert.Equal(t, "default/p00500", v.GetCell(501, 0).GetReference())
+	assert.Contains(t, v.styleTitle(), "1000")
+}
+
+func TestTableWindowSelectItem(t *testing.T) {
+	v, s := makeWindowTable(t, 10000, 20)
+	defer s.Fini()
+
+	v.SelectItem("default/p09000")
+	v.Update(makeWindowData(10000))
+	assert.Equal(t, 9001, v.GetSelectedRowIndex())
+	assert.Equal(t, "default/p09000", v.selectedID())
+
+	v.Draw(s)
+	assertNoBlanks(t, v, s)
+
+	// Selection follows the item once rows come and go.
+	data := makeWindowData(10000)
+	data.RowEvents = data.RowEvents[10:]
+	v.Update(data)
+	assert.Equal(t, 8991, v.GetSelectedRowIndex())
+	assert.Equal(t, "default/p09000", v.selectedID())
+}
+
+func TestTableWindowSorted(t *testing.T) {
+	v, s := makeWindowTable(t, 1000, 20)
+	defer s.Fini()
+
+	v.SetSortCol(0, 3, false)
+	v.Update(makeWindowData(1000))
+	v.Draw(s)
+	assert.Equal(t, "default/p00999", v.GetCell(1, 0).GetReference())
+	v.ScrollToEnd()
+	v.Draw(s)
+	assert.Equal(t, "default/p00000", v.GetCell(1000, 0).GetReference())
+	assertNoBlanks(t, v, s)
+}
+
+func TestTableWindowScroll(t *testing.T) {
+	uu := map[string]struct {
+		rows int
+		keys []tcell.Key
+	}{
+		"down": {
+			rows: 100,
+			keys: repeatKeys(tcell.KeyDown, 60),
+		},
+		"pageDown": {
+			rows: 1000,
+			keys: repeatKeys(tcell.KeyPgDn, 60),
+		},
+		"pageUpFromEnd": {
+			rows: 1000,
+			keys: append([]tcell.Key{tcell.KeyEnd}, repeatKeys(tcell.KeyPgUp, 60)...),
+		},
+		"upFromEnd": {
+			rows: 100,
+			keys: append([]tcell.Key{tcell.KeyEnd}, repeatKeys(tcell.KeyUp, 60)...),
+		},
+		"bounce": {
+			rows: 100,
+			keys: []tcell.Key{tcell.KeyEnd, tcell.KeyHome, tcell.KeyPgDn, tcell.KeyEnd, tcell.KeyUp, tcell.KeyPgUp, tcell.KeyHome},
+		},
+		"fewRows": {
+			rows: 5,
+			keys: []tcell.Key{tcell.KeyEnd, tcell.KeyPgUp, tcell.KeyPgDn},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, s := makeWindowTable(t, u.rows, 20)
+			defer s.Fini()
+
+			v.Draw(s)
+			assertNoBlanks(t, v, s)
+			h := v.InputHandler()
+			for _, key := range u.keys {
+				h(tcell.NewEventKey(key, 0, tcell.ModNone), func(tview.Primitive) {})
+				v.Draw(s)
+				assertNoBlanks(t, v, s)
+			}
+		})
+	}
+}
+
+func TestTableWindowGroups(t *testing.T) {
+	v, s := makeWindowTable(t, 0, 20)
+	defer s.Fini()
+
+	v.SetGroupFn(func(re render.RowEvent) string {
+		return re.Row.Fields[1]
+	})
+	v.Update(makeWindowData(1000))
+	assert.Equal(t, 1003, v.GetRowCount())
+	assert.True(t, v.IsSection(1))
+
+	h := v.InputHandler()
+	for i := 0; i < 80; i++ {
+		h(tcell.NewEventKey(tcell.KeyPgDn, 0, tcell.ModNone), func(tview.Primitive) {})
+		v.Draw(s)
+		assertNoBlanks(t, v, s)
+		assert.False(t, v.IsSection(v.GetSelectedRowIndex()))
+	}
+}
+
+func BenchmarkTableUpdate(b *testing.B) {
+	for _, n := range []int{1000, 10000, 50000} {
+		for _, eager := range []bool{true, false} {
+			b.Run(benchName(n, eager), func(b *testing.B) {
+				v, s := makeWindowTable(b, 0, 50)
+				defer s.Fini()
+				data := makeWindowData(n)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					v.Update(data)
+					if eager {
+						v.buildRows(0, v.window.size())
+					}
+					v.Draw(s)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkTableScroll(b *testing.B) {
+	for _, n := range []int{1000, 10000, 50000} {
+		for _, eager := range []bool{true, false} {
+			b.Run(benchName(n, eager), func(b *testing.B) {
+				v, s := makeWindowTable(b, n, 50)
+				defer s.Fini()
+				if eager {
+					v.buildRows(0, v.window.size())
+				}
+				v.Draw(s)
+				h := v.InputHandler()
+				pgDn := tcell.NewEventKey(tcell.KeyPgDn, 0, tcell.ModNone)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if v.GetSelectedRowIndex() >= n {
+						v.ScrollToBeginning()
+					}
+					h(pgDn, func(tview.Primitive) {})
+					v.Draw(s)
+				}
+			})
+		}
+	}
+}
+
+// Helpers...
+
+func benchName(n int, eager bool) string {
+	if eager {
+		return fmt.Sprintf("%d/eager", n)
+	}
+	return fmt.Sprintf("%d/window", n)
+}
+
+func repeatKeys(k tcell.Key, n int) []tcell.Key {
+	kk := make([]tcell.Key, n)
+	for i := range kk {
+		kk[i] = k
+	}
+
+	return kk
+}
+
+func countPending(w *rowWindow) int {
+	var count int
+	for _, p := range w.pending {
+		if p {
+			count++
+		}
+	}
+
+	return count
+}
+
+func makeWindowTable(t testing.TB, rows, height int) (*Table, tcell.SimulationScreen) {
+	s := tcell.NewSimulationScreen("UTF-8")
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+	s.SetSize(80, height+2)
+
+	v := NewTable("fred")
+	v.Init(context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles()))
+	v.SetRect(0, 0, 80, height+2)
+	if rows > 0 {
+		v.Update(makeWindowData(rows))
+	}
+
+	return v, s
+}
+
+func makeWindowData(n int) render.TableData {
+	data := render.NewTableData()
+	data.Namespace = "default"
+	data.Header = render.HeaderRow{
+		render.Header{Name: "NAME"},
+		render.Header{Name: "STATUS"},
+		render.Header{Name: "RESTARTS", Align: tview.AlignRight},
+	}
+	data.RowEvents = make(render.RowEvents, 0, n)
+	for i := 0; i < n; i++ {
+		status := "Running"
+		if i%2 == 1 {
+			status = "Pending"
+		}
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Kind: render.EventAdd,
+			Row: render.Row{
+				ID:     fmt.Sprintf("default/p%05d", i),
+				Fields: render.Fields{fmt.Sprintf("p%05d", i), status, fmt.Sprintf("%d", i%7)},
+			},
+		})
+	}
+
+	return *data
+}
+
+// assertNoBlanks checks the table lines on screen all display a row.
+func assertNoBlanks(t *testing.T, v *Table, s tcell.SimulationScreen) {
+	x, y, w, h := v.GetInnerRect()
+	lines := h
+	if rows := v.GetRowCount(); rows < lines {
+		lines = rows
+	}
+	s.Show()
+	cc, sw, _ := s.GetContents()
+	for l := 0; l < lines; l++ {
+		var line strings.Builder
+		for c := x; c < x+w; c++ {
+			line.WriteString(string(cc[(y+l)*sw+c].Runes))
+		}
+		assert.NotEmpty(t, strings.TrimSpace(line.String()), "blank line %d at offset %d", l, rowOffset(v))
+	}
+}
+
+func rowOffset(v *Table) int {
+	r, _ := v.GetOffset()
+	return r
+}
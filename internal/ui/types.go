@@ -49,6 +49,21 @@ type Tabular interface {
 	// Watch watches a given resource for changes.
 	Watch(context.Context)
 
+	// Refresh updates the model now, even when paused.
+	Refresh(context.Context)
+
+	// Pause holds off the model periodic updates.
+	Pause()
+
+	// Resume restarts the model periodic updates.
+	Resume()
+
+	// IsPaused checks if the model periodic updates are on hold.
+	IsPaused() bool
+
+	// Age returns how long ago the model data was refreshed.
+	Age() time.Duration
+
 	// SetRefreshRate sets the model watch loop rate.
 	SetRefreshRate(time.Duration)
 
@@ -0,0 +1,141 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/rs/zerolog/log"
+)
+
+// listNS returns the namespace permissions are checked against.
+func listNS(app *App, namespaced bool) string {
+	if !namespaced {
+		return client.AllNamespaces
+	}
+
+	return app.Config.ActiveNamespace()
+}
+
+// permissionRes returns the resource an action permission applies to.
+func permissionRes(gvr string, p ui.Permission) string {
+	res := gvr
+	if p.Resource != "" {
+		res = p.Resource
+	}
+	if p.SubResource != "" {
+		res += ":" + p.SubResource
+	}
+
+	return res
+}
+
+// pruneActions removes the actions the user is known not to be permitted to
+// perform. Unchecked permissions are reviewed in the background and done is
+// called on the UI goroutine once the actions are pruned accordingly.
+func pruneActions(app *App, gvr string, namespaced bool, aa ui.KeyActions, done func()) {
+	ns := listNS(app, namespaced)
+	if unknown := prune(app, ns, gvr, aa); len(unknown) > 0 {
+		go func() {
+			for _, p := range unknown {
+				res := permissionRes(gvr, p)
+				if _, err := app.access.Can(ns, res, []string{p.Verb}); err != nil {
+					log.Warn().Err(err).Msgf("Access check failed for %s", res)
+				}
+			}
+			app.QueueUpdateDraw(func() {
+				prune(app, ns, gvr, aa)
+				done()
+			})
+		}()
+	}
+}
+
+// prune removes the actions known to be denied and returns the permissions
+// yet to be checked.
+func prune(app *App, ns, gvr string, aa ui.KeyActions) []ui.Permission {
+	var unknown []ui.Permission
+	for k, a := range aa {
+		p := a.Permission
+		if p.Verb == "" {
+			continue
+		}
+		res := permissionRes(gvr, p)
+		can, known := app.access.Known(ns, res, []string{p.Verb})
+		if !known {
+			unknown = append(unknown, p)
+			continue
+		}
+		if !can {
+			log.Debug().Msgf("Hiding %q on %s. No %s access", a.Description, res, p.Verb)
+			delete(aa, k)
+		}
+	}
+
+	return unknown
+}
+
+// listDenied checks if the user can't list the given resource. Dial failures
+// are left to the resource view to surface.
+func listDenied(app *App, gvr string, cached bool) (string, bool) {
+	meta, err := dao.MetaFor(client.NewGVR(gvr))
+	if err != nil || dao.IsK9sMeta(meta) {
+		return "", false
+	}
+	ns := listNS(app, meta.Namespaced)
+	if cached {
+		ok, known := app.access.Known(ns, gvr, dao.ListVerbs)
+		return ns, known && !ok
+	}
+	ok, err := app.access.CanList(ns, gvr)
+	if err != nil {
+		log.Warn().Err(err).Msgf("List access check failed for %s", gvr)
+		return "", false
+	}
+
+	return ns, !ok
+}
+
+// noAccess returns a report if the user can't list the given resource.
+func noAccess(app *App, ns, gvr string) *Details {
+	res := client.NewGVR(gvr).ToR()
+	return NewDetails(app, "No Access", res).Update(noAccessReport(app, ns, gvr))
+}
+
+func noAccessReport(app *App, ns, gvr string) string {
+	missing := make([]string, 0, len(dao.ListVerbs))
+	for _, v := range dao.ListVerbs {
+		if ok, known := app.access.Known(ns, gvr, []string{v}); known && !ok {
+			missing = append(missing, v)
+		}
+	}
+	if ns == client.AllNamespaces {
+		ns = "all"
+	}
+	ctx, err := app.Conn().Config().CurrentContextName()
+	if err != nil {
+		log.Warn().Err(err).Msg("No current context")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: no list access\n")
+	fmt.Fprintf(&b, "resource: %s\n", gvr)
+	fmt.Fprintf(&b, "namespace: %s\n", ns)
+	fmt.Fprintf(&b, "context: %s\n", ctx)
+	fmt.Fprintf(&b, "missing: [%s]\n", strings.Join(missing, ", "))
+	fmt.Fprintf(&b, "hint: once access is granted, run :rbac-refresh to reload permissions\n")
+
+	return b.String()
+}
+
+// rbacRefresh clears the cached permissions for the current context.
+func rbacRefresh(app *App) {
+	app.access.Reset()
+	ctx, err := app.Conn().Config().CurrentContextName()
+	if err != nil {
+		log.Warn().Err(err).Msg("No current context")
+	}
+	app.Flash().Infof("Permissions reloaded for context %s", ctx)
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionRes(t *testing.T) {
+	uu := map[string]struct {
+		p ui.Permission
+		e string
+	}{
+		"viewed": {
+			p: ui.Permission{Verb: "delete"},
+			e: "apps/v1/replicasets",
+		},
+		"subResource": {
+			p: ui.Permission{SubResource: "scale", Verb: "update"},
+			e: "apps/v1/replicasets:scale",
+		},
+		"other": {
+			p: ui.Permission{Resource: "apps/v1/deployments", Verb: "patch"},
+			e: "apps/v1/deployments",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, permissionRes("apps/v1/replicasets", u.p))
+		})
+	}
+}
@@ -25,8 +25,8 @@ func NewAlias(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewBrowser(gvr),
 	}
 	a.GetTable().SetColorerFn(render.Alias{}.ColorerFunc())
-	a.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
-	a.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	a.GetTable().SetBorderFocusColor(render.Accent(tcell.ColorMediumSpringGreen))
+	a.GetTable().SetSelectedStyle(render.Selection(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone))
 	a.SetBindKeysFn(a.bindKeys)
 	a.SetContextFn(a.aliasContext)
 
@@ -34,6 +34,7 @@ func NewAlias(gvr client.GVR) ResourceViewer {
 }
 
 func (a *Alias) aliasContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyAccess, a.App().access)
 	return context.WithValue(ctx, internal.KeyAliases, a.App().command.alias)
 }
 
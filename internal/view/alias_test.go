@@ -111,6 +111,11 @@ func (t *testModel) Get(ctx context.Context, path string) (runtime.Object, error
 }
 func (t *testModel) InNamespace(string) bool      { return true }
 func (t *testModel) SetRefreshRate(time.Duration) {}
+func (t *testModel) Refresh(context.Context)      {}
+func (t *testModel) Pause()                       {}
+func (t *testModel) Resume()                      {}
+func (t *testModel) IsPaused() bool               { return false }
+func (t *testModel) Age() time.Duration           { return 0 }
 
 func makeTableData() render.TableData {
 	return render.TableData{
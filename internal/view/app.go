@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/status"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
@@ -34,15 +40,90 @@ type App struct {
 	version    string
 	showHeader bool
 	cancelFn   context.CancelFunc
+	benchFn    context.CancelFunc
+	stylesFn   context.CancelFunc
+	benchmarks *perf.Benchmarks
+	history    *perf.History
+	pins       *model.Pins
+	snapshots  *model.Snapshots
+	notifier   *notifier
+	dock       *Dock
+
+	// groupForwards tracks the port-forwards grouping mode for the session.
+	groupForwards bool
+
+	// managedForwards tracks the cluster configured port-forwards.
+	managedForwards *dao.ManagedForwards
+
+	// auditLog records the mutations performed through K9s.
+	auditLog *dao.AuditLog
+
+	// access caches the user permissions per context.
+	access *dao.Access
+
+	// mouse tracks clicks when mouse support is on.
+	mouse mouseState
+
+	// absTime shows time columns as absolute timestamps.
+	absTime bool
+
+	// status serves the forwards and benchmarks state when configured.
+	status *status.Server
+
+	// statusSnap holds the statusState served off the UI goroutine.
+	statusSnap atomic.Value
+
+	// logsDump tracks the namespace logs dump in progress if any.
+	logsDump *logsDump
+
+	// restarts tallies pods restarts to flag restart storms.
+	restarts *model.RestartTracker
+
+	// events buffers the cluster events shared by the events views.
+	events *model.EventBuffer
+
+	// viewed tracks the resources backing the stacked views.
+	viewed *viewedGVRs
+
+	// unlocks tracks the protected namespaces unlocked by a typed confirmation.
+	unlocks *nsUnlocks
+
+	// restartsNS tracks the namespace restarts are watched in.
+	restartsNS string
+
+	// session tracks the last saved session state.
+	session *config.Session
+
+	// sessionTimer debounces session saves.
+	sessionTimer *time.Timer
+
+	// sessionReady indicates the session state can be saved once the resume
+	// prompt is settled.
+	sessionReady bool
+
+	// quitting tracks a pending quit confirmation.
+	quitting bool
+
+	// bailOnce guards the shutdown sequence.
+	bailOnce sync.Once
 }
 
 // NewApp returns a K9s app instance.
 func NewApp(cfg *config.Config) *App {
 	a := App{
-		App:     ui.NewApp(cfg.K9s.CurrentCluster),
-		Content: NewPageStack(),
+		App:        ui.NewApp(cfg.K9s.CurrentCluster),
+		Content:    NewPageStack(),
+		benchmarks: perf.NewBenchmarks(),
+		history:    perf.NewHistory(),
 	}
 	a.Config = cfg
+	a.pins = model.NewPins(a.pinChanged)
+	a.snapshots = model.NewSnapshots()
+	a.restarts = model.NewRestartTracker(cfg.K9s.RestartStorm.GetWindow())
+	a.events = model.NewEventBuffer(model.DefaultEventsCap)
+	a.unlocks = newNSUnlocks()
+	a.notifier = newNotifier()
+	a.auditLog = dao.NewAuditLog(a.auditFailed)
 	a.InitBench(cfg.K9s.CurrentCluster)
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -61,49 +142,98 @@ func (a *App) Init(version string, rate int) error {
 	}
 	a.Content.Stack.AddListener(a.Crumbs())
 	a.Content.Stack.AddListener(a.Menu())
+	a.viewed = newViewedGVRs(a.Content.Stack)
+	a.Content.Stack.AddListener(a.viewed)
+	a.Content.Stack.AddListener(sessionTracker{app: a})
 
 	a.App.Init()
 	a.bindKeys()
+	a.absTime = a.Config.K9s.AbsoluteTime
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
 	}
-	ns, err := a.Conn().Config().CurrentNamespaceName()
-	if err != nil {
-		log.Info().Msg("No namespace specified using all namespaces")
-	}
+	a.deprecations().AddListener(a)
 
 	a.factory = watch.NewFactory(a.Conn())
-	a.initFactory(ns)
-
+	a.factory.SetMaxInformers(a.Config.K9s.GetMaxInformers())
+	a.factory.SetInUseFunc(a.backsView)
+	a.access = dao.NewAccess(a.factory)
+	a.initManagedForwards()
 	a.command = NewCommand(a)
-	if err := a.command.Init(); err != nil {
-		return err
-	}
-
-	a.clusterInfo().Init(version)
-	if a.Config.K9s.GetHeadless() {
-		a.refreshIndicator()
-	}
+	a.kubeConfigWarnings()
 
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	main.AddItem(a.Content, 0, dockScale, true)
 	main.AddItem(a.Crumbs(), 2, 1, false)
 	main.AddItem(a.Flash(), 2, 1, false)
 
+	a.dock = NewDock(a)
+	a.Styles.AddListener(a.dock)
+
 	a.Main.AddPage("main", main, true, false)
 	a.Main.AddPage("splash", ui.NewSplash(a.Styles, version), true, true)
+
+	return nil
+}
+
+// bootstrap connects the app to the cluster once the api server is reachable.
+func (a *App) bootstrap() error {
+	// Validating the config lists the namespaces thus waits on the health gate.
+	if err := a.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save")
+	}
+	ns, err := a.Conn().Config().CurrentNamespaceName()
+	if err != nil {
+		log.Info().Msg("No namespace specified using all namespaces")
+	}
+	a.initFactory(ns)
+	if err := a.command.Init(); err != nil {
+		return err
+	}
+	a.clusterInfo().Init(a.version)
 	a.toggleHeader(!a.Config.K9s.GetHeadless())
+	if err := a.command.defaultCmd(); err != nil {
+		return err
+	}
+	a.offerResume()
 
 	return nil
 }
 
+// healthGate shows the cluster health screen and defers the bootstrap until
+// the api server is reachable. App level keys are off while the gate is up.
+func (a *App) healthGate(err error) {
+	capture := a.GetInputCapture()
+	a.SetInputCapture(nil)
+
+	var h *Health
+	h = NewHealth(a, err, func() {
+		h.Stop()
+		a.SetInputCapture(capture)
+		a.Main.RemovePage(healthPage)
+		a.Main.SwitchToPage("main")
+		if err := a.bootstrap(); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+	a.Main.AddPage(healthPage, h, true, false)
+	h.Start()
+}
+
 func (a *App) bindKeys() {
 	a.AddActions(ui.KeyActions{
 		ui.KeyH:        ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
 		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
 		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyCtrlB: ui.NewSharedKeyAction("Toggle Dock", a.dockCmd, false),
+		tcell.KeyCtrlG: ui.NewSharedKeyAction("Toggle Time", a.timeCmd, false),
+		tcell.KeyCtrlE: ui.NewSharedKeyAction("Errors", a.errorsCmd, false),
+		tcell.KeyCtrlO: ui.NewSharedKeyAction("Restart Storm", a.stormProblemsCmd, false),
+		tcell.KeyCtrlY: ui.NewSharedKeyAction("Dismiss Storm", a.dismissStormCmd, false),
+		tcell.KeyCtrlP: ui.NewSharedKeyAction("Command Palette", a.paletteCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlC: ui.NewKeyAction("Quit", a.quitCmd, false),
 	})
 }
 
@@ -128,6 +258,55 @@ func (a *App) toggleHeader(flag bool) {
 	}
 }
 
+func (a *App) mainFlex() *tview.Flex {
+	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
+	if !ok {
+		log.Fatal().Msg("Expecting valid flex view")
+	}
+	return flex
+}
+
+func (a *App) openDock() {
+	flex := a.mainFlex()
+	for i := 0; flex.ItemAt(i) != nil; i++ {
+		if flex.ItemAt(i) == a.Content {
+			flex.AddItemAtIndex(i+1, a.dock, 0, a.dock.Size(), false)
+			break
+		}
+	}
+	a.resizeDock()
+	a.AddActions(ui.KeyActions{
+		tcell.KeyTab: ui.NewSharedKeyAction("Switch Pane", a.dockFocusCmd, false),
+	})
+	a.Content.Stack.AddListener(a.dock)
+	a.dock.Open(a.Content.Top())
+}
+
+func (a *App) closeDock() {
+	if !a.dock.IsOpen() {
+		return
+	}
+	a.Content.Stack.RemoveListener(a.dock)
+	a.dock.Close()
+	a.GetActions().Delete(tcell.KeyTab)
+	flex := a.mainFlex()
+	flex.RemoveItem(a.dock)
+	flex.ResizeItem(a.Content, 0, dockScale)
+	a.focusContent()
+}
+
+func (a *App) resizeDock() {
+	flex := a.mainFlex()
+	flex.ResizeItem(a.Content, 0, dockScale-a.dock.Size())
+	flex.ResizeItem(a.dock, 0, a.dock.Size())
+}
+
+func (a *App) focusContent() {
+	if top := a.Content.Top(); top != nil {
+		a.SetFocus(top)
+	}
+}
+
 func (a *App) buildHeader() tview.Primitive {
 	header := tview.NewFlex()
 	header.SetBackgroundColor(a.Styles.BgColor())
@@ -138,6 +317,8 @@ func (a *App) buildHeader() tview.Primitive {
 	}
 	header.AddItem(a.clusterInfo(), 40, 1, false)
 	header.AddItem(a.Menu(), 0, 1, false)
+	header.AddItem(a.Banner(), a.Banner().Width(), 0, false)
+	header.AddItem(a.ErrBadge(), 8, 1, false)
 	header.AddItem(a.Logo(), 26, 1, false)
 
 	return header
@@ -161,19 +342,34 @@ func (a *App) Resume() {
 }
 
 func (a *App) clusterUpdater(ctx context.Context) {
+	a.reconcileForwards()
 	for {
 		select {
 		case <-ctx.Done():
 			log.Debug().Msg("Cluster updater canceled!")
 			return
 		case <-time.After(clusterRefresh):
+			a.reconcileForwards()
 			a.QueueUpdateDraw(func() {
 				a.refreshClusterInfo()
+				a.reapForwarders()
+				a.checkRestartStorm()
+				a.saveSession()
 			})
 		}
 	}
 }
 
+// reapForwarders stops port-forwards past their TTL or idle timeout.
+func (a *App) reapForwarders() {
+	if a.factory == nil {
+		return
+	}
+	for path, reason := range a.factory.Forwarders().Reap() {
+		a.Flash().Warnf("PortForward %s stopped -- %s", path, reason)
+	}
+}
+
 // BOZO!! Refact to use model/view strategy.
 func (a *App) refreshClusterInfo() {
 	if !a.showHeader {
@@ -227,6 +423,7 @@ func (a *App) switchNS(ns string) bool {
 		return false
 	}
 	a.factory.SetActiveNS(ns)
+	a.touchSession()
 
 	return true
 }
@@ -237,6 +434,18 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 	a.Halt()
 	defer a.Resume()
 	{
+		a.session = nil
+		a.benchmarks.CancelAll()
+		a.pins.Clear()
+		a.snapshots.Clear()
+		a.closeDock()
+		a.deprecations().Clear()
+		a.Conn().Config().Stats().Reset()
+		a.restarts.Reset()
+		a.restartsNS = ""
+		a.events.Reset()
+		a.unlocks.Reset()
+		a.showStorm("")
 		ns, err := a.Conn().Config().CurrentNamespaceName()
 		if err != nil {
 			log.Warn().Msg("No namespace specified in context. Using K9s config")
@@ -250,6 +459,9 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 		if err := a.Config.Save(); err != nil {
 			log.Error().Err(err).Msg("Config save failed!")
 		}
+		a.initManagedForwards()
+		a.InitBench(a.Config.K9s.CurrentCluster)
+		a.watchBench()
 		a.Flash().Infof("Switching context to %s", name)
 		if err := a.gotoResource("pods", true); loadPods && err != nil {
 			a.Flash().Err(err)
@@ -261,35 +473,93 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 	return nil
 }
 
+// switchKubeConfig reconnects to the current context of another kubeconfig.
+func (a *App) switchKubeConfig(path string) error {
+	if err := a.Conn().SwitchKubeConfig(path); err != nil {
+		return err
+	}
+	ctx, err := a.Conn().Config().CurrentContextName()
+	if err != nil {
+		return err
+	}
+	if err := a.switchCtx(ctx, true); err != nil {
+		return err
+	}
+	a.Flash().Infof("Using kubeconfig %s with context %s", path, ctx)
+	a.kubeConfigWarnings()
+
+	return nil
+}
+
+// kubeConfigWarnings reports kubeconfig files that failed to load.
+func (a *App) kubeConfigWarnings() {
+	ee := a.Conn().Config().LoadWarnings()
+	if len(ee) == 0 {
+		return
+	}
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, e.Error())
+	}
+	a.Flash().Warnf("Kubeconfig load failed: %s", strings.Join(ss, "; "))
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
 }
 
-// BailOut exists the application.
+// watchBench tracks benchmark config changes for the current cluster.
+func (a *App) watchBench() {
+	if a.benchFn != nil {
+		a.benchFn()
+	}
+	var ctx context.Context
+	ctx, a.benchFn = context.WithCancel(context.Background())
+	if err := a.BenchUpdater(ctx, a, a.Config.K9s.CurrentCluster); err != nil {
+		log.Warn().Err(err).Msg("Unable to track benchmark config changes")
+	}
+}
+
+// BailOut exists the application once background activities are stopped.
 func (a *App) BailOut() {
-	a.factory.Terminate()
-	a.App.BailOut()
+	a.bailOnce.Do(func() {
+		a.shutdown()
+		a.App.BailOut()
+	})
 }
 
 // Run starts the application loop
 func (a *App) Run() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	var ctx context.Context
+	ctx, a.stylesFn = context.WithCancel(context.Background())
 	a.Halt()
 
 	if err := a.StylesUpdater(ctx, a); err != nil {
 		log.Error().Err(err).Msg("Unable to track skin changes")
 	}
+	a.watchBench()
+	a.startStatus()
+
+	if err := a.Conn().CheckConnectivity(); err != nil {
+		log.Error().Err(err).Msg("Unable to connect to api server")
+		a.healthGate(err)
+	} else if err := a.bootstrap(); err != nil {
+		panic(err)
+	}
 
 	go func() {
 		<-time.After(splashTime * time.Second)
 		a.QueueUpdateDraw(func() {
+			if a.Main.HasPage(healthPage) {
+				a.Main.SwitchToPage(healthPage)
+				return
+			}
 			a.Main.SwitchToPage("main")
 		})
 	}()
 
-	if err := a.command.defaultCmd(); err != nil {
+	if err := a.InitMouse(a.mouseEvent, a.Config.K9s.EnableMouse); err != nil {
 		panic(err)
 	}
 	if err := a.Application.Run(); err != nil {
@@ -344,13 +614,72 @@ func (a *App) setIndicator(l ui.FlashLevel, msg string) {
 
 // PrevCmd pops the command stack.
 func (a *App) PrevCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if !a.Content.IsLast() {
-		a.Content.Pop()
+	a.Content.back()
+
+	return nil
+}
+
+func (a *App) dockCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+	if a.dock.IsOpen() {
+		a.closeDock()
+	} else {
+		a.openDock()
+	}
+
+	return nil
+}
+
+func (a *App) timeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+	a.absTime = !a.absTime
+	if v, ok := a.Content.Top().(interface{ GetTable() *Table }); ok {
+		v.GetTable().SetAbsTime(a.absTime)
+	}
+	if a.absTime {
+		a.Flash().Info("Showing absolute timestamps")
+	} else {
+		a.Flash().Info("Showing relative times")
+	}
+
+	return nil
+}
+
+func (a *App) dockFocusCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+	if a.dock.HasFocus() {
+		a.focusContent()
+	} else {
+		a.SetFocus(a.dock)
 	}
 
 	return nil
 }
 
+func (a *App) errorsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.showErrors()
+
+	return nil
+}
+
+// showErrors lists the past error messages and acknowledges them.
+func (a *App) showErrors() {
+	a.Flash().AckErrs()
+	details := NewDetails(a, "Errors", "history").Update(flashHistory(errHistory(a.Flash().History())))
+	if err := a.inject(details); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
 func (a *App) toggleHeaderCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if a.Cmd().InCmdMode() {
 		return evt
@@ -383,7 +712,7 @@ func (a *App) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 	if a.Content.Top() != nil && a.Content.Top().Name() == helpTitle {
-		a.Content.Pop()
+		a.Content.back()
 		return nil
 	}
 
@@ -400,7 +729,7 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	if a.Content.Top() != nil && a.Content.Top().Name() == aliasTitle {
-		a.Content.Pop()
+		a.Content.back()
 		return nil
 	}
 
@@ -411,6 +740,52 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// pinChanged reports changes on a watched resource.
+func (a *App) pinChanged(p model.Pin, cc []string) {
+	msg := fmt.Sprintf("%s %s: %s", client.NewGVR(p.GVR).ToR(), p.Path, strings.Join(cc, ", "))
+	a.alert(ui.FlashWarn, msg)
+}
+
+// backsView checks if a resource is displayed in a view or pinned.
+func (a *App) backsView(gvr string) bool {
+	if a.viewed.Has(gvr) {
+		return true
+	}
+	for _, p := range a.pins.List() {
+		if p.GVR == gvr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// alert flashes a message and dispatches it to the configured notifiers.
+func (a *App) alert(level ui.FlashLevel, msg string) {
+	a.QueueUpdateDraw(func() {
+		a.Flash().SetMessage(level, msg)
+	})
+	evt := notifyEvent{Message: msg, Context: a.Config.K9s.CurrentContext}
+	if err := a.notifier.notify(a.Config.K9s.Notifications, level, evt); err != nil {
+		log.Warn().Err(err).Msg("Notify failed")
+	}
+}
+
+// DeprecationAdded reports a deprecated api in use.
+func (a *App) DeprecationAdded(d client.Deprecation) {
+	msg := fmt.Sprintf("Deprecated API %s in use. Use %s instead", d.GVR, d.Replacement)
+	a.QueueUpdateDraw(func() {
+		a.Flash().Warn(msg)
+	})
+}
+
+func (a *App) deprecations() *client.Deprecations {
+	if a.Conn() == nil || a.Conn().Config() == nil {
+		return nil
+	}
+	return a.Conn().Config().Deprecations()
+}
+
 func (a *App) gotoResource(res string, clearStack bool) error {
 	return a.command.run(res, clearStack)
 }
@@ -420,6 +795,9 @@ func (a *App) inject(c model.Component) error {
 	if err := c.Init(ctx); err != nil {
 		return fmt.Errorf("component init failed for %q %v", c.Name(), err)
 	}
+	if top := a.Content.Top(); top != nil {
+		a.Content.track(c, a.originOf(top))
+	}
 	a.Content.Push(c)
 
 	return nil
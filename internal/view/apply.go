@@ -0,0 +1,114 @@
+package view
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+func showApply(app *App, path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	ns := app.Config.ActiveNamespace()
+	rr, err := dao.ApplyManifests(app.factory, raw, ns, true)
+	if err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("Dry run %s\n\n%s\n\nApply %s?", applySummary(rr), applyReport(rr), path)
+	dialog.ShowConfirm(app.Content.Pages, "Confirm Apply", msg, func() {
+		if err := app.checkApply(rr); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		rr, err := dao.ApplyManifests(app.factory, raw, ns, false)
+		if err != nil {
+			app.audit("Apply", "manifests", path, err)
+			app.Flash().Err(err)
+			return
+		}
+		for _, r := range rr {
+			app.audit("Apply", r.GVR, r.Path, r.Err)
+		}
+		applied(app, path, rr)
+	}, func() {})
+
+	return nil
+}
+
+// checkApply vets the dry run targets against the protected namespaces.
+func (a *App) checkApply(rr []dao.ApplyResult) error {
+	for _, r := range rr {
+		if ns := targetNamespace(r.GVR, r.Path, ""); a.isLocked(ns) {
+			err := fmt.Errorf("namespace %s is protected", ns)
+			a.audit("Apply", r.GVR, r.Path, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applied reports the apply outcome and shows the first applied resource.
+func applied(app *App, path string, rr []dao.ApplyResult) {
+	if applyErrors(rr) > 0 {
+		app.Flash().Warnf("Apply %s", applySummary(rr))
+		details := NewDetails(app, "Apply", path).Update(applyReport(rr))
+		if err := app.inject(details); err != nil {
+			app.Flash().Err(err)
+		}
+		return
+	}
+	app.Flash().Infof("Apply %s", applySummary(rr))
+	if len(rr) == 0 {
+		return
+	}
+	if err := app.command.showItem(rr[0].GVR, rr[0].Path); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func applyErrors(rr []dao.ApplyResult) int {
+	var count int
+	for _, r := range rr {
+		if r.Err != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
+// applySummary tallies results by status.
+func applySummary(rr []dao.ApplyResult) string {
+	counts := make(map[string]int, 4)
+	for _, r := range rr {
+		counts[r.Status]++
+	}
+
+	return fmt.Sprintf("%d created, %d configured, %d unchanged, %d failed",
+		counts[dao.ApplyCreated],
+		counts[dao.ApplyConfigured],
+		counts[dao.ApplyUnchanged],
+		counts[dao.ApplyFailed],
+	)
+}
+
+// applyReport lists each document status and error.
+func applyReport(rr []dao.ApplyResult) string {
+	ss := make([]string, 0, len(rr))
+	for _, r := range rr {
+		s := fmt.Sprintf("%-10s %s", r.Status, r.Name)
+		if r.Err != nil {
+			s += ": " + r.Err.Error()
+		}
+		ss = append(ss, s)
+	}
+
+	return strings.Join(ss, "\n")
+}
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyReport(t *testing.T) {
+	rr := []dao.ApplyResult{
+		{Name: "ConfigMap default/cm1", Status: dao.ApplyCreated},
+		{Name: "ConfigMap default/cm2", Status: dao.ApplyConfigured},
+		{Name: "ConfigMap default/cm3", Status: dao.ApplyUnchanged},
+		{Name: "Zorg default/z1", Status: dao.ApplyFailed, Err: errors.New("boom")},
+		{Name: "Zorg default/z2", Status: dao.ApplyFailed, Err: errors.New("bang")},
+	}
+
+	assert.Equal(t, "1 created, 1 configured, 1 unchanged, 2 failed", applySummary(rr))
+	assert.Equal(t, 2, applyErrors(rr))
+	assert.Equal(t, `created    ConfigMap default/cm1
+configured ConfigMap default/cm2
+unchanged  ConfigMap default/cm3
+error      Zorg default/z1: boom
+error      Zorg default/z2: bang`, applyReport(rr))
+}
@@ -0,0 +1,86 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const auditTitle = "Audit"
+
+// Audit presents the cluster action log viewer.
+type Audit struct {
+	ResourceViewer
+}
+
+// NewAudit returns a new viewer.
+func NewAudit(gvr client.GVR) ResourceViewer {
+	a := Audit{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.Audit{}.ColorerFunc())
+	a.GetTable().SetEnterFn(a.showEntry)
+	a.GetTable().SetSortCol(0, len(render.Audit{}.Header(render.ClusterScope)), false)
+	a.SetBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Name returns the component name.
+func (a *Audit) Name() string { return auditTitle }
+
+func (a *Audit) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Time", a.GetTable().SortColCmd(0, false), false),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Action", a.GetTable().SortColCmd(3, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Outcome", a.GetTable().SortColCmd(5, true), false),
+	})
+}
+
+// showEntry shows an entry in full since long outcomes get truncated.
+func (a *Audit) showEntry(app *App, _, _, _ string) {
+	hh := render.Audit{}.Header(render.ClusterScope)
+	var b strings.Builder
+	for i, h := range hh[:len(hh)-1] {
+		fmt.Fprintf(&b, "%-8s %s\n", h.Name+":", a.GetTable().GetSelectedCell(i))
+	}
+	details := NewDetails(app, "Audit", a.GetTable().GetSelectedCell(4)).Update(b.String())
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) auditFailed(err error) {
+	a.QueueUpdateDraw(func() {
+		a.Flash().Errf("Audit log write failed. Further failures are only logged: %v", err)
+	})
+}
+
+// audit records a mutation performed against the current cluster.
+func (a *App) audit(action, gvr, path string, err error) {
+	e := dao.AuditEntry{
+		Cluster: a.Config.K9s.CurrentCluster,
+		Context: a.Config.K9s.CurrentContext,
+		Action:  action,
+		Target:  client.NewGVR(gvr).ToR() + " " + path,
+		Outcome: dao.AuditOutcome(err),
+	}
+	if a.Conn() != nil {
+		u, uerr := a.Conn().Config().CurrentUserName()
+		if uerr != nil {
+			log.Warn().Err(uerr).Msg("Audit user lookup failed")
+		}
+		e.User = u
+	}
+	a.auditLog.Record(e)
+}
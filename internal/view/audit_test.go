@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditNew(t *testing.T) {
+	v := view.NewAudit(client.NewGVR("audits"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Audit", v.Name())
+	assert.Equal(t, 5, len(v.Hints()))
+}
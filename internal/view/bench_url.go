@@ -0,0 +1,107 @@
+package view
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// urlBenchNS represents the bench history namespace for ad hoc urls.
+const urlBenchNS = "url"
+
+// showBenchURL pops a benchmark dialog for an arbitrary url.
+func showBenchURL(app *App, raw string) error {
+	u, err := parseBenchURL(raw)
+	if err != nil {
+		return err
+	}
+	cfg := benchURLConfig(app.Bench.Benchmarks.Defaults, u)
+	dialog.ShowBench(app.Content.Pages, raw, cfg, func(cfg config.BenchConfig) error {
+		if err := runURLBench(app, u, cfg); err != nil {
+			app.Flash().Err(err)
+			return err
+		}
+		return nil
+	}, nil)
+
+	return nil
+}
+
+func runURLBench(app *App, u *url.URL, cfg config.BenchConfig) error {
+	if app.benchmarks.Busy() {
+		return errBenchBusy
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(cfg.HTTP.Path, "/") {
+		cfg.HTTP.Path = "/" + cfg.HTTP.Path
+	}
+
+	b, err := perf.NewBenchmark(u.Scheme+"://"+u.Host+cfg.HTTP.Path, app.version, cfg)
+	if err != nil {
+		return err
+	}
+	app.Status(ui.FlashWarn, "Benchmark in progress...")
+	log.Debug().Msgf("Bench starting %s...", u)
+	app.benchmarks.Add(b)
+	go b.Run(app.Config.K9s.CurrentCluster, func() {
+		pruneBenchmarks(app)
+		app.QueueUpdate(func() {
+			app.benchmarks.Remove(b)
+			if b.Canceled() {
+				app.Status(ui.FlashInfo, "Benchmark canceled")
+			} else {
+				app.Status(ui.FlashInfo, benchDoneMsg(b))
+				b.Cancel()
+			}
+			go benchTimedOut(app)
+		})
+	})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseBenchURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("Invalid benchmark url %q", raw)
+	}
+
+	return u, nil
+}
+
+// benchURLConfig returns a benchmark config seeded from the defaults.
+func benchURLConfig(d config.Benchmark, u *url.URL) config.BenchConfig {
+	cfg := defaultConfig()
+	if d.C > 0 {
+		cfg.C = d.C
+	}
+	if d.N > 0 {
+		cfg.N = d.N
+	}
+	cfg.HTTP.Path = u.RequestURI()
+	cfg.Name = urlBenchNS + "/" + urlBenchName(u)
+
+	return cfg
+}
+
+// urlBenchName keys a url in the bench history. Separators used by the bench
+// files naming convention are replaced.
+func urlBenchName(u *url.URL) string {
+	n := strings.NewReplacer("/", "-", "_", "-").Replace(u.Host + u.Path)
+
+	return strings.TrimRight(n, "-")
+}
@@ -0,0 +1,69 @@
+package view
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBenchURL(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		err bool
+	}{
+		"http":     {raw: "http://localhost:8080/fred"},
+		"https":    {raw: "https://blee.com"},
+		"noScheme": {raw: "localhost:8080", err: true},
+		"ftp":      {raw: "ftp://blee.com", err: true},
+		"noHost":   {raw: "http:///fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := parseBenchURL(u.raw)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
+
+func TestBenchURLConfig(t *testing.T) {
+	uu := map[string]struct {
+		raw     string
+		d       config.Benchmark
+		c, n    int
+		path, e string
+	}{
+		"defaults": {
+			raw:  "http://localhost:8080",
+			c:    config.DefaultC,
+			n:    config.DefaultN,
+			path: "/",
+			e:    "url/localhost:8080",
+		},
+		"custom": {
+			raw:  "http://localhost:8080/api/v_1/?q=fred",
+			d:    config.Benchmark{C: 2, N: 100},
+			c:    2,
+			n:    100,
+			path: "/api/v_1/?q=fred",
+			e:    "url/localhost:8080-api-v-1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			url, err := url.Parse(u.raw)
+			assert.Nil(t, err)
+			cfg := benchURLConfig(u.d, url)
+			assert.Equal(t, u.c, cfg.C)
+			assert.Equal(t, u.n, cfg.N)
+			assert.Equal(t, config.DefaultMethod, cfg.HTTP.Method)
+			assert.Equal(t, u.path, cfg.HTTP.Path)
+			assert.Equal(t, u.e, cfg.Name)
+		})
+	}
+}
@@ -2,8 +2,8 @@ package view
 
 import (
 	"context"
-	"io/ioutil"
-	"path/filepath"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -13,8 +13,13 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
 )
 
+// errBenchBusy flags service and url benchmarks started while others are in
+// flight. Port-forward benchmarks run concurrently.
+var errBenchBusy = errors.New("A benchmark is already in progress")
+
 // Benchmark represents a service benchmark results view.
 type Benchmark struct {
 	ResourceViewer
@@ -25,8 +30,8 @@ func NewBenchmark(gvr client.GVR) ResourceViewer {
 	b := Benchmark{
 		ResourceViewer: NewBrowser(gvr),
 	}
-	b.GetTable().SetBorderFocusColor(tcell.ColorSeaGreen)
-	b.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorSeaGreen, tcell.AttrNone)
+	b.GetTable().SetBorderFocusColor(render.Accent(tcell.ColorSeaGreen))
+	b.GetTable().SetSelectedStyle(render.Selection(tcell.ColorWhite, tcell.ColorSeaGreen, tcell.AttrNone))
 	b.GetTable().SetColorerFn(render.Benchmark{}.ColorerFunc())
 	b.GetTable().SetSortCol(b.GetTable().NameColIndex()+7, 0, true)
 	b.SetContextFn(b.benchContext)
@@ -35,6 +40,16 @@ func NewBenchmark(gvr client.GVR) ResourceViewer {
 	return &b
 }
 
+// Init initializes the view.
+func (b *Benchmark) Init(ctx context.Context) error {
+	if err := b.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	pruneBenchmarks(b.App())
+
+	return nil
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
@@ -66,14 +81,68 @@ func fileToSubject(path string) string {
 	return ee[0] + "/" + ee[1]
 }
 
-func benchDir(cfg *config.Config) string {
-	return filepath.Join(perf.K9sBenchDir, cfg.K9s.CurrentCluster)
+// pruneBenchmarks enforces the benchmark reports retention policy.
+func pruneBenchmarks(app *App) {
+	r, err := perf.Prune(app.Config.K9s.CurrentCluster, app.Bench.Benchmarks.Retention)
+	if err != nil {
+		log.Error().Err(err).Msg("Benchmark reports prune failed")
+		return
+	}
+	if len(r.Files) > 0 {
+		log.Info().Msgf("Pruned %d benchmark reports (%dKB)", len(r.Files), r.Bytes>>10)
+	}
 }
 
-func readBenchFile(cfg *config.Config, n string) (string, error) {
-	data, err := ioutil.ReadFile(filepath.Join(benchDir(cfg), n))
+// benchStatus reports the number of benchmarks in flight or clears the status
+// when none are left.
+func benchStatus(app *App) {
+	switch n := app.benchmarks.Count(); n {
+	case 0:
+		app.ClearStatus(true)
+	case 1:
+		app.Status(ui.FlashWarn, "Benchmark in progress...")
+	default:
+		app.Status(ui.FlashWarn, fmt.Sprintf("%d benchmarks in progress...", n))
+	}
+}
+
+// benchDoneMsg returns the completion notice naming the benchmark and calling
+// out the most frequent failures if any.
+func benchDoneMsg(b *perf.Benchmark) string {
+	msg := fmt.Sprintf("Benchmark %s Completed!", b.Name())
+	if c, ok := b.Result().Failures.Dominant(); ok {
+		msg += fmt.Sprintf(" (mostly %s)", c.Plural())
+	}
+
+	return msg
+}
+
+func showBenchPrune(app *App) error {
+	r, err := perf.Prune(app.Config.K9s.CurrentCluster, app.Bench.Benchmarks.Retention)
 	if err != nil {
-		return "", err
+		return err
 	}
-	return string(data), nil
+
+	msg := "No benchmark reports exceed the retention limits."
+	if len(r.Files) > 0 {
+		msg = fmt.Sprintf("Pruned %d benchmark reports (%dKB).", len(r.Files), r.Bytes>>10)
+	}
+	m := ui.NewModal("<Benchmarks Prune>", []string{"OK"}).
+		SetTextColor(render.Accent(tcell.ColorFuchsia)).
+		SetText(msg).
+		SetDoneFunc(func(int, string) {
+			dismissModal(app.Content.Pages)
+		})
+	app.Content.Pages.AddPage(promptPage, m, false, false)
+	app.Content.Pages.ShowPage(promptPage)
+
+	return nil
+}
+
+func benchDir(cfg *config.Config) string {
+	return perf.BenchDir(cfg.K9s.CurrentCluster)
+}
+
+func readBenchFile(cfg *config.Config, n string) (string, error) {
+	return perf.ReadReport(cfg.K9s.CurrentCluster, n)
 }
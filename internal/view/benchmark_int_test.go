@@ -0,0 +1,48 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchStatus(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	b1, b2 := makeStatusBench(t, "default/p1:c1"), makeStatusBench(t, "default/p2:c1")
+
+	a.benchmarks.Add(b1)
+	benchStatus(a)
+	assert.Equal(t, "Benchmark in progress...", lastFlash(a))
+
+	a.benchmarks.Add(b2)
+	benchStatus(a)
+	assert.Equal(t, "2 benchmarks in progress...", lastFlash(a))
+
+	a.benchmarks.Remove(b1)
+	benchStatus(a)
+	assert.Equal(t, "Benchmark in progress...", lastFlash(a))
+}
+
+func TestBenchDoneMsg(t *testing.T) {
+	assert.Equal(t, "Benchmark default/p1:c1 Completed!", benchDoneMsg(makeStatusBench(t, "default/p1:c1")))
+}
+
+// Helpers...
+
+func makeStatusBench(t *testing.T, n string) *perf.Benchmark {
+	b, err := perf.NewBenchmark("http://localhost:0", "0.0.0", config.BenchConfig{Name: n, C: 1, N: 1})
+	assert.Nil(t, err)
+
+	return b
+}
+
+func lastFlash(a *App) string {
+	hh := a.Flash().History()
+	if len(hh) == 0 {
+		return ""
+	}
+
+	return hh[len(hh)-1].Text
+}
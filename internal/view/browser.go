@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -18,6 +19,7 @@ import (
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // Browser represents a generic resource browser.
@@ -50,6 +52,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	if err = b.Table.Init(ctx); err != nil {
 		return err
 	}
+	b.SetSortedFn(b.app.touchSession)
 	if !dao.IsK9sMeta(b.meta) {
 		if _, e := b.app.factory.CanForResource(b.app.Config.ActiveNamespace(), b.GVR(), []string{"list", "watch"}); e != nil {
 			return e
@@ -91,13 +94,18 @@ func (b *Browser) Start() {
 	b.Table.Start()
 	ctx := b.defaultContext()
 	ctx, b.cancelFn = context.WithCancel(ctx)
+	b.GetModel().Watch(b.watchContext(ctx))
+}
+
+func (b *Browser) watchContext(ctx context.Context) context.Context {
 	if b.contextFn != nil {
 		ctx = b.contextFn(ctx)
 	}
 	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
 		b.Path = path
 	}
-	b.GetModel().Watch(ctx)
+
+	return ctx
 }
 
 // Stop terminates browser updates.
@@ -135,19 +143,34 @@ func (b *Browser) Aliases() []string {
 func (b *Browser) TableDataChanged(data render.TableData) {
 	b.app.QueueUpdateDraw(func() {
 		b.refreshActions()
+		b.SetDeprecated(b.app.deprecations().Has(b.GVR()))
+		b.SetStale(nil, 0)
+		b.SetPaused(b.GetModel().IsPaused(), 0)
 		b.Update(data)
 		b.App().ClearStatus(true)
 	})
 }
 
-// TableLoadFailed notifies view something went south.
-func (b *Browser) TableLoadFailed(err error) {
+// TableLoadFailed notifies view something went south. The last good rows
+// remain visible and the title flags how stale they are.
+func (b *Browser) TableLoadFailed(err error, stale time.Duration) {
 	b.app.QueueUpdateDraw(func() {
-		b.app.Flash().Err(err)
+		if b.SetStale(err, stale) {
+			b.app.Flash().Err(err)
+		}
+		b.UpdateTitle()
 		b.App().ClearStatus(false)
 	})
 }
 
+// TablePaused notifies view a refresh was skipped while paused.
+func (b *Browser) TablePaused(age time.Duration) {
+	b.app.QueueUpdateDraw(func() {
+		b.SetPaused(b.GetModel().IsPaused(), age)
+		b.UpdateTitle()
+	})
+}
+
 // ----------------------------------------------------------------------------
 // Actions...
 
@@ -157,24 +180,73 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	ctx := b.defaultContext()
-	o, err := b.GetModel().Get(ctx, path)
+	err := showYAML(b.app, path, func() (runtime.Object, error) {
+		return dao.FetchLive(b.app.factory, b.GVR(), path)
+	})
 	if err != nil {
-		b.App().Flash().Errf("unable to get resource %q -- %s", b.gvr, err)
-		return nil
+		b.App().Flash().Errf("unable to view resource %q -- %s", b.gvr, err)
 	}
 
-	raw, err := toYAML(o)
+	return nil
+}
+
+func (b *Browser) infoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	o, err := b.GetModel().Get(b.defaultContext(), path)
+	if err != nil {
+		b.App().Flash().Err(err)
+		return nil
+	}
+	s, err := model.Summarize(b.GVR(), o)
 	if err != nil {
-		b.App().Flash().Errf("unable to marshal resource %s", err)
+		b.App().Flash().Err(err)
 		return nil
 	}
+	dialog.ShowSummary(b.app.Content.Pages, fmt.Sprintf("%s %s", b.gvr.ToR(), path), s, func() {})
 
-	details := NewDetails(b.app, "YAML", path).Update(raw)
-	if err := b.App().inject(details); err != nil {
-		b.App().Flash().Err(err)
+	return nil
+}
+
+func (b *Browser) pinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
 	}
 
+	if b.app.pins.Has(b.GVR(), path) {
+		b.app.pins.Remove(model.Pin{GVR: b.GVR(), Path: path}.ID())
+		b.app.Flash().Infof("Stopped watching %s", path)
+		return nil
+	}
+	if err := b.app.pins.Add(b.app.factory, b.GVR(), path); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	b.app.Flash().Infof("Watching %s for changes...", path)
+
+	return nil
+}
+
+func (b *Browser) bookmarkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	snap := model.NewSnapshot(b.GVR(), b.GetModel().Peek())
+	b.app.snapshots.Set(snap)
+	b.app.Flash().Infof("Bookmarked %d %s", len(snap.Rows), b.GVR())
+
+	return nil
+}
+
+func (b *Browser) bookmarkDiffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	snap, ok := b.app.snapshots.Get(b.GVR(), b.GetModel().GetNamespace())
+	if !ok {
+		b.app.Flash().Warnf("No bookmark for %s. Press b to take one", b.GVR())
+		return nil
+	}
+	showSnapshotDiff(b.app, snap)
+
 	return nil
 }
 
@@ -187,8 +259,9 @@ func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	cmd := b.SearchBuff().String()
 	b.App().Flash().Info("Clearing filter...")
 	b.SearchBuff().Reset()
+	b.app.touchSession()
 
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsServerSelector(cmd) {
 		b.Start()
 	} else {
 		b.Refresh()
@@ -203,9 +276,16 @@ func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	b.SearchBuff().SetActive(false)
+	b.app.touchSession()
 
 	cmd := b.SearchBuff().String()
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsNodeSelector(cmd) && !nodeFilterable(b.gvr) {
+		b.App().Flash().Warnf("node filter not supported for %s", b.gvr.ToR())
+		b.SearchBuff().Reset()
+		b.Refresh()
+		return nil
+	}
+	if ui.IsServerSelector(cmd) {
 		b.Start()
 		return nil
 	}
@@ -231,11 +311,32 @@ func (b *Browser) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (b *Browser) refreshCmd(*tcell.EventKey) *tcell.EventKey {
 	b.app.Flash().Info("Refreshing...")
+	if b.GetModel().IsPaused() {
+		b.GetModel().Refresh(b.watchContext(b.defaultContext()))
+		return nil
+	}
 	b.refresh()
 
 	return nil
 }
 
+func (b *Browser) pauseCmd(*tcell.EventKey) *tcell.EventKey {
+	m := b.GetModel()
+	if m.IsPaused() {
+		m.Resume()
+		b.SetPaused(false, 0)
+		b.app.Flash().Info("Refresh resumed")
+		b.refresh()
+		return nil
+	}
+	m.Pause()
+	b.SetPaused(true, m.Age())
+	b.UpdateTitle()
+	b.app.Flash().Info("Refresh paused. Press ctrl-r to refresh once")
+
+	return nil
+}
+
 func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	selections := b.GetSelectedItems()
 	if len(selections) == 0 {
@@ -287,8 +388,14 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if cfg := b.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
 			args = append(args, "--kubeconfig", *cfg)
 		}
-		if !runK(true, b.app, append(args, n)...) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
+		err := b.app.mutate("Edit", b.GVR(), path, func() error {
+			if !runK(true, b.app, append(args, n)...) {
+				return errors.New("Edit exec failed")
+			}
+			return nil
+		})
+		if err != nil {
+			b.app.Flash().Err(err)
 		}
 	}
 
@@ -355,11 +462,19 @@ func (b *Browser) defaultContext() context.Context {
 		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(b.SearchBuff().String()))
 	}
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	if ui.IsNodeSelector(b.SearchBuff().String()) && nodeFilterable(b.gvr) {
+		ctx = context.WithValue(ctx, internal.KeyFields, "spec.nodeName="+ui.TrimNodeSelector(b.SearchBuff().String()))
+	}
 	ctx = context.WithValue(ctx, internal.KeyNamespace, b.App().Config.ActiveNamespace())
 
 	return ctx
 }
 
+// nodeFilterable checks if a resource can be filtered by node ie @node.
+func nodeFilterable(gvr client.GVR) bool {
+	return gvr.String() == "v1/pods"
+}
+
 func (b *Browser) namespaceActions(aa ui.KeyActions) {
 	if b.app.Conn() == nil || !b.meta.Namespaced || b.GetTable().Path != "" {
 		return
@@ -383,19 +498,28 @@ func (b *Browser) refreshActions() {
 		ui.KeyC:        ui.NewKeyAction("Copy", b.cpCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("View", b.enterCmd, false),
 		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", b.refreshCmd, false),
+		tcell.KeyCtrlZ: ui.NewKeyAction("Pause", b.pauseCmd, false),
 	}
 	b.namespaceActions(aa)
 
 	if client.Can(b.meta.Verbs, "edit") {
-		aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
+		aa[ui.KeyE] = ui.NewDangerousKeyAction("Edit", b.editCmd, true).Requires(ui.Permission{Verb: "patch"})
 	}
 	if client.Can(b.meta.Verbs, "delete") {
-		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
+		aa[tcell.KeyCtrlD] = ui.NewDangerousKeyAction("Delete", b.deleteCmd, true).Requires(ui.Permission{Verb: "delete"})
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyI] = ui.NewKeyAction("Info", b.infoCmd, true)
+	}
+	if !dao.IsK9sMeta(b.meta) && client.Can(b.meta.Verbs, "watch") {
+		aa[ui.KeyW] = ui.NewKeyAction("Watch", b.pinCmd, true)
+	}
+	if !dao.IsK9sMeta(b.meta) {
+		aa[ui.KeyB] = ui.NewKeyAction("Bookmark", b.bookmarkCmd, true)
+		aa[ui.KeyShiftB] = ui.NewKeyAction("Bookmark Diff", b.bookmarkDiffCmd, true)
 	}
 
 	pluginActions(b, aa)
@@ -405,9 +529,19 @@ func (b *Browser) refreshActions() {
 	if b.bindKeysFn != nil {
 		b.bindKeysFn(b.Actions())
 	}
+	if !dao.IsK9sMeta(b.meta) {
+		pruneActions(b.app, b.GVR(), b.meta.Namespaced, b.Actions(), b.refreshHints)
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
+// refreshHints updates the menu when the browser is the active view.
+func (b *Browser) refreshHints() {
+	if top, ok := b.app.Content.Top().(ResourceViewer); ok && top.GetTable() == b.GetTable() {
+		b.app.Menu().HydrateMenu(b.Hints())
+	}
+}
+
 func (b *Browser) simpleDelete(selections []string, msg string) {
 	dialog.ShowConfirm(b.app.Content.Pages, "Confirm Delete", msg, func() {
 		b.ShowDeleted()
@@ -417,7 +551,10 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 			b.app.Flash().Infof("Delete resource %s %s", b.gvr, selections[0])
 		}
 		for _, sel := range selections {
-			if err := b.accessor.(dao.Nuker).Delete(sel, true, true); err != nil {
+			err := b.app.mutate("Delete", b.GVR(), sel, func() error {
+				return b.accessor.(dao.Nuker).Delete(sel, true, true)
+			})
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.GetTable().DeleteMark(sel)
@@ -429,7 +566,8 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 }
 
 func (b *Browser) resourceDelete(selections []string, msg string) {
-	dialog.ShowDelete(b.app.Content.Pages, msg, func(cascade, force bool) {
+	deps := deleteDependents(b.app, selections)
+	dialog.ShowDelete(b.app.Content.Pages, msg, deps, func(cascade, force, cleanup bool) {
 		b.ShowDeleted()
 		if len(selections) > 1 {
 			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.gvr)
@@ -437,10 +575,16 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			b.app.Flash().Infof("Delete resource %s %s", b.gvr, selections[0])
 		}
 		for _, sel := range selections {
-			if err := b.accessor.(dao.Nuker).Delete(sel, cascade, force); err != nil {
+			err := b.app.mutate(deleteAction(cascade, force), b.GVR(), sel, func() error {
+				return b.accessor.(dao.Nuker).Delete(sel, cascade, force)
+			})
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
-				b.app.factory.DeleteForwarder(sel)
+				if cleanup {
+					b.app.factory.DeleteForwarder(sel)
+					b.app.benchmarks.Kill(sel)
+				}
 				b.GetTable().DeleteMark(sel)
 			}
 		}
@@ -448,3 +592,32 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 		b.SelectRow(1, true)
 	}, func() {})
 }
+
+// deleteAction returns a delete audit action given its options.
+func deleteAction(cascade, force bool) string {
+	action := "Delete"
+	if cascade {
+		action += "(cascade)"
+	}
+	if force {
+		action += "(force)"
+	}
+
+	return action
+}
+
+// deleteDependents lists port-forwards and benchmarks targeting resources
+// about to be deleted.
+func deleteDependents(app *App, paths []string) []string {
+	var deps []string
+	for _, p := range paths {
+		for _, f := range app.factory.Forwarders().For(p) {
+			deps = append(deps, "port-forward "+f)
+		}
+		for _, n := range app.benchmarks.Targeting(p) {
+			deps = append(deps, "benchmark "+n)
+		}
+	}
+
+	return deps
+}
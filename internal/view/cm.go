@@ -0,0 +1,66 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConfigMap presents a configmap viewer.
+type ConfigMap struct {
+	ResourceViewer
+}
+
+// NewConfigMap returns a new viewer.
+func NewConfigMap(gvr client.GVR) ResourceViewer {
+	c := ConfigMap{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetEnterFn(c.showKeys)
+	c.SetBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *ConfigMap) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyU: ui.NewKeyAction("Usage", refsCmd(c), true),
+	})
+}
+
+func (c *ConfigMap) showKeys(app *App, _, gvr, path string) {
+	o, err := app.factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var cm v1.ConfigMap
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cm)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	if err := app.inject(NewKeyBrowser(app, "ConfigMap", path, configMapKeys(cm))); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func configMapKeys(cm v1.ConfigMap) []KeyValue {
+	kvs := make([]KeyValue, 0, len(cm.Data)+len(cm.BinaryData))
+	for k, v := range cm.Data {
+		kvs = append(kvs, KeyValue{Key: k, Value: v, Size: len(v)})
+	}
+	for k, v := range cm.BinaryData {
+		kvs = append(kvs, KeyValue{Key: k, Binary: true, Size: len(v)})
+	}
+
+	return kvs
+}
@@ -4,11 +4,22 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// fallbackCmd is shown while a startup command is deferred.
+	fallbackCmd  = "po"
+	deferRetries = 10
+	deferDelay   = 1 * time.Second
 )
 
 var (
@@ -47,6 +58,9 @@ func (c *Command) run(cmd string, clearStack bool) error {
 	if c.specialCmd(cmd) {
 		return nil
 	}
+	if alias, path, ok := splitPathCmd(cmd, c.knownAlias); ok {
+		return c.showPath(alias, path, clearStack)
+	}
 
 	cmds := strings.Split(cmd, " ")
 	gvr, v, err := c.viewMetaFor(cmds[0])
@@ -83,15 +97,87 @@ func (c *Command) Reset() error {
 	return nil
 }
 
+// defaultCmd launches the startup view. Aliases unknown at launch may stem
+// from CRDs still being discovered so these are retried in the background
+// while the fallback view is shown.
 func (c *Command) defaultCmd() error {
-	return c.run(c.app.Config.ActiveView(), true)
+	cmd := c.app.Config.ActiveView()
+	if c.isAlias(cmd) {
+		return c.run(cmd, true)
+	}
+	log.Warn().Msgf("Startup command %q not found yet. Deferring until discovery completes", cmd)
+	if err := c.run(fallbackCmd, true); err != nil {
+		return err
+	}
+	go c.deferCmd(cmd, c.app.Content.Top())
+
+	return nil
+}
+
+// deferCmd retries the startup command as aliases get discovered. Aliases are
+// reloaded on the UI goroutine as the views read them. The command is dropped
+// once the user navigates off the fallback view.
+func (c *Command) deferCmd(cmd string, fallback model.Component) {
+	for i := 0; i < deferRetries; i++ {
+		<-time.After(deferDelay)
+		done := make(chan bool, 1)
+		c.app.QueueUpdateDraw(func() {
+			done <- c.deferredRun(cmd, fallback)
+		})
+		if <-done {
+			return
+		}
+	}
+	c.app.QueueUpdateDraw(func() {
+		c.app.Flash().Errf("Startup command %q does not match any known resource alias", cmd)
+	})
+}
+
+// deferredRun runs the startup command if its alias is now known. It returns
+// true when no further retries are needed.
+func (c *Command) deferredRun(cmd string, fallback model.Component) bool {
+	if c.app.Content.Top() != fallback {
+		log.Debug().Msgf("Startup command %q dropped. User moved on", cmd)
+		return true
+	}
+	if err := c.Reset(); err != nil {
+		log.Warn().Err(err).Msg("Alias reload failed")
+		return false
+	}
+	if !c.isAlias(cmd) {
+		return false
+	}
+	if err := c.run(cmd, true); err != nil {
+		c.app.Flash().Err(err)
+	}
+
+	return true
+}
+
+// isAlias checks if a command starts with a known resource alias.
+func (c *Command) isAlias(cmd string) bool {
+	tokens := strings.Fields(cmd)
+	if len(tokens) == 0 {
+		return false
+	}
+	if _, _, ok := splitPathCmd(tokens[0], c.knownAlias); ok {
+		return true
+	}
+
+	return c.knownAlias(tokens[0])
+}
+
+func (c *Command) knownAlias(alias string) bool {
+	_, ok := c.alias.Get(alias)
+
+	return ok
 }
 
 func (c *Command) specialCmd(cmd string) bool {
 	cmds := strings.Split(cmd, " ")
 	switch cmds[0] {
 	case "q", "Q", "quit":
-		c.app.BailOut()
+		c.app.quit()
 		return true
 	case "?", "h", "help":
 		c.app.helpCmd(nil)
@@ -99,6 +185,119 @@ func (c *Command) specialCmd(cmd string) bool {
 	case "a", "alias":
 		c.app.aliasCmd(nil)
 		return true
+	case "messages", "msgs":
+		details := NewDetails(c.app, "Messages", "history").Update(flashHistory(c.app.Flash().History()))
+		if err := c.app.inject(details); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "errors", "errs":
+		c.app.showErrors()
+		return true
+	case "stats":
+		if err := showStats(c.app); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "deprecations", "deprecated":
+		if err := showDeprecations(c.app); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "diff":
+		if len(cmds) != 2 {
+			c.app.Flash().Warn("Usage: diff <manifest-path>")
+			return true
+		}
+		if err := showDiff(c.app, cmds[1]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "bench":
+		if len(cmds) != 2 {
+			c.app.Flash().Warn("Usage: bench <url>|prune")
+			return true
+		}
+		if cmds[1] == "prune" {
+			if err := showBenchPrune(c.app); err != nil {
+				c.app.Flash().Err(err)
+			}
+			return true
+		}
+		if err := showBenchURL(c.app, cmds[1]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "debug":
+		if len(cmds) != 2 || cmds[1] != "prune" {
+			c.app.Flash().Warn("Usage: debug prune")
+			return true
+		}
+		showDebugPrune(c.app)
+		return true
+	case "evicted":
+		if len(cmds) != 2 || cmds[1] != "prune" {
+			c.app.Flash().Warn("Usage: evicted prune")
+			return true
+		}
+		showEvictedPrune(c.app)
+		return true
+	case "logs-dump":
+		if len(cmds) > 2 {
+			c.app.Flash().Warn("Usage: logs-dump [ns]|stop")
+			return true
+		}
+		if len(cmds) == 2 && cmds[1] == "stop" {
+			if !c.app.stopLogsDump() {
+				c.app.Flash().Warn("No logs dump in progress")
+			}
+			return true
+		}
+		ns := c.app.Config.ActiveNamespace()
+		if len(cmds) == 2 {
+			ns = cmds[1]
+		}
+		c.app.dumpLogs(ns)
+		return true
+	case "mouse":
+		c.app.toggleMouse()
+		return true
+	case "kubeconfig":
+		if len(cmds) != 2 {
+			c.app.Flash().Warn("Usage: kubeconfig <path>")
+			return true
+		}
+		if err := c.app.switchKubeConfig(cmds[1]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "rbac-refresh":
+		rbacRefresh(c.app)
+		return true
+	case "foreach":
+		fc, err := dao.ParseForeach(cmds[1:])
+		if err != nil {
+			c.app.Flash().Warnf("%s -- %s", err, dao.ForeachUsage)
+			return true
+		}
+		gvr, ok := c.alias.Get(fc.Resource)
+		if !ok {
+			c.app.Flash().Errf("Huh? `%s` resource not found", fc.Resource)
+			return true
+		}
+		if err := foreachCmd(c.app, gvr, fc); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "apply":
+		if len(cmds) != 2 {
+			c.app.Flash().Warn("Usage: apply <manifest-path>")
+			return true
+		}
+		if err := showApply(c.app, cmds[1]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -115,6 +314,77 @@ func (c *Command) specialCmd(cmd string) bool {
 	return false
 }
 
+// showItem shows a resource view with the given item selected.
+func (c *Command) showItem(gvr, path string) error {
+	v, ok := customViewers[client.NewGVR(gvr)]
+	if !ok {
+		v = MetaViewer{viewerFn: NewBrowser}
+	}
+	if ns, _ := client.Namespaced(path); ns != "" && !c.app.switchNS(ns) {
+		return fmt.Errorf("namespace switch failed for ns %q", ns)
+	}
+	view := c.componentFor(gvr, &v)
+	view.GetTable().SelectItem(path)
+
+	return c.exec(gvr, view, false)
+}
+
+// showPath shows a resource view filtered on the given item and selects it.
+// Namespaced items without a namespace segment are looked up in the active
+// namespace.
+func (c *Command) showPath(alias, path string, clearStack bool) error {
+	gvr, v, err := c.viewMetaFor(alias)
+	if err != nil {
+		return err
+	}
+	meta, err := dao.MetaFor(client.NewGVR(gvr))
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	switch {
+	case !meta.Namespaced && ns != "":
+		return fmt.Errorf("%s are not namespaced. Use %s/%s", client.NewGVR(gvr).ToR(), alias, n)
+	case meta.Namespaced && ns == "":
+		ns = c.app.Config.ActiveNamespace()
+	}
+	if meta.Namespaced && !c.app.switchNS(ns) {
+		return fmt.Errorf("namespace switch failed for ns %q", ns)
+	}
+	allNS := ns == render.NamespaceAll || ns == render.AllNamespaces
+	if !allNS {
+		path = client.FQN(ns, n)
+	}
+
+	view := c.componentFor(gvr, v)
+	view.GetTable().SelectItem(path)
+	if err := c.exec(gvr, view, clearStack); err != nil {
+		return err
+	}
+	view.GetTable().SearchBuff().Set(n)
+	if meta.Namespaced && allNS {
+		return nil
+	}
+	go c.checkItem(gvr, path)
+
+	return nil
+}
+
+// checkItem warns when a path command item does not exist.
+func (c *Command) checkItem(gvr, path string) {
+	_, err := c.app.factory.Get(gvr, path, true, labels.Everything())
+	if err == nil {
+		return
+	}
+	c.app.QueueUpdateDraw(func() {
+		if errors.IsNotFound(err) {
+			c.app.Flash().Warnf("%s %q not found", client.NewGVR(gvr).ToR(), path)
+			return
+		}
+		c.app.Flash().Err(err)
+	})
+}
+
 func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 	gvr, ok := c.alias.Get(cmd)
 	if !ok {
@@ -153,6 +423,13 @@ func (c *Command) exec(gvr string, comp model.Component, clearStack bool) error
 	}
 
 	g := client.NewGVR(gvr)
+	if ns, denied := listDenied(c.app, gvr, true); denied {
+		c.app.Flash().Warnf("No list access on %s", g.ToR())
+		if clearStack {
+			c.app.Content.Stack.ClearHistory()
+		}
+		return c.app.inject(noAccess(c.app, ns, gvr))
+	}
 	c.app.Flash().Infof("Viewing %s resource...", g.ToR())
 	log.Debug().Msgf("Running Command %s", gvr)
 	c.app.Config.SetActiveView(g.ToR())
@@ -162,6 +439,56 @@ func (c *Command) exec(gvr string, comp model.Component, clearStack bool) error
 	if clearStack {
 		c.app.Content.Stack.ClearHistory()
 	}
+	err := c.app.inject(comp)
+	go c.checkAccess(gvr, comp, err == nil)
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
 
-	return c.app.inject(comp)
+// splitPathCmd splits an `<alias>/[<ns>/]<name>` command into its resource
+// alias and item path. Aliases may contain slashes themselves ie
+// apps/v1/deployments so the longest known alias wins.
+func splitPathCmd(cmd string, known func(string) bool) (string, string, bool) {
+	if strings.ContainsAny(cmd, " \t") || known(cmd) {
+		return "", "", false
+	}
+	tokens := strings.Split(cmd, "/")
+	for i := len(tokens) - 1; i > 0; i-- {
+		alias := strings.Join(tokens[:i], "/")
+		if !known(alias) {
+			continue
+		}
+		rest := tokens[i:]
+		if len(rest) > 2 || rest[len(rest)-1] == "" || rest[0] == "" {
+			return "", "", false
+		}
+		return alias, strings.Join(rest, "/"), true
+	}
+
+	return "", "", false
+}
+
+// checkAccess reviews the list access on a resource off the UI goroutine. A
+// stacked view is swapped for a report when access is denied unless the user
+// moved on.
+func (c *Command) checkAccess(gvr string, comp model.Component, stacked bool) {
+	ns, denied := listDenied(c.app, gvr, false)
+	if !denied {
+		return
+	}
+	c.app.QueueUpdateDraw(func() {
+		if stacked {
+			if c.app.Content.Top() != comp {
+				return
+			}
+			c.app.Content.Pop()
+		}
+		c.app.Flash().Warnf("No list access on %s", client.NewGVR(gvr).ToR())
+		if err := c.app.inject(noAccess(c.app, ns, gvr)); err != nil {
+			c.app.Flash().Err(err)
+		}
+	})
 }
@@ -0,0 +1,57 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeferredRunUserMovedOn(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	c := NewCommand(a)
+	a.Content.Push(NewDetails(a, "fred", "blee"))
+
+	assert.True(t, c.deferredRun("dp", NewDetails(a, "fallback", "blee")))
+	assert.Equal(t, "fred", a.Content.Top().Name())
+}
+
+func TestSplitPathCmd(t *testing.T) {
+	aliases := map[string]struct{}{
+		"po":                  {},
+		"no":                  {},
+		"v1/pods":             {},
+		"apps/v1/deployments": {},
+	}
+	known := func(a string) bool {
+		_, ok := aliases[a]
+		return ok
+	}
+
+	uu := map[string]struct {
+		cmd, alias, path string
+		ok               bool
+	}{
+		"namespaced":   {cmd: "po/kube-system/coredns-abc123", alias: "po", path: "kube-system/coredns-abc123", ok: true},
+		"noNS":         {cmd: "po/coredns-abc123", alias: "po", path: "coredns-abc123", ok: true},
+		"clusterScope": {cmd: "no/node1", alias: "no", path: "node1", ok: true},
+		"gvr":          {cmd: "v1/pods/default/nginx", alias: "v1/pods", path: "default/nginx", ok: true},
+		"groupGVR":     {cmd: "apps/v1/deployments/default/nginx", alias: "apps/v1/deployments", path: "default/nginx", ok: true},
+		"alias":        {cmd: "apps/v1/deployments"},
+		"plain":        {cmd: "po"},
+		"unknown":      {cmd: "blee/default/fred"},
+		"tooDeep":      {cmd: "po/a/b/c"},
+		"trailing":     {cmd: "po/default/"},
+		"spaces":       {cmd: "po/default/fred kube-system"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			alias, path, ok := splitPathCmd(u.cmd, known)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.alias, alias)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
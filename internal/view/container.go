@@ -1,18 +1,19 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
-	"github.com/rs/zerolog/log"
-	"k8s.io/client-go/tools/portforward"
 )
 
 const containerTitle = "Containers"
@@ -29,6 +30,7 @@ func NewContainer(gvr client.GVR) ResourceViewer {
 	c.SetEnvFn(c.k9sEnv)
 	c.GetTable().SetEnterFn(c.viewLogs)
 	c.GetTable().SetColorerFn(render.Container{}.ColorerFunc())
+	c.GetTable().SetSelectedFn(render.ContainerName)
 	c.SetBindKeysFn(c.bindKeys)
 
 	return &c
@@ -42,10 +44,15 @@ func (c *Container) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyS:      ui.NewKeyAction("Shell", c.shellCmd, true),
-		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd(6, false), false),
-		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd(7, false), false),
-		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", c.GetTable().SortColCmd(8, false), false),
-		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM%", c.GetTable().SortColCmd(9, false), false),
+		ui.KeyV:      ui.NewKeyAction("Env", c.envCmd, true),
+		ui.KeyB:      ui.NewKeyAction("Probes", c.probesCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Top", c.topCmd, true),
+		ui.KeyK:      ui.NewDangerousKeyAction("Kill", c.killCmd, true).Requires(ui.Permission{Resource: "v1/pods", SubResource: "exec", Verb: "create"}),
+		ui.KeyShiftR: ui.NewKeyAction("Sort LastRestart", c.GetTable().SortColCmd(6, false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd(10, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd(11, false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", c.GetTable().SortColCmd(12, false), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM%", c.GetTable().SortColCmd(13, false), false),
 	})
 }
 
@@ -87,93 +94,182 @@ func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
-func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := c.GetTable().GetSelectedItem()
-	if path == "" {
+func (c *Container) envCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
 		return evt
 	}
 
-	if _, ok := c.App().factory.ForwarderFor(fwFQN(c.GetTable().Path, path)); ok {
-		c.App().Flash().Err(fmt.Errorf("A PortForward already exist on container %s", c.GetTable().Path))
+	path := fwFQN(c.GetTable().Path, sel)
+	e := NewEnvVar(client.NewGVR("envvars"))
+	e.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := c.App().inject(e); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Container) probesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	report, err := dao.ProbeReport(c.App().factory, c.GetTable().Path, sel)
+	if err != nil {
+		c.App().Flash().Err(err)
 		return nil
 	}
+	details := NewDetails(c.App(), "Probes", fwFQN(c.GetTable().Path, sel)).Update(report)
+	if err := c.App().inject(details); err != nil {
+		c.App().Flash().Err(err)
+	}
 
-	ports, ok := c.isForwardable(path)
-	if !ok {
+	return nil
+}
+
+func (c *Container) topCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	if c.GetTable().GetSelectedCell(3) != "Running" {
+		c.App().Flash().Errf("Container %s is not running", sel)
 		return nil
 	}
 
-	dialog.ShowPortForward(c.App().Content.Pages, c.preparePort(ports), c.portForward)
+	path := fwFQN(c.GetTable().Path, sel)
+	p := NewProcess(client.NewGVR("processes"))
+	p.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := c.App().inject(p); err != nil {
+		c.App().Flash().Err(err)
+	}
 
 	return nil
 }
 
-func (c *Container) isForwardable(path string) ([]string, bool) {
-	state := c.GetTable().GetSelectedCell(3)
-	if state != "Running" {
-		c.App().Flash().Err(fmt.Errorf("Container %s is not running?", path))
-		return nil, false
+func (c *Container) killCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
 	}
 
-	portC := c.GetTable().GetSelectedCell(11)
-	ports := strings.Split(portC, ",")
-	if len(ports) == 0 {
-		c.App().Flash().Err(errors.New("Container exposes no ports"))
-		return nil, false
+	if c.GetTable().GetSelectedCell(3) != "Running" {
+		c.App().Flash().Errf("Container %s is not running", sel)
+		return nil
 	}
+	path := c.GetTable().Path
+	if err := dao.ContainerRestartable(c.App().factory, path, sel); err != nil {
+		c.App().Flash().Errf("Kill refused: %s", err)
+		return nil
+	}
+
+	timeout := c.App().Config.K9s.GetKillTimeout()
+	msg := fmt.Sprintf("Kill container %s? SIGKILL follows after %s if it is still running.", sel, timeout)
+	dialog.ShowConfirm(c.App().Content.Pages, "Confirm Kill", msg, func() {
+		c.App().Flash().Infof("Sending SIGTERM to container %s...", sel)
+		go c.kill(path, sel, timeout)
+	}, func() {})
 
-	return ports, true
+	return nil
 }
 
-func (c *Container) preparePort(pp []string) string {
-	var port string
-	for _, p := range pp {
-		if !isTCPPort(p) {
-			continue
-		}
-		port = strings.TrimSpace(p)
-		tokens := strings.Split(port, ":")
-		if len(tokens) == 2 {
-			port = tokens[1]
+func (c *Container) kill(path, co string, timeout time.Duration) {
+	e, state := dao.NewRemoteExecutor(c.App().Conn()), dao.ContainerState(c.App().factory, path, co)
+	var forced bool
+	err := c.App().mutate("KillContainer", "v1/pods", fwFQN(path, co), func() error {
+		var err error
+		forced, err = dao.KillContainer(e, state, path, co, timeout)
+		return err
+	})
+	c.App().QueueUpdateDraw(func() {
+		switch {
+		case err != nil:
+			c.App().Flash().Errf("Kill %s failed: %s", co, err)
+		case forced:
+			c.App().Flash().Warnf("Container %s killed after %s. Restarting...", co, timeout)
+		default:
+			c.App().Flash().Infof("Container %s terminated. Restarting...", co)
 		}
-		break
+	})
+}
+
+func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
 	}
-	if port == "" {
-		c.App().Flash().Warn("No valid TCP port found on this container. User will specify...")
-		return "MY_TCP_PORT!"
+	if !c.isForwardable(path) {
+		return nil
 	}
+	showForwardDialog(c.App(), c.GetTable().Path, path, c.containerPorts(path))
 
-	return port
+	return nil
 }
 
-func (c *Container) portForward(address, lport, cport string) {
-	co := c.GetTable().GetSelectedCell(0)
-	pf := dao.NewPortForwarder(c.App().Conn())
-	ports := []string{lport + ":" + cport}
-	fw, err := pf.Start(c.GetTable().Path, co, address, ports)
-	if err != nil {
-		c.App().Flash().Err(err)
-		return
+func (c *Container) isForwardable(path string) bool {
+	state := c.GetTable().GetSelectedCell(3)
+	if state != "Running" {
+		c.App().Flash().Err(fmt.Errorf("Container %s is not running?", path))
+		return false
 	}
 
-	log.Debug().Msgf(">>> Starting port forward %q %v", c.GetTable().Path, ports)
-	go c.runForward(pf, fw)
+	return true
 }
 
-func (c *Container) runForward(pf *dao.PortForwarder, f *portforward.PortForwarder) {
-	c.App().QueueUpdateDraw(func() {
-		c.App().factory.AddForwarder(pf)
-		c.App().Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
-		dialog.DismissPortForward(c.App().Content.Pages)
-	})
+func (c *Container) containerPorts(path string) []render.ContainerPort {
+	var pp []render.ContainerPort
+	if row, ok := c.GetTable().GetRow(path); ok {
+		pp, _ = row.Data.([]render.ContainerPort)
+	}
 
-	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		c.App().Flash().Err(err)
-		return
+	return pp
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// forwardPorts preselects the container port and local port of the last
+// successful forward if any, otherwise the first detected container port.
+func forwardPorts(ports []string, last string) ([]string, string) {
+	tokens := strings.Split(last, ":")
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+		return ports, ""
 	}
-	c.App().QueueUpdateDraw(func() {
-		c.App().factory.DeleteForwarder(pf.FQN())
-		pf.SetActive(false)
-	})
+	lport, cport := tokens[0], tokens[1]
+
+	pp := make([]string, 0, len(ports)+1)
+	for _, p := range ports {
+		if portNumber(p) == cport {
+			pp = append(pp, p)
+		}
+	}
+	if len(pp) == 0 {
+		pp = append(pp, cport)
+	}
+	for _, p := range ports {
+		if portNumber(p) != cport {
+			pp = append(pp, p)
+		}
+	}
+
+	return pp, lport
+}
+
+// portNumber extracts the port number of a name:port╱protocol port spec.
+func portNumber(p string) string {
+	if i := strings.Index(p, "╱"); i >= 0 {
+		p = p[:i]
+	}
+	if i := strings.LastIndex(p, ":"); i >= 0 {
+		p = p[i+1:]
+	}
+
+	return p
 }
@@ -0,0 +1,51 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardPorts(t *testing.T) {
+	uu := map[string]struct {
+		ports, eports []string
+		last, elport  string
+	}{
+		"detected": {
+			ports:  []string{"http:8080", "admin:9090"},
+			eports: []string{"http:8080", "admin:9090"},
+		},
+		"last": {
+			ports:  []string{"http:8080", "admin:9090"},
+			last:   "9999:9090",
+			eports: []string{"admin:9090", "http:8080"},
+			elport: "9999",
+		},
+		"lastUnnamed": {
+			ports:  []string{"80", "443"},
+			last:   "8443:443",
+			eports: []string{"443", "80"},
+			elport: "8443",
+		},
+		"lastGone": {
+			ports:  []string{"http:8080"},
+			last:   "9999:7070",
+			eports: []string{"7070", "http:8080"},
+			elport: "9999",
+		},
+		"lastInvalid": {
+			ports:  []string{"http:8080"},
+			last:   "9999",
+			eports: []string{"http:8080"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, lport := forwardPorts(u.ports, u.last)
+			assert.Equal(t, u.eports, pp)
+			assert.Equal(t, u.elport, lport)
+		})
+	}
+}
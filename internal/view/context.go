@@ -2,11 +2,15 @@ package view
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -30,10 +34,97 @@ func NewContext(gvr client.GVR) ResourceViewer {
 
 func (c *Context) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlD: ui.NewDestructiveKeyAction("Delete", c.deleteCmd, true),
+		ui.KeyN:        ui.NewKeyAction("Set Namespace", c.namespaceCmd, true),
+	})
+}
+
+func (c *Context) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := c.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+	if c.isCurrent(name) {
+		c.App().Flash().Errf("Unable to delete current context %s", name)
+		return nil
+	}
+
+	origin, err := c.App().Conn().Config().ContextOrigin(name)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Delete context %s from %s?\nType the context name to confirm.", name, origin)
+	dialog.ShowPrompt(c.App().Content.Pages, "Delete Context", msg, "Context:", "", func(v string) {
+		if v != name {
+			c.App().Flash().Warnf("Context name mismatch. Context %s was not deleted", name)
+			return
+		}
+		err := c.App().Conn().Config().DelContext(name)
+		c.App().audit("Delete", "contexts", name, err)
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Context %s deleted from %s", name, origin)
+		c.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (c *Context) namespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := c.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+
+	ctx, err := c.App().Conn().Config().GetContext(name)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Set default namespace for context %s", name)
+	dialog.ShowPrompt(c.App().Content.Pages, "Set Namespace", msg, "Namespace:", ctx.Namespace, func(ns string) {
+		ns = strings.TrimSpace(ns)
+		if err := c.App().Conn().Config().SetContextNamespace(name, ns); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Context %s default namespace set to %q", name, ns)
+		c.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (c *Context) isCurrent(name string) bool {
+	current, err := c.App().Conn().Config().CurrentContextName()
+	if err != nil {
+		return false
+	}
+	return current == name
 }
 
 func (c *Context) useCtx(app *App, _, res, path string) {
 	log.Debug().Msgf("SWITCH CTX %q--%q", res, path)
+	if c.isCurrent(path) {
+		c.switchCtx(app, path)
+		return
+	}
+
+	msg := switchActivityMsg(path, app.factory.Forwarders().Paths(), app.benchmarks.Names())
+	if msg == "" {
+		c.switchCtx(app, path)
+		return
+	}
+	dialog.ShowConfirm(app.Content.Pages, "Confirm Switch", msg, func() {
+		c.switchCtx(app, path)
+	}, func() {})
+}
+
+func (c *Context) switchCtx(app *App, path string) {
 	if err := c.useContext(path); err != nil {
 		app.Flash().Err(err)
 		return
@@ -64,3 +155,26 @@ func (c *Context) useContext(name string) error {
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// switchActivityMsg lists port-forwards and benchmarks stopped by a context
+// switch or returns an empty message if there are none.
+func switchActivityMsg(ctx string, fwds, benches []string) string {
+	if len(fwds) == 0 && len(benches) == 0 {
+		return ""
+	}
+
+	sort.Strings(fwds)
+	var b strings.Builder
+	fmt.Fprintf(&b, "Switching to context %s will stop:", ctx)
+	for _, f := range fwds {
+		fmt.Fprintf(&b, "\n  port-forward %s", f)
+	}
+	for _, n := range benches {
+		fmt.Fprintf(&b, "\n  benchmark %s", n)
+	}
+
+	return b.String()
+}
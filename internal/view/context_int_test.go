@@ -0,0 +1,32 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwitchActivityMsg(t *testing.T) {
+	uu := map[string]struct {
+		fwds, benches []string
+		e             string
+	}{
+		"none": {},
+		"forwards": {
+			fwds: []string{"ns2/p2:c2", "ns1/p1:c1"},
+			e:    "Switching to context fred will stop:\n  port-forward ns1/p1:c1\n  port-forward ns2/p2:c2",
+		},
+		"all": {
+			fwds:    []string{"ns1/p1:c1"},
+			benches: []string{"default/svc1"},
+			e:       "Switching to context fred will stop:\n  port-forward ns1/p1:c1\n  benchmark default/svc1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, switchActivityMsg("fred", u.fwds, u.benches))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// CustomResourceDefinition represents a CRD viewer.
+type CustomResourceDefinition struct {
+	ResourceViewer
+}
+
+// NewCustomResourceDefinition returns a new viewer.
+func NewCustomResourceDefinition(gvr client.GVR) ResourceViewer {
+	c := CustomResourceDefinition{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *CustomResourceDefinition) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyS: ui.NewKeyAction("Schema", c.schemaCmd, true),
+	})
+}
+
+func (c *CustomResourceDefinition) schemaCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	ss, err := dao.CRDSchemas(c.App().factory, c.GVR(), sel)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	_, n := client.Namespaced(sel)
+	if err := c.App().inject(NewSchemaTree(c.App(), n, ss)); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomResourceDefinition(t *testing.T) {
+	v := view.NewCustomResourceDefinition(client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "CustomResourceDefinition", v.Name())
+	assert.Equal(t, 4, len(v.Hints()))
+}
@@ -63,7 +63,7 @@ func jobCtx(path, uid string) ContextFunc {
 
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
+		tcell.KeyCtrlT: ui.NewDangerousKeyAction("Trigger", c.trigger, true).Requires(ui.Permission{Resource: "batch/v1/jobs", Verb: "create"}),
 	})
 }
 
@@ -83,7 +83,10 @@ func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	if err := runner.Run(sel); err != nil {
+	err = c.App().mutate("Trigger", c.GVR(), sel, func() error {
+		return runner.Run(sel)
+	})
+	if err != nil {
 		c.App().Flash().Errf("Cronjob trigger failed %v", err)
 		return evt
 	}
@@ -0,0 +1,40 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+)
+
+const deprecationFmt = "%-20s %-45s %-30s %s\n"
+
+func showDeprecations(app *App) error {
+	details := NewDetails(app, "Deprecations", "apis")
+	details.SetColorizerFn(tview.Escape)
+	details.SetRefreshFn(func() (string, error) {
+		return deprecationReport(app.deprecations().List()), nil
+	})
+
+	return app.inject(details.Update(deprecationReport(app.deprecations().List())))
+}
+
+// deprecationReport lists deprecated apis in use along with their replacement.
+func deprecationReport(dd []client.Deprecation) string {
+	if len(dd) == 0 {
+		return "No deprecated APIs detected."
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, deprecationFmt, "KIND", "RESOURCE", "REPLACEMENT", "HITS")
+	for _, d := range dd {
+		kind := d.Kind
+		if kind == "" {
+			kind = client.NA
+		}
+		fmt.Fprintf(&b, deprecationFmt, kind, d.GVR, d.Replacement, fmt.Sprintf("%d", d.Count))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationReport(t *testing.T) {
+	uu := map[string]struct {
+		dd []client.Deprecation
+		e  string
+	}{
+		"none": {e: "No deprecated APIs detected."},
+		"some": {
+			dd: []client.Deprecation{
+				{GVR: "apps/v1beta1/deployments", Kind: "Deployment", Replacement: "apps/v1", Count: 3},
+				{GVR: "batch/v1beta1/cronjobs", Replacement: "batch/v1", Count: 1},
+			},
+			e: "KIND                 RESOURCE                                      REPLACEMENT                    HITS\n" +
+				"Deployment           apps/v1beta1/deployments                      apps/v1                        3\n" +
+				"n/a                  batch/v1beta1/cronjobs                        batch/v1                       1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, deprecationReport(u.dd))
+		})
+	}
+}
@@ -3,16 +3,23 @@ package view
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
 
-const detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+const (
+	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+
+	// detailsContext represents the number of lines kept around matches in filter mode.
+	detailsContext = 2
+	detailsSep     = "[gray::]--[-::-]"
+)
 
 // Details represents a generic text viewer.
 type Details struct {
@@ -22,8 +29,21 @@ type Details struct {
 	app            *App
 	title, subject string
 	buff           string
+	cmdBuff        *ui.CmdBuff
+	refreshFn      RefreshFunc
+	colorizerFn    ColorizerFunc
+	changed        map[int]struct{}
+	matches        []string
+	match          int
+	filtered       bool
 }
 
+// RefreshFunc fetches the latest details content.
+type RefreshFunc func() (string, error)
+
+// ColorizerFunc decorates raw details content.
+type ColorizerFunc func(raw string) string
+
 // NewDetails returns a details viewer.
 func NewDetails(app *App, title, subject string) *Details {
 	d := Details{
@@ -32,6 +52,7 @@ func NewDetails(app *App, title, subject string) *Details {
 		title:    title,
 		subject:  subject,
 		actions:  make(ui.KeyActions),
+		cmdBuff:  ui.NewCmdBuff('/', ui.FilterBuff),
 	}
 
 	return &d
@@ -45,8 +66,9 @@ func (d *Details) Init(_ context.Context) error {
 	d.SetScrollable(true)
 	d.SetWrap(true)
 	d.SetDynamicColors(true)
-	d.SetHighlightColor(tcell.ColorOrange)
-	d.SetTitleColor(tcell.ColorAqua)
+	d.SetRegions(true)
+	d.SetHighlightColor(render.Accent(tcell.ColorOrange))
+	d.SetTitleColor(render.Accent(tcell.ColorAqua))
 	d.SetInputCapture(d.keyboard)
 	d.bindKeys()
 	d.SetChangedFunc(func() {
@@ -68,15 +90,85 @@ func (d *Details) StylesChanged(s *config.Styles) {
 	d.Update(d.buff)
 }
 
-// Update updates the view content.
+// Update replaces the view content and resets any search.
 func (d *Details) Update(buff string) *Details {
-	d.buff = buff
-	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, buff))
+	d.buff, d.changed, d.filtered, d.match = buff, nil, false, 0
+	if !d.cmdBuff.Empty() {
+		d.cmdBuff.Reset()
+	}
+	d.render()
 	d.ScrollToBeginning()
 
 	return d
 }
 
+// SetRefreshFn enables content refreshes.
+func (d *Details) SetRefreshFn(f RefreshFunc) {
+	d.refreshFn = f
+}
+
+// SetColorizerFn overrides the default YAML colorizer.
+func (d *Details) SetColorizerFn(f ColorizerFunc) {
+	d.colorizerFn = f
+}
+
+// Refresh fetches the latest content and highlights changed lines.
+func (d *Details) Refresh() error {
+	if d.refreshFn == nil {
+		return nil
+	}
+	buff, err := d.refreshFn()
+	if err != nil {
+		return err
+	}
+	d.changed = changedLines(d.buff, buff)
+	d.buff = buff
+	d.render()
+
+	return nil
+}
+
+// BufferChanged indicates the search buffer changed.
+func (d *Details) BufferChanged(string) {
+	d.render()
+	d.match = 0
+	d.showMatch()
+}
+
+// BufferActive indicates the buff activity changed.
+func (d *Details) BufferActive(state bool, k ui.BufferKind) {
+	d.app.BufferActive(state, k)
+}
+
+func (d *Details) render() {
+	colorized := colorizeYAML(d.app.Styles.Views().Yaml, d.buff)
+	if d.colorizerFn != nil {
+		colorized = d.colorizerFn(d.buff)
+	}
+	q := d.cmdBuff.String()
+	lines, matches, hits := decorateLines(
+		strings.Split(d.buff, "\n"),
+		strings.Split(colorized, "\n"),
+		d.changed,
+		q,
+	)
+	d.matches = matches
+	if d.filtered && q != "" {
+		lines = collapseLines(lines, hits, detailsContext)
+	}
+	d.SetText(strings.Join(lines, "\n"))
+}
+
+func (d *Details) showMatch() {
+	if len(d.matches) == 0 {
+		d.Highlight()
+		return
+	}
+	d.match %= len(d.matches)
+	d.Highlight(d.matches[d.match])
+	d.ScrollToHighlight()
+}
+
 // SetSubject updates the subject.
 func (d *Details) SetSubject(s string) {
 	d.subject = s
@@ -91,10 +183,17 @@ func (d *Details) Actions() ui.KeyActions {
 func (d *Details) Name() string { return d.title }
 
 // Start starts the view updater.
-func (d *Details) Start() {}
+func (d *Details) Start() {
+	d.cmdBuff.RemoveListener(d.app.Cmd())
+	d.cmdBuff.RemoveListener(d)
+	d.cmdBuff.AddListener(d.app.Cmd())
+	d.cmdBuff.AddListener(d)
+}
 
 // Stop terminates the updater.
 func (d *Details) Stop() {
+	d.cmdBuff.RemoveListener(d.app.Cmd())
+	d.cmdBuff.RemoveListener(d)
 	d.app.Styles.RemoveListener(d)
 }
 
@@ -105,15 +204,32 @@ func (d *Details) Hints() model.MenuHints {
 
 func (d *Details) bindKeys() {
 	d.actions.Set(ui.KeyActions{
-		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, false),
-		tcell.KeyCtrlS:  ui.NewKeyAction("Save", d.saveCmd, false),
-		ui.KeyC:         ui.NewKeyAction("Copy", d.cpCmd, true),
+		tcell.KeyEscape:     ui.NewKeyAction("Back", d.resetCmd, false),
+		tcell.KeyCtrlS:      ui.NewKeyAction("Save", d.saveCmd, false),
+		ui.KeyC:             ui.NewKeyAction("Copy", d.cpCmd, true),
+		ui.KeySlash:         ui.NewSharedKeyAction("Filter Mode", d.activateCmd, false),
+		ui.KeyN:             ui.NewKeyAction("Next Match", d.nextCmd(1), false),
+		ui.KeyShiftN:        ui.NewKeyAction("Prev Match", d.nextCmd(-1), false),
+		ui.KeyF:             ui.NewKeyAction("Toggle Filter", d.filterModeCmd, true),
+		tcell.KeyEnter:      ui.NewSharedKeyAction("Filter", d.filterCmd, false),
+		tcell.KeyBackspace2: ui.NewSharedKeyAction("Erase", d.eraseCmd, false),
+		tcell.KeyBackspace:  ui.NewSharedKeyAction("Erase", d.eraseCmd, false),
+		tcell.KeyDelete:     ui.NewSharedKeyAction("Erase", d.eraseCmd, false),
 	})
+	if d.refreshFn != nil {
+		d.actions.Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Refresh", d.refreshCmd, true),
+		})
+	}
 }
 
 func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	key := evt.Key()
 	if key == tcell.KeyRune {
+		if d.cmdBuff.IsActive() {
+			d.cmdBuff.Add(evt.Rune())
+			return nil
+		}
 		key = tcell.Key(evt.Rune())
 	}
 	if a, ok := d.actions[key]; ok {
@@ -123,8 +239,80 @@ func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+func (d *Details) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !d.cmdBuff.InCmdMode() {
+		return d.app.PrevCmd(evt)
+	}
+	d.filtered = false
+	d.cmdBuff.Reset()
+
+	return nil
+}
+
+func (d *Details) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.app.InCmdMode() {
+		return evt
+	}
+	d.app.Flash().Info("Filter mode activated.")
+	d.cmdBuff.SetActive(true)
+
+	return nil
+}
+
+func (d *Details) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !d.cmdBuff.IsActive() {
+		return evt
+	}
+	d.cmdBuff.SetActive(false)
+
+	return nil
+}
+
+func (d *Details) eraseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.cmdBuff.IsActive() {
+		d.cmdBuff.Delete()
+	}
+
+	return nil
+}
+
+func (d *Details) nextCmd(dir int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if len(d.matches) == 0 {
+			return evt
+		}
+		d.match = (d.match + dir + len(d.matches)) % len(d.matches)
+		d.showMatch()
+
+		return nil
+	}
+}
+
+func (d *Details) filterModeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.filtered = !d.filtered
+	if d.filtered {
+		d.app.Flash().Info("Showing matching lines only")
+	} else {
+		d.app.Flash().Info("Showing all lines")
+	}
+	d.render()
+	d.showMatch()
+
+	return nil
+}
+
+func (d *Details) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := d.Refresh(); err != nil {
+		d.app.Flash().Err(err)
+		return nil
+	}
+	d.app.Flash().Infof("Refreshed %s -- %d lines changed", d.subject, len(d.changed))
+
+	return nil
+}
+
 func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveYAML(d.app.Config.K9s.CurrentCluster, d.title, d.GetText(true)); err != nil {
+	if path, err := saveYAML(d.app.Config.K9s.CurrentCluster, d.title, d.buff); err != nil {
 		d.app.Flash().Err(err)
 	} else {
 		d.app.Flash().Infof("Log %s saved successfully!", path)
@@ -134,7 +322,7 @@ func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (d *Details) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.app.Flash().Info("Content copied to clipboard...")
-	if err := clipboard.WriteAll(d.GetText(true)); err != nil {
+	if err := d.app.Clipboard().Copy(d.buff); err != nil {
 		d.app.Flash().Err(err)
 	}
 	return nil
@@ -147,3 +335,113 @@ func (d *Details) updateTitle() {
 	title := ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, d.title, d.subject), d.app.Styles.Frame())
 	d.SetTitle(title)
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// changedLines returns the indexes of the lines that are new since the
+// previous content.
+func changedLines(prev, curr string) map[int]struct{} {
+	if prev == "" {
+		return nil
+	}
+	seen := make(map[string]int)
+	for _, l := range strings.Split(prev, "\n") {
+		seen[l]++
+	}
+
+	changed := make(map[int]struct{})
+	for i, l := range strings.Split(curr, "\n") {
+		if seen[l] > 0 {
+			seen[l]--
+			continue
+		}
+		changed[i] = struct{}{}
+	}
+
+	return changed
+}
+
+// decorateLines marks changed lines and wraps each occurrence of a query into
+// a highlight region. It returns the decorated lines, the matching regions and
+// the indexes of the matching lines.
+func decorateLines(raw, colorized []string, changed map[int]struct{}, q string) ([]string, []string, []int) {
+	lines, regions, hits := make([]string, 0, len(colorized)), []string{}, []int{}
+	for i, l := range colorized {
+		if q != "" && i < len(raw) {
+			var rr []string
+			if l, rr = highlightMatches(raw[i], l, q, len(regions)); len(rr) > 0 {
+				regions, hits = append(regions, rr...), append(hits, i)
+			}
+		}
+		if len(changed) > 0 {
+			if _, ok := changed[i]; ok {
+				l = render.Tags("[orange::b]+[-::-] ") + l
+			} else {
+				l = "  " + l
+			}
+		}
+		lines = append(lines, l)
+	}
+
+	return lines, regions, hits
+}
+
+// highlightMatches wraps all case insensitive occurrences of a query in a raw
+// line into regions numbered from start. Matching lines lose their colors. The
+// colorized line is returned unchanged when there is no match.
+func highlightMatches(raw, colorized, q string, start int) (string, []string) {
+	lraw, lq := strings.ToLower(raw), strings.ToLower(q)
+	if !strings.Contains(lraw, lq) {
+		return colorized, nil
+	}
+	if len(lraw) != len(raw) {
+		id := fmt.Sprintf("m%d", start)
+		return fmt.Sprintf(`["%s"]%s[""]`, id, tview.Escape(raw)), []string{id}
+	}
+
+	var (
+		b       strings.Builder
+		regions []string
+		last    int
+	)
+	for {
+		i := strings.Index(lraw[last:], lq)
+		if i < 0 {
+			break
+		}
+		i += last
+		id := fmt.Sprintf("m%d", start+len(regions))
+		fmt.Fprintf(&b, `%s["%s"][::r]%s[::-][""]`, tview.Escape(raw[last:i]), id, tview.Escape(raw[i:i+len(lq)]))
+		regions, last = append(regions, id), i+len(lq)
+	}
+	b.WriteString(tview.Escape(raw[last:]))
+
+	return b.String(), regions
+}
+
+// collapseLines keeps matching lines along with ctx lines around them,
+// separating disjoint groups like grep -C does.
+func collapseLines(lines []string, hits []int, ctx int) []string {
+	keep := make([]bool, len(lines))
+	for _, h := range hits {
+		for i := h - ctx; i <= h+ctx; i++ {
+			if i >= 0 && i < len(lines) {
+				keep[i] = true
+			}
+		}
+	}
+
+	cc, prev := make([]string, 0, len(lines)), -1
+	for i, l := range lines {
+		if !keep[i] {
+			continue
+		}
+		if prev >= 0 && i > prev+1 {
+			cc = append(cc, detailsSep)
+		}
+		cc, prev = append(cc, l), i
+	}
+
+	return cc
+}
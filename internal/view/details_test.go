@@ -0,0 +1,205 @@
+package view
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedLines(t *testing.T) {
+	uu := map[string]struct {
+		prev, curr string
+		e          map[int]struct{}
+	}{
+		"first": {
+			curr: "a: 1\nb: 2",
+		},
+		"same": {
+			prev: "a: 1\nb: 2",
+			curr: "a: 1\nb: 2",
+			e:    map[int]struct{}{},
+		},
+		"changed": {
+			prev: "a: 1\nb: 2\nc: 3",
+			curr: "a: 1\nb: 3\nc: 3\nd: 4",
+			e:    map[int]struct{}{1: {}, 3: {}},
+		},
+		"dups": {
+			prev: "- a\n- b",
+			curr: "- a\n- a\n- b",
+			e:    map[int]struct{}{1: {}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, changedLines(u.prev, u.curr))
+		})
+	}
+}
+
+func TestDecorateLines(t *testing.T) {
+	uu := map[string]struct {
+		raw     []string
+		changed map[int]struct{}
+		q       string
+		e, rr   []string
+		hits    []int
+	}{
+		"plain": {
+			raw:  []string{"a: 1", "b: 2"},
+			e:    []string{"a: 1", "b: 2"},
+			rr:   []string{},
+			hits: []int{},
+		},
+		"changed": {
+			raw:     []string{"a: 1", "b: 2"},
+			changed: map[int]struct{}{1: {}},
+			e:       []string{"  a: 1", "[orange::b]+[-::-] b: 2"},
+			rr:      []string{},
+			hits:    []int{},
+		},
+		"search": {
+			raw:  []string{"name: fred", "app: blee", "fred: 1"},
+			q:    "FRED",
+			e:    []string{`name: ["m0"][::r]fred[::-][""]`, "app: blee", `["m1"][::r]fred[::-][""]: 1`},
+			rr:   []string{"m0", "m1"},
+			hits: []int{0, 2},
+		},
+		"changedSearch": {
+			raw:     []string{"a: fred", "b: 2"},
+			changed: map[int]struct{}{0: {}},
+			q:       "fred",
+			e:       []string{`[orange::b]+[-::-] a: ["m0"][::r]fred[::-][""]`, "  b: 2"},
+			rr:      []string{"m0"},
+			hits:    []int{0},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll, rr, hh := decorateLines(u.raw, u.raw, u.changed, u.q)
+			assert.Equal(t, u.e, ll)
+			assert.Equal(t, u.rr, rr)
+			assert.Equal(t, u.hits, hh)
+		})
+	}
+}
+
+func TestHighlightMatches(t *testing.T) {
+	uu := map[string]struct {
+		raw, q string
+		start  int
+		e      string
+		rr     []string
+	}{
+		"none": {
+			raw: "[blue::]app: blee",
+			q:   "fred",
+			e:   "[blue::]app: blee",
+		},
+		"start": {
+			raw: "fred: 1",
+			q:   "fred",
+			e:   `["m0"][::r]fred[::-][""]: 1`,
+			rr:  []string{"m0"},
+		},
+		"end": {
+			raw: "name: Fred",
+			q:   "fred",
+			e:   `name: ["m0"][::r]Fred[::-][""]`,
+			rr:  []string{"m0"},
+		},
+		"multi": {
+			raw:   "fred-fred",
+			q:     "fred",
+			start: 3,
+			e:     `["m3"][::r]fred[::-][""]-["m4"][::r]fred[::-][""]`,
+			rr:    []string{"m3", "m4"},
+		},
+		"escaped": {
+			raw: "[fred]",
+			q:   "fred",
+			e:   `[["m0"][::r]fred[::-][""]]`,
+			rr:  []string{"m0"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, rr := highlightMatches(u.raw, u.raw, u.q, u.start)
+			assert.Equal(t, u.e, l)
+			assert.Equal(t, u.rr, rr)
+		})
+	}
+}
+
+func TestCollapseLines(t *testing.T) {
+	ll := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	uu := map[string]struct {
+		hits []int
+		e    []string
+	}{
+		"none": {
+			e: []string{},
+		},
+		"first": {
+			hits: []int{0},
+			e:    []string{"0", "1", "2"},
+		},
+		"last": {
+			hits: []int{9},
+			e:    []string{"7", "8", "9"},
+		},
+		"overlap": {
+			hits: []int{3, 5},
+			e:    []string{"1", "2", "3", "4", "5", "6", "7"},
+		},
+		"disjoint": {
+			hits: []int{1, 8},
+			e:    []string{"0", "1", "2", "3", detailsSep, "6", "7", "8", "9"},
+		},
+		"adjacent": {
+			hits: []int{2, 7},
+			e:    []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, collapseLines(ll, u.hits, 2))
+		})
+	}
+}
+
+func TestDetailsSaveRaw(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-details")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d string) { config.K9sDumpDir = d }(config.K9sDumpDir)
+	config.K9sDumpDir = dir
+
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.CurrentCluster = "c1"
+	d := NewDetails(a, "YAML", "fred")
+	d.refreshFn = func() (string, error) { return "a: 1\nb: 3\n", nil }
+	d.Update("a: 1\nb: 2\n")
+	assert.Nil(t, d.Refresh())
+	assert.NotEqual(t, "a: 1\nb: 3\n", d.GetText(true))
+
+	d.saveCmd(nil)
+	ff, err := ioutil.ReadDir(filepath.Join(dir, "c1"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ff))
+	data, err := ioutil.ReadFile(filepath.Join(dir, "c1", ff[0].Name()))
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 1\nb: 3\n", string(data))
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+)
+
+const (
+	diffAddFmt  = "[green::]%s[-::]"
+	diffDelFmt  = "[red::]%s[-::]"
+	diffHunkFmt = "[aqua::b]%s[-::-]"
+)
+
+func showDiff(app *App, path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	dd, err := dao.DiffManifests(app.factory, raw, app.Config.ActiveNamespace())
+	if err != nil {
+		return err
+	}
+
+	details := NewDetails(app, "Diff", path)
+	details.SetColorizerFn(colorizeDiff)
+	details.SetRefreshFn(func() (string, error) {
+		dd, err := dao.DiffManifests(app.factory, raw, app.Config.ActiveNamespace())
+		if err != nil {
+			return "", err
+		}
+		return diffReport(dd), nil
+	})
+
+	return app.inject(details.Update(diffReport(dd)))
+}
+
+// diffReport summarizes manifests differences followed by each diff.
+func diffReport(dd []dao.ManifestDiff) string {
+	var b strings.Builder
+	var count int
+	for _, d := range dd {
+		status := "unchanged"
+		switch {
+		case d.Err != nil:
+			status = "error"
+		case d.Missing:
+			status = "missing"
+		case d.Differs():
+			status = "differs"
+		}
+		if d.Differs() {
+			count++
+		}
+		fmt.Fprintf(&b, "%-10s %s\n", status, d.Name)
+	}
+	fmt.Fprintf(&b, "\n%d of %d manifests differ\n", count, len(dd))
+
+	for _, d := range dd {
+		if d.Err == nil && !d.Differs() {
+			continue
+		}
+		fmt.Fprintf(&b, "\n=== %s\n", d.Name)
+		if d.Err != nil {
+			fmt.Fprintf(&b, "%s\n", d.Err)
+			continue
+		}
+		b.WriteString(d.Diff)
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func colorizeDiff(raw string) string {
+	lines := strings.Split(tview.Escape(raw), "\n")
+	for i, l := range lines {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"), strings.HasPrefix(l, "==="), strings.HasPrefix(l, "@@"):
+			lines[i] = fmt.Sprintf(render.Tags(diffHunkFmt), l)
+		case strings.HasPrefix(l, "+"), strings.HasPrefix(l, "missing "):
+			lines[i] = fmt.Sprintf(render.Tags(diffAddFmt), l)
+		case strings.HasPrefix(l, "-"), strings.HasPrefix(l, "differs "), strings.HasPrefix(l, "error "):
+			lines[i] = fmt.Sprintf(render.Tags(diffDelFmt), l)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
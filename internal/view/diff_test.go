@@ -0,0 +1,48 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffReport(t *testing.T) {
+	dd := []dao.ManifestDiff{
+		{Name: "Pod default/p1"},
+		{Name: "Pod default/p2", Diff: "--- live\n+++ local\n@@ -1,1 +1,1 @@\n-a: 1\n+a: 2\n"},
+		{Name: "Pod default/p3", Missing: true, Diff: "--- live\n+++ local\n@@ -0,0 +1,1 @@\n+a: 1\n"},
+		{Name: "Fred default/f1", Err: errors.New("boom")},
+	}
+
+	assert.Equal(t, `unchanged  Pod default/p1
+differs    Pod default/p2
+missing    Pod default/p3
+error      Fred default/f1
+
+2 of 4 manifests differ
+
+=== Pod default/p2
+--- live
++++ local
+@@ -1,1 +1,1 @@
+-a: 1
++a: 2
+
+=== Pod default/p3
+--- live
++++ local
+@@ -0,0 +1,1 @@
++a: 1
+
+=== Fred default/f1
+boom`, diffReport(dd))
+}
+
+func TestColorizeDiff(t *testing.T) {
+	assert.Equal(t,
+		"[aqua::b]@@ -1,1 +1,1 @@[-::-]\n[red::]-a: 1[-::]\n[green::]+a: 2[-::]\n b: [x[]",
+		colorizeDiff("@@ -1,1 +1,1 @@\n-a: 1\n+a: 2\n b: [x]"),
+	)
+}
@@ -0,0 +1,408 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// DockMode represents the content of the dock pane.
+type DockMode int
+
+const (
+	// DockLogs streams the selected resource logs.
+	DockLogs DockMode = iota
+
+	// DockEvents shows the selected resource events.
+	DockEvents
+)
+
+const (
+	dockTitleFmt  = " Dock<%s>([fg:bg:]%s) "
+	dockScale     = 10
+	dockSize      = 3
+	dockMinSize   = 1
+	dockMaxSize   = 8
+	dockDebounce  = 300 * time.Millisecond
+	eventsRefresh = 2 * time.Second
+)
+
+// String returns the mode name.
+func (m DockMode) String() string {
+	if m == DockEvents {
+		return "events"
+	}
+	return "logs"
+}
+
+// DockStreamFunc streams a resource content into the dock.
+type DockStreamFunc func(ctx context.Context, gvr client.GVR, path string, mode DockMode)
+
+// Dock represents a pane docked below the main view that follows the current selection.
+type Dock struct {
+	*tview.TextView
+
+	app        *App
+	actions    ui.KeyActions
+	ansiWriter io.Writer
+	table      *Table
+	streamFn   DockStreamFunc
+	size       int
+	open       bool
+
+	mx       sync.Mutex
+	mode     DockMode
+	gvr      client.GVR
+	path     string
+	seq      int
+	timer    *time.Timer
+	cancelFn context.CancelFunc
+}
+
+var _ model.StackListener = &Dock{}
+
+// NewDock returns a new dock.
+func NewDock(app *App) *Dock {
+	d := &Dock{
+		TextView: tview.NewTextView(),
+		app:      app,
+		actions:  make(ui.KeyActions),
+		size:     dockSize,
+	}
+	d.streamFn = d.stream
+	d.SetBorder(true)
+	d.SetBorderPadding(0, 0, 1, 1)
+	d.SetDynamicColors(true)
+	d.SetWrap(false)
+	d.SetMaxBuffer(app.Config.K9s.LogBufferSize)
+	d.SetInputCapture(d.keyboard)
+	d.bindKeys()
+	d.StylesChanged(app.Styles)
+	d.ansiWriter = tview.ANSIWriter(d, app.Styles.Views().Log.FgColor, app.Styles.Views().Log.BgColor)
+
+	return d
+}
+
+// StylesChanged notifies the skin changed.
+func (d *Dock) StylesChanged(s *config.Styles) {
+	d.SetBackgroundColor(config.AsColor(s.Views().Log.BgColor))
+	d.SetTextColor(config.AsColor(s.Views().Log.FgColor))
+	d.SetBorderFocusColor(config.AsColor(s.Frame().Border.FocusColor))
+}
+
+func (d *Dock) bindKeys() {
+	d.actions.Add(ui.KeyActions{
+		ui.KeyL:         ui.NewKeyAction("Logs", d.modeCmd(DockLogs), true),
+		ui.KeyE:         ui.NewKeyAction("Events", d.modeCmd(DockEvents), true),
+		ui.KeyC:         ui.NewKeyAction("Clear", d.clearCmd, true),
+		ui.KeyShiftK:    ui.NewKeyAction("Grow", d.resizeCmd(1), true),
+		ui.KeyShiftJ:    ui.NewKeyAction("Shrink", d.resizeCmd(-1), true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.backCmd, true),
+	})
+}
+
+func (d *Dock) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := d.actions[key]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+// Size returns the dock proportion out of dockScale.
+func (d *Dock) Size() int {
+	return d.size
+}
+
+// IsOpen returns true if the dock is showing.
+func (d *Dock) IsOpen() bool {
+	return d.open
+}
+
+// Mode returns the current dock mode.
+func (d *Dock) Mode() DockMode {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	return d.mode
+}
+
+// Open starts following the given component.
+func (d *Dock) Open(c model.Component) {
+	d.open = true
+	d.track(c)
+}
+
+// Close stops the current stream and any pending selection.
+func (d *Dock) Close() {
+	d.open = false
+	d.untrack()
+
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	d.seq++
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+	d.stop()
+	d.path = ""
+}
+
+// StackPushed notifies a new component was pushed.
+func (d *Dock) StackPushed(c model.Component) {
+	d.track(c)
+}
+
+// StackPopped notifies a component was popped.
+func (d *Dock) StackPopped(_, top model.Component) {
+	d.track(top)
+}
+
+// StackTop notifies the top component.
+func (d *Dock) StackTop(top model.Component) {
+	d.track(top)
+}
+
+// SelectionChanged notifies the followed table selection changed.
+func (d *Dock) SelectionChanged(item string) {
+	d.schedule(item)
+}
+
+func (d *Dock) track(c model.Component) {
+	d.untrack()
+	v, ok := c.(ResourceViewer)
+	if !ok {
+		return
+	}
+	d.table = v.GetTable()
+	d.table.AddSelectionListener(d)
+
+	d.mx.Lock()
+	if gvr := client.NewGVR(v.GVR()); gvr != d.gvr {
+		d.gvr, d.path = gvr, ""
+	}
+	d.mx.Unlock()
+	d.schedule(d.table.GetSelectedItem())
+}
+
+func (d *Dock) untrack() {
+	if d.table == nil {
+		return
+	}
+	d.table.RemoveSelectionListener(d)
+	d.table = nil
+}
+
+// Schedule follows a new selection once it settles.
+func (d *Dock) schedule(path string) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.seq++
+	seq := d.seq
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.timer = time.AfterFunc(dockDebounce, func() {
+		d.follow(seq, path)
+	})
+}
+
+// Follow swaps the current stream for the given selection unless superseded.
+func (d *Dock) follow(seq int, path string) {
+	d.mx.Lock()
+	if seq != d.seq || path == d.path {
+		d.mx.Unlock()
+		return
+	}
+	d.stop()
+	d.path = path
+	var ctx context.Context
+	ctx, d.cancelFn = context.WithCancel(context.Background())
+	gvr, mode := d.gvr, d.mode
+	d.mx.Unlock()
+
+	d.streamFn(ctx, gvr, path, mode)
+}
+
+func (d *Dock) reload() {
+	d.mx.Lock()
+	d.seq++
+	seq, path := d.seq, d.path
+	d.path = ""
+	d.mx.Unlock()
+
+	go d.follow(seq, path)
+}
+
+func (d *Dock) stop() {
+	if d.cancelFn == nil {
+		return
+	}
+	d.cancelFn()
+	d.cancelFn = nil
+}
+
+func (d *Dock) stream(ctx context.Context, gvr client.GVR, path string, mode DockMode) {
+	d.app.QueueUpdateDraw(func() {
+		d.Clear()
+		d.SetTitle(ui.SkinTitle(fmt.Sprintf(dockTitleFmt, mode, path), d.app.Styles.Frame()))
+	})
+	if path == "" {
+		d.write(ctx, "No resource selected.")
+		return
+	}
+
+	switch mode {
+	case DockEvents:
+		go d.events(ctx, gvr, path)
+	default:
+		if err := d.logs(ctx, gvr, path); err != nil {
+			d.write(ctx, err.Error())
+		}
+	}
+}
+
+func (d *Dock) logs(ctx context.Context, gvr client.GVR, path string) error {
+	if gvr.String() == "containers" {
+		return fmt.Errorf("Logs are not available in the dock for %s. Press e for events", gvr)
+	}
+	accessor, err := dao.AccessorFor(d.app.factory, gvr)
+	if err != nil {
+		return err
+	}
+	logger, ok := accessor.(dao.Loggable)
+	if !ok {
+		return fmt.Errorf("Resource %s is not tailable. Press e for events", gvr)
+	}
+
+	c := make(chan string, 10)
+	go d.updateLogs(ctx, c)
+	ctx = context.WithValue(ctx, internal.KeyFactory, d.app.factory)
+	opts := dao.LogOptions{
+		Path:   path,
+		Lines:  int64(d.app.Config.K9s.LogRequestSize),
+		Reopen: !d.app.Config.K9s.DisableLogReopen,
+	}
+
+	return logger.TailLogs(ctx, c, opts)
+}
+
+func (d *Dock) updateLogs(ctx context.Context, c <-chan string) {
+	buff := make([]string, 0, logBuffSize)
+	for {
+		select {
+		case line, ok := <-c:
+			if !ok {
+				d.flush(ctx, buff)
+				return
+			}
+			if buff = append(buff, line); len(buff) == logBuffSize {
+				d.flush(ctx, buff)
+				buff = buff[:0]
+			}
+		case <-time.After(FlushTimeout):
+			d.flush(ctx, buff)
+			buff = buff[:0]
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *Dock) flush(ctx context.Context, buff []string) {
+	if len(buff) == 0 {
+		return
+	}
+	lines := strings.Join(buff, "\n")
+	d.app.QueueUpdateDraw(func() {
+		if ctx.Err() != nil {
+			return
+		}
+		fmt.Fprintln(d.ansiWriter, tview.Escape(lines))
+		d.ScrollToEnd()
+	})
+}
+
+func (d *Dock) events(ctx context.Context, gvr client.GVR, path string) {
+	for {
+		ee, err := dao.EventsFor(d.app.factory, gvr, path)
+		if err != nil {
+			log.Error().Err(err).Msgf("Dock events for %s", path)
+			d.write(ctx, err.Error())
+		} else {
+			d.write(ctx, dao.EventReport(ee))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventsRefresh):
+		}
+	}
+}
+
+func (d *Dock) write(ctx context.Context, text string) {
+	d.app.QueueUpdateDraw(func() {
+		if ctx.Err() != nil {
+			return
+		}
+		d.SetText(tview.Escape(text))
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Actions()...
+
+func (d *Dock) modeCmd(m DockMode) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		d.mx.Lock()
+		changed := d.mode != m
+		d.mode = m
+		d.mx.Unlock()
+		if changed {
+			d.reload()
+		}
+		return nil
+	}
+}
+
+func (d *Dock) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.Clear()
+	d.ScrollTo(0, 0)
+	return nil
+}
+
+func (d *Dock) resizeCmd(delta int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		size := d.size + delta
+		if size < dockMinSize || size > dockMaxSize {
+			return nil
+		}
+		d.size = size
+		d.app.resizeDock()
+		return nil
+	}
+}
+
+func (d *Dock) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.app.focusContent()
+	return nil
+}
@@ -0,0 +1,125 @@
+package view
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDockDebounce(t *testing.T) {
+	d, r := makeDock()
+
+	d.SelectionChanged("default/p1")
+	d.SelectionChanged("default/p2")
+	d.SelectionChanged("default/p3")
+	settle()
+
+	assert.Equal(t, []string{"default/p3"}, r.paths())
+}
+
+func TestDockFollow(t *testing.T) {
+	d, r := makeDock()
+
+	d.SelectionChanged("default/p1")
+	settle()
+	d.SelectionChanged("default/p1")
+	settle()
+	assert.Equal(t, []string{"default/p1"}, r.paths())
+
+	d.SelectionChanged("default/p2")
+	settle()
+	assert.Equal(t, []string{"default/p1", "default/p2"}, r.paths())
+	assert.Error(t, r.ctx(0).Err())
+	assert.NoError(t, r.ctx(1).Err())
+}
+
+func TestDockClose(t *testing.T) {
+	d, r := makeDock()
+
+	d.SelectionChanged("default/p1")
+	settle()
+	d.SelectionChanged("default/p2")
+	d.Close()
+	settle()
+
+	assert.Equal(t, []string{"default/p1"}, r.paths())
+	assert.Error(t, r.ctx(0).Err())
+}
+
+func TestDockMode(t *testing.T) {
+	d, r := makeDock()
+
+	d.SelectionChanged("default/p1")
+	settle()
+	d.modeCmd(DockEvents)(nil)
+	settle()
+	d.modeCmd(DockEvents)(nil)
+	settle()
+
+	assert.Equal(t, DockEvents, d.Mode())
+	assert.Equal(t, []string{"default/p1", "default/p1"}, r.paths())
+	assert.Equal(t, []DockMode{DockLogs, DockEvents}, r.modes())
+	assert.Error(t, r.ctx(0).Err())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type streamCall struct {
+	ctx  context.Context
+	path string
+	mode DockMode
+}
+
+type streamRecorder struct {
+	mx    sync.Mutex
+	calls []streamCall
+}
+
+func (s *streamRecorder) stream(ctx context.Context, _ client.GVR, path string, mode DockMode) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.calls = append(s.calls, streamCall{ctx: ctx, path: path, mode: mode})
+}
+
+func (s *streamRecorder) paths() []string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	pp := make([]string, 0, len(s.calls))
+	for _, c := range s.calls {
+		pp = append(pp, c.path)
+	}
+	return pp
+}
+
+func (s *streamRecorder) modes() []DockMode {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	mm := make([]DockMode, 0, len(s.calls))
+	for _, c := range s.calls {
+		mm = append(mm, c.mode)
+	}
+	return mm
+}
+
+func (s *streamRecorder) ctx(i int) context.Context {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return s.calls[i].ctx
+}
+
+func makeDock() (*Dock, *streamRecorder) {
+	app, _ := extractApp(makeContext())
+	d, r := NewDock(app), streamRecorder{}
+	d.streamFn = r.stream
+
+	return d, &r
+}
+
+func settle() {
+	time.Sleep(2 * dockDebounce)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -34,12 +35,25 @@ func NewDeploy(gvr client.GVR) ResourceViewer {
 
 func (d *Deploy) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftY: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(1, true), false),
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(3, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Rollout", d.rolloutCmd, true),
 	})
 }
 
+func (d *Deploy) rolloutCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := d.App().inject(NewRollout(path)); err != nil {
+		d.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (d *Deploy) showPods(app *App, _, _, path string) {
 	o, err := app.factory.Get(d.GVR(), path, true, labels.Everything())
 	if err != nil {
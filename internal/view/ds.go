@@ -1,9 +1,13 @@
 package view
 
 import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -23,7 +27,7 @@ func NewDaemonSet(gvr client.GVR) ResourceViewer {
 		),
 	}
 	d.SetBindKeysFn(d.bindKeys)
-	d.GetTable().SetEnterFn(d.showPods)
+	d.GetTable().SetEnterFn(d.showCoverage)
 	d.GetTable().SetColorerFn(render.DaemonSet{}.ColorerFunc())
 
 	return &d
@@ -31,6 +35,7 @@ func NewDaemonSet(gvr client.GVR) ResourceViewer {
 
 func (d *DaemonSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
+		ui.KeyP:      ui.NewKeyAction("Show Pods", d.showPodsCmd, true),
 		ui.KeyShiftD: ui.NewKeyAction("Sort Desired", d.GetTable().SortColCmd(1, true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Current", d.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(3, true), false),
@@ -39,6 +44,26 @@ func (d *DaemonSet) bindKeys(aa ui.KeyActions) {
 	})
 }
 
+func (d *DaemonSet) showCoverage(app *App, _, _, path string) {
+	n := NewNodeCoverage(client.NewGVR("dscoverage"))
+	n.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(n); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (d *DaemonSet) showPodsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	d.showPods(d.App(), "", "", path)
+
+	return nil
+}
+
 func (d *DaemonSet) showPods(app *App, _, _, path string) {
 	o, err := app.factory.Get(d.GVR(), path, true, labels.Everything())
 	if err != nil {
@@ -50,6 +75,7 @@ func (d *DaemonSet) showPods(app *App, _, _, path string) {
 	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ds)
 	if err != nil {
 		d.App().Flash().Err(err)
+		return
 	}
 
 	showPodsFromSelector(app, path, ds.Spec.Selector)
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const nodeCoverageTitle = "NodeCoverage"
+
+// NodeCoverage presents a daemonset node coverage viewer.
+type NodeCoverage struct {
+	ResourceViewer
+}
+
+// NewNodeCoverage returns a new viewer.
+func NewNodeCoverage(gvr client.GVR) ResourceViewer {
+	n := NodeCoverage{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetColorerFn(render.NodeCoverage{}.ColorerFunc())
+	n.GetTable().SetEnterFn(n.showPod)
+	n.GetTable().SetSortCol(0, len(render.NodeCoverage{}.Header(render.ClusterScope)), true)
+	n.SetBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+// Name returns the component name.
+func (n *NodeCoverage) Name() string { return nodeCoverageTitle }
+
+func (n *NodeCoverage) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Node", n.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(5, true), false),
+	})
+}
+
+func (n *NodeCoverage) showPod(app *App, _, _, _ string) {
+	po := n.GetTable().GetSelectedCell(1)
+	if po == "" || po == render.NAValue {
+		app.Flash().Warn("No daemonset pod on this node")
+		return
+	}
+	if err := app.command.showItem("v1/pods", po); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeCoverageNew(t *testing.T) {
+	v := view.NewNodeCoverage(client.NewGVR("dscoverage"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "NodeCoverage", v.Name())
+	assert.Equal(t, 3, len(v.Hints()))
+}
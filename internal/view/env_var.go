@@ -0,0 +1,65 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const envVarTitle = "EnvVars"
+
+// EnvVar presents a container environment viewer.
+type EnvVar struct {
+	ResourceViewer
+
+	resolve bool
+}
+
+// NewEnvVar returns a new viewer.
+func NewEnvVar(gvr client.GVR) ResourceViewer {
+	e := EnvVar{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	e.GetTable().SetColorerFn(render.EnvVar{}.ColorerFunc())
+	e.GetTable().SetEnterFn(blankEnterFn)
+	e.SetBindKeysFn(e.bindKeys)
+	e.SetContextFn(nil)
+
+	return &e
+}
+
+// Name returns the component name.
+func (e *EnvVar) Name() string { return envVarTitle }
+
+// SetContextFn populates a custom context.
+func (e *EnvVar) SetContextFn(f ContextFunc) {
+	e.ResourceViewer.SetContextFn(func(ctx context.Context) context.Context {
+		if f != nil {
+			ctx = f(ctx)
+		}
+		return context.WithValue(ctx, internal.KeyDecode, e.resolve)
+	})
+}
+
+func (e *EnvVar) bindKeys(aa ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlX: ui.NewKeyAction("Resolve", e.resolveCmd, true),
+	})
+}
+
+func (e *EnvVar) resolveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.resolve = !e.resolve
+	if e.resolve {
+		e.App().Flash().Info("Resolving secret and configmap references...")
+	} else {
+		e.App().Flash().Info("Hiding secret and configmap references...")
+	}
+	e.Start()
+
+	return nil
+}
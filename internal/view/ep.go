@@ -0,0 +1,59 @@
+package view
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Endpoints represents an endpoints viewer.
+type Endpoints struct {
+	ResourceViewer
+}
+
+// NewEndpoints returns a new viewer.
+func NewEndpoints(gvr client.GVR) ResourceViewer {
+	e := Endpoints{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	e.SetBindKeysFn(e.bindKeys)
+	e.GetTable().SetEnterFn(e.showNotReady)
+	e.GetTable().SetColorerFn(render.Endpoints{}.ColorerFunc())
+
+	return &e
+}
+
+func (e *Endpoints) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", e.GetTable().SortColCmd(2, true), false),
+	})
+}
+
+func (e *Endpoints) showNotReady(app *App, _, _, path string) {
+	s, err := dao.ServiceEndpointsFor(app.factory, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	pp := s.NotReadyPods()
+	if len(pp) == 0 {
+		app.Flash().Infof("No not-ready pods backing %s", path)
+		return
+	}
+
+	showFilteredPods(app, path, "", "", podNamesFilter(pp))
+}
+
+// podNamesFilter returns a table filter matching the given pod names.
+func podNamesFilter(pp []string) string {
+	qq := make([]string, 0, len(pp))
+	for _, p := range pp {
+		qq = append(qq, regexp.QuoteMeta(p))
+	}
+
+	return `(^|\s)(` + strings.Join(qq, "|") + `)(\s|$)`
+}
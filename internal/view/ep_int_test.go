@@ -0,0 +1,30 @@
+package view
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodNamesFilter(t *testing.T) {
+	rx := regexp.MustCompile(podNamesFilter([]string{"fred-1", "blee.2"}))
+
+	uu := map[string]struct {
+		row string
+		e   bool
+	}{
+		"first":    {row: "fred-1 1/1 Running", e: true},
+		"allNS":    {row: "default blee.2 0/1 Running", e: true},
+		"prefix":   {row: "fred-10 1/1 Running"},
+		"escaped":  {row: "bleex2 1/1 Running"},
+		"notFound": {row: "duh 1/1 Running"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rx.MatchString(u.row))
+		})
+	}
+}
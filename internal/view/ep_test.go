@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointsNew(t *testing.T) {
+	v := view.NewEndpoints(client.NewGVR("v1/endpoints"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Endpoints", v.Name())
+	assert.Equal(t, 4, len(v.Hints()))
+}
@@ -1,15 +1,29 @@
 package view
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // Event represents a command alias view.
 type Event struct {
 	ResourceViewer
+
+	feed  *model.EventFeed
+	title string
 }
 
 // NewEvent returns a new alias view.
@@ -18,11 +32,132 @@ func NewEvent(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewBrowser(gvr),
 	}
 	e.GetTable().SetColorerFn(render.Event{}.ColorerFunc())
+	e.GetTable().SetDecorateFn(e.decorate)
+	e.GetTable().SetEnterFn(e.showEvent)
+	h := render.Event{}.Header(render.ClusterScope)
+	e.GetTable().SetSortCol(h.IndexOf("LAST SEEN"), len(h), true)
 	e.SetBindKeysFn(e.bindKeys)
+	e.SetContextFn(nil)
 
 	return &e
 }
 
+// Init initializes the view.
+func (e *Event) Init(ctx context.Context) error {
+	if err := e.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	e.feed = model.NewEventFeed(e.App().events)
+
+	return nil
+}
+
+// SetContextFn populates a custom context.
+func (e *Event) SetContextFn(f ContextFunc) {
+	e.ResourceViewer.SetContextFn(func(ctx context.Context) context.Context {
+		if f != nil {
+			ctx = f(ctx)
+		}
+		return context.WithValue(ctx, internal.KeyEvents, e.feed)
+	})
+}
+
 func (e *Event) bindKeys(aa ui.KeyActions) {
 	aa.Delete(tcell.KeyCtrlD, ui.KeyE)
+	aa.Add(ui.KeyActions{
+		ui.KeyW:      ui.NewKeyAction("Toggle Warnings", e.toggleWarningsCmd, true),
+		ui.KeyO:      ui.NewKeyAction("Goto Object", e.gotoObjectCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Last Seen", e.GetTable().SortColCmd(5, true), false),
+	})
+}
+
+// showEvent shows an event message in full along with its involved object.
+func (e *Event) showEvent(app *App, _, _, path string) {
+	ev, err := e.selectedEvent(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Event", path)
+	details.SetColorizerFn(tview.Escape)
+	details.Actions().Add(ui.KeyActions{
+		ui.KeyO: ui.NewKeyAction("Goto Object", func(evt *tcell.EventKey) *tcell.EventKey {
+			gotoInvolved(app, ev)
+			return nil
+		}, true),
+	})
+	if err := app.inject(details.Update(dao.EventDetails(ev))); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (e *Event) gotoObjectCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := e.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ev, err := e.selectedEvent(path)
+	if err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	gotoInvolved(e.App(), ev)
+
+	return nil
+}
+
+// selectedEvent returns an event folded with its duplicates as listed.
+func (e *Event) selectedEvent(path string) (v1.Event, error) {
+	var ev v1.Event
+	ns, _ := client.Namespaced(path)
+	oo, err := e.App().factory.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return ev, err
+	}
+	for _, o := range model.DedupEvents(oo) {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || client.FQN(u.GetNamespace(), u.GetName()) != path {
+			continue
+		}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev)
+		return ev, err
+	}
+
+	return ev, fmt.Errorf("event %q no longer exists", path)
+}
+
+func gotoInvolved(app *App, ev v1.Event) {
+	gvr, path, err := dao.InvolvedObject(ev)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := app.command.showItem(gvr.String(), path); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (e *Event) toggleWarningsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.feed.ToggleWarnings() {
+		e.App().Flash().Info("Showing warnings only...")
+	} else {
+		e.App().Flash().Info("Showing all events...")
+	}
+	e.Start()
+
+	return nil
+}
+
+func (e *Event) decorate(data render.TableData) render.TableData {
+	if e.title == "" {
+		e.title = e.GetTable().BaseTitle
+	}
+	e.GetTable().BaseTitle = e.title
+	if e.feed.WarningsOnly() {
+		e.GetTable().BaseTitle = fmt.Sprintf("%s(warnings, %d hidden)", e.title, e.feed.Suppressed())
+	}
+
+	return data
 }
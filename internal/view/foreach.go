@@ -0,0 +1,137 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	foreachTitle = "Foreach"
+	// foreachWorkers bounds the number of concurrent calls to the api server.
+	foreachWorkers = 5
+)
+
+// Foreach presents a bulk operation targets and their outcome.
+type Foreach struct {
+	ResourceViewer
+}
+
+// NewForeach returns a new viewer.
+func NewForeach(gvr client.GVR) ResourceViewer {
+	f := Foreach{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetColorerFn(render.Foreach{}.ColorerFunc())
+	f.GetTable().SetSortCol(2, len(render.Foreach{}.Header(render.ClusterScope)), true)
+	f.SetBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+// Name returns the component name.
+func (f *Foreach) Name() string { return foreachTitle }
+
+func (f *Foreach) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", f.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", f.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", f.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// foreachCmd previews the resources matching a bulk operation and runs the
+// operation once confirmed.
+func foreachCmd(app *App, gvr string, cmd *dao.ForeachCmd) error {
+	if cmd.Namespace == "" {
+		cmd.Namespace = app.Config.ActiveNamespace()
+	}
+	tt, err := dao.ForeachTargets(app.factory, gvr, cmd)
+	if err != nil {
+		return err
+	}
+	if len(tt) == 0 {
+		app.Flash().Warnf("No %s matching %q in %s", cmd.Resource, cmd.Selector, foreachScope(cmd.Namespace))
+		return nil
+	}
+
+	run := dao.NewForeachRun(*cmd, tt)
+	app.skipLocked(run)
+	v := NewForeach(client.NewGVR("foreach"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyForeach, run)
+	})
+	if err := app.inject(v); err != nil {
+		return err
+	}
+
+	tally := run.Tally()
+	if tally[dao.ForeachPending] == 0 {
+		app.Flash().Warnf("Nothing to %s, all %d targets are skipped", cmd.Verb, len(tt))
+		return nil
+	}
+	msg := fmt.Sprintf("%s %d resources matching %q in %s?", strings.Title(cmd.Summary()), tally[dao.ForeachPending], cmd.Selector, foreachScope(cmd.Namespace))
+	if n := tally[dao.ForeachSkipped]; n > 0 {
+		msg += fmt.Sprintf(" %d skipped.", n)
+	}
+	var ran bool
+	dialog.ShowConfirm(app.Content.Pages, "Confirm Foreach", msg, func() {
+		ran = true
+		go app.runForeach(v, run)
+	}, func() {
+		if !ran && app.Content.Top() == v {
+			app.Content.back()
+		}
+	})
+
+	return nil
+}
+
+// runForeach runs a bulk operation and reports its outcome.
+func (a *App) runForeach(v ResourceViewer, run *dao.ForeachRun) {
+	exec, action := dao.ForeachExec(a.factory, &run.Cmd), fmt.Sprintf("Foreach(%s)", run.Cmd.Verb)
+	run.Exec(context.Background(), foreachWorkers, func(t dao.ForeachTarget) error {
+		return a.mutate(action, t.GVR, t.Path, func() error {
+			return exec(t)
+		})
+	})
+
+	tally := run.Tally()
+	a.QueueUpdateDraw(func() {
+		v.Refresh()
+		msg := fmt.Sprintf("Foreach %s done: %d succeeded, %d failed, %d skipped", run.Cmd.Verb, tally[dao.ForeachOK], tally[dao.ForeachFailed], tally[dao.ForeachSkipped])
+		if tally[dao.ForeachFailed] > 0 {
+			a.Flash().Errf("%s", msg)
+			return
+		}
+		a.Flash().Info(msg)
+	})
+}
+
+// skipLocked skips the targets living in protected namespaces that are not
+// unlocked.
+func (a *App) skipLocked(run *dao.ForeachRun) int {
+	return run.Skip("namespace protected", func(t dao.ForeachTarget) bool {
+		return a.isLocked(targetNamespace(t.GVR, t.Path, ""))
+	})
+}
+
+func foreachScope(ns string) string {
+	if ns == client.NamespaceAll || ns == client.AllNamespaces {
+		return "all namespaces"
+	}
+
+	return "namespace " + ns
+}
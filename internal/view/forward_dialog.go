@@ -0,0 +1,107 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/portforward"
+)
+
+// showForwardDialog prompts for a port-forward on a pod container given its
+// declared ports.
+func showForwardDialog(app *App, path, co string, pp []render.ContainerPort) {
+	if _, ok := app.factory.ForwarderFor(fwFQN(path, co)); ok {
+		app.Flash().Err(fmt.Errorf("A PortForward already exist on container %s", path))
+		return
+	}
+
+	ports := tcpPorts(pp)
+	if len(ports) == 0 {
+		app.Flash().Warn("No valid TCP port found on this container. User will specify...")
+		ports = []string{"MY_TCP_PORT!"}
+	}
+	k9s := app.Config.K9s
+	last, _ := app.Config.LastPortForward(containerID(path, co))
+	ports, lport := forwardPorts(ports, last)
+	dialog.ShowPortForward(
+		app.Content.Pages,
+		ports,
+		lport,
+		fmtDuration(k9s.GetPortForwardTTL()),
+		fmtDuration(k9s.GetPortForwardIdle()),
+		func(address, lport, cport, ttl, idle string) {
+			startForward(app, path, co, address, lport, cport, ttl, idle)
+		},
+	)
+}
+
+func startForward(app *App, path, co, address, lport, cport, ttl, idle string) {
+	ttlD, err := parseExpiry(ttl)
+	if err != nil {
+		app.Flash().Errf("Invalid TTL %v", err)
+		return
+	}
+	idleD, err := parseExpiry(idle)
+	if err != nil {
+		app.Flash().Errf("Invalid idle timeout %v", err)
+		return
+	}
+
+	pf := dao.NewPortForwarder(app.Conn())
+	pf.SetExpiry(ttlD, idleD)
+	ports := []string{lport + ":" + cport}
+	fw, err := pf.Start(path, co, address, ports)
+	app.audit(fmt.Sprintf("PortForward(%s)", ports[0]), "v1/pods", fwFQN(path, co), err)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	log.Debug().Msgf(">>> Starting port forward %q %v", path, ports)
+	go runForward(app, pf, fw, func() {
+		app.Config.SetLastPortForward(containerID(path, co), lport, cport)
+		if err := app.Config.Save(); err != nil {
+			log.Error().Err(err).Msg("Config save failed!")
+		}
+	})
+}
+
+// runForward streams a port forward. The ready callback runs on the UI
+// goroutine once the local ports are bound.
+func runForward(app *App, pf *dao.PortForwarder, f *portforward.PortForwarder, ready func()) {
+	app.QueueUpdateDraw(func() {
+		app.factory.AddForwarder(pf)
+		app.Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
+		dialog.DismissPortForward(app.Content.Pages)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-pf.Ready():
+		case <-done:
+			// Ready may have fired before the forward ended.
+			select {
+			case <-pf.Ready():
+			default:
+				return
+			}
+		}
+		app.QueueUpdate(ready)
+	}()
+
+	pf.SetActive(true)
+	err := f.ForwardPorts()
+	close(done)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.QueueUpdateDraw(func() {
+		app.factory.DeleteForwarder(pf.FQN())
+		pf.SetActive(false)
+	})
+}
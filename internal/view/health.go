@@ -0,0 +1,278 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	healthPage       = "health"
+	healthTitle      = " [aqua::b]Cluster Unreachable "
+	healthMinBackoff = 2 * time.Second
+	healthMaxBackoff = 30 * time.Second
+	healthFmt        = "[aqua::b]%-9s[white::-] %s\n"
+)
+
+// Health gates the app startup until the api server is reachable.
+type Health struct {
+	*tview.TextView
+
+	app      *App
+	err      error
+	failures int
+	left     time.Duration
+	checking bool
+	notice   string
+	retryCh  chan bool
+	cancelFn context.CancelFunc
+	passFn   func()
+}
+
+// NewHealth returns a new cluster health gate.
+func NewHealth(app *App, err error, pass func()) *Health {
+	h := Health{
+		TextView: tview.NewTextView(),
+		app:      app,
+		err:      err,
+		failures: 1,
+		left:     healthBackoff(1),
+		retryCh:  make(chan bool, 1),
+		passFn:   pass,
+	}
+	h.SetDynamicColors(true)
+	h.SetBorder(true).SetBorderPadding(1, 1, 2, 2)
+	h.SetTitle(render.Tags(healthTitle))
+	h.SetInputCapture(h.keyboard)
+	h.refresh()
+
+	return &h
+}
+
+// Start retries the connection with backoff until it succeeds.
+func (h *Health) Start() {
+	var ctx context.Context
+	ctx, h.cancelFn = context.WithCancel(context.Background())
+	go h.run(ctx)
+}
+
+// Stop cancels the pending retries.
+func (h *Health) Stop() {
+	if h.cancelFn != nil {
+		h.cancelFn()
+	}
+}
+
+func (h *Health) run(ctx context.Context) {
+	failures := 1
+	for {
+		ok, reset := h.wait(ctx, time.Now().Add(healthBackoff(failures)))
+		if !ok {
+			return
+		}
+		if reset {
+			failures = 0
+		}
+		h.app.QueueUpdateDraw(func() {
+			h.checking = true
+			h.refresh()
+		})
+		err := h.app.Conn().CheckConnectivity()
+		if err == nil {
+			h.app.QueueUpdateDraw(h.passFn)
+			return
+		}
+		failures++
+		log.Warn().Err(err).Msgf("Connectivity check failed %d times", failures)
+		n := failures
+		h.app.QueueUpdateDraw(func() {
+			h.checking, h.err, h.failures = false, err, n
+			h.left = healthBackoff(n)
+			h.refresh()
+		})
+	}
+}
+
+// wait ticks the countdown until the deadline or a manual retry. It returns
+// false when the gate was canceled and whether the retry follows a config
+// change.
+func (h *Health) wait(ctx context.Context, deadline time.Time) (bool, bool) {
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, false
+		case reset := <-h.retryCh:
+			return true, reset
+		case <-tick.C:
+			left := time.Until(deadline).Round(time.Second)
+			if left <= 0 {
+				return true, false
+			}
+			h.app.QueueUpdateDraw(func() {
+				h.left = left
+				h.refresh()
+			})
+		}
+	}
+}
+
+func (h *Health) retry(reset bool) {
+	select {
+	case h.retryCh <- reset:
+	default:
+	}
+}
+
+func (h *Health) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if evt.Key() != tcell.KeyRune {
+		return evt
+	}
+	switch evt.Rune() {
+	case 'r':
+		h.retry(false)
+	case 'c':
+		h.promptContext()
+	case 'k':
+		h.promptKubeConfig()
+	case 'q':
+		h.app.Stop()
+	default:
+		return evt
+	}
+
+	return nil
+}
+
+func (h *Health) promptContext() {
+	cfg := h.app.Conn().Config()
+	cc, err := cfg.ContextNames()
+	if err != nil {
+		h.setNotice(err)
+		return
+	}
+	sort.Strings(cc)
+	current, _ := cfg.CurrentContextName()
+	msg := "Available contexts: " + strings.Join(cc, ", ")
+	dialog.ShowPrompt(h.app.Main, "Switch Context", msg, "Context:", current, func(name string) {
+		h.setNotice(h.switchContext(name))
+	}, h.focus)
+}
+
+func (h *Health) switchContext(name string) error {
+	cfg := h.app.Conn().Config()
+	if _, err := cfg.GetContext(name); err != nil {
+		return err
+	}
+	if err := cfg.SwitchContext(name); err != nil {
+		return err
+	}
+	h.resetConfig()
+
+	return nil
+}
+
+func (h *Health) promptKubeConfig() {
+	var path string
+	if f := h.app.Conn().Config().Flags().KubeConfig; f != nil {
+		path = *f
+	}
+	msg := "Load the current context of another kubeconfig file."
+	dialog.ShowPrompt(h.app.Main, "Kubeconfig", msg, "Path:", path, func(path string) {
+		h.setNotice(h.switchKubeConfig(path))
+	}, h.focus)
+}
+
+func (h *Health) switchKubeConfig(path string) error {
+	if err := h.app.Conn().Config().SwitchKubeConfig(path); err != nil {
+		return err
+	}
+	h.resetConfig()
+
+	return nil
+}
+
+// resetConfig points the K9s config to the new context and retries. The
+// config is saved by the bootstrap once the cluster is reachable.
+func (h *Health) resetConfig() {
+	h.app.Config.Reset()
+	h.retry(true)
+}
+
+func (h *Health) setNotice(err error) {
+	h.notice = ""
+	if err != nil {
+		h.notice = err.Error()
+	}
+	h.refresh()
+}
+
+func (h *Health) focus() {
+	h.app.SetFocus(h)
+}
+
+func (h *Health) refresh() {
+	var b strings.Builder
+	ctx, cluster, server := h.target()
+	fmt.Fprintf(&b, healthFmt, "Context:", ctx)
+	fmt.Fprintf(&b, healthFmt, "Cluster:", cluster)
+	fmt.Fprintf(&b, healthFmt, "Server:", server)
+	fmt.Fprintf(&b, healthFmt, "Error:", fmt.Sprintf("[red::b]%s[white::-] %s", client.ConnErrorKind(h.err), tview.Escape(h.err.Error())))
+	status := fmt.Sprintf("retrying in %s (%d failed attempts)", h.left, h.failures)
+	if h.checking {
+		status = "connecting..."
+	}
+	fmt.Fprintf(&b, healthFmt, "Status:", status)
+	if h.notice != "" {
+		fmt.Fprintf(&b, "\n[orange::b]%s[white::-]\n", tview.Escape(h.notice))
+	}
+	b.WriteString("\n[dodgerblue::b]<r>[white::-] Retry  [dodgerblue::b]<c>[white::-] Context  [dodgerblue::b]<k>[white::-] Kubeconfig  [dodgerblue::b]<q>[white::-] Quit")
+	h.SetText(render.Tags(b.String()))
+}
+
+// target returns the context, cluster and server currently dialed.
+func (h *Health) target() (string, string, string) {
+	cfg := h.app.Conn().Config()
+	ctx, err := cfg.CurrentContextName()
+	if err != nil {
+		return render.NAValue, render.NAValue, render.NAValue
+	}
+	cluster, err := cfg.ClusterNameFromContext(ctx)
+	if err != nil {
+		return ctx, render.NAValue, render.NAValue
+	}
+	raw, err := cfg.RawConfig()
+	if err != nil {
+		return ctx, cluster, render.NAValue
+	}
+	server := render.NAValue
+	if c, ok := raw.Clusters[cluster]; ok {
+		server = c.Server
+	}
+
+	return ctx, cluster, server
+}
+
+// healthBackoff returns the exponential wait following a number of failed
+// connection attempts.
+func healthBackoff(failures int) time.Duration {
+	d := healthMinBackoff
+	for i := 1; i < failures && d < healthMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > healthMaxBackoff {
+		return healthMaxBackoff
+	}
+
+	return d
+}
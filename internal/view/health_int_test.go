@@ -0,0 +1,29 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthBackoff(t *testing.T) {
+	uu := map[string]struct {
+		failures int
+		e        time.Duration
+	}{
+		"reset":  {failures: 0, e: 2 * time.Second},
+		"first":  {failures: 1, e: 2 * time.Second},
+		"second": {failures: 2, e: 4 * time.Second},
+		"fourth": {failures: 4, e: 16 * time.Second},
+		"capped": {failures: 5, e: 30 * time.Second},
+		"many":   {failures: 100, e: 30 * time.Second},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, healthBackoff(u.failures))
+		})
+	}
+}
@@ -106,6 +106,10 @@ func (v *Help) showHelp() model.MenuHints {
 			Mnemonic:    "Ctrl-a",
 			Description: "Aliases",
 		},
+		{
+			Mnemonic:    "Ctrl-p",
+			Description: "Command Palette",
+		},
 	}
 }
 
@@ -220,7 +224,7 @@ func (v *Help) showGeneral() model.MenuHints {
 }
 
 func (v *Help) resetTitle() {
-	v.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
+	v.SetTitle(render.Tags(fmt.Sprintf(helpTitleFmt, helpTitle)))
 }
 
 func (v *Help) addSpacer(c int) {
@@ -236,7 +240,7 @@ func (v *Help) addSection(c int, title string, hh model.MenuHints) {
 	}
 	row := 0
 	cell := tview.NewTableCell(title)
-	cell.SetTextColor(tcell.ColorGreen)
+	cell.SetTextColor(render.Accent(tcell.ColorGreen))
 	cell.SetAttributes(tcell.AttrBold)
 	cell.SetExpansion(1)
 	cell.SetAlign(tview.AlignLeft)
@@ -248,15 +252,15 @@ func (v *Help) addSection(c int, title string, hh model.MenuHints) {
 		col := c
 		cell := tview.NewTableCell(render.Pad(toMnemonic(h.Mnemonic), v.maxKey))
 		if _, err := strconv.Atoi(h.Mnemonic); err != nil {
-			cell.SetTextColor(tcell.ColorDodgerBlue)
+			cell.SetTextColor(render.Accent(tcell.ColorDodgerBlue))
 		} else {
-			cell.SetTextColor(tcell.ColorFuchsia)
+			cell.SetTextColor(render.Accent(tcell.ColorFuchsia))
 		}
 		cell.SetAttributes(tcell.AttrBold)
 		v.SetCell(row, col, cell)
 		col++
 		cell = tview.NewTableCell(render.Pad(h.Description, v.maxDesc))
-		cell.SetTextColor(tcell.ColorWhite)
+		cell.SetTextColor(render.Accent(tcell.ColorWhite))
 		v.SetCell(row, col, cell)
 		row++
 	}
@@ -21,8 +21,10 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp()
 
 	assert.Nil(t, v.Init(ctx))
-	assert.Equal(t, 17, v.GetRowCount())
+	assert.Equal(t, 24, v.GetRowCount())
 	assert.Equal(t, 8, v.GetColumnCount())
-	assert.Equal(t, "<ctrl-k>", strings.TrimSpace(v.GetCell(1, 0).Text))
-	assert.Equal(t, "Kill", strings.TrimSpace(v.GetCell(1, 1).Text))
+	assert.Equal(t, "<ctrl-n>", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "Debug Copy", strings.TrimSpace(v.GetCell(1, 1).Text))
+	assert.Equal(t, "<shift-e>", strings.TrimSpace(v.GetCell(2, 0).Text))
+	assert.Equal(t, "Evicted", strings.TrimSpace(v.GetCell(2, 1).Text))
 }
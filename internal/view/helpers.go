@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -97,7 +99,12 @@ func showPodsWithLabels(app *App, path string, sel map[string]string) {
 }
 
 func showPods(app *App, path, labelSel, fieldSel string) {
-	log.Debug().Msgf("SHOW PODS %q -- %q -- %q", path, labelSel, fieldSel)
+	showFilteredPods(app, path, labelSel, fieldSel, "")
+}
+
+// showFilteredPods shows pods matching the given selectors and table filter.
+func showFilteredPods(app *App, path, labelSel, fieldSel, filter string) {
+	log.Debug().Msgf("SHOW PODS %q -- %q -- %q -- %q", path, labelSel, fieldSel, filter)
 	app.switchNS("")
 
 	v := NewPod(client.NewGVR("v1/pods"))
@@ -110,6 +117,10 @@ func showPods(app *App, path, labelSel, fieldSel string) {
 	}
 	if err := app.inject(v); err != nil {
 		app.Flash().Err(err)
+		return
+	}
+	if filter != "" {
+		v.GetTable().SearchBuff().Set(filter)
 	}
 }
 
@@ -117,14 +128,9 @@ func podCtx(app *App, path, labelSel, fieldSel string) ContextFunc {
 	return func(ctx context.Context) context.Context {
 		ctx = context.WithValue(ctx, internal.KeyPath, path)
 		ctx = context.WithValue(ctx, internal.KeyLabels, labelSel)
-
-		ns, _ := client.Namespaced(path)
-		mx := client.NewMetricsServer(app.factory.Client())
-		nmx, err := mx.FetchPodsMetrics(ns)
-		if err != nil {
-			log.Warn().Err(err).Msgf("No pods metrics")
+		if fieldSel == "" {
+			return ctx
 		}
-		ctx = context.WithValue(ctx, internal.KeyMetrics, nmx)
 
 		return context.WithValue(ctx, internal.KeyFields, fieldSel)
 	}
@@ -155,8 +161,49 @@ func fwFQN(po, co string) string {
 	return po + ":" + co
 }
 
-func isTCPPort(p string) bool {
-	return !strings.Contains(p, "UDP")
+// tcpPorts returns the forwardable container ports as name:number.
+func tcpPorts(pp []render.ContainerPort) []string {
+	ports := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if p.IsTCP() {
+			ports = append(ports, p.Target())
+		}
+	}
+
+	return ports
+}
+
+// parseExpiry parses a port-forward expiry. Blank means no expiry.
+func parseExpiry(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration %s", s)
+	}
+
+	return d, nil
+}
+
+// fmtDuration formats a duration without trailing zero units.
+func fmtDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+
+	return s
 }
 
 // ContainerID computes container ID based on ns/po/co.
@@ -188,3 +235,38 @@ func fqn(ns, n string) string {
 	}
 	return ns + "/" + n
 }
+
+// flashHistory renders past flash messages, latest first.
+func flashHistory(hh []ui.FlashMessage) string {
+	ss := make([]string, 0, len(hh))
+	for i := len(hh) - 1; i >= 0; i-- {
+		ss = append(ss, fmt.Sprintf("%s %-5s %s", hh[i].Time.Format("15:04:05"), flashLevel(hh[i].Level), hh[i].Text))
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+// errHistory weeds out past flash messages that are not errors.
+func errHistory(hh []ui.FlashMessage) []ui.FlashMessage {
+	ee := make([]ui.FlashMessage, 0, len(hh))
+	for _, h := range hh {
+		if h.Level >= ui.FlashErr {
+			ee = append(ee, h)
+		}
+	}
+
+	return ee
+}
+
+func flashLevel(l ui.FlashLevel) string {
+	switch l {
+	case ui.FlashWarn:
+		return "WARN"
+	case ui.FlashErr:
+		return "ERROR"
+	case ui.FlashFatal:
+		return "FATAL"
+	default:
+		return "INFO"
+	}
+}
@@ -2,29 +2,96 @@ package view
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func init() {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
 }
 
-func TestIsTCPPort(t *testing.T) {
+func TestTCPPorts(t *testing.T) {
 	uu := map[string]struct {
-		p string
-		e bool
+		pp []render.ContainerPort
+		e  []string
 	}{
-		"tcp": {"80╱TCP", true},
-		"udp": {"80╱UDP", false},
+		"none": {
+			e: []string{},
+		},
+		"named": {
+			pp: []render.ContainerPort{{Name: "http", Port: 80, Protocol: v1.ProtocolTCP}},
+			e:  []string{"http:80"},
+		},
+		"udp": {
+			pp: []render.ContainerPort{{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP}},
+			e:  []string{},
+		},
+		"sctp": {
+			pp: []render.ContainerPort{{Port: 9999, Protocol: v1.ProtocolSCTP}},
+			e:  []string{},
+		},
+		"multi": {
+			pp: []render.ContainerPort{
+				{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP},
+				{Name: "dns-tcp", Port: 53, Protocol: v1.ProtocolTCP},
+				{Port: 8080, Protocol: v1.ProtocolTCP},
+			},
+			e: []string{"dns-tcp:53", "8080"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, tcpPorts(u.pp))
+		})
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   time.Duration
+		err bool
+	}{
+		"blank":    {s: " ", e: 0},
+		"hours":    {s: "8h", e: 8 * time.Hour},
+		"mixed":    {s: "1h30m", e: 90 * time.Minute},
+		"negative": {s: "-1m", err: true},
+		"toast":    {s: "blee", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := parseExpiry(u.s)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestFmtDuration(t *testing.T) {
+	uu := map[string]struct {
+		d time.Duration
+		e string
+	}{
+		"none":    {0, ""},
+		"hours":   {8 * time.Hour, "8h"},
+		"mixed":   {90 * time.Minute, "1h30m"},
+		"seconds": {90 * time.Second, "1m30s"},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			assert.Equal(t, u.e, isTCPPort(u.p))
+			assert.Equal(t, u.e, fmtDuration(u.d))
 		})
 	}
 }
@@ -108,3 +175,29 @@ func TestContainerID(t *testing.T) {
 		})
 	}
 }
+
+func TestFlashHistory(t *testing.T) {
+	at := time.Date(2020, 1, 1, 10, 20, 30, 0, time.UTC)
+	hh := []ui.FlashMessage{
+		{Level: ui.FlashInfo, Text: "Viewing pods...", Time: at},
+		{Level: ui.FlashWarn, Text: "deployments ns1/fred: replicas 3→5", Time: at.Add(time.Second)},
+		{Level: ui.FlashErr, Text: "boom", Time: at.Add(2 * time.Second)},
+	}
+
+	assert.Equal(t, `10:20:32 ERROR boom
+10:20:31 WARN  deployments ns1/fred: replicas 3→5
+10:20:30 INFO  Viewing pods...`, flashHistory(hh))
+}
+
+func TestErrHistory(t *testing.T) {
+	at := time.Date(2020, 1, 1, 10, 20, 30, 0, time.UTC)
+	hh := []ui.FlashMessage{
+		{Level: ui.FlashErr, Text: "boom", Time: at},
+		{Level: ui.FlashInfo, Text: "Viewing pods...", Time: at.Add(time.Second)},
+		{Level: ui.FlashFatal, Text: "bang", Time: at.Add(2 * time.Second)},
+		{Level: ui.FlashWarn, Text: "duh", Time: at.Add(3 * time.Second)},
+	}
+
+	assert.Equal(t, `10:20:32 FATAL bang
+10:20:30 ERROR boom`, flashHistory(errHistory(hh)))
+}
@@ -0,0 +1,31 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{ResourceViewer: NewBrowser(gvr)}
+	i.GetTable().SetEnterFn(i.showBackends)
+
+	return &i
+}
+
+func (*Ingress) showBackends(app *App, _, _, path string) {
+	v := NewIngressBackend(client.NewGVR("ingbackends"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const ingressBackendTitle = "IngressBackends"
+
+// IngressBackend presents an ingress backends validation viewer.
+type IngressBackend struct {
+	ResourceViewer
+}
+
+// NewIngressBackend returns a new viewer.
+func NewIngressBackend(gvr client.GVR) ResourceViewer {
+	i := IngressBackend{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetColorerFn(render.IngressBackend{}.ColorerFunc())
+	i.GetTable().SetEnterFn(i.showService)
+	i.SetBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+// Init initializes the component.
+func (i *IngressBackend) Init(ctx context.Context) error {
+	if err := i.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	i.GetTable().GetModel().AddListener(i)
+
+	return nil
+}
+
+// Name returns the component name.
+func (i *IngressBackend) Name() string { return ingressBackendTitle }
+
+// TableDataChanged notifies view new data is available.
+func (i *IngressBackend) TableDataChanged(render.TableData) {
+	msg, err := dao.IngressClassIssue(i.App().factory, i.GetTable().Path)
+	if err != nil {
+		log.Error().Err(err).Msgf("Ingress class lookup failed")
+		return
+	}
+	i.App().QueueUpdateDraw(func() {
+		i.GetTable().SetNotice(msg)
+		i.GetTable().UpdateTitle()
+	})
+}
+
+// TableLoadFailed notifies view something went south.
+func (i *IngressBackend) TableLoadFailed(error, time.Duration) {}
+
+func (i *IngressBackend) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftH: ui.NewKeyAction("Sort Host", i.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", i.GetTable().SortColCmd(5, true), false),
+	})
+}
+
+func (i *IngressBackend) showService(app *App, _, _, _ string) {
+	svc := i.GetTable().GetSelectedCell(2)
+	if svc == "" {
+		return
+	}
+	ns, _ := client.Namespaced(i.GetTable().Path)
+	if err := app.command.showItem("v1/services", client.FQN(ns, svc)); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressBackendNew(t *testing.T) {
+	v := view.NewIngressBackend(client.NewGVR("ingbackends"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "IngressBackends", v.Name())
+	assert.Equal(t, 4, len(v.Hints()))
+}
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const jobPodTitle = "JobPods"
+
+// JobPod presents a job pods termination viewer.
+type JobPod struct {
+	ResourceViewer
+}
+
+// NewJobPod returns a new viewer.
+func NewJobPod(gvr client.GVR) ResourceViewer {
+	j := JobPod{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	j.GetTable().SetColorerFn(render.JobPod{}.ColorerFunc())
+	j.GetTable().SetEnterFn(j.showFailure)
+	j.GetTable().SetSortCol(0, len(render.JobPod{}.Header(render.ClusterScope)), true)
+	j.SetBindKeysFn(j.bindKeys)
+
+	return &j
+}
+
+// Init initializes the component.
+func (j *JobPod) Init(ctx context.Context) error {
+	if err := j.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	j.GetTable().GetModel().AddListener(j)
+
+	return nil
+}
+
+// Name returns the component name.
+func (j *JobPod) Name() string { return jobPodTitle }
+
+// TableDataChanged notifies view new data is available.
+func (j *JobPod) TableDataChanged(render.TableData) {
+	msg, err := dao.JobFailure(j.App().factory, j.GetTable().Path)
+	if err != nil {
+		log.Error().Err(err).Msgf("Job failure lookup failed")
+		return
+	}
+	j.App().QueueUpdateDraw(func() {
+		j.GetTable().SetNotice(msg)
+		j.GetTable().UpdateTitle()
+	})
+}
+
+// TableLoadFailed notifies view something went south.
+func (j *JobPod) TableLoadFailed(error, time.Duration) {}
+
+func (j *JobPod) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyL:      ui.NewKeyAction("Logs", j.logsCmd(false), true),
+		ui.KeyShiftL: ui.NewKeyAction("Logs Previous", j.logsCmd(true), true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", j.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Restarts", j.GetTable().SortColCmd(4, false), false),
+	})
+}
+
+// showFailure shows the logs of the first container that exited in error.
+// Restarted containers show their previous logs.
+func (j *JobPod) showFailure(app *App, _, _, path string) {
+	e, ok, err := dao.PodFailedExit(app.factory, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if !ok {
+		if err := app.command.showItem("v1/pods", path); err != nil {
+			app.Flash().Err(err)
+		}
+		return
+	}
+	j.showLogs(path, e.Container, e.Previous)
+}
+
+func (j *JobPod) logsCmd(prev bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := j.GetTable().GetSelectedItem()
+		if path == "" {
+			return nil
+		}
+		var co string
+		if e, ok, err := dao.PodFailedExit(j.App().factory, path); err == nil && ok {
+			co = e.Container
+		}
+		j.showLogs(path, co, prev)
+
+		return nil
+	}
+}
+
+func (j *JobPod) showLogs(path, co string, prev bool) {
+	ns, _ := client.Namespaced(path)
+	if _, err := j.App().factory.CanForResource(ns, "v1/pods", watch.ReadVerbs); err != nil {
+		j.App().Flash().Err(err)
+		return
+	}
+	if err := j.App().inject(NewLog(client.NewGVR("v1/pods"), path, co, prev)); err != nil {
+		j.App().Flash().Err(err)
+	}
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobPodNew(t *testing.T) {
+	v := view.NewJobPod(client.NewGVR("jobpods"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "JobPods", v.Name())
+	assert.Equal(t, 6, len(v.Hints()))
+}
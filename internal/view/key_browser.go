@@ -0,0 +1,250 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"sigs.k8s.io/yaml"
+)
+
+const keyBrowserTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+
+// KeyValue represents a resource data entry.
+type KeyValue struct {
+	Key    string
+	Value  string
+	Binary bool
+	Size   int
+}
+
+// KeyBrowser presents resource data keys alongside the selected key value.
+type KeyBrowser struct {
+	*tview.Flex
+
+	app            *App
+	keys           *tview.Table
+	value          *tview.TextView
+	actions        ui.KeyActions
+	title, subject string
+	entries        []KeyValue
+	pretty         bool
+}
+
+var _ model.Component = (*KeyBrowser)(nil)
+
+// NewKeyBrowser returns a new key browser.
+func NewKeyBrowser(app *App, title, subject string, kvs []KeyValue) *KeyBrowser {
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].Key < kvs[j].Key
+	})
+
+	return &KeyBrowser{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		keys:    tview.NewTable(),
+		value:   tview.NewTextView(),
+		actions: make(ui.KeyActions),
+		title:   title,
+		subject: subject,
+		entries: kvs,
+	}
+}
+
+// Init initializes the viewer.
+func (k *KeyBrowser) Init(_ context.Context) error {
+	k.SetBorder(true)
+	k.SetDirection(tview.FlexColumn)
+	k.SetTitle(ui.SkinTitle(fmt.Sprintf(keyBrowserTitleFmt, k.title, k.subject), k.app.Styles.Frame()))
+
+	k.keys.SetBorder(true)
+	k.keys.SetTitle(" Keys ")
+	k.keys.SetSelectable(true, false)
+	k.keys.SetSelectionChangedFunc(func(row, _ int) {
+		k.showValue(row)
+	})
+	for i, e := range k.entries {
+		k.keys.SetCell(i, 0, tview.NewTableCell(e.Key).SetExpansion(1))
+	}
+
+	k.value.SetBorder(true)
+	k.value.SetScrollable(true)
+	k.value.SetWrap(true)
+	k.value.SetDynamicColors(false)
+
+	k.AddItem(k.keys, 0, 1, true)
+	k.AddItem(k.value, 0, 3, false)
+
+	k.bindKeys()
+	k.keys.SetInputCapture(k.keyboard)
+	k.value.SetInputCapture(k.keyboard)
+	k.StylesChanged(k.app.Styles)
+	k.app.Styles.AddListener(k)
+	if len(k.entries) > 0 {
+		k.keys.Select(0, 0)
+		k.showValue(0)
+	}
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (k *KeyBrowser) StylesChanged(s *config.Styles) {
+	k.SetBackgroundColor(s.BgColor())
+	k.SetBorderFocusColor(config.AsColor(s.Frame().Border.FocusColor))
+	for _, p := range []*tview.Box{k.keys.Box, k.value.Box} {
+		p.SetBackgroundColor(s.BgColor())
+		p.SetBorderFocusColor(config.AsColor(s.Frame().Border.FocusColor))
+	}
+	k.value.SetTextColor(s.FgColor())
+	for i := 0; i < k.keys.GetRowCount(); i++ {
+		k.keys.GetCell(i, 0).SetTextColor(s.FgColor())
+	}
+}
+
+// Name returns the component name.
+func (k *KeyBrowser) Name() string { return k.title }
+
+// Start starts the view.
+func (k *KeyBrowser) Start() {}
+
+// Stop terminates the view.
+func (k *KeyBrowser) Stop() {
+	k.app.Styles.RemoveListener(k)
+}
+
+// Hints returns menu hints.
+func (k *KeyBrowser) Hints() model.MenuHints {
+	return k.actions.Hints()
+}
+
+// Actions returns menu actions.
+func (k *KeyBrowser) Actions() ui.KeyActions {
+	return k.actions
+}
+
+// SelectedKey returns the currently selected key entry.
+func (k *KeyBrowser) SelectedKey() (KeyValue, bool) {
+	row, _ := k.keys.GetSelection()
+	if row < 0 || row >= len(k.entries) {
+		return KeyValue{}, false
+	}
+
+	return k.entries[row], true
+}
+
+func (k *KeyBrowser) bindKeys() {
+	k.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", k.app.PrevCmd, false),
+		tcell.KeyTab:    ui.NewKeyAction("Focus", k.focusCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Copy", k.cpCmd, true),
+		ui.KeyP:         ui.NewKeyAction("Pretty", k.prettyCmd, true),
+	})
+}
+
+func (k *KeyBrowser) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := k.actions[key]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (k *KeyBrowser) focusCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if k.keys.HasFocus() {
+		k.app.SetFocus(k.value)
+	} else {
+		k.app.SetFocus(k.keys)
+	}
+
+	return nil
+}
+
+func (k *KeyBrowser) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e, ok := k.SelectedKey()
+	if !ok {
+		return nil
+	}
+	if e.Binary {
+		k.app.Flash().Warn("Binary data can not be copied!")
+		return nil
+	}
+	if err := k.app.Clipboard().Copy(e.Value); err != nil {
+		k.app.Flash().Err(err)
+		return nil
+	}
+	k.app.Flash().Infof("Key %s copied to clipboard...", e.Key)
+
+	return nil
+}
+
+func (k *KeyBrowser) prettyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	k.pretty = !k.pretty
+	row, _ := k.keys.GetSelection()
+	k.showValue(row)
+
+	return nil
+}
+
+func (k *KeyBrowser) showValue(row int) {
+	if row < 0 || row >= len(k.entries) {
+		return
+	}
+	e := k.entries[row]
+	k.value.SetTitle(" " + e.Key + " ")
+	k.value.SetText(formatKeyValue(e, k.pretty))
+	k.value.ScrollToBeginning()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func formatKeyValue(e KeyValue, pretty bool) string {
+	if e.Binary {
+		return fmt.Sprintf("<binary data: %d bytes>", e.Size)
+	}
+	if !pretty {
+		return e.Value
+	}
+
+	return prettyValue(e.Value)
+}
+
+// prettyValue indents JSON values and normalizes YAML documents. Other
+// values are returned as is.
+func prettyValue(s string) string {
+	trimmed := strings.TrimSpace(s)
+	if json.Valid([]byte(trimmed)) && (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) {
+		var buff bytes.Buffer
+		if err := json.Indent(&buff, []byte(trimmed), "", "  "); err == nil {
+			return buff.String()
+		}
+	}
+
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		raw, err := yaml.Marshal(v)
+		if err != nil {
+			return s
+		}
+		return string(raw)
+	default:
+		return s
+	}
+}
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestKeyBrowserNavigation(t *testing.T) {
+	kvs := []KeyValue{
+		{Key: "b", Value: "2"},
+		{Key: "a", Value: "1"},
+		{Key: "c", Binary: true, Size: 42},
+	}
+	k := NewKeyBrowser(makeApp(), "ConfigMap", "fred/blee", kvs)
+
+	assert.Nil(t, k.Init(context.Background()))
+	assert.Equal(t, "ConfigMap", k.Name())
+	assert.Equal(t, 4, len(k.Hints()))
+	assert.Equal(t, 3, k.keys.GetRowCount())
+
+	e, ok := k.SelectedKey()
+	assert.True(t, ok)
+	assert.Equal(t, "a", e.Key)
+	assert.Equal(t, "1", k.value.GetText(true))
+
+	k.keys.Select(2, 0)
+	e, ok = k.SelectedKey()
+	assert.True(t, ok)
+	assert.Equal(t, "c", e.Key)
+	assert.Equal(t, "<binary data: 42 bytes>", k.value.GetText(true))
+}
+
+func TestKeyBrowserPretty(t *testing.T) {
+	kvs := []KeyValue{{Key: "cfg.json", Value: `{"a":1}`}}
+	k := NewKeyBrowser(makeApp(), "ConfigMap", "fred/blee", kvs)
+	assert.Nil(t, k.Init(context.Background()))
+
+	assert.Equal(t, `{"a":1}`, k.value.GetText(true))
+	k.prettyCmd(nil)
+	assert.Equal(t, "{\n  \"a\": 1\n}", k.value.GetText(true))
+}
+
+func TestPrettyValue(t *testing.T) {
+	uu := map[string]struct {
+		v, e string
+	}{
+		"plain":  {v: "blee", e: "blee"},
+		"number": {v: "10", e: "10"},
+		"json":   {v: `{"a":{"b":[1,2]}}`, e: "{\n  \"a\": {\n    \"b\": [\n      1,\n      2\n    ]\n  }\n}"},
+		"yaml":   {v: "b:   2\na: 1\n", e: "a: 1\nb: 2\n"},
+		"broken": {v: "a: [1", e: "a: [1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, prettyValue(u.v))
+		})
+	}
+}
+
+func TestConfigMapKeys(t *testing.T) {
+	cm := v1.ConfigMap{
+		Data:       map[string]string{"a": "blee"},
+		BinaryData: map[string][]byte{"b": []byte("duh")},
+	}
+
+	kvs := configMapKeys(cm)
+	assert.Equal(t, 2, len(kvs))
+	assert.Contains(t, kvs, KeyValue{Key: "a", Value: "blee", Size: 4})
+	assert.Contains(t, kvs, KeyValue{Key: "b", Binary: true, Size: 3})
+}
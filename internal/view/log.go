@@ -77,6 +77,7 @@ func (l *Log) Init(ctx context.Context) (err error) {
 		return err
 	}
 	l.logs.SetWrap(false)
+	l.logs.SetRegions(false)
 	l.logs.SetMaxBuffer(l.app.Config.K9s.LogBufferSize)
 
 	l.ansiWriter = tview.ANSIWriter(l.logs, l.app.Styles.Views().Log.FgColor, l.app.Styles.Views().Log.BgColor)
@@ -127,6 +128,7 @@ func (l *Log) Stop() {
 func (l *Log) Name() string { return logTitle }
 
 func (l *Log) bindKeys() {
+	l.logs.Actions().Clear()
 	l.logs.Actions().Set(ui.KeyActions{
 		tcell.KeyEscape: ui.NewKeyAction("Back", l.app.PrevCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Clear", l.clearCmd, true),
@@ -172,6 +174,7 @@ func (l *Log) logOpts(path, co string, prevLogs bool) dao.LogOptions {
 		Container: co,
 		Lines:     int64(l.app.Config.K9s.LogRequestSize),
 		Previous:  prevLogs,
+		Reopen:    !l.app.Config.K9s.DisableLogReopen,
 	}
 }
 
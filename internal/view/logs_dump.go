@@ -0,0 +1,86 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+const logsDumpWorkers = 5
+
+// logsDump tracks a namespace logs dump in flight.
+type logsDump struct {
+	ns     string
+	cancel context.CancelFunc
+}
+
+// dumpLogs writes the latest logs of every container in a namespace under a
+// timestamped screen dumps directory. Progress shows in the flash.
+func (a *App) dumpLogs(ns string) {
+	if a.logsDump != nil {
+		a.Flash().Warnf("Logs dump for %s in progress. Use `logs-dump stop` to cancel it", a.logsDump.ns)
+		return
+	}
+	if ns == "" || ns == render.NamespaceAll {
+		a.Flash().Warn("Logs dump requires a namespace")
+		return
+	}
+	if a.Conn() == nil {
+		a.Flash().Err(fmt.Errorf("no cluster connection"))
+		return
+	}
+
+	dir := logsDumpDir(a.Config.K9s.CurrentCluster, ns, time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	a.logsDump = &logsDump{ns: ns, cancel: cancel}
+	a.Flash().Infof("Dumping %s logs...", ns)
+
+	fetch := dao.NewLogFetcher(a.Conn().DialOrDie())
+	lines := int64(a.Config.K9s.LogRequestSize)
+	go func() {
+		defer cancel()
+		d, err := dao.DumpNamespaceLogs(ctx, a.factory, fetch, ns, dir, lines, logsDumpWorkers, func(done, total int) {
+			a.QueueUpdateDraw(func() {
+				a.Flash().Infof("Dumping %s logs %d/%d...", ns, done, total)
+			})
+		})
+		a.QueueUpdateDraw(func() {
+			a.logsDump = nil
+			if err != nil {
+				a.Flash().Errf("Logs dump failed %v", err)
+				return
+			}
+			for _, k := range d.Failed() {
+				log.Warn().Err(d.Failures[k]).Msgf("Logs dump failed for %s", k)
+			}
+			if len(d.Failures) > 0 || d.Canceled {
+				a.Flash().Warn(d.Summary())
+				return
+			}
+			a.Flash().Info(d.Summary())
+		})
+	}()
+}
+
+// stopLogsDump cancels the logs dump in flight if any.
+func (a *App) stopLogsDump() bool {
+	if a.logsDump == nil {
+		return false
+	}
+	a.logsDump.cancel()
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func logsDumpDir(cluster, ns string, t time.Time) string {
+	return filepath.Join(config.K9sDumpDir, cluster, fmt.Sprintf("logs-%s-%s", ns, t.Format("20060102-150405")))
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogsDumpDir(t *testing.T) {
+	at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, filepath.Join(config.K9sDumpDir, "c1", "logs-ns1-20200102-030405"), logsDumpDir("c1", "ns1", at))
+}
+
+func TestAppDumpLogsNoNamespace(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.dumpLogs(render.NamespaceAll)
+
+	assert.Nil(t, a.logsDump)
+	assert.False(t, a.stopLogsDump())
+}
+
+func TestAppStopLogsDump(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	var canceled bool
+	a.logsDump = &logsDump{ns: "ns1", cancel: func() { canceled = true }}
+
+	assert.Equal(t, []string{"  1 logs dump: ns1"}, a.inFlight())
+	assert.True(t, a.stopLogsDump())
+	assert.True(t, canceled)
+}
@@ -0,0 +1,81 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+// initManagedForwards loads the active cluster configured port-forwards.
+func (a *App) initManagedForwards() {
+	a.managedForwards = dao.NewManagedForwards(a.Config.K9s.ActiveCluster().PortForwards)
+	a.publishStatus()
+}
+
+// reconcileForwards (re)establishes managed port-forwards. Forwards whose
+// target pod went away are stopped so they can be restarted on a new pod.
+func (a *App) reconcileForwards() {
+	m := a.managedForwards
+	if a.factory == nil || m == nil {
+		return
+	}
+	for fqn := range m.Active() {
+		pod := strings.Split(fqn, ":")[0]
+		if dao.ForwardPodLive(a.factory, pod) {
+			continue
+		}
+		log.Info().Msgf("Managed port-forward target %s is gone. Restarting...", pod)
+		fqn := fqn
+		a.QueueUpdateDraw(func() {
+			a.factory.DeleteForwarder(fqn)
+		})
+	}
+	for _, i := range m.Claim() {
+		go a.startManagedForward(m, i)
+	}
+}
+
+func (a *App) startManagedForward(m *dao.ManagedForwards, i int) {
+	spec := m.Spec(i)
+	path, err := dao.ResolveForwardPod(a.factory, spec)
+	if err != nil {
+		a.managedForwardFailed(m, i, err)
+		return
+	}
+	pf := dao.NewPortForwarder(a.Conn())
+	fw, err := pf.Start(path, spec.Container, spec.Address, spec.Ports)
+	if err != nil {
+		a.managedForwardFailed(m, i, err)
+		return
+	}
+
+	log.Debug().Msgf(">>> Starting managed port forward %q %v", pf.Path(), spec.Ports)
+	// Failed attempts are retried so only established forwards are audited.
+	a.audit("PortForward(managed)", "v1/pods", pf.FQN(), nil)
+	m.Started(i, pf.FQN())
+	a.QueueUpdateDraw(func() {
+		a.factory.AddForwarder(pf)
+	})
+	pf.SetActive(true)
+	if err := fw.ForwardPorts(); err != nil {
+		a.managedForwardFailed(m, i, err)
+	} else {
+		m.Stopped(i)
+	}
+	a.QueueUpdateDraw(func() {
+		a.factory.DeleteForwarder(pf.FQN())
+		pf.SetActive(false)
+	})
+}
+
+func (a *App) managedForwardFailed(m *dao.ManagedForwards, i int, err error) {
+	if !m.Failed(i, err) {
+		return
+	}
+	path := m.Spec(i).Path()
+	log.Warn().Err(err).Msgf("Managed port-forward %s failed", path)
+	a.QueueUpdateDraw(func() {
+		a.Flash().Warnf("PortForward %s failed -- %s", path, err)
+	})
+}
@@ -0,0 +1,97 @@
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const doubleClickDelay = 500 * time.Millisecond
+
+// mouseState tracks clicks across mouse events.
+type mouseState struct {
+	buttons   tcell.ButtonMask
+	table     *ui.Table
+	row       int
+	lastClick time.Time
+}
+
+// doubleClick records a row click and checks if it completes a double click.
+func (m *mouseState) doubleClick(t *ui.Table, row int, now time.Time) bool {
+	double := m.table == t && m.row == row && now.Sub(m.lastClick) < doubleClickDelay
+	m.table, m.row, m.lastClick = t, row, now
+	if double {
+		m.lastClick = time.Time{}
+	}
+
+	return double
+}
+
+// mouseEvent translates mouse events into selections and key events. This
+// runs off the event loop so wheel events are queued as key events and clicks
+// are handled on the main thread.
+func (a *App) mouseEvent(evt *tcell.EventMouse) {
+	switch btns := evt.Buttons(); {
+	case btns&tcell.WheelUp != 0:
+		a.QueueEvent(ui.KeyEvent(tcell.KeyUp))
+	case btns&tcell.WheelDown != 0:
+		a.QueueEvent(ui.KeyEvent(tcell.KeyDown))
+	default:
+		a.QueueUpdateDraw(func() {
+			a.mouseClick(evt)
+		})
+	}
+}
+
+func (a *App) mouseClick(evt *tcell.EventMouse) {
+	pressed := evt.Buttons()&tcell.Button1 != 0 && a.mouse.buttons&tcell.Button1 == 0
+	a.mouse.buttons = evt.Buttons()
+	if !pressed || a.InCmdMode() {
+		return
+	}
+
+	x, y := evt.Position()
+	if a.showHeader && a.ErrBadge().Contains(x, y) {
+		a.showErrors()
+		return
+	}
+	if h, ok := a.Menu().HintAt(x, y); ok {
+		if k, ok := ui.KeyFor(h.Mnemonic); ok {
+			a.QueueEvent(ui.KeyEvent(k))
+		}
+		return
+	}
+	a.clickRow(x, y)
+}
+
+// clickRow selects the clicked row of the active view. A double click
+// triggers the view enter action.
+func (a *App) clickRow(x, y int) {
+	v, ok := a.Content.Top().(interface{ GetTable() *Table })
+	if !ok {
+		return
+	}
+	t := v.GetTable()
+	// Leave dialogs and other modal pages alone.
+	if !t.HasFocus() && !a.dock.HasFocus() {
+		return
+	}
+	row, ok := t.RowAt(x, y)
+	if !ok {
+		return
+	}
+	a.focusContent()
+	t.SelectRow(row, true)
+	if a.mouse.doubleClick(t.Table, row, time.Now()) {
+		a.QueueEvent(ui.KeyEvent(tcell.KeyEnter))
+	}
+}
+
+func (a *App) toggleMouse() {
+	if a.ToggleMouse() {
+		a.Flash().Info("Mouse support on")
+		return
+	}
+	a.Flash().Info("Mouse support off. Terminal text selection restored")
+}
@@ -0,0 +1,22 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMouseDoubleClick(t *testing.T) {
+	t1, t2 := ui.NewTable("t1"), ui.NewTable("t2")
+	now := time.Now()
+
+	var m mouseState
+	assert.False(t, m.doubleClick(t1, 1, now))
+	assert.True(t, m.doubleClick(t1, 1, now.Add(100*time.Millisecond)))
+	assert.False(t, m.doubleClick(t1, 1, now.Add(200*time.Millisecond)))
+	assert.False(t, m.doubleClick(t1, 2, now.Add(300*time.Millisecond)))
+	assert.False(t, m.doubleClick(t2, 2, now.Add(400*time.Millisecond)))
+	assert.False(t, m.doubleClick(t2, 2, now.Add(time.Second)))
+}
@@ -0,0 +1,103 @@
+package view
+
+import (
+	"errors"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// nodeHelperTimeout represents how long to wait for a node helper pod to run.
+const nodeHelperTimeout = 60 * time.Second
+
+var errAdvancedOff = errors.New("Advanced features are disabled. Set k9s.advanced in your K9s config to enable them")
+
+func (p *Pod) netShellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	if !p.App().Config.K9s.Advanced {
+		p.App().Flash().Err(errAdvancedOff)
+		return nil
+	}
+
+	row := p.GetTable().GetSelectedRowIndex()
+	status := ui.TrimCell(p.GetTable().SelectTable, row, p.GetTable().NameColIndex()+2)
+	if status != render.Running {
+		p.App().Flash().Errf("%s is not in a running state", sel)
+		return nil
+	}
+	p.App().Flash().Infof("Launching node helper for %s...", sel)
+	go netShellIn(p.App(), sel)
+
+	return nil
+}
+
+// netShellIn drops into a shell within a pod network namespace using the node
+// tooling. The node helper pod is always deleted once done.
+func netShellIn(a *App, path string) {
+	if !a.Config.K9s.Advanced {
+		a.QueueUpdateDraw(func() { a.Flash().Err(errAdvancedOff) })
+		return
+	}
+	var (
+		h   *dao.NodeHelper
+		pid int
+	)
+	err := a.mutate("NetShell", "v1/pods", path, func() error {
+		var err error
+		h, pid, err = launchNetHelper(a, path)
+		return err
+	})
+	if err != nil {
+		a.QueueUpdateDraw(func() { a.Flash().Errf("Net shell failed %s", err) })
+		return
+	}
+
+	a.QueueUpdateDraw(func() {
+		defer deleteNodeHelper(a, h)
+		args := computeExecArgs(
+			h.Path(),
+			h.Container(),
+			a.Config.K9s.CurrentContext,
+			a.Conn().Config().Flags().KubeConfig,
+			dao.NetnsShellArgs(pid, shellCheck),
+		)
+		log.Debug().Msgf("Net shell args %v", args)
+		if !runK(true, a, args...) {
+			a.Flash().Err(errors.New("Net shell exec failed"))
+		}
+	})
+}
+
+func launchNetHelper(a *App, path string) (*dao.NodeHelper, int, error) {
+	node, cid, err := dao.PodNetTarget(a.factory, path)
+	if err != nil {
+		return nil, 0, err
+	}
+	ns, _ := client.Namespaced(path)
+	h, err := dao.LaunchNodeHelper(a.Conn(), ns, node, a.Config.K9s.GetDebugImage(), nodeHelperTimeout)
+	if err != nil {
+		return nil, 0, err
+	}
+	pid, err := h.ContainerPID(dao.NewRemoteExecutor(a.Conn()), cid)
+	if err != nil {
+		deleteNodeHelper(a, h)
+		return nil, 0, err
+	}
+
+	return h, pid, nil
+}
+
+func deleteNodeHelper(a *App, h *dao.NodeHelper) {
+	if err := h.Delete(); err != nil {
+		log.Error().Err(err).Msgf("Node helper %s cleanup failed", h.Path())
+		a.Flash().Errf("Unable to delete node helper %s -- %s", h.Path(), err)
+	}
+}
@@ -1,14 +1,12 @@
 package view
 
 import (
-	"context"
-
-	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
-	"github.com/rs/zerolog/log"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // Node represents a node view.
@@ -23,7 +21,7 @@ func NewNode(gvr client.GVR) ResourceViewer {
 	}
 	n.SetBindKeysFn(n.bindKeys)
 	n.GetTable().SetEnterFn(n.showPods)
-	n.SetContextFn(n.nodeContext)
+	n.GetTable().SetColorerFn(render.Node{}.ColorerFunc())
 
 	return &n
 }
@@ -39,43 +37,21 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 	})
 }
 
-func (n *Node) nodeContext(ctx context.Context) context.Context {
-	mx := client.NewMetricsServer(n.App().factory.Client())
-	nmx, err := mx.FetchNodesMetrics()
-	if err != nil {
-		log.Warn().Err(err).Msgf("No node metrics")
-	}
-
-	return context.WithValue(ctx, internal.KeyMetrics, nmx)
-}
-
 func (n *Node) showPods(app *App, ns, res, sel string) {
 	showPods(app, n.GetTable().GetSelectedItem(), "", "spec.nodeName="+sel)
 }
 
 func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := n.GetTable().GetSelectedItem()
-	if path == "" {
-		return evt
-	}
-
 	sel := n.GetTable().GetSelectedItem()
-	gvr := client.NewGVR(n.GVR()).AsGVR()
-	o, err := n.App().factory.Client().DynDialOrDie().Resource(gvr).Get(sel, metav1.GetOptions{})
-	if err != nil {
-		n.App().Flash().Errf("Unable to get resource %q -- %s", n.GVR(), err)
-		return nil
+	if sel == "" {
+		return evt
 	}
 
-	raw, err := toYAML(o)
+	err := showYAML(n.App(), sel, func() (runtime.Object, error) {
+		return dao.FetchLive(n.App().factory, n.GVR(), sel)
+	})
 	if err != nil {
-		n.App().Flash().Errf("Unable to marshal resource %s", err)
-		return nil
-	}
-
-	details := NewDetails(n.App(), "YAML", sel).Update(raw)
-	if err := n.App().inject(details); err != nil {
-		n.App().Flash().Err(err)
+		n.App().Flash().Errf("Unable to view resource %q -- %s", n.GVR(), err)
 	}
 
 	return nil
@@ -0,0 +1,162 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	bell                = "\a"
+	crashLoopStatus     = "CrashLoopBackOff"
+	crashNotifyCooldown = 5 * time.Minute
+)
+
+// notifyEvent represents the values exposed to the notify command templates.
+type notifyEvent struct {
+	Level   string
+	Message string
+	Context string
+}
+
+// notifier rings the bell and runs the user notify command on alerts.
+type notifier struct {
+	out io.Writer
+	run func(timeout time.Duration, args []string)
+}
+
+func newNotifier() *notifier {
+	return &notifier{out: os.Stdout, run: runNotifier}
+}
+
+// notify dispatches warnings and errors. The notify command runs detached
+// so a hung notifier can't block the caller.
+func (n *notifier) notify(cfg config.Notifications, level ui.FlashLevel, evt notifyEvent) error {
+	if level < ui.FlashWarn {
+		return nil
+	}
+	if cfg.Bell {
+		if _, err := io.WriteString(n.out, bell); err != nil {
+			log.Warn().Err(err).Msg("Ring bell failed")
+		}
+	}
+	if cfg.Command == "" {
+		return nil
+	}
+	evt.Level = levelName(level)
+	args, err := notifyArgs(cfg.Command, evt)
+	if err != nil {
+		return err
+	}
+	go n.run(cfg.GetTimeout(), args)
+
+	return nil
+}
+
+// notifyArgs splits the notify command and expands each argument template.
+func notifyArgs(cmd string, evt notifyEvent) ([]string, error) {
+	ff := strings.Fields(cmd)
+	args := make([]string, 0, len(ff))
+	for _, f := range ff {
+		tpl, err := template.New("notify").Parse(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notify command %q: %w", cmd, err)
+		}
+		var b bytes.Buffer
+		if err := tpl.Execute(&b, evt); err != nil {
+			return nil, fmt.Errorf("invalid notify command %q: %w", cmd, err)
+		}
+		args = append(args, b.String())
+	}
+
+	return args, nil
+}
+
+func runNotifier(timeout time.Duration, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// #nosec G204
+	if out, err := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput(); err != nil {
+		log.Warn().Err(err).Msgf("Notify command %q failed: %s", args[0], string(out))
+	}
+}
+
+func levelName(l ui.FlashLevel) string {
+	switch l {
+	case ui.FlashWarn:
+		return "warn"
+	case ui.FlashErr:
+		return "error"
+	case ui.FlashFatal:
+		return "fatal"
+	default:
+		return "info"
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+var _ model.TableListener = (*crashLoopWatcher)(nil)
+
+// crashLoopWatcher alerts when pods in a view enter CrashLoopBackOff.
+type crashLoopWatcher struct {
+	app      *App
+	notified map[string]time.Time
+	mx       sync.Mutex
+}
+
+func newCrashLoopWatcher(app *App) *crashLoopWatcher {
+	return &crashLoopWatcher{app: app, notified: make(map[string]time.Time)}
+}
+
+// TableDataChanged notifies the model data changed.
+func (c *crashLoopWatcher) TableDataChanged(data render.TableData) {
+	for _, path := range c.crashed(data, time.Now()) {
+		c.app.alert(ui.FlashWarn, fmt.Sprintf("Pod %s entered %s", path, crashLoopStatus))
+	}
+}
+
+// TableLoadFailed notifies the load failed.
+func (*crashLoopWatcher) TableLoadFailed(error, time.Duration) {}
+
+// crashed returns pods that just transitioned to CrashLoopBackOff. Pods flip
+// in and out of the state on each restart so alerts are throttled per pod.
+func (c *crashLoopWatcher) crashed(data render.TableData, now time.Time) []string {
+	idx := data.Header.IndexOf("STATUS")
+	if idx < 0 {
+		return nil
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	var pp []string
+	for _, re := range data.RowEvents {
+		if re.Kind != render.EventUpdate || idx >= len(re.Deltas) || idx >= len(re.Row.Fields) {
+			continue
+		}
+		if !strings.HasPrefix(re.Row.Fields[idx], crashLoopStatus) || re.Deltas[idx] == "" {
+			continue
+		}
+		if t, ok := c.notified[re.Row.ID]; ok && now.Sub(t) < crashNotifyCooldown {
+			continue
+		}
+		c.notified[re.Row.ID] = now
+		pp = append(pp, re.Row.ID)
+	}
+
+	return pp
+}
@@ -0,0 +1,90 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifierNotify(t *testing.T) {
+	uu := map[string]struct {
+		cfg   config.Notifications
+		level ui.FlashLevel
+		bell  string
+		args  []string
+	}{
+		"info": {
+			cfg:   config.Notifications{Bell: true, Command: "notify-send {{.Message}}"},
+			level: ui.FlashInfo,
+		},
+		"bell": {
+			cfg:   config.Notifications{Bell: true},
+			level: ui.FlashWarn,
+			bell:  bell,
+		},
+		"command": {
+			cfg:   config.Notifications{Command: "notify-send -u {{.Level}} k9s/{{.Context}} {{.Message}}"},
+			level: ui.FlashErr,
+			args:  []string{"notify-send", "-u", "error", "k9s/fred", "pod ns1/a died"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var (
+				out  bytes.Buffer
+				args = make(chan []string, 1)
+			)
+			n := notifier{out: &out, run: func(_ time.Duration, aa []string) { args <- aa }}
+
+			assert.Nil(t, n.notify(u.cfg, u.level, notifyEvent{Message: "pod ns1/a died", Context: "fred"}))
+			assert.Equal(t, u.bell, out.String())
+			if u.args == nil {
+				assert.Equal(t, 0, len(args))
+				return
+			}
+			select {
+			case aa := <-args:
+				assert.Equal(t, u.args, aa)
+			case <-time.After(time.Second):
+				assert.Fail(t, "notify command never ran")
+			}
+		})
+	}
+}
+
+func TestNotifierBadTemplate(t *testing.T) {
+	n := notifier{out: &bytes.Buffer{}, run: func(time.Duration, []string) {}}
+
+	assert.Error(t, n.notify(config.Notifications{Command: "notify-send {{.Blee"}, ui.FlashWarn, notifyEvent{}))
+}
+
+func TestCrashLoopWatcherCrashed(t *testing.T) {
+	h := render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE"}}
+	crash := func(id, prev string) render.RowEvent {
+		return render.NewDeltaRowEvent(
+			render.Row{ID: id, Fields: render.Fields{id, crashLoopStatus, "1m"}},
+			render.DeltaRow{"", prev, ""},
+		)
+	}
+	data := render.TableData{
+		Header: h,
+		RowEvents: render.RowEvents{
+			crash("ns1/a", "Error"),
+			crash("ns1/b", ""),
+			render.NewRowEvent(render.EventAdd, render.Row{ID: "ns1/c", Fields: render.Fields{"c", crashLoopStatus, "1m"}}),
+		},
+	}
+
+	c := newCrashLoopWatcher(nil)
+	now := time.Now()
+	assert.Equal(t, []string{"ns1/a"}, c.crashed(data, now))
+	assert.Equal(t, 0, len(c.crashed(data, now.Add(time.Minute))))
+	assert.Equal(t, []string{"ns1/a"}, c.crashed(data, now.Add(crashNotifyCooldown)))
+}
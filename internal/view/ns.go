@@ -2,21 +2,19 @@ package view
 
 import (
 	"github.com/derailed/k9s/internal/client"
-	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
 
-const (
-	favNSIndicator     = "+"
-	defaultNSIndicator = "(*)"
-)
-
 // Namespace represents a namespace viewer.
 type Namespace struct {
 	ResourceViewer
+
+	alpha bool
 }
 
 // NewNamespace returns a new viewer
@@ -27,6 +25,7 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 	n.GetTable().SetDecorateFn(n.decorate)
 	n.GetTable().SetColorerFn(render.Namespace{}.ColorerFunc())
 	n.GetTable().SetEnterFn(n.switchNs)
+	n.GetTable().SetRankFn(n.rank)
 	n.SetBindKeysFn(n.bindKeys)
 
 	return &n
@@ -34,11 +33,38 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyF:      ui.NewKeyAction("Finalizers", n.finalizersCmd, true),
+		ui.KeyL:      ui.NewKeyAction("Dump Logs", n.dumpLogsCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Toggle Recent Sort", n.toggleRecentCmd, false),
 	})
 }
 
+// toggleRecentCmd switches between recently used first and alphabetical
+// ordering.
+func (n *Namespace) toggleRecentCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n.alpha = !n.alpha
+	if n.alpha {
+		n.GetTable().SetRankFn(nil)
+		n.App().Flash().Info("Namespaces sorted alphabetically")
+	} else {
+		n.GetTable().SetRankFn(n.rank)
+		n.App().Flash().Info("Namespaces sorted by recent use")
+	}
+	n.GetTable().Refresh()
+
+	return nil
+}
+
+func (n *Namespace) rank(re render.RowEvent) int {
+	return recentRank(n.App().Config.RecentNamespaces(), re.Row.ID)
+}
+
 func (n *Namespace) switchNs(app *App, _, res, sel string) {
+	if n.GetTable().GetSelectedCell(1) == render.Terminating {
+		n.showFinalizers(sel)
+		return
+	}
 	n.useNamespace(sel)
 	if err := app.gotoResource("pods", true); err != nil {
 		app.Flash().Err(err)
@@ -55,47 +81,69 @@ func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) finalizersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" || path == render.NamespaceAll {
+		return nil
+	}
+	n.showFinalizers(path)
+
+	return nil
+}
+
+func (n *Namespace) dumpLogsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	n.App().dumpLogs(path)
+
+	return nil
+}
+
+func (n *Namespace) showFinalizers(path string) {
+	report, err := dao.NamespaceTerminationReport(n.App().factory, path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return
+	}
+	details := NewDetails(n.App(), "Finalizers", path).Update(report)
+	if err := n.App().inject(details); err != nil {
+		n.App().Flash().Err(err)
+	}
+}
+
 func (n *Namespace) useNamespace(ns string) {
 	log.Debug().Msgf("SWITCHING NS %q", ns)
-	n.App().switchNS(ns)
-	if err := n.App().Config.SetActiveNamespace(ns); err != nil {
-		n.App().Flash().Err(err)
-	} else {
-		n.App().Flash().Infof("Namespace %s is now active!", ns)
+	if !n.App().switchNS(ns) {
+		n.App().Flash().Errf("Unable to set active namespace %q", ns)
+		return
 	}
 	if err := n.App().Config.Save(); err != nil {
 		log.Error().Err(err).Msg("Config file save failed!")
 	}
+	n.GetTable().Refresh()
+	n.App().Flash().Infof("Namespace %s is now active!", ns)
 }
 
 func (n *Namespace) decorate(data render.TableData) render.TableData {
-	if n.App().Conn() == nil || len(data.RowEvents) == 0 {
+	if n.App().Conn() == nil {
 		return data
 	}
 
-	// checks if all ns is in the list if not add it.
-	if _, ok := data.RowEvents.FindIndex(render.NamespaceAll); !ok {
-		data.RowEvents = append(data.RowEvents,
-			render.RowEvent{
-				Kind: render.EventUnchanged,
-				Row: render.Row{
-					ID:     render.NamespaceAll,
-					Fields: render.Fields{render.NamespaceAll, "Active", "0"},
-				},
-			},
-		)
-	}
+	return model.DecorateNamespaces(data, n.App().Config.FavNamespaces(), n.App().Config.ActiveNamespace())
+}
 
-	for _, re := range data.RowEvents {
-		if config.InList(n.App().Config.FavNamespaces(), re.Row.ID) {
-			re.Row.Fields[0] += favNSIndicator
-			re.Kind = render.EventUnchanged
-		}
-		if n.App().Config.ActiveNamespace() == re.Row.ID {
-			re.Row.Fields[0] += defaultNSIndicator
-			re.Kind = render.EventUnchanged
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// recentRank ranks a namespace by recent use. Namespaces never used rank last.
+func recentRank(mru []string, ns string) int {
+	for i, r := range mru {
+		if r == ns {
+			return i
 		}
 	}
 
-	return data
+	return len(mru)
 }
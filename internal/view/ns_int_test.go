@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentRank(t *testing.T) {
+	mru := []string{"kube-system", "default", "fred"}
+	uu := map[string]struct {
+		mru []string
+		ns  string
+		e   int
+	}{
+		"first": {mru: mru, ns: "kube-system", e: 0},
+		"last":  {mru: mru, ns: "fred", e: 2},
+		"never": {mru: mru, ns: "blee", e: 3},
+		"none":  {ns: "blee", e: 0},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, recentRank(u.mru, u.ns))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package view
+
+import "github.com/derailed/k9s/internal/model"
+
+// origin tracks where a drill-down view was launched from.
+type origin struct {
+	view, namespace, path string
+}
+
+// tableViewer represents a component backed by a resource table.
+type tableViewer interface {
+	GetTable() *Table
+}
+
+// originOf captures the current state of the view a drill-down starts from.
+func (a *App) originOf(c model.Component) origin {
+	o := origin{namespace: a.Config.ActiveNamespace()}
+	if v, ok := c.(tableViewer); ok && v.GetTable() != nil {
+		o.view, o.path = v.GetTable().GVR(), v.GetTable().GetSelectedItem()
+	}
+
+	return o
+}
+
+// restoreOrigin resets the namespace and the selection of the view a
+// drill-down returns to.
+func (a *App) restoreOrigin(c model.Component, o origin) {
+	if o.namespace != "" && o.namespace != a.Config.ActiveNamespace() {
+		a.switchNS(o.namespace)
+	}
+	v, ok := c.(tableViewer)
+	if !ok || v.GetTable() == nil || o.path == "" {
+		return
+	}
+	if v.GetTable().GVR() == o.view {
+		v.GetTable().SelectItem(o.path)
+	}
+}
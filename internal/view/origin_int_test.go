@@ -0,0 +1,181 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	for gvr, kind := range map[string]string{
+		"rbac.authorization.k8s.io/v1/clusterroles": "ClusterRoles",
+		"users":      "Users",
+		"v1/pods":    "Pods",
+		"rbac":       "Rbac",
+		"policy":     "Policy",
+		"containers": "Containers",
+		"aliases":    "Aliases",
+	} {
+		dao.RegisterMeta(gvr, metav1.APIResource{
+			Name:       client.NewGVR(gvr).ToR(),
+			Kind:       kind,
+			Namespaced: gvr == "v1/pods",
+			Categories: []string{"k9s"},
+		})
+	}
+}
+
+func TestOriginBack(t *testing.T) {
+	uu := map[string]struct {
+		parent     func() ResourceViewer
+		drill      func(*App, ResourceViewer)
+		child, sel string
+	}{
+		"clusterRole": {
+			parent: func() ResourceViewer {
+				return NewBrowser(client.NewGVR("rbac.authorization.k8s.io/v1/clusterroles"))
+			},
+			drill: func(a *App, v ResourceViewer) {
+				showRules(a, "", v.GetTable().GVR(), v.GetTable().GetSelectedItem())
+			},
+			child: "Rbac",
+			sel:   "cr2",
+		},
+		"policy": {
+			parent: func() ResourceViewer { return NewUser(client.NewGVR("users")) },
+			drill: func(_ *App, v ResourceViewer) {
+				v.(*User).policyCmd(nil)
+			},
+			child: "Policy",
+			sel:   "fred",
+		},
+		"containers": {
+			parent: func() ResourceViewer { return NewPod(client.NewGVR("v1/pods")) },
+			drill: func(a *App, v ResourceViewer) {
+				v.(*Pod).showContainers(a, "", v.GetTable().GVR(), v.GetTable().GetSelectedItem())
+			},
+			child: containerTitle,
+			sel:   "ns1/p2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := newOriginApp()
+			parent := u.parent()
+			assert.Nil(t, a.inject(parent))
+			defer parent.Stop()
+			parent.GetTable().SetModel(&testTableModel{})
+			parent.GetTable().SetColorerFn(render.DefaultColorer)
+			parent.GetTable().SelectItem(u.sel)
+			parent.GetTable().Update(originData(u.sel))
+			assert.Equal(t, u.sel, parent.GetTable().GetSelectedItem())
+
+			u.drill(a, parent)
+			child := a.Content.Top()
+			assert.Equal(t, u.child, child.Name())
+			o, ok := a.Content.origins[child]
+			assert.True(t, ok)
+			assert.Equal(t, origin{view: parent.GetTable().GVR(), namespace: a.Config.ActiveNamespace(), path: u.sel}, o)
+
+			parent.GetTable().Select(1, 0)
+			a.PrevCmd(nil)
+			assert.Equal(t, parent, a.Content.Top())
+			assert.Empty(t, a.Content.origins)
+			parent.GetTable().Update(originData(u.sel))
+			assert.Equal(t, u.sel, parent.GetTable().GetSelectedItem())
+		})
+	}
+}
+
+func TestOriginBackLast(t *testing.T) {
+	a, v := newOriginView("v1/pods")
+	a.Content.Push(v)
+	a.PrevCmd(nil)
+
+	assert.Equal(t, v, a.Content.Top())
+}
+
+func TestOriginAliasBack(t *testing.T) {
+	a := newOriginApp()
+	a.command = NewCommand(a)
+	a.command.alias = dao.NewAlias(a.factory)
+	v := NewPod(client.NewGVR("v1/pods"))
+	assert.Nil(t, a.inject(v))
+	defer v.Stop()
+	v.GetTable().SetModel(&testTableModel{})
+	v.GetTable().SetColorerFn(render.DefaultColorer)
+	v.GetTable().SelectItem("ns1/p2")
+	v.GetTable().Update(originData("ns1/p2"))
+
+	a.aliasCmd(nil)
+	assert.Equal(t, aliasTitle, a.Content.Top().Name())
+	v.GetTable().Select(1, 0)
+	a.aliasCmd(nil)
+	assert.Equal(t, v, a.Content.Top())
+	assert.Empty(t, a.Content.origins)
+	v.GetTable().Update(originData("ns1/p2"))
+	assert.Equal(t, "ns1/p2", v.GetTable().GetSelectedItem())
+}
+
+// Helpers...
+
+func newOriginApp() *App {
+	a := NewApp(config.NewConfig(ks{}))
+	a.factory = watch.NewFactory(originConn{})
+	a.Content.app = a
+	a.Content.Stack.AddListener(a.Content)
+
+	return a
+}
+
+// originConn denies all access so the drill-down views never load.
+type originConn struct {
+	client.Connection
+}
+
+func (originConn) CanI(string, string, []string) (bool, error) {
+	return false, errors.New("denied")
+}
+
+type originView struct {
+	*Table
+}
+
+func newOriginView(gvr string) (*App, *originView) {
+	v := originView{Table: NewTable(client.NewGVR(gvr))}
+	v.Table.Init(makeContext())
+	v.SetModel(&testTableModel{})
+	v.app.Content.app = v.app
+	v.app.Content.Stack.AddListener(v.app.Content)
+
+	return v.app, &v
+}
+
+func (v *originView) Init(context.Context) error { return nil }
+func (v *originView) Start()                     {}
+func (v *originView) Stop()                      {}
+func (v *originView) GetTable() *Table           { return v.Table }
+
+func originData(sel string) render.TableData {
+	data := render.NewTableData()
+	data.Header = render.HeaderRow{
+		render.Header{Name: "NAME"},
+	}
+	for _, n := range []string{"a", "b", sel, "z"} {
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Row: render.Row{ID: n, Fields: render.Fields{n}},
+		})
+	}
+
+	return *data
+}
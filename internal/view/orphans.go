@@ -0,0 +1,122 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const orphansTitle = "Orphans"
+
+// auditStateFunc returns the audit state of a given controller.
+type auditStateFunc func(dao.Factory, string) (string, error)
+
+// orphans filters a controller view on the revisions left behind by their
+// owner and prunes them.
+type orphans struct {
+	ResourceViewer
+
+	kind, owner string
+	stateFn     auditStateFunc
+	on          bool
+	title       string
+	resetFn     ui.ActionHandler
+}
+
+func newOrphans(v ResourceViewer, kind, owner string, f auditStateFunc) *orphans {
+	return &orphans{ResourceViewer: v, kind: kind, owner: owner, stateFn: f}
+}
+
+func (o *orphans) bindKeys(aa ui.KeyActions) {
+	if a, ok := aa[tcell.KeyEscape]; ok && o.resetFn == nil {
+		o.resetFn = a.Action
+	}
+	aa.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", o.resetCmd, false),
+		ui.KeyZ:         ui.NewKeyAction("Orphans", o.orphansCmd, true),
+		ui.KeyP:         ui.NewDangerousKeyAction("Prune", o.pruneCmd, true).Requires(ui.Permission{Verb: "delete"}),
+	})
+}
+
+func (o *orphans) orphansContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyOrphans, o.on)
+}
+
+func (o *orphans) orphansCmd(evt *tcell.EventKey) *tcell.EventKey {
+	o.toggle()
+
+	return nil
+}
+
+func (o *orphans) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if o.on && o.GetTable().SearchBuff().Empty() {
+		o.toggle()
+		return nil
+	}
+	if o.resetFn == nil {
+		return evt
+	}
+
+	return o.resetFn(evt)
+}
+
+func (o *orphans) toggle() {
+	o.on = !o.on
+	if o.on {
+		o.title, o.GetTable().BaseTitle = o.GetTable().BaseTitle, orphansTitle
+		o.App().Flash().Infof("Showing orphaned and stale %ss", strings.ToLower(o.kind))
+	} else {
+		o.GetTable().BaseTitle = o.title
+		o.App().Flash().Infof("Showing all %ss", strings.ToLower(o.kind))
+	}
+	o.Start()
+}
+
+func (o *orphans) pruneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := o.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	state, err := o.stateFn(o.App().factory, sel)
+	if err != nil {
+		o.App().Flash().Err(err)
+		return nil
+	}
+	if state == "" {
+		o.App().Flash().Warnf("%s %s is managed by its %s. Nothing to prune", o.kind, sel, o.owner)
+		return nil
+	}
+	res, err := dao.AccessorFor(o.App().factory, client.NewGVR(o.GVR()))
+	if err != nil {
+		o.App().Flash().Err(err)
+		return nil
+	}
+	nuker, ok := res.(dao.Nuker)
+	if !ok {
+		o.App().Flash().Errf("expecting a nuker for %s", o.GVR())
+		return nil
+	}
+
+	msg := fmt.Sprintf("Prune %s %s %s and its pods?", strings.ToLower(state), strings.ToLower(o.kind), sel)
+	dialog.ShowConfirm(o.App().Content.Pages, "Confirm Prune", msg, func() {
+		err := o.App().mutate("Prune", o.GVR(), sel, func() error {
+			return nuker.Delete(sel, true, false)
+		})
+		if err != nil {
+			o.App().Flash().Err(err)
+			return
+		}
+		o.App().Flash().Infof("%s %s pruned", o.kind, sel)
+		o.Refresh()
+	}, func() {})
+
+	return nil
+}
@@ -11,13 +11,15 @@ import (
 type PageStack struct {
 	*ui.Pages
 
-	app *App
+	app     *App
+	origins map[model.Component]origin
 }
 
 // NewPageStack returns a new page stack.
 func NewPageStack() *PageStack {
 	return &PageStack{
-		Pages: ui.NewPages(),
+		Pages:   ui.NewPages(),
+		origins: make(map[model.Component]origin),
 	}
 }
 
@@ -40,6 +42,7 @@ func (p *PageStack) StackPushed(c model.Component) {
 // StackPopped notifies a page was removed.
 func (p *PageStack) StackPopped(o, top model.Component) {
 	o.Stop()
+	delete(p.origins, o)
 	p.StackTop(top)
 }
 
@@ -51,3 +54,20 @@ func (p *PageStack) StackTop(top model.Component) {
 	top.Start()
 	p.app.SetFocus(top)
 }
+
+// track records the origin of a page about to be pushed.
+func (p *PageStack) track(c model.Component, o origin) {
+	p.origins[c] = o
+}
+
+// back pops the top page and restores the namespace and selection of the view
+// it was launched from.
+func (p *PageStack) back() {
+	if p.Empty() || p.IsLast() {
+		return
+	}
+	if o, ok := p.origins[p.Top()]; ok {
+		p.app.restoreOrigin(p.Previous(), o)
+	}
+	p.Pop()
+}
@@ -0,0 +1,154 @@
+package view
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// paletteSkips lists keys driving input rather than actions.
+var paletteSkips = map[tcell.Key]struct{}{
+	tcell.KeyEscape:     {},
+	tcell.KeyEnter:      {},
+	tcell.KeyBackspace:  {},
+	tcell.KeyBackspace2: {},
+	tcell.KeyDelete:     {},
+	tcell.KeyCtrlP:      {},
+}
+
+type actioner interface {
+	Actions() ui.KeyActions
+}
+
+type keyCapturer interface {
+	GetInputCapture() func(*tcell.EventKey) *tcell.EventKey
+}
+
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+
+	// App level keys are off while the palette takes input.
+	focus, capture := a.GetFocus(), a.GetInputCapture()
+	a.SetInputCapture(nil)
+	dialog.ShowPalette(a.Content.Pages, a.paletteEntries(), func() {
+		a.SetInputCapture(capture)
+		a.SetFocus(focus)
+	})
+	a.SetFocus(a.Content.Pages)
+
+	return nil
+}
+
+// paletteEntries lists the current view actions, the app actions and the
+// resource aliases.
+func (a *App) paletteEntries() []dialog.PaletteEntry {
+	var ee []dialog.PaletteEntry
+	if top := a.Content.Top(); top != nil {
+		if v, ok := top.(actioner); ok {
+			ee = append(ee, actionEntries(top.Name(), v.Actions(), func(k tcell.Key) {
+				fireKey(top, v.Actions(), k)
+			})...)
+		}
+	}
+	ee = append(ee, actionEntries("global", a.GetActions(), func(k tcell.Key) {
+		if act, ok := a.GetActions()[k]; ok {
+			act.Action(keyEvent(k))
+		}
+	})...)
+
+	return append(ee, a.aliasEntries()...)
+}
+
+func (a *App) aliasEntries() []dialog.PaletteEntry {
+	if a.command == nil || a.command.alias == nil {
+		return nil
+	}
+	gvrs := make(map[string][]string)
+	for alias, gvr := range a.command.alias.Alias {
+		gvrs[gvr] = append(gvrs[gvr], alias)
+	}
+
+	ee := make([]dialog.PaletteEntry, 0, len(gvrs))
+	for gvr, aa := range gvrs {
+		sort.Slice(aa, func(i, j int) bool {
+			if len(aa[i]) == len(aa[j]) {
+				return aa[i] < aa[j]
+			}
+			return len(aa[i]) < len(aa[j])
+		})
+		desc := "View " + gvr
+		if len(aa) > 1 {
+			desc += " (" + strings.Join(aa[1:], ", ") + ")"
+		}
+		alias := aa[0]
+		ee = append(ee, dialog.PaletteEntry{
+			Key:         ":" + alias,
+			Description: desc,
+			Scope:       "alias",
+			Run: func() {
+				if err := a.gotoResource(alias, true); err != nil {
+					a.Flash().Err(err)
+				}
+			},
+		})
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].Key < ee[j].Key
+	})
+
+	return ee
+}
+
+// actionEntries converts key actions to palette entries, sorted by key.
+func actionEntries(scope string, aa ui.KeyActions, fire func(tcell.Key)) []dialog.PaletteEntry {
+	kk := make([]int, 0, len(aa))
+	for k, act := range aa {
+		if _, ok := paletteSkips[k]; ok || act.Description == "" {
+			continue
+		}
+		if _, ok := tcell.KeyNames[k]; !ok {
+			continue
+		}
+		kk = append(kk, int(k))
+	}
+	sort.Ints(kk)
+
+	ee := make([]dialog.PaletteEntry, 0, len(kk))
+	for _, k := range kk {
+		key := tcell.Key(k)
+		ee = append(ee, dialog.PaletteEntry{
+			Key:         tcell.KeyNames[key],
+			Description: aa[key].Description,
+			Scope:       scope,
+			Run:         func() { fire(key) },
+		})
+	}
+
+	return ee
+}
+
+// fireKey delivers a key to a view as if typed so guarded actions are
+// still vetted.
+func fireKey(v interface{}, aa ui.KeyActions, k tcell.Key) {
+	if c, ok := v.(keyCapturer); ok && c.GetInputCapture() != nil {
+		c.GetInputCapture()(keyEvent(k))
+		return
+	}
+	if act, ok := aa[k]; ok {
+		act.Action(keyEvent(k))
+	}
+}
+
+// keyEvent returns the key event for an action key.
+func keyEvent(k tcell.Key) *tcell.EventKey {
+	if k >= ' ' && k < tcell.KeyDEL {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
+
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
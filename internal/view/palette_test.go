@@ -0,0 +1,115 @@
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteFocus(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Init("blee", 10)
+	v := newPaletteTestView()
+	a.Content.Push(v)
+	a.SetFocus(v)
+
+	assert.Nil(t, a.paletteCmd(nil))
+	assert.True(t, a.Content.HasPage("palette"))
+	assert.Nil(t, a.GetInputCapture())
+	input, ok := a.GetFocus().(*tview.InputField)
+	assert.True(t, ok)
+
+	input.GetInputCapture()(tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone))
+	assert.False(t, a.Content.HasPage("palette"))
+	assert.NotNil(t, a.GetInputCapture())
+	assert.Equal(t, v, a.GetFocus())
+	assert.Equal(t, "", v.fired)
+}
+
+func TestPaletteRun(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Init("blee", 10)
+	v := newPaletteTestView()
+	a.Content.Push(v)
+	a.SetFocus(v)
+
+	assert.Nil(t, a.paletteCmd(nil))
+	input := a.GetFocus().(*tview.InputField)
+	input.SetText("shout")
+	input.GetInputCapture()(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+
+	assert.False(t, a.Content.HasPage("palette"))
+	assert.Equal(t, v, a.GetFocus())
+	assert.Equal(t, "Shout", v.fired)
+}
+
+func TestActionEntries(t *testing.T) {
+	var fired []tcell.Key
+	aa := ui.KeyActions{
+		ui.KeyX:         ui.NewKeyAction("Shout", nil, true),
+		tcell.KeyCtrlD:  ui.NewKeyAction("Delete", nil, true),
+		ui.KeyShiftB:    ui.NewSharedKeyAction("Blee", nil, false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", nil, false),
+		tcell.KeyCtrlP:  ui.NewKeyAction("Command Palette", nil, false),
+		ui.KeyY:         ui.NewKeyAction("", nil, false),
+	}
+	ee := actionEntries("fred", aa, func(k tcell.Key) { fired = append(fired, k) })
+
+	kk := make([]string, 0, len(ee))
+	for _, e := range ee {
+		assert.Equal(t, "fred", e.Scope)
+		kk = append(kk, e.Key+" "+e.Description)
+		e.Run()
+	}
+	assert.Equal(t, []string{"Ctrl-D Delete", "Shift-B Blee", "x Shout"}, kk)
+	assert.Equal(t, []tcell.Key{tcell.KeyCtrlD, ui.KeyShiftB, ui.KeyX}, fired)
+}
+
+func TestKeyEvent(t *testing.T) {
+	evt := keyEvent(ui.KeyX)
+	assert.Equal(t, tcell.KeyRune, evt.Key())
+	assert.Equal(t, 'x', evt.Rune())
+
+	evt = keyEvent(tcell.KeyCtrlD)
+	assert.Equal(t, tcell.KeyCtrlD, evt.Key())
+}
+
+// Helpers...
+
+type paletteTestView struct {
+	*tview.Box
+
+	actions ui.KeyActions
+	fired   string
+}
+
+func newPaletteTestView() *paletteTestView {
+	v := paletteTestView{Box: tview.NewBox(), actions: make(ui.KeyActions)}
+	v.actions[ui.KeyX] = ui.NewKeyAction("Shout", func(evt *tcell.EventKey) *tcell.EventKey {
+		v.fired = "Shout"
+		return nil
+	}, true)
+	v.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if evt.Key() == tcell.KeyRune {
+			if a, ok := v.actions[tcell.Key(evt.Rune())]; ok {
+				return a.Action(evt)
+			}
+		}
+		return evt
+	})
+
+	return &v
+}
+
+func (v *paletteTestView) Init(context.Context) error { return nil }
+func (v *paletteTestView) Start()                     {}
+func (v *paletteTestView) Stop()                      {}
+func (v *paletteTestView) Name() string               { return "test" }
+func (v *paletteTestView) Hints() model.MenuHints     { return v.actions.Hints() }
+func (v *paletteTestView) Actions() ui.KeyActions     { return v.actions }
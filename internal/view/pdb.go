@@ -0,0 +1,62 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PodDisruptionBudget presents a pdb viewer.
+type PodDisruptionBudget struct {
+	ResourceViewer
+}
+
+// NewPodDisruptionBudget returns a new viewer.
+func NewPodDisruptionBudget(gvr client.GVR) ResourceViewer {
+	p := PodDisruptionBudget{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.showPods)
+	p.GetTable().SetColorerFn(render.PodDisruptionBudget{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PodDisruptionBudget) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Allowed", p.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Covered", p.GetTable().SortColCmd(7, false), false),
+	})
+}
+
+func (p *PodDisruptionBudget) showPods(app *App, _, gvr, path string) {
+	o, err := app.factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var pdb v1beta1.PodDisruptionBudget
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pdb)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	sel, err := dao.PDBSelector(&pdb)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if sel.String() == "" {
+		app.Flash().Warnf("PodDisruptionBudget %s does not cover any pods", path)
+		return
+	}
+
+	showPods(app, path, sel.String(), "")
+}
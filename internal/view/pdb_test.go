@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodDisruptionBudgetNew(t *testing.T) {
+	v := view.NewPodDisruptionBudget(client.NewGVR("policy/v1beta1/poddisruptionbudgets"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "PodDisruptionBudgets", v.Name())
+	assert.Equal(t, 5, len(v.Hints()))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -33,11 +34,11 @@ func (v *Picker) Init(ctx context.Context) error {
 	v.actions[tcell.KeyEscape] = ui.NewKeyAction("Back", app.PrevCmd, true)
 
 	v.SetBorder(true)
-	v.SetMainTextColor(tcell.ColorWhite)
+	v.SetMainTextColor(render.Accent(tcell.ColorWhite))
 	v.ShowSecondaryText(false)
-	v.SetShortcutColor(tcell.ColorAqua)
-	v.SetSelectedBackgroundColor(tcell.ColorAqua)
-	v.SetTitle(" [aqua::b]Containers Picker ")
+	v.SetShortcutColor(render.Accent(tcell.ColorAqua))
+	v.SetSelectedBackgroundColor(render.Accent(tcell.ColorAqua))
+	v.SetTitle(render.Tags(" [aqua::b]Containers Picker "))
 	v.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
 		if a, ok := v.actions[evt.Key()]; ok {
 			a.Action(evt)
@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
@@ -19,16 +23,29 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const shellCheck = "command -v bash >/dev/null && exec bash || exec sh"
+const (
+	problemsTitle    = "Problems"
+	evictedTitle     = "Evicted"
+	shellCheck       = "command -v bash >/dev/null && exec bash || exec sh"
+	usageChartHeight = 8
+)
 
 // Pod represents a pod viewer.
 type Pod struct {
 	ResourceViewer
+
+	history *model.PodHistory
+	filter  string
+	title   string
+	resetFn ui.ActionHandler
 }
 
 // NewPod returns a new viewer.
 func NewPod(gvr client.GVR) ResourceViewer {
-	p := Pod{ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil)}
+	p := Pod{
+		ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil),
+		history:        model.NewPodHistory(model.DefaultPodHistorySize),
+	}
 	p.SetBindKeysFn(p.bindKeys)
 	p.GetTable().SetEnterFn(p.showContainers)
 	p.GetTable().SetColorerFn(render.Pod{}.ColorerFunc())
@@ -37,20 +54,46 @@ func NewPod(gvr client.GVR) ResourceViewer {
 	return &p
 }
 
+// Init initializes the viewer.
+func (p *Pod) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().AddListener(newCrashLoopWatcher(p.App()))
+
+	return nil
+}
+
 func (p *Pod) bindKeys(aa ui.KeyActions) {
+	if a, ok := aa[tcell.KeyEscape]; ok && p.resetFn == nil {
+		p.resetFn = a.Action
+	}
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
-		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(1, true), false),
-		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(2, true), false),
-		ui.KeyShiftT:   ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd(3, false), false),
-		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd(4, false), false),
-		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd(5, false), false),
-		ui.KeyShiftX:   ui.NewKeyAction("Sort CPU%", p.GetTable().SortColCmd(6, false), false),
-		ui.KeyShiftZ:   ui.NewKeyAction("Sort MEM%", p.GetTable().SortColCmd(7, false), false),
-		ui.KeyShiftI:   ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd(8, true), false),
-		ui.KeyShiftO:   ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd(9, true), false),
+		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", p.resetCmd, false),
+		ui.KeyZ:         ui.NewKeyAction("Problems", p.problemsCmd, true),
+		ui.KeyShiftE:    ui.NewKeyAction("Evicted", p.evictedCmd, true),
+		tcell.KeyCtrlK:  ui.NewDangerousKeyAction("Kill", p.killCmd, true).Requires(ui.Permission{Verb: "delete"}),
+		tcell.KeyCtrlN:  ui.NewKeyAction("Debug Copy", p.debugCmd, true),
+		ui.KeyS:         ui.NewKeyAction("Shell", p.shellCmd, true),
+		ui.KeyShiftF:    ui.NewKeyAction("PortForward", p.portFwdCmd, true),
+		ui.KeyO:         ui.NewKeyAction("Scheduling", p.schedulingCmd, true),
+		ui.KeyU:         ui.NewKeyAction("Usage", p.usageCmd, true),
+		ui.KeyA:         ui.NewKeyAction("SA Policies", p.saPolicyCmd, true),
+		ui.KeyShiftR:    ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftS:    ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftT:    ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd(4, false), false),
+		ui.KeyShiftM:    ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd(5, false), false),
+		ui.KeyShiftX:    ui.NewKeyAction("Sort CPU%", p.GetTable().SortColCmd(6, false), false),
+		ui.KeyShiftZ:    ui.NewKeyAction("Sort MEM%", p.GetTable().SortColCmd(7, false), false),
+		ui.KeyShiftI:    ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd(8, true), false),
+		ui.KeyShiftO:    ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd(9, true), false),
 	})
+	if p.App().Config.K9s.Advanced {
+		aa.Add(ui.KeyActions{
+			ui.KeyN: ui.NewKeyAction("Net Shell", p.netShellCmd, true),
+		})
+	}
 }
 
 func (p *Pod) showContainers(app *App, ns, gvr, path string) {
@@ -63,17 +106,26 @@ func (p *Pod) showContainers(app *App, ns, gvr, path string) {
 }
 
 func (p *Pod) podContext(ctx context.Context) context.Context {
-	ns, ok := ctx.Value(internal.KeyNamespace).(string)
-	if !ok {
-		log.Error().Err(fmt.Errorf("Expecting context namespace"))
-	}
+	return context.WithValue(ctx, internal.KeyPodHistory, p.history)
+}
 
-	mx := client.NewMetricsServer(p.App().factory.Client())
-	nmx, err := mx.FetchPodsMetrics(ns)
-	if err != nil {
-		log.Warn().Err(err).Msgf("No pods metrics")
+// SetContextFn sets a custom context that honors the problems and evicted
+// filters.
+func (p *Pod) SetContextFn(f ContextFunc) {
+	p.ResourceViewer.SetContextFn(func(ctx context.Context) context.Context {
+		return p.filterContext(f(ctx))
+	})
+}
+
+func (p *Pod) filterContext(ctx context.Context) context.Context {
+	switch p.filter {
+	case problemsTitle:
+		return context.WithValue(ctx, internal.KeyProblemRestarts, p.App().Config.K9s.GetProblemRestarts())
+	case evictedTitle:
+		return context.WithValue(ctx, internal.KeyEvicted, true)
+	default:
+		return ctx
 	}
-	return context.WithValue(ctx, internal.KeyMetrics, nmx)
 }
 
 func (p *Pod) coContext(ctx context.Context) context.Context {
@@ -82,6 +134,118 @@ func (p *Pod) coContext(ctx context.Context) context.Context {
 
 // Commands...
 
+func (p *Pod) problemsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.toggleFilter(problemsTitle)
+
+	return nil
+}
+
+func (p *Pod) evictedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.toggleFilter(evictedTitle)
+
+	return nil
+}
+
+func (p *Pod) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if p.filter != "" && p.GetTable().SearchBuff().Empty() {
+		p.toggleFilter(p.filter)
+		return nil
+	}
+	if p.resetFn == nil {
+		return evt
+	}
+
+	return p.resetFn(evt)
+}
+
+// toggleFilter turns the given quick filter on or off. Quick filters are
+// exclusive of one another.
+func (p *Pod) toggleFilter(f string) {
+	if p.filter == "" {
+		p.title = p.GetTable().BaseTitle
+	}
+	if p.filter == f {
+		p.filter, p.GetTable().BaseTitle = "", p.title
+		p.App().Flash().Info("Showing all pods")
+		p.Start()
+		return
+	}
+
+	p.filter, p.GetTable().BaseTitle = f, f
+	switch f {
+	case problemsTitle:
+		p.App().Flash().Infof("Showing pods with more than %d restarts or not running", p.App().Config.K9s.GetProblemRestarts())
+	case evictedTitle:
+		p.App().Flash().Info("Showing evicted pods. Use `:evicted prune` to clean them up")
+	}
+	p.Start()
+}
+
+func (p *Pod) schedulingCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	report, err := dao.SchedulingReport(p.App().factory, sel)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Scheduling", sel).Update(report)
+	if err := p.App().inject(details); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) saPolicyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	name, err := dao.ServiceAccountFor(p.App().factory, sel)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if err := p.App().inject(NewPolicy(p.App(), sa, name)); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) usageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	usage := func() (string, error) {
+		cpu, mem := p.history.Samples(sel)
+		if len(cpu) == 0 {
+			return "", fmt.Errorf("no metrics samples for %s", sel)
+		}
+		return usageReport(cpu, mem), nil
+	}
+	report, err := usage()
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Usage", sel)
+	details.SetColorizerFn(tview.Escape)
+	details.SetRefreshFn(usage)
+	if err := p.App().inject(details.Update(report)); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sels := p.GetTable().GetSelectedItems()
 	if len(sels) == 0 {
@@ -101,10 +265,14 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	p.GetTable().ShowDeleted()
 	for _, res := range sels {
 		p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
-		if err := nuker.Delete(res, true, false); err != nil {
+		err := p.App().mutate("Kill", p.GVR(), res, func() error {
+			return nuker.Delete(res, true, false)
+		})
+		if err != nil {
 			p.App().Flash().Errf("Delete failed with %s", err)
 		} else {
 			p.App().factory.DeleteForwarder(res)
+			p.App().benchmarks.Kill(res)
 		}
 	}
 	p.Refresh()
@@ -145,6 +313,45 @@ func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+func (p *Pod) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	row := p.GetTable().GetSelectedRowIndex()
+	status := ui.TrimCell(p.GetTable().SelectTable, row, p.GetTable().NameColIndex()+2)
+	if status != render.Running {
+		p.App().Flash().Errf("%s is not in a running state", sel)
+		return nil
+	}
+	pod, err := fetchPod(p.App().factory, sel)
+	if err != nil {
+		p.App().Flash().Errf("Unable to retrieve containers %s", err)
+		return nil
+	}
+	cc := pod.Spec.Containers
+	if len(cc) == 1 {
+		showForwardDialog(p.App(), sel, cc[0].Name, render.ToContainerPorts(cc[0].Ports))
+		return nil
+	}
+	nn := make([]string, 0, len(cc))
+	for _, c := range cc {
+		nn = append(nn, c.Name)
+	}
+	picker := NewPicker()
+	picker.populate(nn)
+	picker.SetSelectedFunc(func(i int, t, d string, r rune) {
+		p.App().PrevCmd(nil)
+		showForwardDialog(p.App(), sel, t, render.ToContainerPorts(cc[i].Ports))
+	})
+	if err := p.App().inject(picker); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) shellIn(path, co string) {
 	p.Stop()
 	shellIn(p.App(), path, co)
@@ -154,7 +361,33 @@ func (p *Pod) shellIn(path, co string) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func fetchContainers(f *watch.Factory, path string, includeInit bool) ([]string, error) {
+// usageReport graphs cpu (millicores) and memory (bytes) samples.
+func usageReport(cpu, mem []int64) string {
+	var b strings.Builder
+	writeUsage(&b, "CPU", cpu, func(v int64) string { return fmt.Sprintf("%dm", v) })
+	fmt.Fprintln(&b)
+	writeUsage(&b, "MEM", mem, func(v int64) string { return fmt.Sprintf("%dMi", v/(1024*1024)) })
+
+	return b.String()
+}
+
+func writeUsage(b *strings.Builder, title string, vv []int64, unit func(int64) string) {
+	min, max := vv[0], vv[0]
+	for _, v := range vv {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	fmt.Fprintf(b, "%s  last %s  min %s  max %s  (%d samples)\n", title, unit(vv[len(vv)-1]), unit(min), unit(max), len(vv))
+	for _, l := range render.SparkChart(vv, usageChartHeight) {
+		fmt.Fprintf(b, "%s\n", l)
+	}
+}
+
+func fetchPod(f *watch.Factory, path string) (*v1.Pod, error) {
 	o, err := f.Get("v1/pods", path, true, labels.Everything())
 	if err != nil {
 		return nil, err
@@ -166,6 +399,15 @@ func fetchContainers(f *watch.Factory, path string, includeInit bool) ([]string,
 		return nil, err
 	}
 
+	return &pod, nil
+}
+
+func fetchContainers(f *watch.Factory, path string, includeInit bool) ([]string, error) {
+	pod, err := fetchPod(f, path)
+	if err != nil {
+		return nil, err
+	}
+
 	nn := make([]string, 0, len(pod.Spec.Containers)+len(pod.Spec.InitContainers))
 	for _, c := range pod.Spec.Containers {
 		nn = append(nn, c.Name)
@@ -187,7 +429,11 @@ func shellIn(a *App, path, co string) {
 }
 
 func computeShellArgs(path, co, context string, kcfg *string) []string {
-	args := make([]string, 0, 15)
+	return computeExecArgs(path, co, context, kcfg, []string{"sh", "-c", shellCheck})
+}
+
+func computeExecArgs(path, co, context string, kcfg *string, cmd []string) []string {
+	args := make([]string, 0, 10+len(cmd))
 	args = append(args, "exec", "-it")
 	args = append(args, "--context", context)
 	ns, po := client.Namespaced(path)
@@ -200,5 +446,31 @@ func computeShellArgs(path, co, context string, kcfg *string) []string {
 		args = append(args, "-c", co)
 	}
 
-	return append(args, "--", "sh", "-c", shellCheck)
+	return append(append(args, "--"), cmd...)
+}
+
+// showEvictedPrune deletes the evicted pods in the active namespace once
+// confirmed.
+func showEvictedPrune(app *App) {
+	ns, scope := app.Config.ActiveNamespace(), app.Config.ActiveNamespace()
+	if ns == render.NamespaceAll {
+		ns, scope = render.AllNamespaces, "all namespaces"
+	}
+
+	msg := fmt.Sprintf("Delete all evicted pods in %s?", scope)
+	dialog.ShowConfirm(app.Content.Pages, "Confirm Evicted Prune", msg, func() {
+		if err := app.checkScope("Delete", ns); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		pp, err := dao.DeleteEvictedPods(app.Conn().DialOrDie(), ns)
+		for _, p := range pp {
+			app.audit("Delete", "v1/pods", p, nil)
+		}
+		if err != nil {
+			app.Flash().Errf("Evicted prune failed %v", err)
+			return
+		}
+		app.Flash().Infof("Deleted %d evicted pods", len(pp))
+	}, func() {})
 }
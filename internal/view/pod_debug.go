@@ -0,0 +1,74 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	cc, ii, err := dao.PodImages(p.App().factory, sel)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	dialog.ShowDebug(p.App().Content.Pages, sel, cc, ii, func(co, image string, cmd []string, noProbes bool) {
+		opts := dao.DebugOptions{
+			Container:     co,
+			Image:         image,
+			Command:       cmd,
+			DisableProbes: noProbes,
+		}
+		var po *v1.Pod
+		err := p.App().mutate("DebugCopy", p.GVR(), sel, func() error {
+			var err error
+			po, err = dao.CreateDebugCopy(p.App().Conn().DialOrDie(), sel, opts)
+			return err
+		})
+		if err != nil {
+			p.App().Flash().Errf("Debug copy failed %v", err)
+			return
+		}
+		path := client.FQN(po.Namespace, po.Name)
+		p.App().Flash().Infof("Debug copy %s created", path)
+		p.GetTable().SelectItem(path)
+	})
+
+	return nil
+}
+
+// showDebugPrune deletes the debug copies in the active namespace once
+// confirmed.
+func showDebugPrune(app *App) {
+	ns, scope := app.Config.ActiveNamespace(), app.Config.ActiveNamespace()
+	if ns == render.NamespaceAll {
+		ns, scope = render.AllNamespaces, "all namespaces"
+	}
+
+	msg := fmt.Sprintf("Delete all pods labeled %s in %s?", dao.DebugCopyLabel, scope)
+	dialog.ShowConfirm(app.Content.Pages, "Confirm Debug Prune", msg, func() {
+		if err := app.checkScope("Delete", ns); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		pp, err := dao.DeleteDebugCopies(app.Conn().DialOrDie(), ns)
+		for _, p := range pp {
+			app.audit("Delete", "v1/pods", p, nil)
+		}
+		if err != nil {
+			app.Flash().Errf("Debug prune failed %v", err)
+			return
+		}
+		app.Flash().Infof("Deleted %d debug copies", len(pp))
+	}, func() {})
+}
@@ -53,3 +53,35 @@ func TestComputeShellArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestUsageReport(t *testing.T) {
+	mi := int64(1024 * 1024)
+	s := usageReport([]int64{100, 200, 50}, []int64{10 * mi, 20 * mi, 40 * mi})
+
+	assert.Equal(t, `CPU  last 50m  min 50m  max 200m  (3 samples)
+ █ 
+ █ 
+ █ 
+ █ 
+██ 
+██ 
+███
+███
+
+MEM  last 40Mi  min 10Mi  max 40Mi  (3 samples)
+  █
+  █
+  █
+  █
+ ██
+ ██
+███
+███
+`, s)
+}
+
+func TestComputeNetShellArgs(t *testing.T) {
+	args := computeExecArgs("fred/k9s-node-helper-x1", "helper", "ctx1", nil, []string{"nsenter", "--net=/proc/42/ns/net", "--", "sh"})
+
+	assert.Equal(t, "exec -it --context ctx1 -n fred k9s-node-helper-x1 -c helper -- nsenter --net=/proc/42/ns/net -- sh", strings.Join(args, " "))
+}
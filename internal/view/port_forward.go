@@ -2,9 +2,9 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
+	"net/url"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -13,18 +13,17 @@ import (
 	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/tview"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
 
 const promptPage = "prompt"
 
-// PortForward presents active portforward viewer.
+// PortForward presents active portforward viewer. Benchmarks run
+// concurrently, one per port-forward.
 type PortForward struct {
 	ResourceViewer
-
-	bench *perf.Benchmark
 }
 
 // NewPortForward returns a new viewer.
@@ -32,18 +31,32 @@ func NewPortForward(gvr client.GVR) ResourceViewer {
 	p := PortForward{
 		ResourceViewer: NewBrowser(gvr),
 	}
-	p.GetTable().SetBorderFocusColor(tcell.ColorDodgerBlue)
-	p.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorDodgerBlue, tcell.AttrNone)
+	p.GetTable().SetBorderFocusColor(render.Accent(tcell.ColorDodgerBlue))
+	p.GetTable().SetSelectedStyle(render.Selection(tcell.ColorWhite, tcell.ColorDodgerBlue, tcell.AttrNone))
 	p.GetTable().SetColorerFn(render.PortForward{}.ColorerFunc())
-	p.GetTable().SetSortCol(p.GetTable().NameColIndex()+6, 0, true)
+	p.GetTable().SetSortCol(p.GetTable().NameColIndex()+12, 0, true)
 	p.SetContextFn(p.portForwardContext)
 	p.SetBindKeysFn(p.bindKeys)
 
 	return &p
 }
 
+// Init initializes the view.
+func (p *PortForward) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.setGrouped(p.App().groupForwards)
+
+	return nil
+}
+
 func (p *PortForward) portForwardContext(ctx context.Context) context.Context {
-	return context.WithValue(ctx, internal.KeyBenchCfg, p.App().Bench)
+	ctx = context.WithValue(ctx, internal.KeyBenchCfg, p.App().Bench)
+	ctx = context.WithValue(ctx, internal.KeyBenchHistory, p.App().history)
+	ctx = context.WithValue(ctx, internal.KeyCluster, p.App().Config.K9s.CurrentCluster)
+
+	return context.WithValue(ctx, internal.KeyForwards, p.App().managedForwards)
 }
 
 func (p *PortForward) bindKeys(aa ui.KeyActions) {
@@ -51,9 +64,29 @@ func (p *PortForward) bindKeys(aa ui.KeyActions) {
 		tcell.KeyEnter: ui.NewKeyAction("Benchmarks", p.showBenchCmd, true),
 		ui.KeyB:        ui.NewKeyAction("Bench", p.benchCmd, true),
 		ui.KeyK:        ui.NewKeyAction("Bench Stop", p.benchStopCmd, true),
-		tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true),
+		tcell.KeyCtrlD: ui.NewDestructiveKeyAction("Delete", p.deleteCmd, true),
 		ui.KeyShiftP:   ui.NewKeyAction("Sort Ports", p.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftU:   ui.NewKeyAction("Sort URL", p.GetTable().SortColCmd(4, true), false),
+		ui.KeyShiftX:   ui.NewKeyAction("Sort TX", p.GetTable().SortColCmd(8, false), false),
+		ui.KeyV:        ui.NewKeyAction("Toggle Groups", p.toggleGroupsCmd, true),
+	})
+}
+
+func (p *PortForward) toggleGroupsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.App().groupForwards = !p.App().groupForwards
+	p.setGrouped(p.App().groupForwards)
+	p.GetTable().Refresh()
+
+	return nil
+}
+
+func (p *PortForward) setGrouped(b bool) {
+	if !b {
+		p.GetTable().SetGroupFn(nil)
+		return
+	}
+	p.GetTable().SetGroupFn(func(re render.RowEvent) string {
+		return re.Row.Fields[0]
 	})
 }
 
@@ -65,13 +98,20 @@ func (p *PortForward) showBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// benchStopCmd cancels the selected port-forward benchmark. Other benchmarks
+// keep running.
 func (p *PortForward) benchStopCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if p.bench != nil {
-		log.Debug().Msg(">>> Benchmark cancelFned!!")
-		p.App().Status(ui.FlashErr, "Benchmark Camceled!")
-		p.bench.Cancel()
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return nil
+	}
+
+	if !p.App().benchmarks.Cancel(sel) {
+		p.App().Flash().Warnf("No benchmark running for %s", sel)
+		return nil
 	}
-	p.App().ClearStatus(true)
+	log.Debug().Msgf("Benchmark %s canceled", sel)
+	p.App().Status(ui.FlashErr, fmt.Sprintf("Benchmark %s canceled!", sel))
 
 	return nil
 }
@@ -82,48 +122,94 @@ func (p *PortForward) benchCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	if p.bench != nil {
-		p.App().Flash().Err(errors.New("Only one benchmark allowed at a time"))
+	if p.App().benchmarks.Running(sel) {
+		p.App().Flash().Warnf("Benchmark already running for %s", sel)
+		return nil
+	}
+	f, ok := p.App().factory.ForwarderFor(sel)
+	if !ok {
+		p.App().Flash().Warnf("PortForward %s is not active", sel)
 		return nil
 	}
 
 	r, _ := p.GetTable().GetSelection()
-	cfg := defaultConfig()
-	if b, ok := p.App().Bench.Benchmarks.Containers[sel]; ok {
-		cfg = b
+	base := ui.TrimCell(p.GetTable().SelectTable, r, 4)
+	u, err := url.Parse(base)
+	if err != nil {
+		p.App().Flash().Errf("Bench failed %v", err)
+		return nil
 	}
-	cfg.Name = sel
+	id, cfg := forwardBenchConfig(p.App().Bench, sel, containerID(f.Path(), f.Container()))
+	cfg.Name, cfg.HTTP.Path = sel, u.RequestURI()
 
-	base := ui.TrimCell(p.GetTable().SelectTable, r, 4)
-	var err error
-	if p.bench, err = perf.NewBenchmark(base, p.App().version, cfg); err != nil {
+	dialog.ShowBench(p.App().Content.Pages, base, cfg, func(cfg config.BenchConfig) error {
+		return p.startBenchmark(u, cfg)
+	}, func(cfg config.BenchConfig) error {
+		if err := p.saveBenchConfig(id, cfg); err != nil {
+			p.App().Flash().Err(err)
+			return err
+		}
+		return p.startBenchmark(u, cfg)
+	})
+
+	return nil
+}
+
+// saveBenchConfig persists a container benchmark configuration.
+func (p *PortForward) saveBenchConfig(id string, cfg config.BenchConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	p.App().Bench.SetContainer(id, cfg)
+
+	return p.App().Bench.Save(ui.BenchConfig(p.App().Config.K9s.CurrentCluster))
+}
+
+func (p *PortForward) startBenchmark(u *url.URL, cfg config.BenchConfig) error {
+	if p.App().benchmarks.Running(cfg.Name) {
+		err := fmt.Errorf("Benchmark already running for %s", cfg.Name)
+		p.App().Flash().Err(err)
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		p.App().Flash().Err(err)
+		return err
+	}
+	if !strings.HasPrefix(cfg.HTTP.Path, "/") {
+		cfg.HTTP.Path = "/" + cfg.HTTP.Path
+	}
+
+	b, err := perf.NewBenchmark(u.Scheme+"://"+u.Host+cfg.HTTP.Path, p.App().version, cfg)
+	if err != nil {
 		p.App().Flash().Errf("Bench failed %v", err)
 		p.App().ClearStatus(false)
-		return nil
+		return err
 	}
 
-	p.App().Status(ui.FlashWarn, "Benchmark in progress...")
-	log.Debug().Msg("Bench starting...")
-	go p.runBenchmark()
+	log.Debug().Msgf("Bench starting %s...", b.Name())
+	p.App().benchmarks.Add(b)
+	benchStatus(p.App())
+	go runForwardBench(p.App(), b)
 
 	return nil
 }
 
-func (p *PortForward) runBenchmark() {
-	p.bench.Run(p.App().Config.K9s.CurrentCluster, func() {
-		log.Debug().Msg("Bench Completed!")
-		p.App().QueueUpdate(func() {
-			if p.bench.Canceled() {
-				p.App().Status(ui.FlashInfo, "Benchmark canceled")
+// runForwardBench runs a port-forward benchmark. Its completion only reports
+// on that benchmark, the status reverts to the benchmarks still in flight
+// shortly after.
+func runForwardBench(app *App, b *perf.Benchmark) {
+	b.Run(app.Config.K9s.CurrentCluster, func() {
+		log.Debug().Msgf("Bench %s Completed!", b.Name())
+		pruneBenchmarks(app)
+		app.QueueUpdate(func() {
+			app.benchmarks.Remove(b)
+			if b.Canceled() {
+				app.Status(ui.FlashInfo, fmt.Sprintf("Benchmark %s canceled", b.Name()))
 			} else {
-				p.App().Status(ui.FlashInfo, "Benchmark Completed!")
-				p.bench.Cancel()
+				app.Status(ui.FlashInfo, benchDoneMsg(b))
+				b.Cancel()
 			}
-			p.bench = nil
-			go func() {
-				<-time.After(2 * time.Second)
-				p.App().QueueUpdate(func() { p.App().ClearStatus(true) })
-			}()
+			go benchTimedOut(app)
 		})
 	})
 }
@@ -140,10 +226,25 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 	log.Debug().Msgf("PF DELETE %q", path)
 
-	showModal(p.App().Content.Pages, fmt.Sprintf("Delete PortForward `%s?", path), func() {
+	msg := fmt.Sprintf("Delete PortForward `%s?", path)
+	managed := p.App().managedForwards.Managed(path)
+	if managed {
+		msg = fmt.Sprintf("Delete managed PortForward `%s`? It will be re-established on restart.", path)
+	}
+	showModal(p.App().Content.Pages, msg, func() {
+		if managed {
+			p.App().managedForwards.Suspend(path)
+		}
+		if _, ok := p.App().factory.ForwarderFor(path); !ok {
+			p.App().Flash().Infof("PortForward %s deleted!", path)
+			p.GetTable().Refresh()
+			return
+		}
 		var pf dao.PortForward
 		pf.Init(p.App().factory, client.NewGVR("portforwards"))
-		if err := pf.Delete(path, true, true); err != nil {
+		err := pf.Delete(path, true, true)
+		p.App().audit("Delete", "portforwards", path, err)
+		if err != nil {
 			p.App().Flash().Err(err)
 			return
 		}
@@ -157,6 +258,19 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// forwardBenchConfig returns the first container benchmark configuration
+// found under the given ids along with its id. Defaults are keyed by the
+// last id.
+func forwardBenchConfig(b *config.Bench, ids ...string) (string, config.BenchConfig) {
+	for _, id := range ids {
+		if cfg, ok := b.Benchmarks.Containers[id]; ok {
+			return id, cfg
+		}
+	}
+
+	return ids[len(ids)-1], defaultConfig()
+}
+
 func defaultConfig() config.BenchConfig {
 	return config.BenchConfig{
 		C: config.DefaultC,
@@ -169,9 +283,8 @@ func defaultConfig() config.BenchConfig {
 }
 
 func showModal(p *ui.Pages, msg string, ok func()) {
-	m := tview.NewModal().
-		AddButtons([]string{"Cancel", "OK"}).
-		SetTextColor(tcell.ColorFuchsia).
+	m := ui.NewModal("<Delete Benchmark>", []string{"Cancel", "OK"}).
+		SetTextColor(render.Accent(tcell.ColorFuchsia)).
 		SetText(msg).
 		SetDoneFunc(func(_ int, b string) {
 			if b == "OK" {
@@ -179,7 +292,6 @@ func showModal(p *ui.Pages, msg string, ok func()) {
 			}
 			dismissModal(p)
 		})
-	m.SetTitle("<Delete Benchmark>")
 	p.AddPage(promptPage, m, false, false)
 	p.ShowPage(promptPage)
 }
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardBenchConfig(t *testing.T) {
+	b := config.Bench{Benchmarks: &config.Benchmarks{
+		Containers: map[string]config.BenchConfig{
+			"default/nginx-123:nginx": {C: 2, N: 20},
+			"default/nginx:nginx":     {C: 3, N: 30},
+			"default/fred:fred":       {C: 4, N: 40},
+		},
+	}}
+
+	uu := map[string]struct {
+		ids []string
+		id  string
+		c   int
+	}{
+		"path": {
+			ids: []string{"default/nginx-123:nginx", "default/nginx:nginx"},
+			id:  "default/nginx-123:nginx",
+			c:   2,
+		},
+		"container": {
+			ids: []string{"default/fred-123:fred", "default/fred:fred"},
+			id:  "default/fred:fred",
+			c:   4,
+		},
+		"defaults": {
+			ids: []string{"default/blee-123:blee", "default/blee:blee"},
+			id:  "default/blee:blee",
+			c:   config.DefaultC,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			id, cfg := forwardBenchConfig(&b, u.ids...)
+			assert.Equal(t, u.id, id)
+			assert.Equal(t, u.c, cfg.C)
+		})
+	}
+}
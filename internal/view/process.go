@@ -0,0 +1,77 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const processTitle = "Processes"
+
+// Process presents a container processes viewer.
+type Process struct {
+	ResourceViewer
+}
+
+// NewProcess returns a new viewer.
+func NewProcess(gvr client.GVR) ResourceViewer {
+	p := Process{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.Process{}.ColorerFunc())
+	p.GetTable().SetEnterFn(blankEnterFn)
+	p.GetTable().SetSortCol(2, len(render.Process{}.Header(render.ClusterScope)), false)
+	p.SetBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+// Name returns the component name.
+func (p *Process) Name() string { return processTitle }
+
+func (p *Process) bindKeys(aa ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlK: ui.NewDestructiveKeyAction("Kill", p.killCmd, true),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort PID", p.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd(2, false), false),
+		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd(3, false), false),
+	})
+}
+
+func (p *Process) killCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	pid, err := strconv.Atoi(sel)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	tokens := strings.Split(p.GetTable().Path, ":")
+	if len(tokens) != 2 {
+		p.App().Flash().Errf("Invalid container path %q", p.GetTable().Path)
+		return nil
+	}
+
+	cmd := p.GetTable().GetSelectedCell(5)
+	msg := fmt.Sprintf("Send SIGTERM to process %d (%s)?", pid, cmd)
+	dialog.ShowConfirm(p.App().Content.Pages, "Confirm Kill", msg, func() {
+		if err := dao.KillProcess(dao.NewRemoteExecutor(p.App().Conn()), tokens[0], tokens[1], pid, "TERM"); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Process %d terminated", pid)
+		p.Refresh()
+	}, func() {})
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+// guard requires the resource name to be typed in before a dangerous action
+// runs against a protected namespace. It returns true when it takes over the
+// action.
+func (t *Table) guard(action string, run func()) bool {
+	path, ns, ok := t.protectedItem()
+	if !ok {
+		return false
+	}
+
+	_, n := client.Namespaced(path)
+	msg := fmt.Sprintf("Namespace %s is protected! Type %s to %s it.", ns, n, action)
+	dialog.ShowProtect(t.app.Content.Pages, "Protected "+action, msg, n, func() {
+		t.app.audit(fmt.Sprintf("Protected(%s)", action), t.GVR(), path, nil)
+		for _, ns := range t.protectedNamespaces() {
+			t.app.unlocks.Unlock(ns)
+		}
+		run()
+	}, func() {})
+
+	return true
+}
+
+// protectedItem returns the first selected item living in a protected
+// namespace.
+func (t *Table) protectedItem() (string, string, bool) {
+	if t.app.Config == nil || len(t.app.Config.K9s.ProtectedNS) == 0 {
+		return "", "", false
+	}
+	items := t.GetSelectedItems()
+	sort.Strings(items)
+	for _, path := range items {
+		if path == "" {
+			continue
+		}
+		ns := targetNamespace(t.GVR(), path, t.Path)
+		if t.app.Config.K9s.IsProtected(ns) {
+			return path, ns, true
+		}
+	}
+
+	return "", "", false
+}
+
+// protectedNamespaces returns the protected namespaces of the selected items.
+func (t *Table) protectedNamespaces() []string {
+	set := make(map[string]struct{})
+	for _, path := range t.GetSelectedItems() {
+		ns := targetNamespace(t.GVR(), path, t.Path)
+		if t.app.Config.K9s.IsProtected(ns) {
+			set[ns] = struct{}{}
+		}
+	}
+	nn := make([]string, 0, len(set))
+	for ns := range set {
+		nn = append(nn, ns)
+	}
+
+	return nn
+}
+
+// mutate runs a mutation against a cluster resource and audits it. All
+// cluster mutations go through here so mutations in a protected namespace are
+// refused unless a typed confirmation unlocked it.
+func (a *App) mutate(action, gvr, path string, fn func() error) error {
+	if ns := targetNamespace(gvr, path, ""); a.isLocked(ns) {
+		err := fmt.Errorf("namespace %s is protected", ns)
+		a.audit(action, gvr, path, err)
+		return err
+	}
+	err := fn()
+	a.audit(action, gvr, path, err)
+
+	return err
+}
+
+// checkScope vets a bulk mutation spanning a namespace. Mutations across all
+// namespaces are refused while any namespace is protected.
+func (a *App) checkScope(action, ns string) error {
+	var err error
+	switch {
+	case ns == render.AllNamespaces && len(a.Config.K9s.ProtectedNS) > 0:
+		err = fmt.Errorf("namespaces %s are protected", strings.Join(a.Config.K9s.ProtectedNS, ","))
+	case a.isLocked(ns):
+		err = fmt.Errorf("namespace %s is protected", ns)
+	}
+	if err != nil {
+		a.audit(action, "v1/namespaces", ns, err)
+	}
+
+	return err
+}
+
+func (a *App) isLocked(ns string) bool {
+	return a.Config.K9s.IsProtected(ns) && !a.unlocks.IsUnlocked(ns)
+}
+
+// unlockTTL tracks how long a typed confirmation unlocks a namespace.
+const unlockTTL = time.Minute
+
+// nsUnlocks tracks protected namespaces temporarily unlocked by the user.
+type nsUnlocks struct {
+	mx sync.Mutex
+	nn map[string]time.Time
+}
+
+func newNSUnlocks() *nsUnlocks {
+	return &nsUnlocks{nn: make(map[string]time.Time)}
+}
+
+// Unlock lifts a namespace protection for a while.
+func (u *nsUnlocks) Unlock(ns string) {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	u.nn[ns] = time.Now().Add(unlockTTL)
+}
+
+// IsUnlocked checks if a namespace protection is lifted.
+func (u *nsUnlocks) IsUnlocked(ns string) bool {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	exp, ok := u.nn[ns]
+	if ok && time.Now().After(exp) {
+		delete(u.nn, ns)
+		return false
+	}
+
+	return ok
+}
+
+// Reset clears all unlocks.
+func (u *nsUnlocks) Reset() {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	u.nn = make(map[string]time.Time)
+}
+
+// targetNamespace returns the namespace a resource lives in. Namespaces
+// protect themselves and unscoped items ie containers fall back to their
+// parent resource.
+func targetNamespace(gvr, path, parent string) string {
+	if gvr == "v1/namespaces" {
+		return path
+	}
+	if ns, _ := client.Namespaced(path); ns != "" {
+		return ns
+	}
+	ns, _ := client.Namespaced(parent)
+
+	return ns
+}
@@ -0,0 +1,118 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutateProtected(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.ProtectedNS = []string{"prod-*"}
+
+	var (
+		ran int
+		err error
+	)
+	// A plain action that never opted into the guard still hits the gate.
+	act := ui.NewKeyAction("Fred", func(*tcell.EventKey) *tcell.EventKey {
+		err = a.mutate("Fred", "v1/pods", "prod-eu/p1", func() error {
+			ran++
+			return nil
+		})
+		return nil
+	}, true)
+
+	act.Action(nil)
+	assert.EqualError(t, err, "namespace prod-eu is protected")
+	assert.Equal(t, 0, ran)
+
+	a.unlocks.Unlock("prod-eu")
+	act.Action(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ran)
+
+	a.unlocks.Reset()
+	act.Action(nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, ran)
+
+	assert.NoError(t, a.mutate("Fred", "v1/pods", "dev/p1", func() error {
+		ran++
+		return nil
+	}))
+	assert.Equal(t, 2, ran)
+}
+
+func TestCheckScope(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.ProtectedNS = []string{"prod-*"}
+
+	assert.EqualError(t, a.checkScope("Delete", ""), "namespaces prod-* are protected")
+	assert.EqualError(t, a.checkScope("Delete", "prod-eu"), "namespace prod-eu is protected")
+	assert.NoError(t, a.checkScope("Delete", "dev"))
+}
+
+func TestForeachSkipLocked(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.ProtectedNS = []string{"prod-*"}
+	a.unlocks.Unlock("prod-us")
+
+	run := dao.NewForeachRun(dao.ForeachCmd{Verb: "delete"}, []dao.ForeachTarget{
+		{GVR: "v1/pods", Path: "prod-eu/p1", Status: dao.ForeachPending},
+		{GVR: "v1/pods", Path: "prod-us/p1", Status: dao.ForeachPending},
+		{GVR: "v1/pods", Path: "dev/p1", Status: dao.ForeachPending},
+		{GVR: "v1/namespaces", Path: "prod-eu", Status: dao.ForeachPending},
+	})
+
+	assert.Equal(t, 2, a.skipLocked(run))
+	ss := make(map[string]string)
+	for _, t := range run.Targets() {
+		ss[t.GVR+":"+t.Path] = t.Status
+	}
+	assert.Equal(t, map[string]string{
+		"v1/pods:prod-eu/p1":    dao.ForeachSkipped,
+		"v1/pods:prod-us/p1":    dao.ForeachPending,
+		"v1/pods:dev/p1":        dao.ForeachPending,
+		"v1/namespaces:prod-eu": dao.ForeachSkipped,
+	}, ss)
+}
+
+func TestTargetNamespace(t *testing.T) {
+	uu := map[string]struct {
+		gvr, path, parent string
+		e                 string
+	}{
+		"namespaced": {
+			gvr:  "v1/pods",
+			path: "kube-system/coredns",
+			e:    "kube-system",
+		},
+		"namespace": {
+			gvr:  "v1/namespaces",
+			path: "prod-eu",
+			e:    "prod-eu",
+		},
+		"cluster": {
+			gvr:  "v1/nodes",
+			path: "n1",
+		},
+		"parent": {
+			gvr:    "containers",
+			path:   "nginx",
+			parent: "prod-eu/nginx-1",
+			e:      "prod-eu",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, targetNamespace(u.gvr, u.path, u.parent))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+// PersistentVolume represents a persistent volume viewer.
+type PersistentVolume struct {
+	ResourceViewer
+}
+
+// NewPersistentVolume returns a new viewer.
+func NewPersistentVolume(gvr client.GVR) ResourceViewer {
+	p := PersistentVolume{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.PersistentVolume{}.ColorerFunc())
+	p.SetBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PersistentVolume) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyR:      ui.NewDangerousKeyAction("Release", p.releaseCmd, true).Requires(ui.Permission{Verb: "patch"}),
+		ui.KeyP:      ui.NewDangerousKeyAction("Reclaim Policy", p.reclaimCmd, true).Requires(ui.Permission{Verb: "patch"}),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(4, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Claim", p.GetTable().SortColCmd(5, true), false),
+	})
+}
+
+func (p *PersistentVolume) releaseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	res, pv, err := p.load(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if err := dao.CanRelease(pv); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Clear claim %s from volume %s so it becomes Available?", dao.ClaimPath(pv), pv.Name)
+	dialog.ShowConfirm(p.App().Content.Pages, "Confirm Release", msg, func() {
+		err := p.App().mutate("Release", p.GVR(), path, func() error {
+			return res.Release(path, pv.ResourceVersion)
+		})
+		if err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Volume %s released", pv.Name)
+	}, func() {})
+
+	return nil
+}
+
+func (p *PersistentVolume) reclaimCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	res, pv, err := p.load(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	policy := v1.PersistentVolumeReclaimDelete
+	if pv.Spec.PersistentVolumeReclaimPolicy == v1.PersistentVolumeReclaimDelete {
+		policy = v1.PersistentVolumeReclaimRetain
+	}
+	if err := dao.CanReclaim(pv, policy); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Change volume %s reclaim policy from %s to %s?", pv.Name, pv.Spec.PersistentVolumeReclaimPolicy, policy)
+	if claim := dao.ClaimPath(pv); claim != "" {
+		msg += fmt.Sprintf(" (claim %s)", claim)
+	}
+	dialog.ShowConfirm(p.App().Content.Pages, "Confirm Reclaim Policy", msg, func() {
+		err := p.App().mutate(fmt.Sprintf("Reclaim(%s)", policy), p.GVR(), path, func() error {
+			return res.SetReclaimPolicy(path, policy, pv.ResourceVersion)
+		})
+		if err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Volume %s reclaim policy set to %s", pv.Name, policy)
+	}, func() {})
+
+	return nil
+}
+
+func (p *PersistentVolume) load(path string) (*dao.PersistentVolume, *v1.PersistentVolume, error) {
+	res, err := dao.AccessorFor(p.App().factory, client.NewGVR(p.GVR()))
+	if err != nil {
+		return nil, nil, err
+	}
+	r, ok := res.(*dao.PersistentVolume)
+	if !ok {
+		return nil, nil, fmt.Errorf("expecting a persistent volume accessor but got %T", res)
+	}
+	pv, err := r.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, pv, nil
+}
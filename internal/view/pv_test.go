@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentVolumeNew(t *testing.T) {
+	v := view.NewPersistentVolume(client.NewGVR("v1/persistentvolumes"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "PersistentVolumes", v.Name())
+	assert.Equal(t, 7, len(v.Hints()))
+}
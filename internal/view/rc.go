@@ -0,0 +1,61 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ReplicationController presents a replication controller viewer.
+type ReplicationController struct {
+	ResourceViewer
+
+	orphans *orphans
+}
+
+// NewReplicationController returns a new viewer.
+func NewReplicationController(gvr client.GVR) ResourceViewer {
+	r := ReplicationController{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.SetBindKeysFn(r.bindKeys)
+	r.GetTable().SetEnterFn(r.showPods)
+	r.GetTable().SetColorerFn(render.ReplicationController{}.ColorerFunc())
+	r.orphans = newOrphans(r.ResourceViewer, "ReplicationController", "deployment config", dao.ReplicationControllerState)
+	r.SetContextFn(r.orphans.orphansContext)
+
+	return &r
+}
+
+func (r *ReplicationController) bindKeys(aa ui.KeyActions) {
+	r.orphans.bindKeys(aa)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(3, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+func (r *ReplicationController) showPods(app *App, _, gvr, path string) {
+	o, err := app.factory.Get(r.GVR(), path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var rc v1.ReplicationController
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rc)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	showPodsFromSelector(app, path, &metav1.LabelSelector{MatchLabels: rc.Spec.Selector})
+}
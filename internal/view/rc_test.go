@@ -0,0 +1,35 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("v1/replicationcontrollers", metav1.APIResource{
+		Name:         "replicationcontrollers",
+		SingularName: "replicationcontroller",
+		Namespaced:   true,
+		Kind:         "ReplicationControllers",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestReplicationController(t *testing.T) {
+	v := view.NewReplicationController(client.NewGVR("v1/replicationcontrollers"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "ReplicationControllers", v.Name())
+	assert.Equal(t, 9, len(v.Hints()))
+	_, ok := v.Actions()[ui.KeyZ]
+	assert.True(t, ok)
+	_, ok = v.Actions()[ui.KeyP]
+	assert.True(t, ok)
+}
@@ -0,0 +1,115 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	refTitle    = "References"
+	noRefNotice = "no consumers found"
+)
+
+// refKinds maps consumer kinds to their resources.
+var refKinds = map[string]string{
+	"Pod":            "v1/pods",
+	"ServiceAccount": "v1/serviceaccounts",
+}
+
+// Ref presents a secret or configmap consumers viewer.
+type Ref struct {
+	ResourceViewer
+}
+
+// NewRef returns a new viewer.
+func NewRef(gvr client.GVR) ResourceViewer {
+	r := Ref{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetColorerFn(render.Ref{}.ColorerFunc())
+	r.GetTable().SetEnterFn(r.showConsumer)
+	r.GetTable().SetSortCol(0, len(render.Ref{}.Header(render.ClusterScope)), true)
+	r.SetBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the component.
+func (r *Ref) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().GetModel().AddListener(r)
+
+	return nil
+}
+
+// Name returns the component name.
+func (r *Ref) Name() string { return refTitle }
+
+// TableDataChanged notifies view new data is available.
+func (r *Ref) TableDataChanged(render.TableData) {
+	r.App().QueueUpdateDraw(func() {
+		var notice string
+		if r.GetTable().GetRowCount() <= 1 {
+			notice = noRefNotice
+		}
+		r.GetTable().SetNotice(notice)
+		r.GetTable().UpdateTitle()
+	})
+}
+
+// TableLoadFailed notifies view something went south.
+func (r *Ref) TableLoadFailed(error, time.Duration) {}
+
+func (r *Ref) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", r.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reference", r.GetTable().SortColCmd(2, true), false),
+	})
+}
+
+func (r *Ref) showConsumer(app *App, _, _, _ string) {
+	kind, path := r.GetTable().GetSelectedCell(0), r.GetTable().GetSelectedCell(1)
+	gvr, ok := refKinds[kind]
+	if !ok {
+		app.Flash().Errf("Unsupported consumer kind %s", kind)
+		return
+	}
+	if err := app.command.showItem(gvr, path); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showRefs(app *App, gvr, path string) {
+	v := NewRef(client.NewGVR("refs"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func refsCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		showRefs(v.App(), v.GVR(), path)
+
+		return nil
+	}
+}
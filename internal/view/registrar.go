@@ -13,6 +13,7 @@ func loadCustomViewers() MetaViewers {
 	appsRes(m)
 	rbacRes(m)
 	batchRes(m)
+	policyRes(m)
 	extRes(m)
 
 	return m
@@ -28,15 +29,27 @@ func coreRes(vv MetaViewers) {
 	vv[client.NewGVR("v1/pods")] = MetaViewer{
 		viewerFn: NewPod,
 	}
+	vv[client.NewGVR("v1/endpoints")] = MetaViewer{
+		viewerFn: NewEndpoints,
+	}
 	vv[client.NewGVR("v1/services")] = MetaViewer{
 		viewerFn: NewService,
 	}
 	vv[client.NewGVR("v1/nodes")] = MetaViewer{
 		viewerFn: NewNode,
 	}
+	vv[client.NewGVR("v1/configmaps")] = MetaViewer{
+		viewerFn: NewConfigMap,
+	}
 	vv[client.NewGVR("v1/secrets")] = MetaViewer{
 		viewerFn: NewSecret,
 	}
+	vv[client.NewGVR("v1/persistentvolumes")] = MetaViewer{
+		viewerFn: NewPersistentVolume,
+	}
+	vv[client.NewGVR("v1/replicationcontrollers")] = MetaViewer{
+		viewerFn: NewReplicationController,
+	}
 }
 
 func miscRes(vv MetaViewers) {
@@ -46,9 +59,39 @@ func miscRes(vv MetaViewers) {
 	vv[client.NewGVR("containers")] = MetaViewer{
 		viewerFn: NewContainer,
 	}
+	vv[client.NewGVR("envvars")] = MetaViewer{
+		viewerFn: NewEnvVar,
+	}
+	vv[client.NewGVR("processes")] = MetaViewer{
+		viewerFn: NewProcess,
+	}
+	vv[client.NewGVR("dscoverage")] = MetaViewer{
+		viewerFn: NewNodeCoverage,
+	}
+	vv[client.NewGVR("jobpods")] = MetaViewer{
+		viewerFn: NewJobPod,
+	}
+	vv[client.NewGVR("ingbackends")] = MetaViewer{
+		viewerFn: NewIngressBackend,
+	}
+	vv[client.NewGVR("audits")] = MetaViewer{
+		viewerFn: NewAudit,
+	}
+	vv[client.NewGVR("refs")] = MetaViewer{
+		viewerFn: NewRef,
+	}
 	vv[client.NewGVR("portforwards")] = MetaViewer{
 		viewerFn: NewPortForward,
 	}
+	vv[client.NewGVR("watches")] = MetaViewer{
+		viewerFn: NewWatch,
+	}
+	vv[client.NewGVR("snapshotdiffs")] = MetaViewer{
+		viewerFn: NewSnapshotDiff,
+	}
+	vv[client.NewGVR("foreach")] = MetaViewer{
+		viewerFn: NewForeach,
+	}
 	vv[client.NewGVR("screendumps")] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
@@ -76,6 +119,9 @@ func appsRes(vv MetaViewers) {
 	vv[client.NewGVR("extensions/v1beta1/daemonsets")] = MetaViewer{
 		viewerFn: NewDaemonSet,
 	}
+	vv[client.NewGVR("extensions/v1beta1/ingresses")] = MetaViewer{
+		viewerFn: NewIngress,
+	}
 }
 
 func rbacRes(vv MetaViewers) {
@@ -111,12 +157,20 @@ func batchRes(vv MetaViewers) {
 	}
 }
 
+func policyRes(vv MetaViewers) {
+	vv[client.NewGVR("policy/v1beta1/poddisruptionbudgets")] = MetaViewer{
+		viewerFn: NewPodDisruptionBudget,
+	}
+}
+
 func extRes(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
-		enterFn: showCRD,
+		viewerFn: NewCustomResourceDefinition,
+		enterFn:  showCRD,
 	}
 	vv[client.NewGVR("apiextensions.k8s.io/v1beta1/customresourcedefinitions")] = MetaViewer{
-		enterFn: showCRD,
+		viewerFn: NewCustomResourceDefinition,
+		enterFn:  showCRD,
 	}
 }
 
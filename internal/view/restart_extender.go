@@ -26,7 +26,7 @@ func NewRestartExtender(v ResourceViewer) ResourceViewer {
 // BindKeys creates additional menu actions.
 func (r *RestartExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Restart", r.restartCmd, true),
+		tcell.KeyCtrlT: ui.NewDangerousKeyAction("Restart", r.restartCmd, true).Requires(ui.Permission{Verb: "patch"}),
 	})
 }
 
@@ -44,6 +44,7 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 			r.App().Flash().Err(err)
 		} else {
 			r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
+			r.App().followRollout(r.GVR(), path)
 		}
 	}, func() {})
 
@@ -61,5 +62,7 @@ func (r *RestartExtender) restartRollout(path string) error {
 		return errors.New("resource is not restartable")
 	}
 
-	return s.Restart(path)
+	return r.App().mutate("Restart", r.GVR(), path, func() error {
+		return s.Restart(path)
+	})
 }
@@ -0,0 +1,95 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// watchRestarts hooks the restart tracker onto the active namespace pods.
+func (a *App) watchRestarts(ns string) {
+	if a.factory == nil || a.restartsNS == ns {
+		return
+	}
+	a.restartsNS = ns
+	if ns == render.NamespaceAll {
+		ns = render.AllNamespaces
+	}
+	inf, err := a.factory.CanForResource(ns, "v1/pods", []string{"list", "watch"})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Restart storm detector disabled in %q", ns)
+		return
+	}
+	a.restarts.Attach(inf.Informer())
+}
+
+// checkRestartStorm raises or clears the header banner as restarts burst in
+// the active namespace.
+func (a *App) checkRestartStorm() {
+	if a.Config.K9s.RestartStorm.Disable {
+		return
+	}
+	ns := a.Config.ActiveNamespace()
+	a.watchRestarts(ns)
+	s, ok := a.restarts.Check(ns, a.Config.K9s.RestartStorm.GetThreshold(), time.Now())
+	if !ok {
+		a.showStorm("")
+		return
+	}
+	a.showStorm(stormAlert(s), "<ctrl-o> problems", "<ctrl-y> dismiss")
+}
+
+func (a *App) showStorm(alert string, hints ...string) {
+	b := a.Banner()
+	if alert == "" && b.Width() == 0 {
+		return
+	}
+	b.Show(alert, hints...)
+	if !a.showHeader {
+		return
+	}
+	if header, ok := a.mainFlex().ItemAt(0).(*tview.Flex); ok {
+		header.ResizeItem(b, b.Width(), 0)
+	}
+}
+
+func (a *App) stormProblemsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Banner().Width() == 0 {
+		return evt
+	}
+	a.dismissStormCmd(evt)
+	if err := a.gotoResource("pods", true); err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	if p, ok := a.Content.Top().(*Pod); ok && p.filter != problemsTitle {
+		p.toggleFilter(problemsTitle)
+	}
+
+	return nil
+}
+
+func (a *App) dismissStormCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Banner().Width() == 0 {
+		return evt
+	}
+	a.restarts.Dismiss(a.Config.ActiveNamespace())
+	a.showStorm("")
+
+	return nil
+}
+
+func stormAlert(s model.RestartStorm) string {
+	ns := s.Namespace
+	if ns == render.AllNamespaces || ns == render.NamespaceAll {
+		ns = "all namespaces"
+	}
+
+	return fmt.Sprintf("⚠ %d restarts in %s in last %s", s.Restarts, ns, duration.HumanDuration(s.Window))
+}
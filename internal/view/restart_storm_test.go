@@ -0,0 +1,32 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStormAlert(t *testing.T) {
+	uu := map[string]struct {
+		s model.RestartStorm
+		e string
+	}{
+		"namespace": {
+			s: model.RestartStorm{Namespace: "fred", Restarts: 12, Window: 5 * time.Minute},
+			e: "⚠ 12 restarts in fred in last 5m",
+		},
+		"all": {
+			s: model.RestartStorm{Namespace: "all", Restarts: 30, Window: 90 * time.Second},
+			e: "⚠ 30 restarts in all namespaces in last 90s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, stormAlert(u.s))
+		})
+	}
+}
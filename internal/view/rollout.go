@@ -0,0 +1,212 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	rolloutTitle   = "rollout"
+	rolloutRefresh = 2 * time.Second
+	rolloutFmt     = "[aqua::b]%-11s[white::-]%s\n"
+)
+
+// Rollout presents a deployment rollout progress along with the pods of the
+// current revision.
+type Rollout struct {
+	*tview.Flex
+
+	app      *App
+	path     string
+	status   *tview.TextView
+	pods     ResourceViewer
+	selector string
+	complete bool
+	cancelFn context.CancelFunc
+	follow   *rolloutFollow
+}
+
+var _ model.Component = &Rollout{}
+
+// NewRollout returns a new rollout viewer for a given deployment.
+func NewRollout(path string) *Rollout {
+	return &Rollout{
+		Flex: tview.NewFlex(),
+		path: path,
+	}
+}
+
+// Init initializes the viewer.
+func (r *Rollout) Init(ctx context.Context) (err error) {
+	if r.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	r.status = tview.NewTextView()
+	r.status.SetDynamicColors(true)
+	r.status.SetBorder(true).SetBorderPadding(0, 0, 1, 1)
+	r.status.SetTitle(render.Tags(r.title(time.Now())))
+
+	r.pods = NewPod(client.NewGVR("v1/pods"))
+	r.pods.SetContextFn(r.podContext)
+	r.pods.GetTable().SetColorerFn(render.Pod{}.ColorerFunc())
+	if err := r.pods.Init(ctx); err != nil {
+		return err
+	}
+	if r.follow != nil {
+		ns, _ := client.Namespaced(r.path)
+		h := render.Pod{}.Header(ns)
+		r.pods.GetTable().SetSortCol(h.AgeIndex(), len(h), true)
+	}
+
+	r.SetDirection(tview.FlexRow)
+	r.AddItem(r.status, 7, 1, false)
+	r.AddItem(r.pods, 0, 1, true)
+
+	return nil
+}
+
+// Name returns the component name.
+func (r *Rollout) Name() string { return rolloutTitle }
+
+// Hints returns the pods menu hints.
+func (r *Rollout) Hints() model.MenuHints {
+	return r.pods.Hints()
+}
+
+// GetTable returns the pods table.
+func (r *Rollout) GetTable() *Table {
+	return r.pods.GetTable()
+}
+
+// Start tracks the rollout progress.
+func (r *Rollout) Start() {
+	r.Stop()
+
+	var ctx context.Context
+	ctx, r.cancelFn = context.WithCancel(context.Background())
+	r.update(dao.DeploymentRollout(r.app.factory, r.path))
+	r.pods.Start()
+	go r.updater(ctx)
+}
+
+// Stop terminates the rollout tracking.
+func (r *Rollout) Stop() {
+	if r.cancelFn != nil {
+		r.cancelFn()
+		r.cancelFn = nil
+	}
+	r.pods.Stop()
+}
+
+func (r *Rollout) updater(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msgf("Rollout updater canceled for %s", r.path)
+			return
+		case <-time.After(rolloutRefresh):
+			s, err := dao.DeploymentRollout(r.app.factory, r.path)
+			r.app.QueueUpdateDraw(func() {
+				if ctx.Err() != nil {
+					return
+				}
+				if r.update(s, err) {
+					r.pods.Start()
+				}
+				r.followUp(s, time.Now())
+			})
+		}
+	}
+}
+
+// update refreshes the rollout status and returns true when the current
+// revision pods selection changed.
+func (r *Rollout) update(s *dao.RolloutStatus, err error) bool {
+	if err != nil {
+		r.status.SetText(render.Tags(fmt.Sprintf("[red::b]%s", tview.Escape(err.Error()))))
+		return false
+	}
+	r.status.SetText(render.Tags(rolloutText(s)))
+	if s.Complete && !r.complete && r.follow == nil {
+		r.app.Flash().Infof("Rollout complete for %s", r.path)
+	}
+	r.complete = s.Complete
+	sel := s.Selector
+	if r.follow != nil {
+		sel = s.Workload
+	}
+	if sel == r.selector {
+		return false
+	}
+	r.selector = sel
+
+	return true
+}
+
+func (r *Rollout) podContext(ctx context.Context) context.Context {
+	return podCtx(r.app, r.path, r.selector, "")(ctx)
+}
+
+// title returns the status title along with the time left when following.
+func (r *Rollout) title(now time.Time) string {
+	if r.follow == nil {
+		return fmt.Sprintf(" Rollout([aqua::b]%s[white::-]) ", r.path)
+	}
+
+	return fmt.Sprintf(" Follow Rollout([aqua::b]%s[white::-]) [orange::b]%s left[white::-] <esc> ",
+		r.path,
+		r.follow.left(now),
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func rolloutText(s *dao.RolloutStatus) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, rolloutFmt, "Replicas:", fmt.Sprintf(
+		"%d desired, %d updated, %d ready, %d available, %d unavailable",
+		s.Desired, s.Updated, s.Ready, s.Available, s.Unavailable,
+	))
+	newRS := render.MissingValue
+	if s.NewRS != nil {
+		newRS = s.NewRS.String()
+	}
+	fmt.Fprintf(&b, rolloutFmt, "New RS:", newRS)
+	old := make([]string, 0, len(s.OldRS))
+	for _, rs := range s.OldRS {
+		old = append(old, rs.String())
+	}
+	if len(old) == 0 {
+		old = append(old, render.MissingValue)
+	}
+	fmt.Fprintf(&b, rolloutFmt, "Old RS:", strings.Join(old, ", "))
+	msg := render.MissingValue
+	if s.Message != "" {
+		msg = tview.Escape(s.Message)
+	}
+	fmt.Fprintf(&b, rolloutFmt, "Message:", msg)
+
+	var state string
+	switch {
+	case s.Failed:
+		state = "[red::b]ProgressDeadlineExceeded"
+	case s.Complete:
+		state = "[lawngreen::b]Rollout complete"
+	default:
+		state = "[orange::b]Rollout in progress..."
+	}
+	fmt.Fprintf(&b, rolloutFmt, "Status:", state)
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,105 @@
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+)
+
+// followSettle delays a follow view exit on a rollout reported complete
+// right away as the cache may not reflect the mutation yet.
+const followSettle = 2 * rolloutRefresh
+
+// rolloutFollow tracks a rollout followed for a limited time after a
+// deployment mutation.
+type rolloutFollow struct {
+	started, deadline time.Time
+	timeout           time.Duration
+	progressed, done  bool
+}
+
+func newRolloutFollow(now time.Time, timeout time.Duration) *rolloutFollow {
+	return &rolloutFollow{
+		started:  now,
+		deadline: now.Add(timeout),
+		timeout:  timeout,
+	}
+}
+
+// NewFollowRollout returns a rollout viewer listing the deployment pods,
+// newest first. The view unwinds back to the prior view once the rollout
+// completes or the timeout elapses.
+func NewFollowRollout(path string, timeout time.Duration) *Rollout {
+	r := NewRollout(path)
+	r.follow = newRolloutFollow(time.Now(), timeout)
+
+	return r
+}
+
+// expired checks if the follow time is up.
+func (f *rolloutFollow) expired(now time.Time) bool {
+	return !now.Before(f.deadline)
+}
+
+// left returns the time left to follow the rollout.
+func (f *rolloutFollow) left(now time.Time) time.Duration {
+	if f.expired(now) {
+		return 0
+	}
+
+	return f.deadline.Sub(now).Round(time.Second)
+}
+
+// complete checks if the followed rollout is done. Completion only counts
+// once the rollout was seen progressing or the settle period elapsed.
+func (f *rolloutFollow) complete(s *dao.RolloutStatus, now time.Time) bool {
+	if s == nil {
+		return false
+	}
+	if !s.Complete {
+		f.progressed = true
+		return false
+	}
+
+	return f.progressed || now.Sub(f.started) >= followSettle
+}
+
+// followUp leaves the follow view once the rollout completed or the follow
+// time is up.
+func (r *Rollout) followUp(s *dao.RolloutStatus, now time.Time) {
+	if r.follow == nil || r.follow.done {
+		return
+	}
+	switch {
+	case r.follow.complete(s, now):
+		r.app.Flash().Infof("Rollout complete for %s", r.path)
+	case r.follow.expired(now):
+		r.app.Flash().Warnf("Stopped following %s rollout after %s", r.path, r.follow.timeout)
+	default:
+		r.status.SetTitle(r.title(now))
+		return
+	}
+	r.unwind()
+}
+
+// unwind leaves the follow view. The view is only popped while on top of
+// the stack so views stacked atop it are left alone.
+func (r *Rollout) unwind() {
+	r.follow.done = true
+	if r.app.Content.Top() != r {
+		r.Stop()
+		return
+	}
+	r.app.Content.back()
+}
+
+// followRollout switches to the rollout follow view after a deployment was
+// mutated when enabled in the configuration.
+func (a *App) followRollout(gvr, path string) {
+	if !a.Config.K9s.FollowRollout.Enable || gvr != "apps/v1/deployments" {
+		return
+	}
+	if err := a.inject(NewFollowRollout(path, a.Config.K9s.FollowRollout.GetTimeout())); err != nil {
+		a.Flash().Err(err)
+	}
+}
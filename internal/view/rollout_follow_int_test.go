@@ -0,0 +1,194 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutFollowComplete(t *testing.T) {
+	start := time.Now()
+	uu := map[string]struct {
+		ss   []dao.RolloutStatus
+		at   time.Duration
+		done bool
+	}{
+		"staleComplete": {
+			ss: []dao.RolloutStatus{{Complete: true}},
+		},
+		"settledComplete": {
+			ss:   []dao.RolloutStatus{{Complete: true}},
+			at:   followSettle,
+			done: true,
+		},
+		"progressed": {
+			ss:   []dao.RolloutStatus{{}, {Complete: true}},
+			done: true,
+		},
+		"progressing": {
+			ss: []dao.RolloutStatus{{}, {}},
+			at: time.Minute,
+		},
+		"failed": {
+			ss: []dao.RolloutStatus{{}, {Failed: true}},
+			at: time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := newRolloutFollow(start, 5*time.Minute)
+			var done bool
+			for i := range u.ss {
+				done = f.complete(&u.ss[i], start.Add(u.at))
+			}
+			assert.Equal(t, u.done, done)
+		})
+	}
+}
+
+func TestRolloutFollowLeft(t *testing.T) {
+	start := time.Now()
+	f := newRolloutFollow(start, 5*time.Minute)
+
+	assert.Equal(t, 5*time.Minute, f.left(start))
+	assert.Equal(t, 3*time.Minute, f.left(start.Add(2*time.Minute)))
+	assert.False(t, f.expired(start.Add(4*time.Minute)))
+	assert.True(t, f.expired(start.Add(5*time.Minute)))
+	assert.Equal(t, time.Duration(0), f.left(start.Add(6*time.Minute)))
+}
+
+func TestRolloutFollowUnwind(t *testing.T) {
+	uu := map[string]struct {
+		s  *dao.RolloutStatus
+		at time.Duration
+	}{
+		"complete": {
+			s:  &dao.RolloutStatus{Complete: true},
+			at: followSettle,
+		},
+		"timeout": {
+			s:  &dao.RolloutStatus{},
+			at: 5 * time.Minute,
+		},
+		"loadFailed": {
+			at: 5 * time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, parent := newFollowApp()
+			a.Content.Push(parent)
+			r := newTestFollow(a)
+			a.Content.Push(r)
+
+			r.followUp(u.s, r.follow.started.Add(u.at-time.Second))
+			assert.Equal(t, r, a.Content.Top())
+
+			r.followUp(u.s, r.follow.started.Add(u.at))
+			assert.Equal(t, parent, a.Content.Top())
+			assert.True(t, r.follow.done)
+
+			// Further updates leave the prior view alone.
+			r.followUp(u.s, r.follow.started.Add(u.at))
+			assert.Equal(t, parent, a.Content.Top())
+			assert.Equal(t, 1, len(a.Content.Peek()))
+		})
+	}
+}
+
+func TestRolloutFollowEscape(t *testing.T) {
+	a, parent := newFollowApp()
+	a.Content.Push(parent)
+	r := newTestFollow(a)
+	a.Content.Push(r)
+
+	a.PrevCmd(nil)
+	assert.Equal(t, parent, a.Content.Top())
+
+	r.followUp(&dao.RolloutStatus{Complete: true}, r.follow.started.Add(followSettle))
+	assert.Equal(t, parent, a.Content.Top())
+	assert.Equal(t, 1, len(a.Content.Peek()))
+}
+
+func TestRolloutFollowCovered(t *testing.T) {
+	a, parent := newFollowApp()
+	a.Content.Push(parent)
+	r := newTestFollow(a)
+	a.Content.Push(r)
+	_, child := newOriginView("containers")
+	a.Content.Push(child)
+
+	r.followUp(&dao.RolloutStatus{}, r.follow.deadline)
+	assert.True(t, r.follow.done)
+	assert.Equal(t, child, a.Content.Top())
+	assert.Equal(t, 3, len(a.Content.Peek()))
+
+	a.PrevCmd(nil)
+	assert.Equal(t, r, a.Content.Top())
+	a.PrevCmd(nil)
+	assert.Equal(t, parent, a.Content.Top())
+}
+
+func TestAppFollowRolloutSkipped(t *testing.T) {
+	uu := map[string]struct {
+		enable bool
+		gvr    string
+	}{
+		"disabled": {
+			gvr: "apps/v1/deployments",
+		},
+		"notDeployment": {
+			enable: true,
+			gvr:    "apps/v1/statefulsets",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, parent := newFollowApp()
+			a.Config.K9s.FollowRollout.Enable = u.enable
+			a.Content.Push(parent)
+
+			a.followRollout(u.gvr, "default/fred")
+			assert.Equal(t, parent, a.Content.Top())
+		})
+	}
+}
+
+func TestRolloutFollowTitle(t *testing.T) {
+	r := NewRollout("default/fred")
+	assert.Equal(t, " Rollout([aqua::b]default/fred[white::-]) ", r.title(time.Now()))
+
+	r = NewFollowRollout("default/fred", 5*time.Minute)
+	assert.Contains(t, r.title(r.follow.started.Add(time.Minute)), "[orange::b]4m0s left")
+}
+
+// Helpers...
+
+// newFollowApp returns an app whose stack does not start the pushed views.
+func newFollowApp() (*App, *originView) {
+	v := originView{Table: NewTable(client.NewGVR("apps/v1/deployments"))}
+	v.Table.Init(makeContext())
+	v.SetModel(&testTableModel{})
+	v.app.Content.app = v.app
+
+	return v.app, &v
+}
+
+func newTestFollow(a *App) *Rollout {
+	r := NewFollowRollout("default/fred", 5*time.Minute)
+	r.app = a
+	r.status = tview.NewTextView()
+	r.pods = NewPod(client.NewGVR("v1/pods"))
+
+	return r
+}
@@ -0,0 +1,56 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutText(t *testing.T) {
+	uu := map[string]struct {
+		s  dao.RolloutStatus
+		ee []string
+	}{
+		"progressing": {
+			s: dao.RolloutStatus{
+				Desired: 3, Updated: 1, Ready: 3, Available: 3, Unavailable: 1,
+				NewRS:   &dao.RolloutReplicaSet{Name: "rs3", Desired: 1, Current: 1},
+				OldRS:   []dao.RolloutReplicaSet{{Name: "rs2", Desired: 3, Current: 3, Ready: 3}},
+				Message: "rs3 is progressing.",
+			},
+			ee: []string{
+				"3 desired, 1 updated, 3 ready, 3 available, 1 unavailable",
+				"rs3 0/1 ready (1 desired)",
+				"rs2 3/3 ready (3 desired)",
+				"rs3 is progressing.",
+				"[orange::b]Rollout in progress...",
+			},
+		},
+		"complete": {
+			s: dao.RolloutStatus{Complete: true},
+			ee: []string{
+				"[lawngreen::b]Rollout complete",
+			},
+		},
+		"deadline": {
+			s: dao.RolloutStatus{Failed: true, Message: "rs3 has timed out progressing."},
+			ee: []string{
+				"[red::b]ProgressDeadlineExceeded",
+				"rs3 has timed out progressing.",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			txt := rolloutText(&u.s)
+			assert.Equal(t, 5, len(strings.Split(txt, "\n")))
+			for _, e := range u.ee {
+				assert.Contains(t, txt, e)
+			}
+		})
+	}
+}
@@ -7,10 +7,10 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
-	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	appsv1 "k8s.io/api/apps/v1"
@@ -25,6 +25,8 @@ import (
 // ReplicaSet presents a replicaset viewer.
 type ReplicaSet struct {
 	ResourceViewer
+
+	orphans *orphans
 }
 
 // NewReplicaSet returns a new viewer.
@@ -35,16 +37,20 @@ func NewReplicaSet(gvr client.GVR) ResourceViewer {
 	r.SetBindKeysFn(r.bindKeys)
 	r.GetTable().SetEnterFn(r.showPods)
 	r.GetTable().SetColorerFn(render.ReplicaSet{}.ColorerFunc())
+	r.orphans = newOrphans(r.ResourceViewer, "ReplicaSet", "deployment", dao.ReplicaSetState)
+	r.SetContextFn(r.orphans.orphansContext)
 
 	return &r
 }
 
 func (r *ReplicaSet) bindKeys(aa ui.KeyActions) {
+	r.orphans.bindKeys(aa)
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftD:   ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd(1, true), false),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(3, true), false),
-		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(4, true), false),
+		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true).Requires(ui.Permission{Resource: "apps/v1/deployments", Verb: "patch"}),
 	})
 }
 
@@ -73,7 +79,13 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 	r.showModal(fmt.Sprintf("Rollback %s %s?", r.GVR(), sel), func(_ int, button string) {
 		if button == "OK" {
 			r.App().Flash().Infof("Rolling back %s %s", r.GVR(), sel)
-			if res, err := rollback(r.App().factory, sel); err != nil {
+			var res string
+			err := r.App().mutate("Rollback", r.GVR(), sel, func() error {
+				var err error
+				res, err = rollback(r.App().factory, sel)
+				return err
+			})
+			if err != nil {
 				r.App().Flash().Err(err)
 			} else {
 				r.App().Flash().Info(res)
@@ -91,9 +103,8 @@ func (r *ReplicaSet) dismissModal() {
 }
 
 func (r *ReplicaSet) showModal(msg string, done func(int, string)) {
-	confirm := tview.NewModal().
-		AddButtons([]string{"Cancel", "OK"}).
-		SetTextColor(tcell.ColorFuchsia).
+	confirm := ui.NewModal("", []string{"Cancel", "OK"}).
+		SetTextColor(render.Accent(tcell.ColorFuchsia)).
 		SetText(msg).
 		SetDoneFunc(done)
 	r.App().Content.AddPage("confirm", confirm, false, false)
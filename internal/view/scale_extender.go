@@ -7,6 +7,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -28,7 +29,7 @@ func NewScaleExtender(r ResourceViewer) ResourceViewer {
 
 func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Scale", s.scaleCmd, true),
+		ui.KeyS: ui.NewDangerousKeyAction("Scale", s.scaleCmd, true).Requires(ui.Permission{SubResource: "scale", Verb: "update"}),
 	})
 }
 
@@ -46,7 +47,7 @@ func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (s *ScaleExtender) showScaleDialog(path string) {
-	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(path))
+	confirm := ui.NewModalForm("<Scale>", s.makeScaleForm(path))
 	confirm.SetText(fmt.Sprintf("Scale %s %s", s.GVR(), path))
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
@@ -79,6 +80,7 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 			s.App().Flash().Err(err)
 		} else {
 			s.App().Flash().Infof("Resource %s:%s scaled successfully", s.GVR(), sel)
+			s.App().followRollout(s.GVR(), sel)
 		}
 	})
 
@@ -99,8 +101,8 @@ func (s *ScaleExtender) makeStyledForm() *tview.Form {
 	f.SetButtonsAlign(tview.AlignCenter).
 		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
+		SetLabelColor(render.Accent(tcell.ColorAqua)).
+		SetFieldTextColor(render.Accent(tcell.ColorOrange))
 
 	return f
 }
@@ -115,5 +117,7 @@ func (s *ScaleExtender) scale(path string, replicas int) error {
 		return fmt.Errorf("expecting a scalable resource for %q", s.GVR())
 	}
 
-	return scaler.Scale(path, int32(replicas))
+	return s.App().mutate(fmt.Sprintf("Scale(%d)", replicas), s.GVR(), path, func() error {
+		return scaler.Scale(path, int32(replicas))
+	})
 }
@@ -0,0 +1,273 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	schemaTitleFmt    = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	maxSchemaDescSize = 60
+)
+
+// SchemaTree presents a CRD schema as a collapsible tree.
+type SchemaTree struct {
+	*tview.Flex
+
+	app     *App
+	tree    *tview.TreeView
+	desc    *tview.TextView
+	actions ui.KeyActions
+	cmdBuff *ui.CmdBuff
+	subject string
+	schemas []dao.VersionSchema
+}
+
+var _ model.Component = (*SchemaTree)(nil)
+
+// NewSchemaTree returns a new schema viewer.
+func NewSchemaTree(app *App, subject string, ss []dao.VersionSchema) *SchemaTree {
+	return &SchemaTree{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		tree:    tview.NewTreeView(),
+		desc:    tview.NewTextView(),
+		actions: make(ui.KeyActions),
+		cmdBuff: ui.NewCmdBuff('/', ui.FilterBuff),
+		subject: subject,
+		schemas: ss,
+	}
+}
+
+// Init initializes the viewer.
+func (s *SchemaTree) Init(_ context.Context) error {
+	s.SetBorder(true)
+	s.SetDirection(tview.FlexRow)
+	s.SetTitle(ui.SkinTitle(fmt.Sprintf(schemaTitleFmt, "Schema", s.subject), s.app.Styles.Frame()))
+
+	s.tree.SetSelectedFunc(func(n *tview.TreeNode) {
+		n.SetExpanded(!n.IsExpanded())
+	})
+	s.tree.SetChangedFunc(s.showDesc)
+	s.tree.SetInputCapture(s.keyboard)
+	s.desc.SetBorder(true)
+	s.desc.SetTitle(" Description ")
+	s.desc.SetWrap(true)
+	s.desc.SetWordWrap(true)
+
+	s.AddItem(s.tree, 0, 1, true)
+	s.AddItem(s.desc, 5, 1, false)
+
+	s.bindKeys()
+	s.StylesChanged(s.app.Styles)
+	s.app.Styles.AddListener(s)
+	s.refresh()
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (s *SchemaTree) StylesChanged(st *config.Styles) {
+	s.SetBackgroundColor(st.BgColor())
+	s.SetBorderFocusColor(config.AsColor(st.Frame().Border.FocusColor))
+	s.tree.SetBackgroundColor(st.BgColor())
+	s.tree.SetGraphicsColor(st.FgColor())
+	s.desc.SetBackgroundColor(st.BgColor())
+	s.desc.SetTextColor(st.FgColor())
+}
+
+// Name returns the component name.
+func (s *SchemaTree) Name() string { return "schema" }
+
+// Start starts the view.
+func (s *SchemaTree) Start() {
+	s.Stop()
+	s.cmdBuff.AddListener(s.app.Cmd())
+	s.cmdBuff.AddListener(s)
+}
+
+// Stop terminates the view.
+func (s *SchemaTree) Stop() {
+	s.cmdBuff.RemoveListener(s.app.Cmd())
+	s.cmdBuff.RemoveListener(s)
+	s.app.Styles.RemoveListener(s)
+}
+
+// Hints returns menu hints.
+func (s *SchemaTree) Hints() model.MenuHints {
+	return s.actions.Hints()
+}
+
+// Actions returns menu actions.
+func (s *SchemaTree) Actions() ui.KeyActions {
+	return s.actions
+}
+
+// BufferChanged indicates the filter changed.
+func (s *SchemaTree) BufferChanged(string) {
+	s.refresh()
+}
+
+// BufferActive indicates the buff activity changed.
+func (s *SchemaTree) BufferActive(state bool, k ui.BufferKind) {
+	s.app.BufferActive(state, k)
+}
+
+func (s *SchemaTree) bindKeys() {
+	s.actions.Set(ui.KeyActions{
+		tcell.KeyEscape:     ui.NewKeyAction("Back", s.resetCmd, false),
+		ui.KeySlash:         ui.NewKeyAction("Filter Mode", s.activateCmd, false),
+		tcell.KeyEnter:      ui.NewKeyAction("Toggle", s.toggleCmd, true),
+		ui.KeyE:             ui.NewKeyAction("Expand All", s.expandCmd, true),
+		tcell.KeyBackspace2: ui.NewKeyAction("Erase", s.eraseCmd, false),
+		tcell.KeyBackspace:  ui.NewKeyAction("Erase", s.eraseCmd, false),
+		tcell.KeyDelete:     ui.NewKeyAction("Erase", s.eraseCmd, false),
+	})
+}
+
+func (s *SchemaTree) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		if s.cmdBuff.IsActive() {
+			s.cmdBuff.Add(evt.Rune())
+			return nil
+		}
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := s.actions[key]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (s *SchemaTree) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !s.cmdBuff.InCmdMode() {
+		return s.app.PrevCmd(evt)
+	}
+	s.cmdBuff.Reset()
+
+	return nil
+}
+
+func (s *SchemaTree) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.app.InCmdMode() {
+		return evt
+	}
+	s.app.Flash().Info("Filter mode activated.")
+	s.cmdBuff.SetActive(true)
+
+	return nil
+}
+
+func (s *SchemaTree) eraseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.cmdBuff.IsActive() {
+		s.cmdBuff.Delete()
+	}
+
+	return nil
+}
+
+func (s *SchemaTree) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.cmdBuff.IsActive() {
+		s.cmdBuff.SetActive(false)
+		return nil
+	}
+
+	return evt
+}
+
+func (s *SchemaTree) expandCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n := s.tree.GetCurrentNode(); n != nil {
+		n.ExpandAll()
+	}
+
+	return nil
+}
+
+func (s *SchemaTree) refresh() {
+	root := schemaTree(s.subject, s.schemas, s.cmdBuff.String())
+	s.tree.SetRoot(root)
+	s.tree.SetCurrentNode(root)
+	s.showDesc(root)
+}
+
+func (s *SchemaTree) showDesc(n *tview.TreeNode) {
+	s.desc.Clear()
+	if n == nil {
+		return
+	}
+	if sn, ok := n.GetReference().(*dao.SchemaNode); ok {
+		s.desc.SetText(sn.Description)
+	}
+	s.desc.ScrollToBeginning()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// schemaTree builds a tree of all versions schemas. When a filter is given,
+// only matching properties and their ancestors are kept.
+func schemaTree(crd string, ss []dao.VersionSchema, filter string) *tview.TreeNode {
+	root := tview.NewTreeNode(crd).SetColor(render.Accent(tcell.ColorAqua))
+	q := strings.ToLower(filter)
+	for _, s := range ss {
+		v := tview.NewTreeNode(s.Version).SetColor(render.Accent(tcell.ColorOrange))
+		v.SetReference(s.Root)
+		for _, c := range s.Root.Children {
+			if n := schemaTreeNode(c, q); n != nil {
+				v.AddChild(n)
+			}
+		}
+		if q != "" && len(v.GetChildren()) == 0 {
+			continue
+		}
+		root.AddChild(v)
+	}
+
+	return root
+}
+
+func schemaTreeNode(sn *dao.SchemaNode, q string) *tview.TreeNode {
+	n := tview.NewTreeNode(schemaNodeText(sn)).SetReference(sn)
+	for _, c := range sn.Children {
+		if cn := schemaTreeNode(c, q); cn != nil {
+			n.AddChild(cn)
+		}
+	}
+	if q == "" {
+		n.SetExpanded(false)
+		return n
+	}
+	if len(n.GetChildren()) == 0 && !strings.Contains(strings.ToLower(sn.Name), q) {
+		return nil
+	}
+
+	return n
+}
+
+func schemaNodeText(sn *dao.SchemaNode) string {
+	s := tview.Escape(sn.Name)
+	if sn.Required {
+		s += render.Tags("[red::b]*[-::-]")
+	}
+	s += render.Tags(" [gray::]<") + tview.Escape(sn.Type) + render.Tags(">[-::]")
+	if d := strings.TrimSpace(sn.Description); d != "" {
+		d = strings.SplitN(d, "\n", 2)[0]
+		if rr := []rune(d); len(rr) > maxSchemaDescSize {
+			d = string(rr[:maxSchemaDescSize]) + "..."
+		}
+		s += " " + tview.Escape(d)
+	}
+
+	return s
+}
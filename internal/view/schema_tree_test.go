@@ -0,0 +1,79 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaTree(t *testing.T) {
+	ss := []dao.VersionSchema{
+		{
+			Version: "v1",
+			Root: &dao.SchemaNode{
+				Name: "v1",
+				Type: "object",
+				Children: []*dao.SchemaNode{
+					{
+						Name: "spec",
+						Type: "object",
+						Children: []*dao.SchemaNode{
+							{Name: "replicas", Type: "integer", Required: true},
+							{Name: "image", Type: "string", Description: "Container image."},
+						},
+					},
+					{Name: "status", Type: "object (preserve-unknown-fields)"},
+				},
+			},
+		},
+		{
+			Version: "v1beta1",
+			Root:    &dao.SchemaNode{Name: "v1beta1", Type: "object"},
+		},
+	}
+
+	uu := map[string]struct {
+		filter string
+		e      []string
+	}{
+		"all": {
+			e: []string{
+				"fred",
+				"v1",
+				"spec [gray::]<object>[-::]",
+				"replicas[red::b]*[-::-] [gray::]<integer>[-::]",
+				"image [gray::]<string>[-::] Container image.",
+				"status [gray::]<object (preserve-unknown-fields)>[-::]",
+				"v1beta1",
+			},
+		},
+		"filter": {
+			filter: "REP",
+			e: []string{
+				"fred",
+				"v1",
+				"spec [gray::]<object>[-::]",
+				"replicas[red::b]*[-::-] [gray::]<integer>[-::]",
+			},
+		},
+		"none": {
+			filter: "zorg",
+			e:      []string{"fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := schemaTree("fred", ss, u.filter)
+			var tt []string
+			root.Walk(func(n, _ *tview.TreeNode) bool {
+				tt = append(tt, n.GetText())
+				return true
+			})
+			assert.Equal(t, u.e, tt)
+		})
+	}
+}
@@ -23,8 +23,8 @@ func NewScreenDump(gvr client.GVR) ResourceViewer {
 	s := ScreenDump{
 		ResourceViewer: NewBrowser(gvr),
 	}
-	s.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
-	s.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorRoyalBlue, tcell.AttrNone)
+	s.GetTable().SetBorderFocusColor(render.Accent(tcell.ColorSteelBlue))
+	s.GetTable().SetSelectedStyle(render.Selection(tcell.ColorWhite, tcell.ColorRoyalBlue, tcell.AttrNone))
 	s.GetTable().SetColorerFn(render.ScreenDump{}.ColorerFunc())
 	s.GetTable().SetSortCol(s.GetTable().NameColIndex(), 0, true)
 	s.GetTable().SelectRow(1, true)
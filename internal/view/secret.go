@@ -30,6 +30,7 @@ func NewSecret(gvr client.GVR) ResourceViewer {
 func (s *Secret) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlX: ui.NewKeyAction("Decode", s.decodeCmd, true),
+		ui.KeyU:        ui.NewKeyAction("Usage", refsCmd(s), true),
 	})
 }
 
@@ -0,0 +1,178 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// sessionDebounce delays saving the session until changes settle.
+const sessionDebounce = 2 * time.Second
+
+// sessionParents lists the drill down views that can be resumed along with
+// the resource their path refers to.
+var sessionParents = map[string]string{
+	"containers": "v1/pods",
+}
+
+// sessionState snapshots the view stack, namespace and pins. Views that can
+// not be rebuilt on their own end the snapshot.
+func (a *App) sessionState() *config.Session {
+	s := config.Session{
+		Context:   a.Config.K9s.CurrentContext,
+		Namespace: a.Config.ActiveNamespace(),
+	}
+	for _, c := range a.Content.Stack.Peek() {
+		v, ok := c.(ResourceViewer)
+		if !ok {
+			break
+		}
+		st := v.GetTable().State()
+		if _, ok := sessionParents[st.GVR]; st.Path != "" && !ok {
+			break
+		}
+		s.Views = append(s.Views, st)
+	}
+	for _, p := range a.pins.List() {
+		s.Pins = append(s.Pins, config.SessionPin{GVR: p.GVR, Path: p.Path})
+	}
+
+	return &s
+}
+
+// saveSession persists the session state when it changed since last saved.
+func (a *App) saveSession() {
+	if !a.sessionReady || a.Config.K9s.ResumeDisabled() || a.Config.K9s.CurrentContext == "" {
+		return
+	}
+	s := a.sessionState()
+	if s.Empty() || s.Same(a.session) {
+		return
+	}
+	s.SavedAt = time.Now()
+	if err := s.Save(config.SessionFile(s.Context)); err != nil {
+		log.Warn().Err(err).Msg("Session save failed")
+		return
+	}
+	a.session = s
+}
+
+// touchSession schedules a session save once changes settle. It must be
+// called on the UI goroutine.
+func (a *App) touchSession() {
+	if a.sessionTimer != nil {
+		a.sessionTimer.Stop()
+	}
+	a.sessionTimer = time.AfterFunc(sessionDebounce, func() {
+		a.QueueUpdate(a.saveSession)
+	})
+}
+
+// sessionTracker schedules session saves as views get stacked.
+type sessionTracker struct {
+	app *App
+}
+
+// StackPushed notifies a new component was pushed.
+func (s sessionTracker) StackPushed(model.Component) {
+	s.app.touchSession()
+}
+
+// StackPopped notifies a component was popped.
+func (s sessionTracker) StackPopped(_, _ model.Component) {
+	s.app.touchSession()
+}
+
+// StackTop notifies of the top component.
+func (sessionTracker) StackTop(model.Component) {}
+
+// offerResume prompts to resume the previous session of the current context
+// if any.
+func (a *App) offerResume() {
+	if a.Config.K9s.ResumeDisabled() {
+		a.sessionReady = true
+		return
+	}
+	s, err := config.LoadSession(config.SessionFile(a.Config.K9s.CurrentContext))
+	if err != nil || s.Empty() {
+		a.sessionReady = true
+		return
+	}
+
+	last := s.Views[len(s.Views)-1]
+	msg := fmt.Sprintf("Resume previous session? (%s in %s, %s ago)",
+		client.NewGVR(last.GVR).ToR(),
+		s.Namespace,
+		time.Since(s.SavedAt).Round(time.Second),
+	)
+	dialog.ShowConfirm(a.Content.Pages, "Resume Session", msg, func() {
+		a.resumeSession(s)
+	}, func() {
+		a.sessionReady = true
+	})
+}
+
+// resumeSession rebuilds a saved session on a best effort basis. Views whose
+// resources are gone are skipped along with the views stacked atop them.
+func (a *App) resumeSession(s *config.Session) {
+	if s.Namespace != "" && !a.switchNS(s.Namespace) {
+		a.Flash().Warnf("Unable to resume namespace %q", s.Namespace)
+	}
+
+	var count int
+	for i, v := range s.Views {
+		if err := a.command.resume(v, i == 0); err != nil {
+			log.Warn().Err(err).Msgf("Session resume stopped at %s", v.GVR)
+			break
+		}
+		count++
+	}
+	for _, p := range s.Pins {
+		if _, err := a.factory.Get(p.GVR, p.Path, true, labels.Everything()); err != nil {
+			log.Warn().Err(err).Msgf("Skipping pin %s %s", p.GVR, p.Path)
+			continue
+		}
+		if err := a.pins.Add(a.factory, p.GVR, p.Path); err != nil {
+			log.Warn().Err(err).Msgf("Skipping pin %s %s", p.GVR, p.Path)
+		}
+	}
+
+	if count < len(s.Views) {
+		a.Flash().Warnf("Resumed %d of %d views. Some resources are gone", count, len(s.Views))
+		return
+	}
+	a.Flash().Infof("Resumed %d views", count)
+}
+
+// resume reruns a saved session view. Drill down views are only resumed when
+// the resource they refer to still exists.
+func (c *Command) resume(s config.SessionView, clearStack bool) error {
+	if s.Path != "" {
+		parent, ok := sessionParents[s.GVR]
+		if !ok {
+			return fmt.Errorf("%s can not be resumed", s.GVR)
+		}
+		if _, err := c.app.factory.Get(parent, s.Path, true, labels.Everything()); err != nil {
+			return err
+		}
+	}
+	gvr := client.NewGVR(s.GVR)
+	if _, err := dao.MetaFor(gvr); err != nil {
+		return err
+	}
+	v, ok := customViewers[gvr]
+	if !ok {
+		v = MetaViewer{viewerFn: NewBrowser}
+	}
+	view := c.componentFor(s.GVR, &v)
+	view.GetTable().SetState(s)
+
+	return c.exec(s.GVR, view, clearStack)
+}
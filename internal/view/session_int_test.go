@@ -0,0 +1,60 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableState(t *testing.T) {
+	s := config.SessionView{
+		GVR:      "containers",
+		Path:     "blee/nginx",
+		Filter:   "fred",
+		SortCol:  2,
+		SortCols: 10,
+		SortAsc:  true,
+	}
+	v := NewTable(client.NewGVR("containers"))
+	v.SetState(s)
+
+	assert.Equal(t, s, v.State())
+}
+
+func TestCommandResumeDrillDown(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	c := NewCommand(a)
+
+	err := c.resume(config.SessionView{GVR: "v1/pods", Path: "blee/fred"}, true)
+	assert.EqualError(t, err, "v1/pods can not be resumed")
+}
+
+func TestSaveSessionNotReady(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.CurrentContext = "fred"
+	a.saveSession()
+
+	assert.Nil(t, a.session)
+}
+
+func TestSessionStateEmpty(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.CurrentContext = "fred"
+
+	s := a.sessionState()
+	assert.Equal(t, "fred", s.Context)
+	assert.True(t, s.Empty())
+}
+
+func TestSessionTracker(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	s := sessionTracker{app: a}
+
+	s.StackTop(nil)
+	assert.Nil(t, a.sessionTimer)
+	s.StackPushed(nil)
+	assert.NotNil(t, a.sessionTimer)
+	assert.True(t, a.sessionTimer.Stop())
+}
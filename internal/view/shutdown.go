@@ -0,0 +1,104 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+func (a *App) quitCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.quit()
+
+	return nil
+}
+
+// quit confirms tearing down the port-forwards, benchmarks, logs dump and pins in
+// flight if any. Quitting again while confirming bails out right away.
+func (a *App) quit() {
+	ss := a.inFlight()
+	if a.quitting || len(ss) == 0 || a.Config.K9s.ForceQuit() {
+		a.BailOut()
+		return
+	}
+
+	a.quitting = true
+	msg := "Quitting will stop:\n" + strings.Join(ss, "\n") + "\nQuit anyway?"
+	dialog.ShowConfirm(a.Content.Pages, "Confirm Quit", msg, func() {
+		a.BailOut()
+	}, func() {
+		a.quitting = false
+	})
+}
+
+// inFlight summarizes the activities a shutdown would stop.
+func (a *App) inFlight() []string {
+	ss := make([]string, 0, 3)
+	if a.factory != nil {
+		if s := inFlightLine("port-forward", a.factory.Forwarders().Paths()); s != "" {
+			ss = append(ss, s)
+		}
+	}
+	if s := inFlightLine("benchmark", a.benchmarks.Names()); s != "" {
+		ss = append(ss, s)
+	}
+	if a.logsDump != nil {
+		ss = append(ss, inFlightLine("logs dump", []string{a.logsDump.ns}))
+	}
+	pp := a.pins.List()
+	kk := make([]string, 0, len(pp))
+	for _, p := range pp {
+		kk = append(kk, p.Path)
+	}
+	if s := inFlightLine("pin", kk); s != "" {
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+// shutdown stops background activities in order so none are left dangling
+// once the ui is gone.
+func (a *App) shutdown() {
+	log.Debug().Msg("Shutting down...")
+	if a.sessionTimer != nil {
+		a.sessionTimer.Stop()
+	}
+	a.saveSession()
+	a.stopLogsDump()
+	a.benchmarks.CancelAll()
+	if a.factory != nil {
+		a.factory.Forwarders().DeleteAll()
+	}
+	if a.benchFn != nil {
+		a.benchFn()
+	}
+	if a.stylesFn != nil {
+		a.stylesFn()
+	}
+	a.Halt()
+	a.stopStatus()
+	if a.factory != nil {
+		a.factory.Terminate()
+	}
+	a.auditLog.Close()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func inFlightLine(kind string, nn []string) string {
+	switch len(nn) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("  1 %s: %s", kind, nn[0])
+	default:
+		return fmt.Sprintf("  %d %ss: %s", len(nn), kind, strings.Join(nn, ", "))
+	}
+}
@@ -0,0 +1,119 @@
+package view
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/portforward"
+)
+
+func TestAppShutdownNoLeaks(t *testing.T) {
+	defer checkLeaks(t, runtime.NumGoroutine())
+
+	a := NewApp(config.NewConfig(ks{}))
+	a.factory = watch.NewFactory(nil)
+	f := newLeakyForward("default/p1:c1")
+	a.factory.AddForwarder(f)
+	a.watchBench()
+
+	a.BailOut()
+	a.BailOut()
+
+	assert.Equal(t, 0, a.factory.Forwarders().Len())
+	assert.True(t, f.stopped)
+}
+
+func TestAppInFlight(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	assert.Equal(t, 0, len(a.inFlight()))
+
+	a.factory = watch.NewFactory(nil)
+	a.factory.AddForwarder(newLeakyForward("default/p2:c1"))
+	a.factory.AddForwarder(newLeakyForward("default/p1:c1"))
+	b, err := perf.NewBenchmark("http://localhost:8080", "test", config.BenchConfig{Name: "default/p1:c1"})
+	assert.Nil(t, err)
+	a.benchmarks.Add(b)
+	defer a.BailOut()
+
+	ss := a.inFlight()
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, "  2 port-forwards: default/p1:c1, default/p2:c1", ss[0])
+	assert.Equal(t, "  1 benchmark: default/p1:c1", ss[1])
+}
+
+func TestAppQuitTwice(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.factory = watch.NewFactory(nil)
+	f := newLeakyForward("default/p1:c1")
+	a.factory.AddForwarder(f)
+
+	a.quit()
+	assert.True(t, a.quitting)
+	assert.False(t, f.stopped)
+
+	a.quit()
+	assert.True(t, f.stopped)
+}
+
+func TestAppQuitForce(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.Config.K9s.OverrideForceQuit(true)
+	a.factory = watch.NewFactory(nil)
+	f := newLeakyForward("default/p1:c1")
+	a.factory.AddForwarder(f)
+
+	a.quit()
+	assert.False(t, a.quitting)
+	assert.True(t, f.stopped)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// checkLeaks fails if goroutines started by a test outlive it.
+func checkLeaks(t *testing.T, count int) {
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > count && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > count {
+		buff := make([]byte, 1<<16)
+		t.Errorf("%d goroutines leaked\n%s", n-count, buff[:runtime.Stack(buff, true)])
+	}
+}
+
+type leakyForward struct {
+	path    string
+	done    chan struct{}
+	stopped bool
+}
+
+var _ watch.Forwarder = (*leakyForward)(nil)
+
+func newLeakyForward(path string) *leakyForward {
+	f := leakyForward{path: path, done: make(chan struct{})}
+	go func() { <-f.done }()
+
+	return &f
+}
+
+func (f *leakyForward) Start(string, string, string, []string) (*portforward.PortForwarder, error) {
+	return nil, nil
+}
+func (f *leakyForward) Stop() {
+	f.stopped = true
+	close(f.done)
+}
+func (f *leakyForward) Path() string              { return f.path }
+func (f *leakyForward) Container() string         { return "" }
+func (f *leakyForward) Ports() []string           { return nil }
+func (f *leakyForward) Active() bool              { return true }
+func (f *leakyForward) Age() string               { return "" }
+func (f *leakyForward) TTL() string               { return "" }
+func (f *leakyForward) Traffic() (uint64, uint64) { return 0, 0 }
+func (f *leakyForward) Expired() (string, bool)   { return "", false }
@@ -0,0 +1,86 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const snapshotTitle = "Bookmark Diff"
+
+// SnapshotDiff presents a view bookmark compared against live data.
+type SnapshotDiff struct {
+	ResourceViewer
+}
+
+// NewSnapshotDiff returns a new viewer.
+func NewSnapshotDiff(gvr client.GVR) ResourceViewer {
+	s := SnapshotDiff{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetColorerFn(render.SnapshotDiff{}.ColorerFunc())
+	s.GetTable().SetEnterFn(s.showChanges)
+	s.GetTable().SetSortCol(1, len(render.SnapshotDiff{}.Header(render.ClusterScope)), true)
+	s.SetBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+// Name returns the component name.
+func (s *SnapshotDiff) Name() string { return snapshotTitle }
+
+func (s *SnapshotDiff) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", s.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", s.GetTable().SortColCmd(1, true), false),
+	})
+}
+
+func (s *SnapshotDiff) showChanges(app *App, _, _, path string) {
+	row, ok := s.GetTable().GetRow(path)
+	if !ok {
+		return
+	}
+	cc, _ := row.Data.([]render.CellChange)
+	details := NewDetails(app, "Changes", path)
+	details.SetColorizerFn(colorizeDiff)
+	if err := app.inject(details.Update(changesReport(row.Fields[0], cc))); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showSnapshotDiff(app *App, snap model.Snapshot) {
+	v := NewSnapshotDiff(client.NewGVR("snapshotdiffs"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeySnapshot, snap)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// changesReport lists a row cell changes, bookmarked values first.
+func changesReport(state string, cc []render.CellChange) string {
+	if len(cc) == 0 {
+		return fmt.Sprintf("row %s since bookmark", strings.ToLower(state))
+	}
+
+	var b strings.Builder
+	for _, c := range cc {
+		fmt.Fprintf(&b, "-%s: %s\n", c.Column, c.Before)
+		fmt.Fprintf(&b, "+%s: %s\n", c.Column, c.After)
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
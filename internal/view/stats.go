@@ -0,0 +1,100 @@
+package view
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	statsFmt = "%-8s %8s %8s %7s %10s %10s %10s %10s\n"
+	cacheFmt = "%-50s %-20s %8s\n"
+)
+
+func showStats(app *App) error {
+	if app.Conn() == nil {
+		return fmt.Errorf("no cluster connection")
+	}
+	stats := app.Conn().Config().Stats()
+	refresh := func() (string, error) {
+		var cc []watch.CacheSize
+		if app.factory != nil {
+			cc = app.factory.CacheSizes()
+		}
+		return statsReport(stats.Since(), stats.List(), cc, runtime.NumGoroutine()), nil
+	}
+
+	details := NewDetails(app, "Stats", "api")
+	details.SetColorizerFn(tview.Escape)
+	details.SetRefreshFn(refresh)
+	details.Actions().Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Reset Counters", func(evt *tcell.EventKey) *tcell.EventKey {
+			stats.Reset()
+			if err := details.Refresh(); err != nil {
+				app.Flash().Err(err)
+				return nil
+			}
+			app.Flash().Info("Request counters reset")
+			return nil
+		}, true),
+	})
+	raw, _ := refresh()
+
+	return app.inject(details.Update(raw))
+}
+
+// statsReport lists the api requests metrics per verb along with the
+// informers caches sizes.
+func statsReport(since time.Time, rr []client.RequestStat, cc []watch.CacheSize, goroutines int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Since:      %s (%s)\n", since.Format(time.RFC3339), statsDuration(time.Since(since).Truncate(time.Second)))
+	fmt.Fprintf(&b, "Goroutines: %d\n\n", goroutines)
+
+	if len(rr) == 0 {
+		b.WriteString("No api requests recorded.\n")
+	} else {
+		fmt.Fprintf(&b, statsFmt, "VERB", "COUNT", "ERRORS", "ERR%", "MEAN", "P50", "P90", "P99")
+		for _, r := range rr {
+			fmt.Fprintf(&b, statsFmt,
+				r.Verb,
+				fmt.Sprintf("%d", r.Count),
+				fmt.Sprintf("%d", r.Errors),
+				fmt.Sprintf("%.1f", r.ErrorRate()),
+				statsDuration(r.Mean),
+				statsDuration(r.P50),
+				statsDuration(r.P90),
+				statsDuration(r.P99),
+			)
+		}
+	}
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, cacheFmt, "INFORMER", "NAMESPACE", "ITEMS")
+	for _, c := range cc {
+		ns := c.Namespace
+		if ns == "" {
+			ns = client.NamespaceAll
+		}
+		fmt.Fprintf(&b, cacheFmt, c.GVR, ns, fmt.Sprintf("%d", c.Items))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func statsDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(10 * time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(100 * time.Microsecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
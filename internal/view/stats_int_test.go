@@ -0,0 +1,37 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsReport(t *testing.T) {
+	since := time.Now().Add(-90 * time.Second)
+	rr := []client.RequestStat{
+		{Verb: "list", Count: 4, Errors: 1, Mean: 12345 * time.Microsecond, P50: 10 * time.Millisecond, P90: 2 * time.Second, P99: 2500 * time.Millisecond},
+	}
+	cc := []watch.CacheSize{
+		{GVR: "v1/nodes", Items: 3},
+		{Namespace: "default", GVR: "v1/pods", Items: 10},
+	}
+
+	ll := strings.Split(statsReport(since, rr, cc, 42), "\n")
+	assert.Equal(t, 9, len(ll))
+	assert.Contains(t, ll[0], "(1m30s)")
+	assert.Equal(t, "Goroutines: 42", ll[1])
+	assert.Equal(t, []string{"VERB", "COUNT", "ERRORS", "ERR%", "MEAN", "P50", "P90", "P99"}, strings.Fields(ll[3]))
+	assert.Equal(t, []string{"list", "4", "1", "25.0", "12.3ms", "10ms", "2s", "2.5s"}, strings.Fields(ll[4]))
+	assert.Equal(t, []string{"v1/nodes", "all", "3"}, strings.Fields(ll[7]))
+	assert.Equal(t, []string{"v1/pods", "default", "10"}, strings.Fields(ll[8]))
+}
+
+func TestStatsReportEmpty(t *testing.T) {
+	ll := strings.Split(statsReport(time.Now(), nil, nil, 1), "\n")
+
+	assert.Equal(t, "No api requests recorded.", ll[3])
+}
@@ -0,0 +1,91 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/status"
+	"github.com/rs/zerolog/log"
+)
+
+// statusState represents the cluster state served by the status endpoint. It
+// is replaced wholesale on context switch.
+type statusState struct {
+	cluster  string
+	forwards *dao.ManagedForwards
+}
+
+// statusSource feeds the status endpoint off the app state.
+type statusSource struct {
+	app *App
+}
+
+var _ status.Source = (*statusSource)(nil)
+
+// Forwards returns the session port-forwards including the failed managed ones.
+func (s *statusSource) Forwards() []render.ForwardRes {
+	st := s.app.statusState()
+	ff := s.app.factory.Forwarders().List()
+	failed := st.forwards.Failures()
+	rr := make([]render.ForwardRes, 0, len(ff)+len(failed))
+	for _, f := range ff {
+		rr = append(rr, render.ForwardRes{
+			Forwarder: f,
+			Managed:   st.forwards.Managed(f.Path()),
+		})
+	}
+	for _, f := range failed {
+		rr = append(rr, render.ForwardRes{
+			Forwarder: f,
+			Managed:   true,
+			Err:       f.Err.Error(),
+		})
+	}
+
+	return rr
+}
+
+// Benchmarks returns the benchmarks in flight.
+func (s *statusSource) Benchmarks() []string {
+	return s.app.benchmarks.Names()
+}
+
+// LastBenchmark returns the most recent benchmark result on the current cluster.
+func (s *statusSource) LastBenchmark() (string, *perf.Result, error) {
+	return perf.LatestResult(s.app.statusState().cluster)
+}
+
+// publishStatus snapshots the state served by the status endpoint.
+func (a *App) publishStatus() {
+	a.statusSnap.Store(statusState{
+		cluster:  a.Config.K9s.CurrentCluster,
+		forwards: a.managedForwards,
+	})
+}
+
+func (a *App) statusState() statusState {
+	st, _ := a.statusSnap.Load().(statusState)
+	return st
+}
+
+func (a *App) startStatus() {
+	cfg := a.Config.K9s.StatusServer
+	if !cfg.Enabled() {
+		return
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Error().Err(err).Msg("Invalid status server config")
+		return
+	}
+	a.status = status.NewServer(cfg.Port, cfg.Token, &statusSource{app: a})
+	if err := a.status.Start(); err != nil {
+		log.Error().Err(err).Msgf("Unable to start status server on port %d", cfg.Port)
+		a.status = nil
+	}
+}
+
+func (a *App) stopStatus() {
+	if a.status != nil {
+		a.status.Stop()
+	}
+}
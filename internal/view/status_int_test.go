@@ -0,0 +1,33 @@
+package view
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusState(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	assert.Equal(t, statusState{}, a.statusState())
+
+	a.Config.K9s.CurrentCluster = "c1"
+	a.initManagedForwards()
+	st := a.statusState()
+	assert.Equal(t, "c1", st.cluster)
+	assert.True(t, st.forwards == a.managedForwards)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = a.statusState().forwards.Failures()
+		}
+	}()
+	a.Config.K9s.CurrentCluster = "c2"
+	a.initManagedForwards()
+	wg.Wait()
+	assert.Equal(t, "c2", a.statusState().cluster)
+}
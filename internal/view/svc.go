@@ -109,6 +109,10 @@ func (s *Service) benchCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if sel == "" || s.bench != nil {
 		return evt
 	}
+	if s.App().benchmarks.Busy() {
+		s.App().Flash().Err(errBenchBusy)
+		return nil
+	}
 
 	if err := s.reloadBenchCfg(); err != nil {
 		s.App().Flash().Err(err)
@@ -156,6 +160,7 @@ func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
 
 	s.App().Status(ui.FlashWarn, "Benchmark in progress...")
 	log.Debug().Msg("Bench starting...")
+	s.App().benchmarks.Add(s.bench)
 	go s.bench.Run(s.App().Config.K9s.CurrentCluster, s.benchDone)
 
 	return nil
@@ -163,11 +168,13 @@ func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
 
 func (s *Service) benchDone() {
 	log.Debug().Msg("Bench Completed!")
+	pruneBenchmarks(s.App())
 	s.App().QueueUpdate(func() {
+		s.App().benchmarks.Remove(s.bench)
 		if s.bench.Canceled() {
 			s.App().Status(ui.FlashInfo, "Benchmark canceled")
 		} else {
-			s.App().Status(ui.FlashInfo, "Benchmark Completed!")
+			s.App().Status(ui.FlashInfo, benchDoneMsg(s.bench))
 			s.bench.Cancel()
 		}
 		s.bench = nil
@@ -175,9 +182,11 @@ func (s *Service) benchDone() {
 	})
 }
 
+// benchTimedOut reverts the status to the benchmarks still in flight once a
+// completion notice was shown.
 func benchTimedOut(app *App) {
 	<-time.After(2 * time.Second)
 	app.QueueUpdate(func() {
-		app.ClearStatus(true)
+		benchStatus(app)
 	})
 }
@@ -11,6 +11,32 @@ import (
 )
 
 func init() {
+	dao.RegisterMeta("dscoverage", metav1.APIResource{
+		Name:       "dscoverage",
+		Kind:       "NodeCoverage",
+		Categories: []string{"k9s"},
+	})
+	dao.RegisterMeta("jobpods", metav1.APIResource{
+		Name:       "jobpods",
+		Kind:       "JobPods",
+		Categories: []string{"k9s"},
+	})
+	dao.RegisterMeta("refs", metav1.APIResource{
+		Name:       "refs",
+		Kind:       "References",
+		Categories: []string{"k9s"},
+	})
+	dao.RegisterMeta("ingbackends", metav1.APIResource{
+		Name:       "ingbackends",
+		Kind:       "IngressBackends",
+		Categories: []string{"k9s"},
+	})
+	dao.RegisterMeta("audits", metav1.APIResource{
+		Name:       "audits",
+		Kind:       "Audits",
+		ShortNames: []string{"audit"},
+		Categories: []string{"k9s"},
+	})
 	dao.RegisterMeta("v1/pods", metav1.APIResource{
 		Name:         "pods",
 		SingularName: "pod",
@@ -27,6 +53,22 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.RegisterMeta("v1/endpoints", metav1.APIResource{
+		Name:         "endpoints",
+		SingularName: "endpoints",
+		Namespaced:   true,
+		Kind:         "Endpoints",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.RegisterMeta("policy/v1beta1/poddisruptionbudgets", metav1.APIResource{
+		Name:         "poddisruptionbudgets",
+		SingularName: "poddisruptionbudget",
+		Namespaced:   true,
+		Kind:         "PodDisruptionBudgets",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 	dao.RegisterMeta("v1/services", metav1.APIResource{
 		Name:         "services",
 		SingularName: "service",
@@ -35,6 +77,13 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.RegisterMeta("v1/persistentvolumes", metav1.APIResource{
+		Name:         "persistentvolumes",
+		SingularName: "persistentvolume",
+		Kind:         "PersistentVolumes",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 	dao.RegisterMeta("v1/secrets", metav1.APIResource{
 		Name:         "secrets",
 		SingularName: "secret",
@@ -92,6 +141,11 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.RegisterMeta("watches", metav1.APIResource{
+		Name:       "watches",
+		Kind:       "Watches",
+		Categories: []string{"k9s"},
+	})
 
 	dao.RegisterMeta("screendumps", metav1.APIResource{
 		Name:         "screendumps",
@@ -125,6 +179,13 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.RegisterMeta("apiextensions.k8s.io/v1/customresourcedefinitions", metav1.APIResource{
+		Name:         "customresourcedefinitions",
+		SingularName: "customresourcedefinition",
+		Kind:         "CustomResourceDefinition",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 }
 
 func TestServiceNew(t *testing.T) {
@@ -4,10 +4,11 @@ import (
 	"context"
 	"time"
 
-	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -38,6 +39,10 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	}
 	ctx = context.WithValue(ctx, internal.KeyStyles, t.app.Styles)
 	t.Table.Init(ctx)
+	t.SetGuardFn(t.guard)
+	if t.app.Config.K9s.SafeKeymap() {
+		t.SetKeySequence(ui.NewKeySequence(ui.DefaultSequenceTimeout), t.app.Flash().Warn)
+	}
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
 	t.envFn = t.defaultK9sEnv
@@ -74,6 +79,7 @@ func (t *Table) App() *App {
 // Start runs the component.
 func (t *Table) Start() {
 	t.Stop()
+	t.SetAbsTime(t.app.absTime)
 	t.SearchBuff().AddListener(t.app.Cmd())
 	t.SearchBuff().AddListener(t)
 	t.Styles().AddListener(t.Table)
@@ -86,6 +92,31 @@ func (t *Table) Stop() {
 	t.Styles().RemoveListener(t.Table)
 }
 
+// State returns the view state to be saved in the session.
+func (t *Table) State() config.SessionView {
+	col, cols, asc := t.SortCol()
+	return config.SessionView{
+		GVR:      t.GVR(),
+		Path:     t.Path,
+		Filter:   t.SearchBuff().String(),
+		SortCol:  col,
+		SortCols: cols,
+		SortAsc:  asc,
+		Selected: t.GetSelectedItem(),
+	}
+}
+
+// SetState restores a saved view state. It must be called prior to the view
+// being started.
+func (t *Table) SetState(s config.SessionView) {
+	t.Path = s.Path
+	t.SearchBuff().Set(s.Filter)
+	t.SetSortCol(s.SortCol, s.SortCols, s.SortAsc)
+	if s.Selected != "" {
+		t.SelectItem(s.Selected)
+	}
+}
+
 // SetEnterFn specifies the default enter behavior.
 func (t *Table) SetEnterFn(f EnterFunc) {
 	t.enterFn = f
@@ -124,9 +155,44 @@ func (t *Table) bindKeys() {
 		tcell.KeyDelete:     ui.NewSharedKeyAction("Erase", t.eraseCmd, false),
 		ui.KeyShiftN:        ui.NewKeyAction("Sort Name", t.SortColCmd(0, true), false),
 		ui.KeyShiftA:        ui.NewKeyAction("Sort Age", t.SortColCmd(-1, true), false),
+		tcell.KeyCtrlW:      ui.NewSharedKeyAction("Toggle Wide", t.wideCmd, false),
+		ui.KeyX:             ui.NewSharedKeyAction("Full Values", t.valuesCmd, false),
 	})
 }
 
+func (t *Table) valuesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := t.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ss := t.LongFields(path)
+	if len(ss) == 0 {
+		t.app.Flash().Info("No long columns on this view")
+		return nil
+	}
+
+	copyFn := func(v string) {
+		if err := t.app.Clipboard().Copy(v); err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		t.app.Flash().Info("Full values copied to clipboard...")
+	}
+	dialog.ShowValues(t.app.Content.Pages, path, ss, copyFn, func() {})
+
+	return nil
+}
+
+func (t *Table) wideCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.ToggleWide() {
+		t.app.Flash().Info("Wide mode on")
+	} else {
+		t.app.Flash().Info("Wide mode off")
+	}
+
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -136,7 +202,7 @@ func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	_, n := client.Namespaced(path)
 	log.Debug().Msgf("Copied selection to clipboard %q", n)
 	t.app.Flash().Info("Current selection copied to clipboard...")
-	if err := clipboard.WriteAll(n); err != nil {
+	if err := t.app.Clipboard().Copy(n); err != nil {
 		t.app.Flash().Err(err)
 	}
 
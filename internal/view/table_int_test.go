@@ -103,6 +103,11 @@ func (t *testTableModel) Get(ctx context.Context, path string) (runtime.Object,
 }
 func (t *testTableModel) InNamespace(string) bool      { return true }
 func (t *testTableModel) SetRefreshRate(time.Duration) {}
+func (t *testTableModel) Refresh(context.Context)      {}
+func (t *testTableModel) Pause()                       {}
+func (t *testTableModel) Resume()                      {}
+func (t *testTableModel) IsPaused() bool               { return false }
+func (t *testTableModel) Age() time.Duration           { return 0 }
 
 func makeTableData() render.TableData {
 	t := render.NewTableData()
@@ -0,0 +1,58 @@
+package view
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/model"
+)
+
+// viewedGVRs tracks the resources backing the views on the stack. It is kept
+// in sync by the stack notifications so watchers can check it off the UI
+// goroutine.
+type viewedGVRs struct {
+	stack *model.Stack
+
+	mx   sync.RWMutex
+	gvrs map[string]struct{}
+}
+
+func newViewedGVRs(s *model.Stack) *viewedGVRs {
+	return &viewedGVRs{stack: s, gvrs: make(map[string]struct{})}
+}
+
+// StackPushed notifies a new component was pushed.
+func (v *viewedGVRs) StackPushed(model.Component) {
+	v.refresh()
+}
+
+// StackPopped notifies a component was popped.
+func (v *viewedGVRs) StackPopped(_, _ model.Component) {
+	v.refresh()
+}
+
+// StackTop notifies of the top component.
+func (v *viewedGVRs) StackTop(model.Component) {
+	v.refresh()
+}
+
+// Has checks if a resource backs a view.
+func (v *viewedGVRs) Has(gvr string) bool {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	_, ok := v.gvrs[gvr]
+	return ok
+}
+
+func (v *viewedGVRs) refresh() {
+	gvrs := make(map[string]struct{})
+	for _, c := range v.stack.Peek() {
+		if r, ok := c.(ResourceViewer); ok {
+			gvrs[r.GVR()] = struct{}{}
+		}
+	}
+
+	v.mx.Lock()
+	defer v.mx.Unlock()
+	v.gvrs = gvrs
+}
@@ -0,0 +1,23 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewedGVRs(t *testing.T) {
+	s := model.NewStack()
+	v := newViewedGVRs(s)
+	s.AddListener(v)
+	assert.False(t, v.Has("v1/pods"))
+
+	s.Push(NewBrowser(client.NewGVR("v1/pods")))
+	assert.True(t, v.Has("v1/pods"))
+	assert.False(t, v.Has("v1/services"))
+
+	s.Pop()
+	assert.False(t, v.Has("v1/pods"))
+}
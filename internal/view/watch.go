@@ -0,0 +1,69 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Watch represents a watched resources view.
+type Watch struct {
+	ResourceViewer
+}
+
+// NewWatch returns a new watch view.
+func NewWatch(gvr client.GVR) ResourceViewer {
+	w := Watch{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetColorerFn(render.Watch{}.ColorerFunc())
+	w.SetBindKeysFn(w.bindKeys)
+	w.SetContextFn(w.watchContext)
+
+	return &w
+}
+
+func (w *Watch) watchContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPins, w.App().pins)
+}
+
+func (w *Watch) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", w.gotoCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Remove", w.removeCmd, true),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Resource", w.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Last Change", w.GetTable().SortColCmd(2, true), false),
+	})
+}
+
+func (w *Watch) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if w.GetTable().SearchBuff().IsActive() {
+		return w.GetTable().activateCmd(evt)
+	}
+	pin, ok := w.App().pins.Get(w.GetTable().GetSelectedItem())
+	if !ok {
+		return evt
+	}
+	if err := w.App().command.showItem(pin.GVR, pin.Path); err != nil {
+		w.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (w *Watch) removeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	pin, ok := w.App().pins.Get(w.GetTable().GetSelectedItem())
+	if !ok {
+		return evt
+	}
+	w.App().pins.Remove(pin.ID())
+	w.App().Flash().Infof("Stopped watching %s", pin.Path)
+	w.Refresh()
+
+	return nil
+}
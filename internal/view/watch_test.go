@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchNew(t *testing.T) {
+	w := view.NewWatch(client.NewGVR("watches"))
+
+	assert.Nil(t, w.Init(makeCtx()))
+	assert.Equal(t, "Watches", w.Name())
+	assert.Equal(t, 5, len(w.Hints()))
+}
@@ -8,15 +8,19 @@ import (
 	"strings"
 	"time"
 
-	"github.com/derailed/tview"
-
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var (
 	keyValRX = regexp.MustCompile(`\A(\s*)([\w|\-|\.|\/|\s]+):\s(.+)\z`)
 	keyRX    = regexp.MustCompile(`\A(\s*)([\w|\-|\.|\/|\s]+):\s*\z`)
+	scalarRX = regexp.MustCompile(`\A\s*(-?\d+(\.\d+)?|true|false|null)\z`)
 )
 
 const (
@@ -37,11 +41,23 @@ func colorizeYAML(style config.Yaml, raw string) string {
 
 	valFmt := strings.Replace(yamlValueFmt, "[val", "["+style.ValueColor, 1)
 
+	numColor := style.NumberColor
+	if numColor == "" {
+		numColor = style.ValueColor
+	}
+	numFmt := strings.Replace(yamlFullFmt, "[key", "["+style.KeyColor, 1)
+	numFmt = strings.Replace(numFmt, "[colon", "["+style.ColonColor, 1)
+	numFmt = strings.Replace(numFmt, "[val", "["+numColor, 1)
+
 	buff := make([]string, 0, len(lines))
 	for _, l := range lines {
 		res := keyValRX.FindStringSubmatch(l)
 		if len(res) == 4 {
-			buff = append(buff, fmt.Sprintf(fullFmt, res[1], res[2], res[3]))
+			f := fullFmt
+			if scalarRX.MatchString(res[3]) {
+				f = numFmt
+			}
+			buff = append(buff, fmt.Sprintf(f, res[1], res[2], res[3]))
 			continue
 		}
 
@@ -57,6 +73,58 @@ func colorizeYAML(style config.Yaml, raw string) string {
 	return strings.Join(buff, "\n")
 }
 
+// ResourceFetcher fetches a resource.
+type ResourceFetcher func() (runtime.Object, error)
+
+// showYAML displays a resource manifest that can be refreshed on demand.
+// Managed fields are hidden unless toggled on.
+func showYAML(app *App, path string, fetch ResourceFetcher) error {
+	var managed bool
+	refresh := func() (string, error) {
+		o, err := fetch()
+		if err != nil {
+			return "", err
+		}
+		if !managed {
+			o = stripManagedFields(o)
+		}
+		return toYAML(o)
+	}
+	raw, err := refresh()
+	if err != nil {
+		return err
+	}
+
+	details := NewDetails(app, "YAML", path)
+	details.SetRefreshFn(refresh)
+	details.Actions().Add(ui.KeyActions{
+		ui.KeyM: ui.NewKeyAction("Toggle ManagedFields", func(evt *tcell.EventKey) *tcell.EventKey {
+			managed = !managed
+			raw, err := refresh()
+			if err != nil {
+				app.Flash().Err(err)
+				return nil
+			}
+			details.Update(raw)
+			return nil
+		}, true),
+	})
+
+	return app.inject(details.Update(raw))
+}
+
+// stripManagedFields returns a copy of the resource without managed fields.
+func stripManagedFields(o runtime.Object) runtime.Object {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return o
+	}
+	u = u.DeepCopy()
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+
+	return u
+}
+
 func saveYAML(cluster, name, data string) (string, error) {
 	dir := filepath.Join(config.K9sDumpDir, cluster)
 	if err := ensureDir(dir); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestYaml(t *testing.T) {
@@ -43,6 +44,10 @@ func TestYaml(t *testing.T) {
 			"certmanager.k8s.io/cluster-issuer: nameOfClusterIssuer",
 			"[steelblue::b]certmanager.k8s.io/cluster-issuer[white::-]: [papayawhip::]nameOfClusterIssuer",
 		},
+		{
+			"replicas: 3\nready: true\nratio: -0.5\nport: \"80\"",
+			"[steelblue::b]replicas[white::-]: [lightskyblue::]3\n[steelblue::b]ready[white::-]: [lightskyblue::]true\n[steelblue::b]ratio[white::-]: [lightskyblue::]-0.5\n[steelblue::b]port[white::-]: [papayawhip::]\"80\"",
+		},
 		{
 			"Message: Pod The node was low on resource: [DiskPressure].",
 			"[steelblue::b]Message[white::-]: [papayawhip::]Pod The node was low on resource: [DiskPressure[].",
@@ -54,3 +59,16 @@ func TestYaml(t *testing.T) {
 		assert.Equal(t, u.e, colorizeYAML(s.Views().Yaml, u.s))
 	}
 }
+
+func TestStripManagedFields(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":          "fred",
+			"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+	}}
+
+	s := stripManagedFields(o).(*unstructured.Unstructured)
+	assert.Equal(t, map[string]interface{}{"name": "fred"}, s.Object["metadata"])
+	assert.NotNil(t, o.Object["metadata"].(map[string]interface{})["managedFields"])
+}
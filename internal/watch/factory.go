@@ -2,14 +2,18 @@ package watch
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -21,42 +25,76 @@ const (
 // ReadVerbs lists out RO verbs.
 var ReadVerbs = []string{"get", "list", "watch"}
 
+// warmGVRs lists resources the header and drill downs rely on. Their
+// informers are primed on start and never evicted.
+var warmGVRs = []string{"v1/pods", "v1/namespaces", "v1/nodes"}
+
+// InUseFunc checks if a resource is backing a view.
+type InUseFunc func(gvr string) bool
+
+// CacheSize represents an informer cache item count.
+type CacheSize struct {
+	Namespace, GVR string
+	Items          int
+}
+
 // Factory tracks various resource informers.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
-	client     client.Connection
-	stopChan   chan struct{}
-	forwarders Forwarders
+	informers    map[informerKey]*informer
+	client       client.Connection
+	dialFn       func() dynamic.Interface
+	forwarders   *Forwarders
+	maxInformers int
+	inUseFn      InUseFunc
+	clock        uint64
+	mx           sync.Mutex
 }
 
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
-	return &Factory{
+	f := Factory{
 		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[informerKey]*informer),
 		forwarders: NewForwarders(),
 	}
+	f.dialFn = func() dynamic.Interface {
+		return f.client.DynDialOrDie()
+	}
+
+	return &f
+}
+
+// SetMaxInformers caps the number of informers retained. Zero means no cap.
+func (f *Factory) SetMaxInformers(n int) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.maxInformers = n
 }
 
-// Start initializes the informers until caller cancels the context.
+// SetInUseFunc registers a check guarding informers backing views from
+// being evicted.
+func (f *Factory) SetInUseFunc(fn InUseFunc) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.inUseFn = fn
+}
+
+// Start primes the warm informers. Others are created on first access.
 func (f *Factory) Start(ns string) {
 	log.Debug().Msgf("Factory START with ns `%q", ns)
-	f.stopChan = make(chan struct{})
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
-	}
+	f.warm(ns)
 }
 
 // Terminate terminates all watchers and forwards.
 func (f *Factory) Terminate() {
-	if f.stopChan != nil {
-		close(f.stopChan)
-		f.stopChan = nil
-	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	f.mx.Lock()
+	for k, inf := range f.informers {
+		inf.stop()
+		delete(f.informers, k)
 	}
+	f.mx.Unlock()
 	f.forwarders.DeleteAll()
 }
 
@@ -71,7 +109,7 @@ func (f *Factory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtim
 		return nil, err
 	}
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 
 	return inf.Lister().ByNamespace(ns).List(sel)
@@ -89,63 +127,98 @@ func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime
 		return nil, err
 	}
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
-	if fac, ok := f.factories[ns]; ok {
-		// Hang for a sec for the cache to refresh if still not done bail out!
-		const dur = 1 * time.Second
-		c := make(chan struct{})
-		go func(c chan struct{}) {
-			<-time.After(dur)
-			log.Debug().Msgf("Wait for sync timed out!")
-			close(c)
-		}(c)
-		fac.WaitForCacheSync(c)
-		log.Debug().Msgf("Sync completed for ns %q", ns)
+// waitForCacheSync hangs for a sec for the cache to refresh if still not done bail out!
+func waitForCacheSync(inf informers.GenericInformer) {
+	const dur = 1 * time.Second
+	c := make(chan struct{})
+	t := time.AfterFunc(dur, func() { close(c) })
+	defer t.Stop()
+	if !cache.WaitForCacheSync(c, inf.Informer().HasSynced) {
+		log.Debug().Msgf("Wait for sync timed out!")
 	}
 }
 
-// WaitForCacheSync waits for all factories to update their cache.
+// WaitForCacheSync waits for all informers to update their cache.
 func (f *Factory) WaitForCacheSync() {
-	for ns, fac := range f.factories {
-		m := fac.WaitForCacheSync(f.stopChan)
-		for k, v := range m {
-			log.Debug().Msgf("CACHE `%q Loaded %t:%s", ns, v, k)
-		}
+	f.mx.Lock()
+	ii := make(map[informerKey]*informer, len(f.informers))
+	for k, inf := range f.informers {
+		ii[k] = inf
+	}
+	f.mx.Unlock()
+
+	for k, inf := range ii {
+		ok := cache.WaitForCacheSync(inf.stopChan, inf.Informer().HasSynced)
+		log.Debug().Msgf("CACHE `%q Loaded %t:%s", k.ns, ok, k.gvr)
 	}
 }
 
+// CacheSizes returns the item counts of the informers caches.
+func (f *Factory) CacheSizes() []CacheSize {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	cc := make([]CacheSize, 0, len(f.informers))
+	for k, inf := range f.informers {
+		cc = append(cc, CacheSize{
+			Namespace: k.ns,
+			GVR:       k.gvr,
+			Items:     len(inf.Informer().GetStore().ListKeys()),
+		})
+	}
+	sort.Slice(cc, func(i, j int) bool {
+		if cc[i].GVR == cc[j].GVR {
+			return cc[i].Namespace < cc[j].Namespace
+		}
+		return cc[i].GVR < cc[j].GVR
+	})
+
+	return cc
+}
+
 // Client return the factory connection.
 func (f *Factory) Client() client.Connection {
 	return f.client
 }
 
-// FactoryFor returns a factory for a given namespace.
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
-}
-
 // SetActiveNS sets the active namespace.
 // BOZO!! Check ns access for resource??
 func (f *Factory) SetActiveNS(ns string) {
-	if !f.isClusterWide() {
-		f.ensureFactory(ns)
+	f.warm(ns)
+}
+
+// warm primes informers for the warm resources unless already cached.
+func (f *Factory) warm(ns string) {
+	if ns == clusterScope {
+		ns = allNamespaces
+	}
+	for _, gvr := range warmGVRs {
+		if f.hasInformer(allNamespaces, gvr) || f.hasInformer(ns, gvr) {
+			continue
+		}
+		if _, err := f.CanForResource(ns, gvr, ReadVerbs); err != nil {
+			log.Debug().Err(err).Msgf("Unable to warm %q:%q", ns, gvr)
+		}
 	}
 }
 
-func (f *Factory) isClusterWide() bool {
-	_, ok := f.factories[allNamespaces]
+func (f *Factory) hasInformer(ns, gvr string) bool {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	_, ok := f.informers[informerKey{ns: ns, gvr: gvr}]
 	return ok
 }
 
 // CanForResource return an informer is user has access.
 func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
-	// If user can access resource cluster wide, prefer cluster wide factory.
+	// If user can access resource cluster wide, prefer cluster wide informer.
 	if ns != allNamespaces {
 		auth, err := f.Client().CanI(allNamespaces, gvr, verbs)
 		if auth && err == nil {
@@ -163,59 +236,98 @@ func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.Gene
 	return f.ForResource(ns, gvr), nil
 }
 
-// ForResource returns an informer for a given resource.
+// ForResource returns an informer for a given resource. Informers are
+// created and started on first access.
 func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
-	fact := f.ensureFactory(ns)
-	inf := fact.ForResource(toGVR(gvr))
-	if inf == nil {
-		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
-		return inf
-	}
-	log.Debug().Msgf("FOR_RESOURCE %q:%q", ns, gvr)
-	fact.Start(f.stopChan)
-
-	return inf
-}
-
-func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
 	if ns == clusterScope {
 		ns = allNamespaces
 	}
-	if fac, ok := f.factories[ns]; ok {
-		return fac
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.clock++
+	key := informerKey{ns: ns, gvr: gvr}
+	if inf, ok := f.informers[key]; ok {
+		inf.used = f.clock
+		return inf.GenericInformer
 	}
 
-	log.Debug().Msgf("FACTORY_NEW for ns %q", ns)
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
-		f.client.DynDialOrDie(),
-		defaultResync,
+	log.Debug().Msgf("INFORMER_NEW %q:%q", ns, gvr)
+	inf := newInformer(di.NewFilteredDynamicInformer(
+		newTransformClient(f.dialFn()),
+		toGVR(gvr),
 		ns,
+		defaultResync,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 		nil,
-	)
+	))
+	inf.used = f.clock
+	f.informers[key] = inf
+	f.evict(key)
 
-	return f.factories[ns]
+	return inf.GenericInformer
+}
+
+// evict tears down the least recently used informers while over capacity.
+// Warm informers, the one being accessed and those backing a view are kept.
+func (f *Factory) evict(keep informerKey) {
+	if f.maxInformers <= 0 {
+		return
+	}
+	for len(f.informers) > f.maxInformers {
+		var (
+			victim informerKey
+			lru    *informer
+		)
+		for k, inf := range f.informers {
+			if k == keep || isWarm(k.gvr) || f.inUse(k.gvr) {
+				continue
+			}
+			if lru == nil || inf.used < lru.used {
+				victim, lru = k, inf
+			}
+		}
+		if lru == nil {
+			return
+		}
+		log.Debug().Msgf("INFORMER_EVICT %q:%q", victim.ns, victim.gvr)
+		lru.stop()
+		delete(f.informers, victim)
+	}
+}
+
+func (f *Factory) inUse(gvr string) bool {
+	return f.inUseFn != nil && f.inUseFn(gvr)
+}
+
+func isWarm(gvr string) bool {
+	for _, g := range warmGVRs {
+		if g == gvr {
+			return true
+		}
+	}
+
+	return false
 }
 
 // AddForwarder registers a new portforward for a given container.
 func (f *Factory) AddForwarder(pf Forwarder) {
-	f.forwarders[pf.Path()] = pf
+	f.forwarders.Add(pf)
 }
 
 // DeleteForwarder deletes portforward for a given container.
 func (f *Factory) DeleteForwarder(path string) {
-
 	f.forwarders.Dump()
 	count := f.forwarders.Kill(path)
 	log.Warn().Msgf("Deleted (%d) portforward for %q", count, path)
 }
 
 // Forwarders returns all portforwards.
-func (f *Factory) Forwarders() Forwarders {
+func (f *Factory) Forwarders() *Forwarders {
 	return f.forwarders
 }
 
 // ForwarderFor returns a portforward for a given container or nil if none exists.
 func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
-	fwd, ok := f.forwarders[path]
-	return fwd, ok
+	return f.forwarders.Get(path)
 }
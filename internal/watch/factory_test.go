@@ -0,0 +1,161 @@
+package watch
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestFactoryForResourceReuse(t *testing.T) {
+	f := newTestFactory(0, syntheticPod(1))
+	defer f.Terminate()
+
+	i1, i2 := f.ForResource("default", "v1/pods"), f.ForResource("default", "v1/pods")
+	assert.True(t, i1 == i2)
+	assert.Equal(t, 1, len(f.informers))
+
+	waitForCacheSync(i1)
+	oo, err := i1.Lister().ByNamespace("default").List(labels.Everything())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+}
+
+func TestFactoryCacheSizes(t *testing.T) {
+	f := newTestFactory(0, syntheticPod(1), syntheticPod(2))
+	defer f.Terminate()
+
+	f.ForResource("default", "v1/pods")
+	f.ForResource("default", "v1/configmaps")
+	f.WaitForCacheSync()
+
+	assert.Equal(t, []CacheSize{
+		{Namespace: "default", GVR: "v1/configmaps"},
+		{Namespace: "default", GVR: "v1/pods", Items: 2},
+	}, f.CacheSizes())
+}
+
+func TestFactoryEvict(t *testing.T) {
+	uu := map[string]struct {
+		max    int
+		inUse  []string
+		access []string
+		e      []string
+	}{
+		"uncapped": {
+			access: []string{"v1/pods", "v1/secrets", "apps/v1/deployments", "v1/configmaps"},
+			e:      []string{"v1/pods", "v1/secrets", "apps/v1/deployments", "v1/configmaps"},
+		},
+		"lru": {
+			max:    2,
+			access: []string{"v1/secrets", "apps/v1/deployments", "v1/secrets", "v1/configmaps"},
+			e:      []string{"v1/secrets", "v1/configmaps"},
+		},
+		"warm": {
+			max:    2,
+			access: []string{"v1/pods", "v1/nodes", "v1/secrets", "v1/configmaps"},
+			e:      []string{"v1/pods", "v1/nodes", "v1/configmaps"},
+		},
+		"inUse": {
+			max:    2,
+			inUse:  []string{"v1/secrets"},
+			access: []string{"v1/secrets", "apps/v1/deployments", "v1/configmaps"},
+			e:      []string{"v1/secrets", "v1/configmaps"},
+		},
+		"allInUse": {
+			max:    1,
+			inUse:  []string{"v1/secrets", "apps/v1/deployments"},
+			access: []string{"v1/secrets", "apps/v1/deployments", "v1/configmaps"},
+			e:      []string{"v1/secrets", "apps/v1/deployments", "v1/configmaps"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := newTestFactory(u.max)
+			defer f.Terminate()
+			f.SetInUseFunc(func(gvr string) bool {
+				return in(u.inUse, gvr)
+			})
+
+			for _, gvr := range u.access {
+				f.ForResource(allNamespaces, gvr)
+			}
+
+			assert.Equal(t, len(u.e), len(f.informers))
+			for _, gvr := range u.e {
+				assert.True(t, f.hasInformer(allNamespaces, gvr), gvr)
+			}
+		})
+	}
+}
+
+func TestFactoryTerminate(t *testing.T) {
+	count := runtime.NumGoroutine()
+	f := newTestFactory(0, syntheticPod(1))
+	for _, gvr := range []string{"v1/pods", "v1/secrets"} {
+		waitForCacheSync(f.ForResource("default", gvr))
+	}
+	f.Terminate()
+
+	assert.Equal(t, 0, len(f.informers))
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > count && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, runtime.NumGoroutine() <= count, "informers goroutines leaked")
+}
+
+func BenchmarkFactoryStartup(b *testing.B) {
+	kinds := make([]string, 0, 30)
+	for i := 0; i < 30; i++ {
+		kinds = append(kinds, fmt.Sprintf("blee.io/v1/kind%d", i))
+	}
+	uu := map[string][]string{
+		"eager": append(kinds, warmGVRs...),
+		"lazy":  warmGVRs,
+	}
+
+	for k, gvrs := range uu {
+		b.Run(k, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				f := newTestFactory(0, syntheticPod(1))
+				for _, gvr := range gvrs {
+					f.ForResource(allNamespaces, gvr)
+				}
+				f.WaitForCacheSync()
+				f.Terminate()
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newTestFactory(max int, oo ...kruntime.Object) *Factory {
+	c := fake.NewSimpleDynamicClient(kruntime.NewScheme(), oo...)
+	f := NewFactory(nil)
+	f.dialFn = func() dynamic.Interface { return c }
+	f.SetMaxInformers(max)
+
+	return f
+}
+
+func in(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
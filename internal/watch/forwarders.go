@@ -1,7 +1,9 @@
 package watch
 
 import (
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"k8s.io/client-go/tools/portforward"
@@ -29,49 +31,187 @@ type Forwarder interface {
 
 	// Age returns forwarder age.
 	Age() string
+
+	// TTL returns forwarder remaining time to live.
+	TTL() string
+
+	// Traffic returns forwarder bytes received and sent.
+	Traffic() (uint64, uint64)
+
+	// Expired returns the reason a forwarder should be stopped if any.
+	Expired() (string, bool)
 }
 
-// Forwarders tracks active port forwards.
-type Forwarders map[string]Forwarder
+// Forwarders tracks active port forwards. It is safe for concurrent use as
+// forwards are started, killed and listed from both the ui and their own
+// go routines.
+type Forwarders struct {
+	ff map[string]Forwarder
+	mx sync.RWMutex
+}
 
 // NewForwarders returns new forwarders.
-func NewForwarders() Forwarders {
-	return make(map[string]Forwarder)
+func NewForwarders() *Forwarders {
+	return &Forwarders{ff: make(map[string]Forwarder)}
+}
+
+// Add registers a port-forward keyed by its path.
+func (ff *Forwarders) Add(f Forwarder) {
+	ff.mx.Lock()
+	defer ff.mx.Unlock()
+
+	ff.ff[f.Path()] = f
+}
+
+// Remove deletes a port-forward without stopping it.
+func (ff *Forwarders) Remove(path string) bool {
+	ff.mx.Lock()
+	defer ff.mx.Unlock()
+
+	_, ok := ff.ff[path]
+	delete(ff.ff, path)
+
+	return ok
+}
+
+// Get returns the port-forward for a given path if any.
+func (ff *Forwarders) Get(path string) (Forwarder, bool) {
+	ff.mx.RLock()
+	defer ff.mx.RUnlock()
+
+	f, ok := ff.ff[path]
+	return f, ok
+}
+
+// List returns the port-forwards sorted by path.
+func (ff *Forwarders) List() []Forwarder {
+	ff.mx.RLock()
+	defer ff.mx.RUnlock()
+
+	kk := make([]string, 0, len(ff.ff))
+	for k := range ff.ff {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	fwds := make([]Forwarder, 0, len(kk))
+	for _, k := range kk {
+		fwds = append(fwds, ff.ff[k])
+	}
+
+	return fwds
+}
+
+// Paths returns the sorted port-forwards paths.
+func (ff *Forwarders) Paths() []string {
+	ff.mx.RLock()
+	defer ff.mx.RUnlock()
+
+	kk := make([]string, 0, len(ff.ff))
+	for k := range ff.ff {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+// Len returns the number of port-forwards.
+func (ff *Forwarders) Len() int {
+	ff.mx.RLock()
+	defer ff.mx.RUnlock()
+
+	return len(ff.ff)
 }
 
 // DeleteAll stops and delete all port-forwards.
-func (ff Forwarders) DeleteAll() {
-	for k, f := range ff {
+func (ff *Forwarders) DeleteAll() {
+	for _, f := range ff.drain(func(string, Forwarder) bool { return true }) {
 		log.Debug().Msgf("Deleting forwarder %s", f.Path())
 		f.Stop()
-		delete(ff, k)
 	}
 }
 
+// For returns the sorted port-forwards associated with a pod or container.
+func (ff *Forwarders) For(path string) []string {
+	ff.mx.RLock()
+	defer ff.mx.RUnlock()
+
+	kk := make([]string, 0, len(ff.ff))
+	for k := range ff.ff {
+		if isVictim(k, path) {
+			kk = append(kk, k)
+		}
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
 // Kill stops and delete a port-forwards associated with pod.
-func (ff Forwarders) Kill(path string) int {
-	hasContainer := strings.Contains(path, ":")
-	var stats int
-	for k, f := range ff {
-		victim := k
-		if !hasContainer {
-			victim = strings.Split(k, ":")[0]
+func (ff *Forwarders) Kill(path string) int {
+	victims := ff.drain(func(k string, _ Forwarder) bool {
+		return isVictim(k, path)
+	})
+	for k, f := range victims {
+		log.Debug().Msgf("Stop + Delete port-forward %s", k)
+		f.Stop()
+	}
+
+	return len(victims)
+}
+
+// Reap stops and deletes expired port-forwards. It returns the reasons
+// keyed by forwarder.
+func (ff *Forwarders) Reap() map[string]string {
+	reaped := make(map[string]string)
+	expired := ff.drain(func(k string, f Forwarder) bool {
+		reason, ok := f.Expired()
+		if ok {
+			reaped[k] = reason
 		}
-		if victim == path {
-			stats++
-			log.Debug().Msgf("Stop + Delete port-forward %s", k)
-			f.Stop()
-			delete(ff, k)
+		return ok
+	})
+	for k, f := range expired {
+		log.Debug().Msgf("Reaping port-forward %s -- %s", k, reaped[k])
+		f.Stop()
+	}
+
+	return reaped
+}
+
+// drain removes the port-forwards matching a predicate and returns them so
+// they get stopped outside the lock.
+func (ff *Forwarders) drain(match func(string, Forwarder) bool) map[string]Forwarder {
+	ff.mx.Lock()
+	defer ff.mx.Unlock()
+
+	mm := make(map[string]Forwarder)
+	for k, f := range ff.ff {
+		if match(k, f) {
+			mm[k] = f
+			delete(ff.ff, k)
 		}
 	}
 
-	return stats
+	return mm
+}
+
+// isVictim checks if a port-forward targets a pod or container path.
+func isVictim(k, path string) bool {
+	if !strings.Contains(path, ":") {
+		k = strings.Split(k, ":")[0]
+	}
+
+	return k == path
 }
 
 // Dump for debug!
-func (ff Forwarders) Dump() {
+func (ff *Forwarders) Dump() {
+	ff.mx.RLock()
+	defer ff.mx.RUnlock()
+
 	log.Debug().Msgf("----------- PORT-FORWARDS --------------")
-	for k, f := range ff {
+	for k, f := range ff.ff {
 		log.Debug().Msgf("  %s -- %#v", k, f)
 	}
 }
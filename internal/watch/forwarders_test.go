@@ -0,0 +1,123 @@
+package watch
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/portforward"
+)
+
+func TestForwardersRegistry(t *testing.T) {
+	ff := NewForwarders()
+	f1, f2, f3 := newTestForward("default/p1:c1"), newTestForward("default/p1:c2"), newTestForward("default/p2:c1")
+	ff.Add(f3)
+	ff.Add(f1)
+	ff.Add(f2)
+
+	assert.Equal(t, 3, ff.Len())
+	assert.Equal(t, []string{"default/p1:c1", "default/p1:c2", "default/p2:c1"}, ff.Paths())
+	assert.Equal(t, []Forwarder{f1, f2, f3}, ff.List())
+	assert.Equal(t, []string{"default/p1:c1", "default/p1:c2"}, ff.For("default/p1"))
+
+	f, ok := ff.Get("default/p2:c1")
+	assert.True(t, ok)
+	assert.Equal(t, f3, f)
+
+	assert.True(t, ff.Remove("default/p2:c1"))
+	assert.False(t, ff.Remove("default/p2:c1"))
+	assert.False(t, f3.isStopped())
+
+	assert.Equal(t, 2, ff.Kill("default/p1"))
+	assert.True(t, f1.isStopped())
+	assert.True(t, f2.isStopped())
+	assert.Equal(t, 0, ff.Len())
+}
+
+func TestForwardersReap(t *testing.T) {
+	ff := NewForwarders()
+	f1, f2 := newTestForward("default/p1:c1"), newTestForward("default/p2:c1")
+	f2.expired = "idle for 5m0s"
+	ff.Add(f1)
+	ff.Add(f2)
+
+	assert.Equal(t, map[string]string{"default/p2:c1": "idle for 5m0s"}, ff.Reap())
+	assert.False(t, f1.isStopped())
+	assert.True(t, f2.isStopped())
+	assert.Equal(t, []string{"default/p1:c1"}, ff.Paths())
+}
+
+// Run with -race to check the registry guards concurrent add, kill and list.
+func TestForwardersConcurrent(t *testing.T) {
+	const workers, count = 4, 50
+
+	ff := NewForwarders()
+	var wg sync.WaitGroup
+	wg.Add(3 * workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				ff.Add(newTestForward(fmt.Sprintf("default/p%d-%d:c1", w, i)))
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				ff.Kill(fmt.Sprintf("default/p%d-%d", w, i))
+				ff.Reap()
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				for _, f := range ff.List() {
+					_ = f.Path()
+				}
+				_, _ = ff.Get("default/p0-0:c1")
+				_ = ff.Len()
+			}
+		}()
+	}
+	wg.Wait()
+
+	ff.DeleteAll()
+	assert.Equal(t, 0, ff.Len())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type testForward struct {
+	path    string
+	expired string
+	stopped bool
+	mx      sync.Mutex
+}
+
+func newTestForward(path string) *testForward {
+	return &testForward{path: path}
+}
+
+func (f *testForward) Start(string, string, string, []string) (*portforward.PortForwarder, error) {
+	return nil, nil
+}
+func (f *testForward) Stop() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.stopped = true
+}
+func (f *testForward) isStopped() bool {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	return f.stopped
+}
+func (f *testForward) Path() string              { return f.path }
+func (f *testForward) Container() string         { return "c1" }
+func (f *testForward) Ports() []string           { return nil }
+func (f *testForward) Active() bool              { return true }
+func (f *testForward) Age() string               { return "" }
+func (f *testForward) TTL() string               { return "" }
+func (f *testForward) Traffic() (uint64, uint64) { return 0, 0 }
+func (f *testForward) Expired() (string, bool)   { return f.expired, f.expired != "" }
@@ -29,9 +29,12 @@ func namespaced(n string) (string, string) {
 
 // Dump for debug.
 func Dump(f *Factory) {
-	log.Debug().Msgf("----------- FACTORIES -------------")
-	for ns := range f.factories {
-		log.Debug().Msgf("  Factory for NS %q", ns)
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	log.Debug().Msgf("----------- INFORMERS -------------")
+	for k, inf := range f.informers {
+		log.Debug().Msgf("  Informer for %q:%q (%d)", k.ns, k.gvr, inf.used)
 	}
 	log.Debug().Msgf("-----------------------------------")
 }
@@ -39,11 +42,12 @@ func Dump(f *Factory) {
 // Debug for debug.
 func Debug(f *Factory, ns string, gvr string) {
 	log.Debug().Msgf("----------- DEBUG FACTORY (%s) -------------", gvr)
-	fac, ok := f.factories[ns]
+	f.mx.Lock()
+	inf, ok := f.informers[informerKey{ns: ns, gvr: gvr}]
+	f.mx.Unlock()
 	if !ok {
 		return
 	}
-	inf := fac.ForResource(toGVR(gvr))
 	for i, k := range inf.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("%d -- %s", i, k)
 	}
@@ -0,0 +1,31 @@
+package watch
+
+import (
+	"k8s.io/client-go/informers"
+)
+
+type informerKey struct {
+	ns, gvr string
+}
+
+// informer tracks a running resource informer.
+type informer struct {
+	informers.GenericInformer
+
+	stopChan chan struct{}
+	used     uint64
+}
+
+func newInformer(inf informers.GenericInformer) *informer {
+	i := informer{
+		GenericInformer: inf,
+		stopChan:        make(chan struct{}),
+	}
+	go i.Informer().Run(i.stopChan)
+
+	return &i
+}
+
+func (i *informer) stop() {
+	close(i.stopChan)
+}
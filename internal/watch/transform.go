@@ -0,0 +1,102 @@
+package watch
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	wa "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+const lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+// transformClient strips unrendered heavy fields from the listed and watched
+// resources before they land in the informers cache. Views needing the full
+// object must fetch it live.
+type transformClient struct {
+	dynamic.Interface
+}
+
+func newTransformClient(c dynamic.Interface) dynamic.Interface {
+	return transformClient{Interface: c}
+}
+
+// Resource returns a transforming resource client.
+func (c transformClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return transformNamespaceable{
+		NamespaceableResourceInterface: c.Interface.Resource(gvr),
+	}
+}
+
+type transformNamespaceable struct {
+	dynamic.NamespaceableResourceInterface
+}
+
+// Namespace returns a transforming namespaced resource client.
+func (t transformNamespaceable) Namespace(ns string) dynamic.ResourceInterface {
+	return transformResource{
+		ResourceInterface: t.NamespaceableResourceInterface.Namespace(ns),
+	}
+}
+
+// List lists stripped resources.
+func (t transformNamespaceable) List(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return stripList(t.NamespaceableResourceInterface.List(opts))
+}
+
+// Watch watches stripped resources.
+func (t transformNamespaceable) Watch(opts metav1.ListOptions) (wa.Interface, error) {
+	return stripWatch(t.NamespaceableResourceInterface.Watch(opts))
+}
+
+type transformResource struct {
+	dynamic.ResourceInterface
+}
+
+// List lists stripped resources.
+func (t transformResource) List(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return stripList(t.ResourceInterface.List(opts))
+}
+
+// Watch watches stripped resources.
+func (t transformResource) Watch(opts metav1.ListOptions) (wa.Interface, error) {
+	return stripWatch(t.ResourceInterface.Watch(opts))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func stripList(l *unstructured.UnstructuredList, err error) (*unstructured.UnstructuredList, error) {
+	if err != nil {
+		return nil, err
+	}
+	for i := range l.Items {
+		stripObject(&l.Items[i])
+	}
+
+	return l, nil
+}
+
+func stripWatch(w wa.Interface, err error) (wa.Interface, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return wa.Filter(w, func(e wa.Event) (wa.Event, bool) {
+		if u, ok := e.Object.(*unstructured.Unstructured); ok {
+			stripObject(u)
+		}
+		return e, true
+	}), nil
+}
+
+// stripObject removes the managed fields and last applied configuration that
+// often weigh more than the rest of the resource. The object is edited in
+// place and must be freshly decoded ie not shared with another reader.
+func stripObject(u *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(u.Object, "metadata", "annotations", lastAppliedKey)
+	if aa, ok, _ := unstructured.NestedMap(u.Object, "metadata", "annotations"); ok && len(aa) == 0 {
+		unstructured.RemoveNestedField(u.Object, "metadata", "annotations")
+	}
+}
@@ -0,0 +1,231 @@
+package watch
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	wa "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	ktesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestStripObject(t *testing.T) {
+	uu := map[string]struct {
+		o  *unstructured.Unstructured
+		aa map[string]string
+	}{
+		"heavy": {
+			o:  syntheticPod(1),
+			aa: map[string]string{"team": "blee"},
+		},
+		"lastAppliedOnly": {
+			o: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":        "p1",
+					"annotations": map[string]interface{}{lastAppliedKey: "{}"},
+				},
+			}},
+		},
+		"light": {
+			o: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "p1"},
+			}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			stripObject(u.o)
+			assert.Nil(t, u.o.GetManagedFields())
+			assert.Equal(t, u.aa, u.o.GetAnnotations())
+			_, ok, _ := unstructured.NestedFieldNoCopy(u.o.Object, "metadata", "annotations")
+			assert.Equal(t, u.aa != nil, ok)
+		})
+	}
+}
+
+func TestTransformClientList(t *testing.T) {
+	c := newTransformClient(fake.NewSimpleDynamicClient(kruntime.NewScheme(), syntheticPod(1), syntheticPod(2)))
+
+	l, err := c.Resource(toGVR("v1/pods")).Namespace("default").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(l.Items))
+	for _, o := range l.Items {
+		assert.Nil(t, o.GetManagedFields())
+		assert.NotContains(t, o.GetAnnotations(), lastAppliedKey)
+	}
+}
+
+func TestTransformClientWatch(t *testing.T) {
+	// The fake tracker hands out the objects it stores. Feed the watch
+	// objects of its own as an api server decode would.
+	fw := wa.NewFake()
+	f := fake.NewSimpleDynamicClient(kruntime.NewScheme())
+	f.PrependWatchReactor("*", func(ktesting.Action) (bool, wa.Interface, error) {
+		return true, fw, nil
+	})
+	c := newTransformClient(f)
+	res := c.Resource(toGVR("v1/pods")).Namespace("default")
+
+	w, err := res.Watch(metav1.ListOptions{})
+	assert.Nil(t, err)
+	defer w.Stop()
+	go fw.Add(syntheticPod(1))
+
+	e := <-w.ResultChan()
+	o, ok := e.Object.(*unstructured.Unstructured)
+	assert.True(t, ok)
+	assert.Equal(t, "nginx-1", o.GetName())
+	assert.Nil(t, o.GetManagedFields())
+	assert.NotContains(t, o.GetAnnotations(), lastAppliedKey)
+}
+
+func TestStripRender(t *testing.T) {
+	full, stripped := syntheticPod(1), syntheticPod(1)
+	stripObject(stripped)
+
+	var po render.Pod
+	hh := po.Header("")
+	r1, r2 := render.NewRow(len(hh)), render.NewRow(len(hh))
+	assert.Nil(t, po.Render(&render.PodWithMetrics{Raw: full}, "", &r1))
+	assert.Nil(t, po.Render(&render.PodWithMetrics{Raw: stripped}, "", &r2))
+	for i, h := range hh {
+		if h.Name == "AGE" {
+			r1.Fields[i], r2.Fields[i] = "", ""
+		}
+	}
+	assert.Equal(t, r1, r2)
+
+	s1, err := render.PodSummary(full)
+	assert.Nil(t, err)
+	s2, err := render.PodSummary(stripped)
+	assert.Nil(t, err)
+	assert.Equal(t, s1, s2)
+}
+
+func BenchmarkCacheFootprint(b *testing.B) {
+	uu := map[string]bool{
+		"raw":      false,
+		"stripped": true,
+	}
+
+	for k, strip := range uu {
+		b.Run(k, func(b *testing.B) {
+			b.ReportAllocs()
+			var heap uint64
+			for i := 0; i < b.N; i++ {
+				heap = cacheFootprint(10000, strip)
+			}
+			b.ReportMetric(float64(heap), "heap-B")
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// cacheFootprint returns the heap used by an informer store holding count
+// synthetic pods.
+func cacheFootprint(count int, strip bool) uint64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	s := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for i := 0; i < count; i++ {
+		o := syntheticPod(i)
+		if strip {
+			stripObject(o)
+		}
+		_ = s.Add(o)
+	}
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(s)
+	if after.HeapAlloc < before.HeapAlloc {
+		return 0
+	}
+
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+// syntheticPod returns a pod carrying managed fields and a last applied
+// configuration as kubectl apply and server side apply would.
+func syntheticPod(i int) *unstructured.Unstructured {
+	env := make([]interface{}, 0, 10)
+	for j := 0; j < 10; j++ {
+		env = append(env, map[string]interface{}{
+			"name":  fmt.Sprintf("ENV_%d", j),
+			"value": fmt.Sprintf("value-%d", j),
+		})
+	}
+	fields := make(map[string]interface{}, 20)
+	for j := 0; j < 20; j++ {
+		fields[fmt.Sprintf(`k:{"name":"ENV_%d"}`, j)] = map[string]interface{}{
+			".":       map[string]interface{}{},
+			"f:name":  map[string]interface{}{},
+			"f:value": map[string]interface{}{},
+		}
+	}
+	managed := make([]interface{}, 0, 3)
+	for _, m := range []string{"kubectl", "kube-controller-manager", "kubelet"} {
+		managed = append(managed, map[string]interface{}{
+			"manager":    m,
+			"operation":  "Update",
+			"apiVersion": "v1",
+			"time":       "2020-01-01T00:00:00Z",
+			"fieldsType": "FieldsV1",
+			"fieldsV1": map[string]interface{}{
+				"f:spec": map[string]interface{}{
+					"f:containers": map[string]interface{}{
+						`k:{"name":"nginx"}`: map[string]interface{}{"f:env": fields},
+					},
+				},
+			},
+		})
+	}
+	container := map[string]interface{}{
+		"name":  "nginx",
+		"image": "nginx:1.17",
+		"env":   env,
+		"resources": map[string]interface{}{
+			"requests": map[string]interface{}{"cpu": "10m", "memory": "10Mi"},
+		},
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":              fmt.Sprintf("nginx-%d", i),
+			"namespace":         "default",
+			"creationTimestamp": "2020-01-01T00:00:00Z",
+			"labels":            map[string]interface{}{"app": "nginx"},
+			"annotations": map[string]interface{}{
+				"team":         "blee",
+				lastAppliedKey: fmt.Sprintf(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"nginx-%d"},"spec":{"containers":[%v]}}`, i, container),
+			},
+			"managedFields": managed,
+		},
+		"spec": map[string]interface{}{
+			"nodeName":   "n1",
+			"containers": []interface{}{container},
+		},
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"podIP": "10.0.0.1",
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "nginx", "ready": true, "restartCount": int64(0)},
+			},
+		},
+	}}
+}
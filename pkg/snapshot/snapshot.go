@@ -0,0 +1,197 @@
+// Package snapshot captures resource tables as K9s displays them, without a
+// terminal. It is meant for tooling such as scheduled cluster reports.
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/gdamore/tcell"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// Options represents the snapshot connection options.
+type Options struct {
+	// KubeConfig is the kubeconfig path. Blank uses the default loading rules.
+	KubeConfig string
+
+	// Context is the kubeconfig context. Blank uses the current context.
+	Context string
+
+	// Namespace to snapshot. Blank means all namespaces.
+	Namespace string
+}
+
+// Column represents a table column.
+type Column struct {
+	Name string `json:"name"`
+	Wide bool   `json:"wide,omitempty"`
+}
+
+// Row represents a table row and its severity as colored by K9s.
+type Row struct {
+	ID       string   `json:"id"`
+	Fields   []string `json:"fields"`
+	Severity string   `json:"severity"`
+}
+
+// Table represents a resource table snapshot.
+type Table struct {
+	GVR       string   `json:"gvr"`
+	Namespace string   `json:"namespace"`
+	Header    []Column `json:"header"`
+	Rows      []Row    `json:"rows"`
+}
+
+var colorsOnce sync.Once
+
+// Take snapshots a resource given its alias, short name or fully qualified
+// gvr ie po, deploy or apps/v1/deployments. The first snapshot assigns the
+// render package row colors process wide so rows can be classified.
+func Take(ctx context.Context, opts Options, resource string) (*Table, error) {
+	flags := genericclioptions.NewConfigFlags(false)
+	if opts.KubeConfig != "" {
+		flags.KubeConfig = &opts.KubeConfig
+	}
+	if opts.Context != "" {
+		flags.Context = &opts.Context
+	}
+	cfg := client.NewConfig(flags)
+	// Resolve the rest config upfront so connection errors are reported
+	// instead of panicking down the line.
+	if _, err := cfg.RESTConfig(); err != nil {
+		return nil, err
+	}
+	conn := client.InitConnectionOrDie(cfg)
+	if _, err := conn.ServerVersion(); err != nil {
+		return nil, err
+	}
+
+	f := watch.NewFactory(conn)
+	f.Start(opts.Namespace)
+	defer f.Terminate()
+
+	gvr, err := resolve(f, resource)
+	if err != nil {
+		return nil, err
+	}
+	// Prime the informers so the snapshot is taken off a synced cache.
+	if _, err := snap(ctx, f, gvr, opts.Namespace); err != nil {
+		return nil, err
+	}
+	synced := make(chan struct{})
+	go func() {
+		f.WaitForCacheSync()
+		close(synced)
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-synced:
+	}
+
+	return snap(ctx, f, gvr, opts.Namespace)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func resolve(f dao.Factory, resource string) (string, error) {
+	aliases, err := dao.NewAlias(f).Ensure()
+	if err != nil {
+		return "", err
+	}
+	if gvr, ok := aliases[resource]; ok {
+		return gvr, nil
+	}
+	if strings.Contains(resource, "/") {
+		if _, err := dao.MetaFor(client.NewGVR(resource)); err == nil {
+			return resource, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown resource %q", resource)
+}
+
+// snap hydrates a resource table the same way the K9s views do.
+func snap(ctx context.Context, f dao.Factory, gvr, ns string) (*Table, error) {
+	colorsOnce.Do(initColors)
+
+	if meta, err := dao.MetaFor(client.NewGVR(gvr)); err == nil && !meta.Namespaced {
+		ns = render.ClusterScope
+	}
+	m := model.NewTable(gvr)
+	m.SetNamespace(ns)
+
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr)
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyNamespace, ns)
+	if err := m.Reconcile(ctx); err != nil {
+		return nil, err
+	}
+
+	meta, ok := model.Registry[gvr]
+	if !ok || meta.Renderer == nil {
+		meta.Renderer = &render.Generic{}
+	}
+
+	return toTable(gvr, m.Peek(), meta.Renderer.ColorerFunc()), nil
+}
+
+// toTable converts table data as displayed. Rows are sorted by the first
+// column and fields are decorated as per their headers.
+func toTable(gvr string, data render.TableData, colorer render.ColorerFunc) *Table {
+	t := Table{
+		GVR:       gvr,
+		Namespace: data.Namespace,
+		Header:    make([]Column, 0, len(data.Header)),
+		Rows:      make([]Row, 0, len(data.RowEvents)),
+	}
+	for _, h := range data.Header {
+		t.Header = append(t.Header, Column{Name: h.Name, Wide: h.Wide})
+	}
+	if len(data.RowEvents) > 0 {
+		data.RowEvents.Sort(data.Namespace, 0, true)
+	}
+	for _, re := range data.RowEvents {
+		re.Kind = render.EventUnchanged
+		ff := make([]string, len(re.Row.Fields))
+		for i, f := range re.Row.Fields {
+			if i < len(data.Header) && data.Header[i].Decorator != nil {
+				f = data.Header[i].Decorator(f)
+			}
+			ff[i] = f
+		}
+		t.Rows = append(t.Rows, Row{
+			ID:       re.Row.ID,
+			Fields:   ff,
+			Severity: render.Severity(colorer(data.Namespace, re)),
+		})
+	}
+
+	return &t
+}
+
+// initColors assigns distinct row colors so rows can be classified. Colors
+// are otherwise assigned by the terminal skin. NOTE: the render colors are
+// package globals so this affects the whole process.
+func initColors() {
+	render.StdColor = tcell.ColorWhite
+	render.AddColor = tcell.ColorBlue
+	render.ModColor = tcell.ColorGreen
+	render.ErrColor = tcell.ColorRed
+	render.HighlightColor = tcell.ColorAqua
+	render.CompletedColor = tcell.ColorGray
+	render.KillColor = tcell.ColorPurple
+	render.EvictedColor = tcell.ColorOrangeRed
+}
@@ -0,0 +1,165 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const kubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: fred
+  cluster:
+    server: https://127.0.0.1:1
+contexts:
+- name: fred
+  context:
+    cluster: fred
+    user: fred
+current-context: fred
+users:
+- name: fred
+  user:
+    token: blee
+`
+
+func init() {
+	zerolog.SetGlobalLevel(zerolog.FatalLevel)
+}
+
+func TestTakeBadContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := filepath.Join(dir, "config")
+	assert.Nil(t, ioutil.WriteFile(cfg, []byte(kubeConfig), 0600))
+
+	_, err = Take(context.Background(), Options{KubeConfig: cfg, Context: "nope"}, "po")
+	assert.EqualError(t, err, `context "nope" does not exist`)
+}
+
+func TestSnap(t *testing.T) {
+	f := testFactory{objects: map[string][]runtime.Object{
+		"v1/pods":             {load(t, "po"), load(t, "po_toast")},
+		"apps/v1/deployments": {load(t, "dp")},
+	}}
+
+	uu := map[string]struct {
+		gvr, ns string
+	}{
+		"pods":        {gvr: "v1/pods", ns: "default"},
+		"deployments": {gvr: "apps/v1/deployments"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tbl, err := snap(context.Background(), f, u.gvr, u.ns)
+			assert.Nil(t, err)
+			blankAges(tbl)
+			actual, err := json.MarshalIndent(tbl, "", "  ")
+			assert.Nil(t, err)
+
+			golden := filepath.Join("testdata", k+".golden")
+			if *update {
+				assert.Nil(t, ioutil.WriteFile(golden, actual, 0644))
+			}
+			expected, err := ioutil.ReadFile(golden)
+			assert.Nil(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestToTableSeverity(t *testing.T) {
+	initColors()
+	data := render.TableData{
+		Header: render.HeaderRow{render.Header{Name: "NAME"}},
+		RowEvents: render.RowEvents{
+			{Kind: render.EventAdd, Row: render.Row{ID: "r2", Fields: render.Fields{"r2"}}},
+			{Kind: render.EventAdd, Row: render.Row{ID: "r1", Fields: render.Fields{"r1"}}},
+		},
+	}
+	colorer := func(ns string, re render.RowEvent) tcell.Color {
+		if re.Row.ID == "r1" {
+			return render.ErrColor
+		}
+		return render.DefaultColorer(ns, re)
+	}
+
+	tbl := toTable("v1/fred", data, colorer)
+	assert.Equal(t, []Row{
+		{ID: "r1", Fields: []string{"r1"}, Severity: render.SeverityError},
+		{ID: "r2", Fields: []string{"r2"}, Severity: render.SeverityOK},
+	}, tbl.Rows)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func blankAges(t *Table) {
+	for i, c := range t.Header {
+		if c.Name != "AGE" {
+			continue
+		}
+		for _, r := range t.Rows {
+			r.Fields[i] = "<age>"
+		}
+	}
+}
+
+func load(t *testing.T, n string) *unstructured.Unstructured {
+	raw, err := ioutil.ReadFile(fmt.Sprintf("testdata/%s.json", n))
+	assert.Nil(t, err)
+	var o unstructured.Unstructured
+	assert.Nil(t, json.Unmarshal(raw, &o))
+
+	return &o
+}
+
+type testFactory struct {
+	objects map[string][]runtime.Object
+}
+
+var _ dao.Factory = testFactory{}
+
+func (f testFactory) Client() client.Connection {
+	return nil
+}
+func (f testFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	return nil, nil
+}
+func (f testFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	return f.objects[gvr], nil
+}
+func (f testFactory) ForResource(ns, gvr string) informers.GenericInformer {
+	return nil
+}
+func (f testFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
+	return nil, nil
+}
+func (f testFactory) WaitForCacheSync() {}
+func (f testFactory) Forwarders() *watch.Forwarders {
+	return watch.NewForwarders()
+}
+func (f testFactory) DeleteForwarder(string) {}